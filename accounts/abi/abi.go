@@ -112,6 +112,9 @@ func (abi *ABI) UnmarshalJSON(data []byte) error {
 		Name      string
 		Constant  bool
 		Anonymous bool
+
+		// Solidity 0.6 and later drop the constant flag in favour of the state mutability
+		StateMutability string
 		Inputs    []Argument
 		Outputs   []Argument
 	}
@@ -137,7 +140,7 @@ func (abi *ABI) UnmarshalJSON(data []byte) error {
 			abi.Methods[name] = Method{
 				Name:    name,
 				RawName: field.Name,
-				Const:   field.Constant,
+				Const:   field.Constant || field.StateMutability == "view" || field.StateMutability == "pure",
 				Inputs:  field.Inputs,
 				Outputs: field.Outputs,
 			}
@@ -98,6 +98,24 @@ func TestReader(t *testing.T) {
 	}
 }
 
+func TestReaderStateMutability(t *testing.T) {
+	const definition = `[
+	{ "type" : "function", "name" : "balance", "stateMutability" : "view", "inputs" : [], "outputs" : [] },
+	{ "type" : "function", "name" : "hash", "stateMutability" : "pure", "inputs" : [], "outputs" : [] },
+	{ "type" : "function", "name" : "send", "stateMutability" : "nonpayable", "inputs" : [], "outputs" : [] },
+	{ "type" : "function", "name" : "deposit", "stateMutability" : "payable", "inputs" : [], "outputs" : [] }
+]`
+	abi, err := JSON(strings.NewReader(definition))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, want := range map[string]bool{"balance": true, "hash": true, "send": false, "deposit": false} {
+		if have := abi.Methods[name].Const; have != want {
+			t.Errorf("%s: constant mismatch: have %v, want %v", name, have, want)
+		}
+	}
+}
+
 func TestTestNumbers(t *testing.T) {
 	abi, err := JSON(strings.NewReader(jsondata2))
 	if err != nil {
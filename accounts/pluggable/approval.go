@@ -0,0 +1,110 @@
+package pluggable
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	plugin "github.com/ethereum/go-ethereum/plugin/account"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var ErrUnknownTicket = errors.New("unknown approval ticket")
+
+// PendingApprovalError is returned when signing a transaction which the plugin holds until it is approved out
+// of band. The transaction is signed once approved, see Backend.Approval.
+type PendingApprovalError struct {
+	TicketID string `json:"ticketId"`
+}
+
+func (e *PendingApprovalError) Error() string {
+	return fmt.Sprintf("transaction signing pending approval, ticket %s", e.TicketID)
+}
+
+// ErrorData returns the ticket as the data of the RPC error
+func (e *PendingApprovalError) ErrorData() interface{} {
+	return e
+}
+
+// Approval is the status of a transaction awaiting approval
+type Approval struct {
+	Status  plugin.ApprovalStatus
+	Account accounts.Account
+	// signed once approved
+	Tx *types.Transaction
+	// reason of the rejection
+	Reason string
+}
+
+// approvalRecord is a transaction awaiting approval as kept on disk
+type approvalRecord struct {
+	From    common.Address `json:"from"`
+	Tx      hexutil.Bytes  `json:"tx"` // RLP of the unsigned transaction
+	ChainID *hexutil.Big   `json:"chainId,omitempty"`
+}
+
+// loadApprovals reads the transactions awaiting approval kept in the file, none if there is no file
+func loadApprovals(path string) (map[string]*pendingTx, error) {
+	pending := make(map[string]*pendingTx)
+	if path == "" {
+		return pending, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return pending, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var records map[string]*approvalRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("invalid approvals file %s: %v", path, err)
+	}
+	for ticketID, r := range records {
+		tx := new(types.Transaction)
+		if err := rlp.DecodeBytes(r.Tx, tx); err != nil {
+			return nil, fmt.Errorf("invalid transaction of ticket %s in %s: %v", ticketID, path, err)
+		}
+		pending[ticketID] = &pendingTx{
+			account: accounts.Account{Address: r.From},
+			tx:      tx,
+			chainID: (*big.Int)(r.ChainID),
+		}
+	}
+	return pending, nil
+}
+
+// saveApprovals replaces the file with the transactions awaiting approval so that their tickets survive a restart
+func saveApprovals(path string, pending map[string]*pendingTx) error {
+	if path == "" {
+		return nil
+	}
+	records := make(map[string]*approvalRecord, len(pending))
+	for ticketID, p := range pending {
+		enc, err := rlp.EncodeToBytes(p.tx)
+		if err != nil {
+			return err
+		}
+		records[ticketID] = &approvalRecord{From: p.account.Address, Tx: enc, ChainID: (*hexutil.Big)(p.chainID)}
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/event"
 	plugin "github.com/ethereum/go-ethereum/plugin/account"
 )
@@ -15,7 +16,9 @@ type Backend struct {
 	wallets []accounts.Wallet
 }
 
-func NewBackend() *Backend {
+// NewBackend returns the backend of the account plugin. The transactions awaiting approval are kept in the
+// approvalsPath file so that their tickets survive a restart, in memory only if it is empty.
+func NewBackend(approvalsPath string) *Backend {
 	return &Backend{
 		wallets: []accounts.Wallet{
 			&wallet{
@@ -23,6 +26,7 @@ func NewBackend() *Backend {
 					Scheme: "plugin",
 					Path:   "account",
 				},
+				approvalsPath: approvalsPath,
 			},
 		},
 	}
@@ -46,6 +50,28 @@ func (b *Backend) SetPluginService(s plugin.Service) error {
 	return b.wallet().setPluginService(s)
 }
 
+// SetApprovalService makes the transactions signed subject to the signing policy of the plugin
+func (b *Backend) SetApprovalService(s plugin.ApprovalService) error {
+	return b.wallet().setApprovalService(s)
+}
+
+// Approval returns the status of the approval of a transaction signing which returned a PendingApprovalError,
+// with the transaction signed once approved
+func (b *Backend) Approval(ticketID string) (*Approval, error) {
+	return b.wallet().approval(ticketID)
+}
+
+// PendingNonce returns the nonce following the transactions of the account awaiting approval, which are not
+// in the transaction pool yet. It returns false if the account has none.
+func (b *Backend) PendingNonce(address common.Address) (uint64, bool) {
+	return b.wallet().pendingNonce(address)
+}
+
+// ForgetApproval drops the transaction of a ticket once approved and submitted, or rejected
+func (b *Backend) ForgetApproval(ticketID string) {
+	b.wallet().forgetApproval(ticketID)
+}
+
 func (b *Backend) TimedUnlock(account accounts.Account, password string, duration time.Duration) error {
 	return b.wallet().timedUnlock(account, password, duration)
 }
@@ -11,7 +11,7 @@ import (
 )
 
 func TestBackend_Subscribe_NoOp(t *testing.T) {
-	b := NewBackend()
+	b := NewBackend("")
 
 	subscriber := make(chan accounts.WalletEvent, 4)
 	sub := b.Subscribe(subscriber)
@@ -38,7 +38,7 @@ func TestBackend_Wallets_ReturnsCopy(t *testing.T) {
 		},
 	}
 
-	b := NewBackend()
+	b := NewBackend("")
 	b.wallets = wallets
 
 	got := b.Wallets()
@@ -64,7 +64,7 @@ func TestBackend_TimedUnlock(t *testing.T) {
 		TimedUnlock(gomock.Any(), gomock.Eq(acct1), gomock.Eq("pwd"), gomock.Eq(time.Minute)).
 		Return(nil)
 
-	b := NewBackend()
+	b := NewBackend("")
 	b.wallets[0].(*wallet).pluginService = mockClient
 
 	err := b.TimedUnlock(acct1, "pwd", time.Minute)
@@ -81,7 +81,7 @@ func TestBackend_Lock(t *testing.T) {
 		Lock(gomock.Any(), gomock.Eq(acct1)).
 		Return(nil)
 
-	b := NewBackend()
+	b := NewBackend("")
 	b.wallets[0].(*wallet).pluginService = mockClient
 
 	err := b.Lock(acct1)
@@ -101,7 +101,7 @@ func TestBackend_NewAccount(t *testing.T) {
 		NewAccount(gomock.Any(), gomock.Eq(newAccountConfig)).
 		Return(newAccount, nil)
 
-	b := NewBackend()
+	b := NewBackend("")
 	b.wallets[0].(*wallet).pluginService = mockClient
 
 	got, err := b.NewAccount(newAccountConfig)
@@ -122,7 +122,7 @@ func TestBackend_ImportRawKey(t *testing.T) {
 		ImportRawKey(gomock.Any(), gomock.Eq("rawkey"), gomock.Eq(newAccountConfig)).
 		Return(newAccount, nil)
 
-	b := NewBackend()
+	b := NewBackend("")
 	b.wallets[0].(*wallet).pluginService = mockClient
 
 	got, err := b.ImportRawKey("rawkey", newAccountConfig)
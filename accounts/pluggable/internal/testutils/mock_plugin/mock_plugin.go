@@ -1,18 +1,75 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/ethereum/go-ethereum/plugin/account (interfaces: Service)
+// Source: github.com/ethereum/go-ethereum/plugin/account (interfaces: ApprovalService,Service)
 
 // Package mock_plugin is a generated GoMock package.
 package mock_plugin
 
 import (
 	context "context"
+	big "math/big"
 	reflect "reflect"
 	time "time"
 
 	accounts "github.com/ethereum/go-ethereum/accounts"
+	types "github.com/ethereum/go-ethereum/core/types"
+	account "github.com/ethereum/go-ethereum/plugin/account"
 	gomock "github.com/golang/mock/gomock"
 )
 
+// MockApprovalService is a mock of ApprovalService interface
+type MockApprovalService struct {
+	ctrl     *gomock.Controller
+	recorder *MockApprovalServiceMockRecorder
+}
+
+// MockApprovalServiceMockRecorder is the mock recorder for MockApprovalService
+type MockApprovalServiceMockRecorder struct {
+	mock *MockApprovalService
+}
+
+// NewMockApprovalService creates a new mock instance
+func NewMockApprovalService(ctrl *gomock.Controller) *MockApprovalService {
+	mock := &MockApprovalService{ctrl: ctrl}
+	mock.recorder = &MockApprovalServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockApprovalService) EXPECT() *MockApprovalServiceMockRecorder {
+	return m.recorder
+}
+
+// Approval mocks base method
+func (m *MockApprovalService) Approval(arg0 context.Context, arg1 string) (*account.Approval, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approval", arg0, arg1)
+	ret0, _ := ret[0].(*account.Approval)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Approval indicates an expected call of Approval
+func (mr *MockApprovalServiceMockRecorder) Approval(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approval", reflect.TypeOf((*MockApprovalService)(nil).Approval), arg0, arg1)
+}
+
+// SignTx mocks base method
+func (m *MockApprovalService) SignTx(arg0 context.Context, arg1 accounts.Account, arg2 *types.Transaction, arg3 *big.Int, arg4 []byte, arg5 string) ([]byte, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignTx", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SignTx indicates an expected call of SignTx
+func (mr *MockApprovalServiceMockRecorder) SignTx(arg0, arg1, arg2, arg3, arg4, arg5 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignTx", reflect.TypeOf((*MockApprovalService)(nil).SignTx), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
 // MockService is a mock of Service interface
 type MockService struct {
 	ctrl     *gomock.Controller
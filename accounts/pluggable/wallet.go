@@ -2,6 +2,7 @@ package pluggable
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"sync"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
 	plugin "github.com/ethereum/go-ethereum/plugin/account"
 )
 
@@ -18,6 +20,18 @@ type wallet struct {
 	url           accounts.URL
 	mu            sync.Mutex
 	pluginService plugin.Service
+
+	// transactions are signed subject to the signing policy of the plugin if set
+	approvalService plugin.ApprovalService
+	pending         map[string]*pendingTx // transactions awaiting approval by ticket
+	approvalsPath   string                // file keeping the pending transactions across restarts, none if empty
+}
+
+// pendingTx is a transaction awaiting approval
+type pendingTx struct {
+	account accounts.Account
+	tx      *types.Transaction
+	chainID *big.Int
 }
 
 func (w *wallet) setPluginService(s plugin.Service) error {
@@ -29,6 +43,20 @@ func (w *wallet) setPluginService(s plugin.Service) error {
 	return nil
 }
 
+func (w *wallet) setApprovalService(s plugin.ApprovalService) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	pending, err := loadApprovals(w.approvalsPath)
+	if err != nil {
+		return err
+	}
+	w.approvalService = s
+	w.pending = pending
+
+	return nil
+}
+
 func (w *wallet) URL() accounts.URL {
 	return w.url
 }
@@ -78,6 +106,10 @@ func (w *wallet) SignTextWithPassphrase(account accounts.Account, passphrase str
 func (w *wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	toSign, signer := prepareTxForSign(tx, chainID)
 
+	if w.approvalService != nil {
+		return w.signTxWithApproval(account, "", tx, chainID, toSign, signer)
+	}
+
 	sig, err := w.pluginService.Sign(context.Background(), account, toSign.Bytes())
 	if err != nil {
 		return nil, err
@@ -89,6 +121,10 @@ func (w *wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID
 func (w *wallet) SignTxWithPassphrase(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
 	toSign, signer := prepareTxForSign(tx, chainID)
 
+	if w.approvalService != nil {
+		return w.signTxWithApproval(account, passphrase, tx, chainID, toSign, signer)
+	}
+
 	sig, err := w.pluginService.UnlockAndSign(context.Background(), account, toSign.Bytes(), passphrase)
 	if err != nil {
 		return nil, err
@@ -97,6 +133,93 @@ func (w *wallet) SignTxWithPassphrase(account accounts.Account, passphrase strin
 	return tx.WithSignature(signer, sig)
 }
 
+// signTxWithApproval signs the transaction subject to the signing policy of the plugin, keeping the transaction
+// and returning a PendingApprovalError if it awaits approval
+func (w *wallet) signTxWithApproval(account accounts.Account, passphrase string, tx *types.Transaction, chainID *big.Int, toSign common.Hash, signer types.Signer) (*types.Transaction, error) {
+	sig, ticketID, err := w.approvalService.SignTx(context.Background(), account, tx, chainID, toSign.Bytes(), passphrase)
+	if err != nil {
+		return nil, err
+	}
+	if ticketID == "" {
+		return tx.WithSignature(signer, sig)
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.pending[ticketID] = &pendingTx{account: account, tx: tx, chainID: chainID}
+	if err := saveApprovals(w.approvalsPath, w.pending); err != nil {
+		log.Warn("Failed to keep the transaction awaiting approval, its ticket is lost on restart", "ticket", ticketID, "err", err)
+	}
+
+	return nil, &PendingApprovalError{TicketID: ticketID}
+}
+
+// approval returns the status of the approval of a ticket with the transaction, signed once approved
+func (w *wallet) approval(ticketID string) (*Approval, error) {
+	w.mu.Lock()
+	p, ok := w.pending[ticketID]
+	w.mu.Unlock()
+	if !ok {
+		return nil, ErrUnknownTicket
+	}
+
+	a, err := w.approvalService.Approval(context.Background(), ticketID)
+	if err != nil {
+		return nil, err
+	}
+	approval := &Approval{
+		Status:  a.Status,
+		Account: p.account,
+		Tx:      p.tx,
+		Reason:  a.Reason,
+	}
+	if a.Status != plugin.ApprovalApproved {
+		return approval, nil
+	}
+
+	_, signer := prepareTxForSign(p.tx, p.chainID)
+	signed, err := p.tx.WithSignature(signer, a.Sig)
+	if err != nil {
+		return nil, err
+	}
+	from, err := types.Sender(signer, signed)
+	if err != nil {
+		return nil, err
+	}
+	if from != p.account.Address {
+		return nil, fmt.Errorf("approved transaction signed by %s instead of %s", from.Hex(), p.account.Address.Hex())
+	}
+	approval.Tx = signed
+
+	return approval, nil
+}
+
+func (w *wallet) forgetApproval(ticketID string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	delete(w.pending, ticketID)
+	if err := saveApprovals(w.approvalsPath, w.pending); err != nil {
+		log.Warn("Failed to forget the transaction awaiting approval", "ticket", ticketID, "err", err)
+	}
+}
+
+// pendingNonce returns the nonce following the transactions of the account awaiting approval, false if there is none
+func (w *wallet) pendingNonce(address common.Address) (uint64, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	var nonce uint64
+	found := false
+	for _, p := range w.pending {
+		if p.account.Address == address && (!found || p.tx.Nonce() >= nonce) {
+			nonce, found = p.tx.Nonce()+1, true
+		}
+	}
+	return nonce, found
+}
+
 func (w *wallet) timedUnlock(account accounts.Account, password string, duration time.Duration) error {
 	return w.pluginService.TimedUnlock(context.Background(), account, password, duration)
 }
@@ -1,8 +1,12 @@
 package pluggable
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"math/big"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -10,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	plugin "github.com/ethereum/go-ethereum/plugin/account"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -395,3 +400,203 @@ func TestWallet_SignTxWithPassphrase(t *testing.T) {
 		})
 	}
 }
+
+func approvalWallet(m *mock_plugin.MockService, a *mock_plugin.MockApprovalService) *wallet {
+	w := validWallet(m)
+	_ = w.setApprovalService(a)
+	return w
+}
+
+func TestWallet_SignTx_WithApproval_Signed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	toSign := types.NewTransaction(1, acct2.Address, big.NewInt(1), 0, big.NewInt(1), nil)
+	signer := types.HomesteadSigner{}
+	mockSig := make([]byte, 65)
+	rand.Read(mockSig)
+
+	mockApproval := mock_plugin.NewMockApprovalService(ctrl)
+	mockApproval.
+		EXPECT().
+		SignTx(gomock.Any(), acct1, toSign, nil, signer.Hash(toSign).Bytes(), "").
+		Return(mockSig, "", nil)
+
+	w := approvalWallet(mock_plugin.NewMockService(ctrl), mockApproval)
+	got, err := w.SignTx(acct1, toSign, nil)
+	require.NoError(t, err)
+
+	gotV, gotR, gotS := got.RawSignatureValues()
+	wantR, wantS, wantV, err := signer.SignatureValues(&types.Transaction{}, mockSig)
+	require.NoError(t, err)
+	assert.Equal(t, wantV, gotV)
+	assert.Equal(t, wantR, gotR)
+	assert.Equal(t, wantS, gotS)
+}
+
+func TestWallet_SignTx_WithApproval_Approved(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := accounts.Account{Address: crypto.PubkeyToAddress(key.PublicKey)}
+	toSign := types.NewTransaction(1, acct2.Address, big.NewInt(1), 0, big.NewInt(1), nil)
+	signer := types.NewEIP155Signer(big.NewInt(20))
+	sig, err := crypto.Sign(signer.Hash(toSign).Bytes(), key)
+	require.NoError(t, err)
+
+	mockApproval := mock_plugin.NewMockApprovalService(ctrl)
+	mockApproval.
+		EXPECT().
+		SignTx(gomock.Any(), from, toSign, big.NewInt(20), signer.Hash(toSign).Bytes(), "pwd").
+		Return(nil, "ticket", nil)
+	gomock.InOrder(
+		mockApproval.
+			EXPECT().
+			Approval(gomock.Any(), "ticket").
+			Return(&plugin.Approval{Status: plugin.ApprovalPending}, nil),
+		mockApproval.
+			EXPECT().
+			Approval(gomock.Any(), "ticket").
+			Return(&plugin.Approval{Status: plugin.ApprovalApproved, Sig: sig}, nil),
+	)
+
+	w := approvalWallet(mock_plugin.NewMockService(ctrl), mockApproval)
+	_, err = w.SignTxWithPassphrase(from, "pwd", toSign, big.NewInt(20))
+	require.Equal(t, &PendingApprovalError{TicketID: "ticket"}, err)
+
+	got, err := w.approval("ticket")
+	require.NoError(t, err)
+	assert.Equal(t, plugin.ApprovalPending, got.Status)
+	assert.Equal(t, from, got.Account)
+
+	got, err = w.approval("ticket")
+	require.NoError(t, err)
+	assert.Equal(t, plugin.ApprovalApproved, got.Status)
+	sender, err := types.Sender(signer, got.Tx)
+	require.NoError(t, err)
+	assert.Equal(t, from.Address, sender)
+
+	w.forgetApproval("ticket")
+	_, err = w.approval("ticket")
+	assert.Equal(t, ErrUnknownTicket, err)
+}
+
+func TestWallet_SignTx_WithApproval_ApprovedByAnotherKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	toSign := types.NewTransaction(1, acct2.Address, big.NewInt(1), 0, big.NewInt(1), nil)
+	signer := types.HomesteadSigner{}
+	sig, err := crypto.Sign(signer.Hash(toSign).Bytes(), key)
+	require.NoError(t, err)
+
+	mockApproval := mock_plugin.NewMockApprovalService(ctrl)
+	mockApproval.
+		EXPECT().
+		SignTx(gomock.Any(), acct1, toSign, nil, signer.Hash(toSign).Bytes(), "").
+		Return(nil, "ticket", nil)
+	mockApproval.
+		EXPECT().
+		Approval(gomock.Any(), "ticket").
+		Return(&plugin.Approval{Status: plugin.ApprovalApproved, Sig: sig}, nil)
+
+	w := approvalWallet(mock_plugin.NewMockService(ctrl), mockApproval)
+	_, err = w.SignTx(acct1, toSign, nil)
+	require.IsType(t, &PendingApprovalError{}, err)
+
+	_, err = w.approval("ticket")
+	assert.EqualError(t, err, "approved transaction signed by "+crypto.PubkeyToAddress(key.PublicKey).Hex()+" instead of "+acct1.Address.Hex())
+}
+
+func TestWallet_SignTx_WithApproval_Rejected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	toSign := types.NewTransaction(1, acct2.Address, big.NewInt(1), 0, big.NewInt(1), nil)
+
+	mockApproval := mock_plugin.NewMockApprovalService(ctrl)
+	mockApproval.
+		EXPECT().
+		SignTx(gomock.Any(), acct1, toSign, nil, gomock.Any(), "").
+		Return(nil, "ticket", nil)
+	mockApproval.
+		EXPECT().
+		Approval(gomock.Any(), "ticket").
+		Return(&plugin.Approval{Status: plugin.ApprovalRejected, Reason: "over the limit"}, nil)
+
+	w := approvalWallet(mock_plugin.NewMockService(ctrl), mockApproval)
+	_, err := w.SignTx(acct1, toSign, nil)
+	require.IsType(t, &PendingApprovalError{}, err)
+
+	got, err := w.approval("ticket")
+	require.NoError(t, err)
+	assert.Equal(t, plugin.ApprovalRejected, got.Status)
+	assert.Equal(t, "over the limit", got.Reason)
+	assert.Equal(t, toSign, got.Tx)
+}
+
+func TestWallet_SignTx_WithApproval_KeptAcrossRestarts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	dir, err := ioutil.TempDir("", "pluggable")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "approvals.json")
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := accounts.Account{Address: crypto.PubkeyToAddress(key.PublicKey)}
+	toSign := types.NewTransaction(1, acct2.Address, big.NewInt(1), 0, big.NewInt(1), nil)
+	signer := types.NewEIP155Signer(big.NewInt(20))
+	sig, err := crypto.Sign(signer.Hash(toSign).Bytes(), key)
+	require.NoError(t, err)
+
+	mockApproval := mock_plugin.NewMockApprovalService(ctrl)
+	mockApproval.
+		EXPECT().
+		SignTx(gomock.Any(), from, toSign, big.NewInt(20), signer.Hash(toSign).Bytes(), "").
+		Return(nil, "ticket", nil)
+	mockApproval.
+		EXPECT().
+		Approval(gomock.Any(), "ticket").
+		Return(&plugin.Approval{Status: plugin.ApprovalApproved, Sig: sig}, nil)
+
+	w := validWallet(mock_plugin.NewMockService(ctrl))
+	w.approvalsPath = path
+	require.NoError(t, w.setApprovalService(mockApproval))
+	_, err = w.SignTx(from, toSign, big.NewInt(20))
+	require.Equal(t, &PendingApprovalError{TicketID: "ticket"}, err)
+
+	restarted := validWallet(mock_plugin.NewMockService(ctrl))
+	restarted.approvalsPath = path
+	require.NoError(t, restarted.setApprovalService(mockApproval))
+	nonce, ok := restarted.pendingNonce(from.Address)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(2), nonce)
+
+	got, err := restarted.approval("ticket")
+	require.NoError(t, err)
+	assert.Equal(t, plugin.ApprovalApproved, got.Status)
+	sender, err := types.Sender(signer, got.Tx)
+	require.NoError(t, err)
+	assert.Equal(t, from.Address, sender)
+
+	restarted.forgetApproval("ticket")
+	_, ok = restarted.pendingNonce(from.Address)
+	assert.False(t, ok)
+	pending, err := loadApprovals(path)
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestPendingApprovalError_ErrorData(t *testing.T) {
+	data, err := json.Marshal((&PendingApprovalError{TicketID: "ticket"}).ErrorData())
+
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"ticketId": "ticket"}`, string(data))
+}
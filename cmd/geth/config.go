@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/plugin"
 	"github.com/ethereum/go-ethereum/private"
 	"github.com/ethereum/go-ethereum/private/engine"
 	whisper "github.com/ethereum/go-ethereum/whisper/whisperv6"
@@ -162,7 +163,10 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 	// plugin service must be after eth service so that eth service will be stopped gradually if any of the plugin
 	// fails to start
 	if cfg.Node.Plugins != nil {
-		utils.RegisterPluginService(stack, &cfg.Node, ctx.Bool(utils.PluginSkipVerifyFlag.Name), ctx.Bool(utils.PluginLocalVerifyFlag.Name), ctx.String(utils.PluginPublicKeyFlag.Name))
+		utils.RegisterPluginService(stack, &cfg.Node, &cfg.Eth, ctx.Bool(utils.PluginSkipVerifyFlag.Name), ctx.Bool(utils.PluginLocalVerifyFlag.Name), ctx.String(utils.PluginPublicKeyFlag.Name))
+		if _, ok := cfg.Node.Plugins.Providers[plugin.EventStreamPluginInterfaceName]; ok {
+			utils.RegisterEventStreamService(stack)
+		}
 	}
 
 	if cfg.Node.IsPermissionEnabled() {
@@ -178,7 +182,7 @@ func makeFullNode(ctx *cli.Context) *node.Node {
 	}
 
 	ipcPath := quorumGetPrivateTransactionManager()
-	if ipcPath != "" {
+	if ipcPath != "" || quorumIsPrivateTransactionManagerPlugin(&cfg.Node) {
 		utils.RegisterExtensionService(stack, ethChan)
 	}
 
@@ -259,9 +263,19 @@ func quorumValidateConsensus(ethereum *eth.Ethereum, isRaft bool) {
 }
 
 // quorumValidatePrivateTransactionManager returns whether the "PRIVATE_CONFIG"
-// environment variable is set
+// environment variable is set or the private transaction manager is provided by a plugin
 func quorumValidatePrivateTransactionManager() bool {
-	return os.Getenv("PRIVATE_CONFIG") != ""
+	return os.Getenv("PRIVATE_CONFIG") != "" || private.P != nil
+}
+
+// quorumIsPrivateTransactionManagerPlugin returns whether the private transaction manager
+// is provided by a plugin
+func quorumIsPrivateTransactionManagerPlugin(cfg *node.Config) bool {
+	if cfg.Plugins == nil {
+		return false
+	}
+	_, ok := cfg.Plugins.Providers[plugin.PrivateTxManagerPluginInterfaceName]
+	return ok
 }
 
 //
@@ -35,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/cmd/utils"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/console"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/eth"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -155,6 +156,7 @@ var (
 		// Quorum
 		utils.QuorumImmutabilityThreshold,
 		utils.EnableNodePermissionFlag,
+		utils.AllowListRefreshIntervalFlag,
 		utils.RaftModeFlag,
 		utils.RaftBlockTimeFlag,
 		utils.RaftJoinExistingFlag,
@@ -163,6 +165,7 @@ var (
 		utils.EmitCheckpointsFlag,
 		utils.IstanbulRequestTimeoutFlag,
 		utils.IstanbulBlockPeriodFlag,
+		utils.IstanbulRelayTTLFlag,
 		utils.PluginSettingsFlag,
 		utils.PluginSkipVerifyFlag,
 		utils.PluginLocalVerifyFlag,
@@ -246,6 +249,10 @@ func init() {
 		dumpConfigCommand,
 		// See retesteth.go
 		retestethCommand,
+		// See permissioncmd.go
+		permissionCommand,
+		// See plugincmd.go
+		pluginCommand,
 	}
 	sort.Sort(cli.CommandsByName(app.Commands))
 
@@ -369,6 +376,20 @@ func startNode(ctx *cli.Context, stack *node.Node) {
 		}
 	}
 
+	// Quorum - apply the custom rules of the transaction validator plugin to transactions
+	if stack.PluginManager().IsEnabled(plugin.TxValidatorPluginInterfaceName) {
+		validate, err := stack.PluginManager().TransactionValidationFunc()
+		if err != nil {
+			utils.Fatalf("failed to setup transaction validator plugin: %v", err)
+		}
+		core.TransactionValidationFunc = validate
+		validateBlock, err := stack.PluginManager().BlockTransactionValidationFunc()
+		if err != nil {
+			utils.Fatalf("failed to setup transaction validator plugin: %v", err)
+		}
+		core.BlockTransactionValidationFunc = validateBlock
+	}
+
 	// Unlock any account specifically requested
 	unlockAccounts(ctx, stack)
 
@@ -464,6 +485,7 @@ func startNode(ctx *cli.Context, stack *node.Node) {
 	// checking if permissions is enabled and staring the permissions service
 	if stack.Config().EnableNodePermission {
 		stack.Server().SetIsNodePermissioned(permission.IsNodePermissioned)
+		stack.Server().SetNodeCertificateCheck(permission.IsNodeCertified)
 		if stack.IsPermissionEnabled() {
 			var permissionService *permission.PermissionCtrl
 			if err := stack.Service(&permissionService); err != nil {
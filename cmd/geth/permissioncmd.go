@@ -0,0 +1,210 @@
+// Copyright 2016 The go-ethereum Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/permission"
+	"github.com/ethereum/go-ethereum/rpc"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	permissionFromFlag = cli.StringFlag{
+		Name:  "from",
+		Usage: "Network or org admin account submitting the permission transactions",
+	}
+	permissionSwitchBlockFlag = cli.Uint64Flag{
+		Name:  "switch-block",
+		Usage: "Block after which the nodes switch to the migrated permission contracts",
+	}
+
+	permissionCommand = cli.Command{
+		Name:     "permission",
+		Usage:    "Manage the permissions of a running node",
+		Category: "PERMISSION COMMANDS",
+		Description: `
+The desired state file lists the orgs of the network, each with its admin
+account, nodes, roles, accounts and sub orgs. The commands compare it with the
+permissions of the node at the given endpoint (default: the node's IPC socket).`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "plan",
+				Usage:     "Print the permission changes needed to reach a desired state",
+				Action:    utils.MigrateFlags(permissionPlan),
+				ArgsUsage: "<statefile> [endpoint]",
+				Flags:     append([]cli.Flag{utils.DataDirFlag}, rpcClientFlags...),
+			},
+			{
+				Name:      "reconcile",
+				Usage:     "Submit the permission changes needed to reach a desired state",
+				Action:    utils.MigrateFlags(permissionReconcile),
+				ArgsUsage: "<statefile> [endpoint]",
+				Flags:     append([]cli.Flag{utils.DataDirFlag, permissionFromFlag}, rpcClientFlags...),
+				Description: `
+The changes are submitted from the --from account, which must be unlocked in
+the node, in dependency order. Each change is waited for before the next one;
+the command stops at changes which still need the approval of other voters.`,
+			},
+			{
+				Name:      "migrate",
+				Usage:     "Migrate the permissions to new contracts of the latest permissions model",
+				Action:    utils.MigrateFlags(permissionMigrate),
+				ArgsUsage: "[endpoint...]",
+				Flags:     append([]cli.Flag{utils.DataDirFlag, permissionFromFlag, permissionSwitchBlockFlag}, rpcClientFlags...),
+				Description: `
+The first node deploys the new contracts from the --from network admin
+account, which must be unlocked in it, and copies the orgs, roles, nodes and
+accounts of the network into them. Once the new contracts match the
+permissions of each node, the nodes switch permission-config.json to them
+after the --switch-block block. Run the command again to resume a migration
+waiting for the org admin accounts or votes of other nodes.`,
+			},
+		},
+	}
+)
+
+// dials the node and loads the desired state given on the command line
+func permissionSetup(ctx *cli.Context) (*rpc.Client, *permission.DesiredState) {
+	if len(ctx.Args()) < 1 {
+		utils.Fatalf("This command requires a desired state file.")
+	}
+	state, err := permission.LoadDesiredState(ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("Failed to load desired state: %v", err)
+	}
+	return permissionDial(ctx, ctx.Args().Get(1)), state
+}
+
+// dials the node at the given endpoint, or the node's IPC socket
+func permissionDial(ctx *cli.Context, endpoint string) *rpc.Client {
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("%s/geth.ipc", utils.MakeDataDir(ctx))
+	}
+	client, err := dialRPC(endpoint, ctx)
+	if err != nil {
+		utils.Fatalf("Unable to attach to remote geth: %v", err)
+	}
+	return client
+}
+
+func permissionPlan(ctx *cli.Context) error {
+	client, state := permissionSetup(ctx)
+	defer client.Close()
+
+	var plan permission.ReconcilePlan
+	if err := client.Call(&plan, "quorumPermission_planReconcile", state); err != nil {
+		utils.Fatalf("Failed to plan: %v", err)
+	}
+	if len(plan.Steps) == 0 {
+		fmt.Println("Permissions are up to date")
+	}
+	for i, step := range plan.Steps {
+		fmt.Printf("%3d. %v\n", i+1, step)
+	}
+	for _, drift := range plan.Drift {
+		fmt.Printf("drift: %s\n", drift)
+	}
+	return nil
+}
+
+func permissionReconcile(ctx *cli.Context) error {
+	if !common.IsHexAddress(ctx.String(permissionFromFlag.Name)) {
+		utils.Fatalf("A valid --%s account is required", permissionFromFlag.Name)
+	}
+	client, state := permissionSetup(ctx)
+	defer client.Close()
+
+	txa := ethapi.SendTxArgs{From: common.HexToAddress(ctx.String(permissionFromFlag.Name))}
+	var results []permission.ReconcileStepResult
+	if err := client.Call(&results, "quorumPermission_reconcile", state, txa); err != nil {
+		utils.Fatalf("Failed to reconcile: %v", err)
+	}
+	for i, result := range results {
+		fmt.Printf("%3d. %v: %s", i+1, result.Step, result.Status)
+		if result.Error != "" {
+			fmt.Printf(" (%s)", result.Error)
+		}
+		fmt.Println()
+	}
+	return nil
+}
+
+func permissionMigrate(ctx *cli.Context) error {
+	if !common.IsHexAddress(ctx.String(permissionFromFlag.Name)) {
+		utils.Fatalf("A valid --%s account is required", permissionFromFlag.Name)
+	}
+	if !ctx.IsSet(permissionSwitchBlockFlag.Name) {
+		utils.Fatalf("The --%s block is required", permissionSwitchBlockFlag.Name)
+	}
+	endpoints := []string(ctx.Args())
+	if len(endpoints) == 0 {
+		endpoints = []string{""}
+	}
+	txa := ethapi.SendTxArgs{From: common.HexToAddress(ctx.String(permissionFromFlag.Name))}
+
+	client := permissionDial(ctx, endpoints[0])
+	var result permission.MigrationResult
+	err := client.Call(&result, "quorumPermission_migrate", ctx.Uint64(permissionSwitchBlockFlag.Name), txa)
+	client.Close()
+	if err != nil {
+		utils.Fatalf("Failed to migrate: %v", err)
+	}
+	for i, step := range result.Steps {
+		fmt.Printf("%3d. %v: %s", i+1, step.Step, step.Status)
+		if step.Error != "" {
+			fmt.Printf(" (%s)", step.Error)
+		}
+		fmt.Println()
+	}
+	printMigrationResult(endpoints[0], &result)
+	if !result.Staged {
+		return nil
+	}
+
+	// the other nodes verify the new contracts against their own permissions
+	result.Schedule.SwitchBlock = ctx.Uint64(permissionSwitchBlockFlag.Name)
+	for _, endpoint := range endpoints[1:] {
+		client := permissionDial(ctx, endpoint)
+		var nodeResult permission.MigrationResult
+		if err := client.Call(&nodeResult, "quorumPermission_scheduleMigration", result.Schedule, txa); err != nil {
+			fmt.Printf("%s: failed to schedule the migration: %v\n", endpoint, err)
+		} else {
+			printMigrationResult(endpoint, &nodeResult)
+		}
+		client.Close()
+	}
+	return nil
+}
+
+func printMigrationResult(endpoint string, result *permission.MigrationResult) {
+	if endpoint == "" {
+		endpoint = "local node"
+	}
+	for _, diff := range result.Differences {
+		fmt.Printf("%s: difference: %s\n", endpoint, diff)
+	}
+	if result.Staged {
+		fmt.Printf("%s: switching to %s after block %d\n", endpoint, result.Schedule.Config.InterfAddress.Hex(), result.Schedule.SwitchBlock)
+	} else {
+		fmt.Printf("%s: migration to %s is not complete\n", endpoint, result.Schedule.Config.InterfAddress.Hex())
+	}
+}
@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/plugin"
+	"gopkg.in/urfave/cli.v1"
+)
+
+var (
+	pluginRegistryFlag = cli.StringFlag{
+		Name:  "plugins.registry",
+		Usage: "Local plugin registry, a directory laid out like Plugin Central. E.g.: file:///opt/geth/plugin-registry",
+	}
+
+	pluginFlags = []cli.Flag{
+		utils.DataDirFlag,
+		utils.PluginSettingsFlag, // flag is used implicitly by makeConfigNode() for the plugin base directory
+		utils.PluginPublicKeyFlag,
+		utils.PluginSkipVerifyFlag,
+	}
+
+	pluginCommand = cli.Command{
+		Name:     "plugin",
+		Usage:    "Manage the plugins installed in the plugin base directory",
+		Category: "PLUGIN COMMANDS",
+		Description: `
+Plugins are installed in the plugin base directory of the --plugins settings
+(default: <datadir>/plugins), where geth finds them without downloading from
+Plugin Central. Each version is installed side by side, the one used being
+given by the plugin settings.
+
+Plugin signatures are verified with the PGP public key given by
+--plugins.publickey (default: file:///<pluginBaseDir>/` + plugin.DefaultPublicKeyFile + `).`,
+		Subcommands: []cli.Command{
+			{
+				Name:      "install",
+				Usage:     "Install a plugin from a distribution file or a local registry",
+				Action:    utils.MigrateFlags(pluginInstall),
+				ArgsUsage: "<name>@<version> | <distribution file>",
+				Flags:     append([]cli.Flag{pluginRegistryFlag}, pluginFlags...),
+				Description: `
+    geth plugin install --plugins.registry file:///opt/registry <name>@<version>
+    geth plugin install /path/to/<name>-<version>.zip
+
+The plugin is installed with its signature file, <distribution file>.sha256sum.asc,
+which must be next to the distribution file. Nothing is installed if the
+signature can't be verified.`,
+			},
+			{
+				Name:   "list",
+				Usage:  "Print the installed plugins",
+				Action: utils.MigrateFlags(pluginList),
+				Flags:  []cli.Flag{utils.DataDirFlag, utils.PluginSettingsFlag},
+			},
+			{
+				Name:      "verify",
+				Usage:     "Verify the signatures of installed plugins",
+				Action:    utils.MigrateFlags(pluginVerify),
+				ArgsUsage: "[<name>@<version>...]",
+				Flags:     pluginFlags,
+				Description: `
+Verifies the given plugins, or all installed plugins if none is given.`,
+			},
+			{
+				Name:      "remove",
+				Usage:     "Remove an installed plugin",
+				Action:    utils.MigrateFlags(pluginRemove),
+				ArgsUsage: "<name>@<version>",
+				Flags:     []cli.Flag{utils.DataDirFlag, utils.PluginSettingsFlag},
+			},
+		},
+	}
+)
+
+// creates the local registry of the plugin base directory given by the
+// command line, verifying plugins if verify is set
+func pluginRegistrySetup(ctx *cli.Context, verify bool) *plugin.LocalRegistry {
+	_, cfg := makeConfigNode(ctx)
+	if cfg.Node.Plugins == nil {
+		cfg.Node.Plugins = new(plugin.Settings)
+	}
+	if err := cfg.Node.ResolvePluginBaseDir(); err != nil {
+		utils.Fatalf("Unable to resolve plugin base dir: %v", err)
+	}
+	baseDir := cfg.Node.Plugins.BaseDir.String()
+	if verify && ctx.Bool(utils.PluginSkipVerifyFlag.Name) {
+		log.Warn("plugin: ignore integrity verification")
+	}
+	verifier, err := plugin.NewOfflineVerifier(baseDir, !verify || ctx.Bool(utils.PluginSkipVerifyFlag.Name), ctx.String(utils.PluginPublicKeyFlag.Name))
+	if err != nil {
+		utils.Fatalf("Unable to verify plugins: %v, use --%s or --%s", err, utils.PluginPublicKeyFlag.Name, utils.PluginSkipVerifyFlag.Name)
+	}
+	return plugin.NewLocalRegistry(baseDir, verifier)
+}
+
+func pluginInstall(ctx *cli.Context) error {
+	if len(ctx.Args()) != 1 {
+		utils.Fatalf("This command requires a plugin or a distribution file.")
+	}
+	source := ctx.Args().First()
+	var (
+		distFile   string
+		definition *plugin.PluginDefinition
+		err        error
+	)
+	if strings.HasSuffix(source, ".zip") {
+		distFile = source
+	} else {
+		if !ctx.IsSet(pluginRegistryFlag.Name) {
+			utils.Fatalf("--%s is required to install %s", pluginRegistryFlag.Name, source)
+		}
+		if definition, err = plugin.ParsePluginReference(source); err != nil {
+			utils.Fatalf("%v", err)
+		}
+		if distFile, err = plugin.FindInRegistry(pluginRegistryDir(ctx.String(pluginRegistryFlag.Name)), definition); err != nil {
+			utils.Fatalf("%v", err)
+		}
+	}
+	installed, err := pluginRegistrySetup(ctx, true).Install(distFile, definition)
+	if err != nil {
+		utils.Fatalf("Failed to install plugin: %v", err)
+	}
+	fmt.Printf("Installed %s version %s\n", installed.Name, installed.Version)
+	fmt.Printf("Path:     %s\n", installed.Path)
+	fmt.Printf("Checksum: %s\n", installed.Checksum)
+	return nil
+}
+
+func pluginList(ctx *cli.Context) error {
+	plugins, err := pluginRegistrySetup(ctx, false).List()
+	if err != nil {
+		utils.Fatalf("Failed to list plugins: %v", err)
+	}
+	if len(plugins) == 0 {
+		fmt.Println("No plugins installed")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tVERSION\tSIGNED\tCHECKSUM")
+	for _, p := range plugins {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\n", p.Name, p.Version, p.Signed, p.Checksum)
+	}
+	return w.Flush()
+}
+
+func pluginVerify(ctx *cli.Context) error {
+	registry := pluginRegistrySetup(ctx, true)
+	var definitions []*plugin.PluginDefinition
+	if len(ctx.Args()) == 0 {
+		plugins, err := registry.List()
+		if err != nil {
+			utils.Fatalf("Failed to list plugins: %v", err)
+		}
+		for _, p := range plugins {
+			definitions = append(definitions, &plugin.PluginDefinition{Name: p.Name, Version: p.Version})
+		}
+	}
+	for _, ref := range ctx.Args() {
+		definition, err := plugin.ParsePluginReference(ref)
+		if err != nil {
+			utils.Fatalf("%v", err)
+		}
+		definitions = append(definitions, definition)
+	}
+	failed := 0
+	for _, definition := range definitions {
+		if err := registry.Verify(definition); err != nil {
+			fmt.Printf("%s: FAILED (%v)\n", definition.FullName(), err)
+			failed++
+		} else {
+			fmt.Printf("%s: OK\n", definition.FullName())
+		}
+	}
+	if failed > 0 {
+		utils.Fatalf("%d of %d plugins failed verification", failed, len(definitions))
+	}
+	return nil
+}
+
+func pluginRemove(ctx *cli.Context) error {
+	if len(ctx.Args()) != 1 {
+		utils.Fatalf("This command requires a plugin.")
+	}
+	definition, err := plugin.ParsePluginReference(ctx.Args().First())
+	if err != nil {
+		utils.Fatalf("%v", err)
+	}
+	if err := pluginRegistrySetup(ctx, false).Remove(definition); err != nil {
+		utils.Fatalf("Failed to remove plugin: %v", err)
+	}
+	fmt.Printf("Removed %s\n", definition.FullName())
+	return nil
+}
+
+// the registry is given as a directory or a file URI
+func pluginRegistryDir(registry string) string {
+	if u, err := url.Parse(registry); err == nil && u.Scheme == "file" {
+		return filepath.Join(u.Host, u.Path)
+	}
+	return registry
+}
@@ -276,6 +276,7 @@ var AppHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			utils.QuorumImmutabilityThreshold,
 			utils.EnableNodePermissionFlag,
+			utils.AllowListRefreshIntervalFlag,
 			utils.PluginSettingsFlag,
 			utils.PluginSkipVerifyFlag,
 			utils.PluginLocalVerifyFlag,
@@ -304,6 +305,7 @@ var AppHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			utils.IstanbulRequestTimeoutFlag,
 			utils.IstanbulBlockPeriodFlag,
+			utils.IstanbulRelayTTLFlag,
 		},
 	},
 	// END QUORUM
@@ -30,6 +30,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"text/tabwriter"
 	"text/template"
 	"time"
@@ -54,6 +55,7 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/ethstats"
 	"github.com/ethereum/go-ethereum/extension"
+	"github.com/ethereum/go-ethereum/extension/privacyExtension"
 	"github.com/ethereum/go-ethereum/graphql"
 	"github.com/ethereum/go-ethereum/les"
 	"github.com/ethereum/go-ethereum/log"
@@ -70,7 +72,11 @@ import (
 	"github.com/ethereum/go-ethereum/permission"
 	"github.com/ethereum/go-ethereum/permission/core/types"
 	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/eventstream"
+	"github.com/ethereum/go-ethereum/plugin/privatetxmanager"
+	"github.com/ethereum/go-ethereum/plugin/signer"
 	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
 	"github.com/ethereum/go-ethereum/raft"
 	"github.com/ethereum/go-ethereum/rpc"
 	whisper "github.com/ethereum/go-ethereum/whisper/whisperv6"
@@ -837,6 +843,11 @@ var (
 		Name:  "permissioned",
 		Usage: "If enabled, the node will allow only a defined list of nodes to connect",
 	}
+	AllowListRefreshIntervalFlag = cli.DurationFlag{
+		Name:  "permissioned.refresh",
+		Usage: "Interval at which the node allow list files are checked for changes where file system notifications are unavailable",
+		Value: p2p.DefaultAllowListRefreshInterval,
+	}
 	AllowedFutureBlockTimeFlag = cli.Uint64Flag{
 		Name:  "allowedfutureblocktime",
 		Usage: "Max time (in seconds) from current time allowed for blocks, before they're considered future blocks",
@@ -875,6 +886,11 @@ var (
 		Usage: "Default minimum difference between two consecutive block's timestamps in seconds",
 		Value: eth.DefaultConfig.Istanbul.BlockPeriod,
 	}
+	IstanbulRelayTTLFlag = cli.Uint64Flag{
+		Name:  "istanbul.relayttl",
+		Usage: "Number of hops Istanbul messages are relayed through non-validator peers (0 = disabled)",
+		Value: eth.DefaultConfig.Istanbul.RelayTTL,
+	}
 )
 
 // MakeDataDir retrieves the currently requested data directory, terminating
@@ -1281,6 +1297,11 @@ func SetP2PConfig(ctx *cli.Context, cfg *p2p.Config) {
 		cfg.NetRestrict = list
 	}
 
+	// Quorum
+	if ctx.GlobalIsSet(AllowListRefreshIntervalFlag.Name) {
+		cfg.AllowListRefreshInterval = ctx.GlobalDuration(AllowListRefreshIntervalFlag.Name)
+	}
+
 	if ctx.GlobalBool(DeveloperFlag.Name) {
 		// --dev mode can't use p2p networking.
 		cfg.MaxPeers = 0
@@ -1545,6 +1566,9 @@ func setIstanbul(ctx *cli.Context, cfg *eth.Config) {
 	if ctx.GlobalIsSet(IstanbulBlockPeriodFlag.Name) {
 		cfg.Istanbul.BlockPeriod = ctx.GlobalUint64(IstanbulBlockPeriodFlag.Name)
 	}
+	if ctx.GlobalIsSet(IstanbulRelayTTLFlag.Name) {
+		cfg.Istanbul.RelayTTL = ctx.GlobalUint64(IstanbulRelayTTLFlag.Name)
+	}
 }
 
 func setRaft(ctx *cli.Context, cfg *eth.Config) {
@@ -1822,15 +1846,61 @@ func RegisterGraphQLService(stack *node.Node, endpoint string, cors, vhosts []st
 // Quorum
 //
 // Register plugin manager as a service in geth
-func RegisterPluginService(stack *node.Node, cfg *node.Config, skipVerify bool, localVerify bool, publicKey string) {
+func RegisterPluginService(stack *node.Node, cfg *node.Config, ethCfg *eth.Config, skipVerify bool, localVerify bool, publicKey string) {
 	if err := cfg.ResolvePluginBaseDir(); err != nil {
 		Fatalf("plugins: unable to resolve plugin base dir due to %s", err)
 	}
+	var pm atomic.Value
 	if err := stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
-		return plugin.NewPluginManager(cfg.UserIdent, cfg.Plugins, skipVerify, localVerify, publicKey)
+		m, err := plugin.NewPluginManager(cfg.UserIdent, cfg.Plugins, skipVerify, localVerify, publicKey)
+		if err != nil {
+			return nil, err
+		}
+		pm.Store(m)
+		return m, nil
 	}); err != nil {
 		Fatalf("plugins: Failed to register the Plugins service: %v", err)
 	}
+	if _, ok := cfg.Plugins.Providers[plugin.PrivateTxManagerPluginInterfaceName]; ok {
+		setPrivateTxManagerPlugin(&pm)
+	}
+	if _, ok := cfg.Plugins.Providers[plugin.SignerPluginInterfaceName]; ok {
+		setConsensusSignerPlugin(ethCfg, &pm)
+	}
+}
+
+// setConsensusSignerPlugin makes the signer plugin hold the validator key.
+// The eth service is constructed before the plugin manager, so the plugin is
+// looked up on every call.
+func setConsensusSignerPlugin(ethCfg *eth.Config, pm *atomic.Value) {
+	ethCfg.ConsensusSigner = signer.NewConsensusSigner(&signer.ReloadableSigner{
+		DeferFunc: func() (signer.Signer, error) {
+			m, ok := pm.Load().(*plugin.PluginManager)
+			if !ok {
+				return nil, signer.ErrSignerNotReady
+			}
+			return m.Signer()
+		},
+	})
+}
+
+// setPrivateTxManagerPlugin makes the private transaction manager plugin the
+// one used by the node. Services use it before the plugin manager exists, so
+// the plugin is looked up on every call.
+func setPrivateTxManagerPlugin(pm *atomic.Value) {
+	if private.P != nil {
+		log.Warn("Using the private transaction manager plugin instead of the one given by PRIVATE_CONFIG")
+	}
+	private.P = &privatetxmanager.ReloadablePrivateTxManager{
+		DeferFunc: func() (private.PrivateTransactionManager, error) {
+			m, ok := pm.Load().(*plugin.PluginManager)
+			if !ok {
+				return nil, engine.ErrPrivateTxManagerNotReady
+			}
+			return m.PrivateTxManager()
+		},
+	}
+	privacyExtension.DefaultExtensionHandler = privacyExtension.NewExtensionHandler(private.P)
 }
 
 // Configure smart-contract-based permissioning service
@@ -1852,6 +1922,28 @@ func RegisterPermissionService(stack *node.Node, useDns bool) {
 	log.Info("permission service registered")
 }
 
+// RegisterEventStreamService streams the blocks of the chain to the event
+// stream plugin. It must be registered after the eth and plugin services.
+func RegisterEventStreamService(stack *node.Node) {
+	if err := stack.Register(func(ctx *node.ServiceContext) (node.Service, error) {
+		var ethereum *eth.Ethereum
+		if err := ctx.Service(&ethereum); err != nil {
+			return nil, fmt.Errorf("event stream requires the eth service: %v", err)
+		}
+		var pm *plugin.PluginManager
+		if err := ctx.Service(&pm); err != nil {
+			return nil, fmt.Errorf("event stream requires the plugin service: %v", err)
+		}
+		stream, err := pm.EventStream()
+		if err != nil {
+			return nil, err
+		}
+		return eventstream.NewStreamer(ethereum.BlockChain(), stream, ctx.ResolvePath(eventstream.CursorFileName))
+	}); err != nil {
+		Fatalf("Failed to register the event stream service: %v", err)
+	}
+}
+
 func RegisterRaftService(stack *node.Node, ctx *cli.Context, nodeCfg *node.Config, ethChan chan *eth.Ethereum) {
 	blockTimeMillis := ctx.GlobalInt(RaftBlockTimeFlag.Name)
 	datadir := ctx.GlobalString(DataDirFlag.Name)
@@ -2017,6 +2109,14 @@ func MakeChain(ctx *cli.Context, stack *node.Node, useExist bool) (chain *core.B
 		}
 		istanbulConfig.ProposerPolicy = istanbul.ProposerPolicy(config.Istanbul.ProposerPolicy)
 		istanbulConfig.Ceil2Nby3Block = config.Istanbul.Ceil2Nby3Block
+		istanbulConfig.AggregatedSealBlock = config.Istanbul.AggregatedSealBlock
+		istanbulConfig.CommitRoundBlock = config.Istanbul.CommitRoundBlock
+		istanbulConfig.RaftMigrationBlock = config.Istanbul.RaftMigrationBlock
+		istanbulConfig.Validators = config.Istanbul.Validators
+		istanbulConfig.BLSKeys = make(map[common.Address]*istanbul.BLSKey)
+		for addr, key := range config.Istanbul.BLSKeys {
+			istanbulConfig.BLSKeys[addr] = &istanbul.BLSKey{PublicKey: key.PublicKey, Proof: key.Proof}
+		}
 		engine = istanbulBackend.New(istanbulConfig, stack.GetNodeKey(), chainDb)
 	} else if config.IsQuorum {
 		// for Raft
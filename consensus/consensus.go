@@ -130,6 +130,18 @@ type Handler interface {
 
 	// SetBroadcaster sets the broadcaster to send message to peers
 	SetBroadcaster(Broadcaster)
+
+	// SetChain sets the chain messages are checked against, also before the
+	// engine is started
+	SetChain(ChainReader)
+}
+
+// Bootstrapper should be implemented if the consensus can bootstrap a new node
+// from a proof fetched from a peer instead of the headers before it
+type Bootstrapper interface {
+	// Bootstrap fetches the proof from the peer, verifies it and stores the
+	// consensus state it proves
+	Bootstrap(chain ChainReader, address common.Address, peer Peer) error
 }
 
 // PoW is a consensus engine based on proof-of-work.
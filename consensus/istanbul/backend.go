@@ -41,9 +41,10 @@ type Backend interface {
 	// Gossip sends a message to all validators (exclude self)
 	Gossip(valSet ValidatorSet, payload []byte) error
 
-	// Commit delivers an approved proposal to backend.
+	// Commit delivers an approved proposal to backend, along with the committed
+	// seals, the validators they came from and the round they were given in.
 	// The delivered proposal will be put into blockchain.
-	Commit(proposal Proposal, seals [][]byte) error
+	Commit(proposal Proposal, seals [][]byte, committers []common.Address, round *big.Int) error
 
 	// Verify verifies the proposal. If a consensus.ErrFutureBlock error is returned,
 	// the time difference of the proposal and current time is also returned.
@@ -52,6 +53,9 @@ type Backend interface {
 	// Sign signs input data with the backend's private key
 	Sign([]byte) ([]byte, error)
 
+	// SignCommittedSeal signs the committed seal of the given proposal in the given round
+	SignCommittedSeal(proposal Proposal, round *big.Int) ([]byte, error)
+
 	// CheckSignature verifies the signature by checking if it's signed by
 	// the given validator
 	CheckSignature(data []byte, addr common.Address, sig []byte) error
@@ -20,6 +20,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -42,6 +44,20 @@ func (api *API) NodeAddress() common.Address {
 	return api.istanbul.Address()
 }
 
+// NodeBLSKey returns the node's BLS public key and proof of possession, for adding to
+// the blsKeys of the istanbul chain config before aggregated seals are activated, or
+// for proposing the node as a validator afterwards
+func (api *API) NodeBLSKey() (*params.IstanbulBLSKey, error) {
+	key := api.istanbul.BLSKey()
+	if key == nil {
+		return nil, errNoBLSKey
+	}
+	return &params.IstanbulBLSKey{
+		PublicKey: key.PublicKey,
+		Proof:     key.Proof,
+	}, nil
+}
+
 // GetSignersFromBlock returns the signers and minter for a given block number, or the
 // latest block available if none is specified
 func (api *API) GetSignersFromBlock(number *rpc.BlockNumber) (*BlockSigners, error) {
@@ -76,7 +92,7 @@ func (api *API) signers(header *types.Header) (*BlockSigners, error) {
 		return nil, err
 	}
 
-	committers, err := api.istanbul.Signers(header)
+	committers, err := api.istanbul.signers(api.chain, header)
 	if err != nil {
 		return nil, err
 	}
@@ -147,6 +163,21 @@ func (api *API) GetValidatorsAtHash(hash common.Hash) ([]common.Address, error)
 	return snap.validators(), nil
 }
 
+// GetValidatorTransitions returns the headers in the given range, up to the latest
+// block if no end is specified, that are sealed by a different validator set than
+// their parent. These are the transitions a new node verifies to bootstrap.
+func (api *API) GetValidatorTransitions(from rpc.BlockNumber, to *rpc.BlockNumber) ([]*types.Header, error) {
+	end := api.chain.CurrentHeader().Number.Uint64()
+	if to != nil && *to != rpc.LatestBlockNumber {
+		end = uint64(to.Int64())
+	}
+	start := uint64(0)
+	if from != rpc.LatestBlockNumber && from != rpc.PendingBlockNumber {
+		start = uint64(from.Int64())
+	}
+	return api.istanbul.validatorTransitions(api.chain, start, end)
+}
+
 // Candidates returns the current candidates the node tries to uphold and vote on.
 func (api *API) Candidates() map[common.Address]bool {
 	api.istanbul.candidatesLock.RLock()
@@ -160,12 +191,31 @@ func (api *API) Candidates() map[common.Address]bool {
 }
 
 // Propose injects a new authorization candidate that the validator will attempt to
-// push through.
-func (api *API) Propose(address common.Address, auth bool) {
+// push through. The BLS key of the candidate, as returned by its nodeBLSKey, is
+// registered along with the votes to authorize it, and is required once
+// aggregated seals are activated.
+func (api *API) Propose(address common.Address, auth bool, blsKey *params.IstanbulBLSKey) error {
+	var key *types.IstanbulBLSKey
+	if blsKey != nil {
+		if !auth {
+			return errInvalidVoteBLSKey
+		}
+		pub, err := bls.UnmarshalPublicKey(blsKey.PublicKey)
+		if err != nil || !bls.VerifyProofOfPossession(pub, blsKey.Proof) {
+			return errInvalidVoteBLSKey
+		}
+		key = &types.IstanbulBLSKey{PublicKey: blsKey.PublicKey, Proof: blsKey.Proof}
+	}
 	api.istanbul.candidatesLock.Lock()
 	defer api.istanbul.candidatesLock.Unlock()
 
 	api.istanbul.candidates[address] = auth
+	if key != nil {
+		api.istanbul.candidateBLSKeys[address] = key
+	} else {
+		delete(api.istanbul.candidateBLSKeys, address)
+	}
+	return nil
 }
 
 // Discard drops a currently running candidate, stopping the validator from casting
@@ -175,4 +225,5 @@ func (api *API) Discard(address common.Address) {
 	defer api.istanbul.candidatesLock.Unlock()
 
 	delete(api.istanbul.candidates, address)
+	delete(api.istanbul.candidateBLSKeys, address)
 }
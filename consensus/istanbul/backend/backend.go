@@ -18,6 +18,7 @@ package backend
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 	"math/big"
 	"sync"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -43,6 +45,30 @@ const (
 
 // New creates an Ethereum backend for Istanbul core engine.
 func New(config *istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Database) consensus.Istanbul {
+	backend := newEngine(config, consensus.NewKeySigner(privateKey), db)
+	backend.privateKey = privateKey
+	backend.address = crypto.PubkeyToAddress(privateKey.PublicKey)
+	backend.blsKey = bls.DeriveSecretKey(crypto.FromECDSA(privateKey))
+	backend.core = istanbulCore.New(backend, backend.config)
+	return backend
+}
+
+// Quorum
+//
+// NewWithSigner creates an Ethereum backend for Istanbul core engine whose
+// validator key is held by the signer, e.g. a signer plugin. The signer may
+// only be available once the node is started, so the address of the
+// validator is resolved when the engine is started.
+//
+// The BLS key of aggregated seals is derived from the validator key, hence
+// aggregated seals can't be signed by the node.
+func NewWithSigner(config *istanbul.Config, signer consensus.Signer, db ethdb.Database) consensus.Istanbul {
+	backend := newEngine(config, signer, db)
+	backend.core = istanbulCore.New(backend, backend.config)
+	return backend
+}
+
+func newEngine(config *istanbul.Config, signer consensus.Signer, db ethdb.Database) *backend {
 	// Allocate the snapshot caches and create the engine
 	recents, _ := lru.NewARC(inmemorySnapshots)
 	recentMessages, _ := lru.NewARC(inmemoryPeers)
@@ -50,28 +76,49 @@ func New(config *istanbul.Config, privateKey *ecdsa.PrivateKey, db ethdb.Databas
 	backend := &backend{
 		config:           config,
 		istanbulEventMux: new(event.TypeMux),
-		privateKey:       privateKey,
-		address:          crypto.PubkeyToAddress(privateKey.PublicKey),
+		signer:           signer,
 		logger:           log.New(),
 		db:               db,
 		commitCh:         make(chan *types.Block, 1),
 		recents:          recents,
 		candidates:       make(map[common.Address]bool),
+		candidateBLSKeys: make(map[common.Address]*types.IstanbulBLSKey),
 		coreStarted:      false,
 		recentMessages:   recentMessages,
 		knownMessages:    knownMessages,
+		proofRequests:    make(map[common.Address]chan *validatorProof),
+	}
+	backend.blsKeys = loadBLSKeys(config.BLSKeys)
+	if record, err := loadBootstrapRecord(config.Epoch, db); err == nil {
+		backend.bootstrap = record
 	}
-	backend.core = istanbulCore.New(backend, backend.config)
 	return backend
 }
 
+// loadBLSKeys decodes the registered BLS keys of the validators, leaving out any
+// key without a valid proof of possession.
+func loadBLSKeys(registered map[common.Address]*istanbul.BLSKey) map[common.Address]*bls.PublicKey {
+	keys := make(map[common.Address]*bls.PublicKey, len(registered))
+	for addr, key := range registered {
+		pub, err := bls.UnmarshalPublicKey(key.PublicKey)
+		if err != nil || !bls.VerifyProofOfPossession(pub, key.Proof) {
+			log.Error("Ignoring invalid validator BLS key", "validator", addr, "err", err)
+			continue
+		}
+		keys[addr] = pub
+	}
+	return keys
+}
+
 // ----------------------------------------------------------------------------
 
 type backend struct {
 	config           *istanbul.Config
 	istanbulEventMux *event.TypeMux
-	privateKey       *ecdsa.PrivateKey
+	privateKey       *ecdsa.PrivateKey // the validator key, nil if it is held by the signer only
+	signer           consensus.Signer
 	address          common.Address
+	addressMu        sync.RWMutex // Quorum: the address of a signer is resolved when the engine is started
 	core             istanbulCore.Engine
 	logger           log.Logger
 	db               ethdb.Database
@@ -88,6 +135,8 @@ type backend struct {
 
 	// Current list of candidates we are pushing
 	candidates map[common.Address]bool
+	// BLS keys registered along with the votes for the candidates
+	candidateBLSKeys map[common.Address]*types.IstanbulBLSKey
 	// Protects the signer fields
 	candidatesLock sync.RWMutex
 	// Snapshots for recent block to speed up reorgs
@@ -98,6 +147,18 @@ type backend struct {
 
 	recentMessages *lru.ARCCache // the cache of peer's messages
 	knownMessages  *lru.ARCCache // the cache of self messages
+
+	blsKey  *bls.SecretKey                    // the key committed seals are signed with once aggregated, nil if the validator key isn't local
+	blsKeys map[common.Address]*bls.PublicKey // the registered BLS keys of the validators
+
+	bootstrap     *bootstrapRecord                        // the checkpoint the node bootstrapped from, nil if none
+	bootstrapMu   sync.RWMutex                            // protects the bootstrap record
+	bootstrapLock sync.Mutex                              // serializes the attempts to bootstrap
+	proofRequests map[common.Address]chan *validatorProof // pending validator proof requests by peer
+	proofMu       sync.Mutex                              // protects the pending validator proof requests
+	transitions   []*validatorTransition                  // validator set transitions served to bootstrapping peers
+	transitionsTo uint64                                  // the block the transitions were scanned up to
+	transitionsMu sync.Mutex                              // protects the served transitions
 }
 
 // zekun: HACK
@@ -107,9 +168,30 @@ func (sb *backend) CalcDifficulty(chain consensus.ChainReader, time uint64, pare
 
 // Address implements istanbul.Backend.Address
 func (sb *backend) Address() common.Address {
+	sb.addressMu.RLock()
+	defer sb.addressMu.RUnlock()
 	return sb.address
 }
 
+// resolveAddress resolves the address of the validator from the signer the
+// first time the engine is started, creating the core for it. It must be
+// called with coreMu held.
+func (sb *backend) resolveAddress() error {
+	if sb.Address() != (common.Address{}) {
+		return nil
+	}
+	address, err := sb.signer.Address()
+	if err != nil {
+		return fmt.Errorf("unable to get the validator address from the signer: %v", err)
+	}
+	sb.addressMu.Lock()
+	sb.address = address
+	sb.addressMu.Unlock()
+	sb.core = istanbulCore.New(sb, sb.config)
+	log.Info("Using the validator key of the signer", "address", address)
+	return nil
+}
+
 // Validators implements istanbul.Backend.Validators
 func (sb *backend) Validators(proposal istanbul.Proposal) istanbul.ValidatorSet {
 	return sb.getValidators(proposal.Number().Uint64(), proposal.Hash())
@@ -132,37 +214,55 @@ func (sb *backend) Gossip(valSet istanbul.ValidatorSet, payload []byte) error {
 	hash := istanbul.RLPHash(payload)
 	sb.knownMessages.Add(hash, true)
 
+	sb.gossip(valSet, payload, hash, sb.config.RelayTTL)
+	return nil
+}
+
+// gossip sends the message to the connected validators and, if ttl is not
+// zero, wraps it for the remaining peers able to relay it further.
+func (sb *backend) gossip(valSet istanbul.ValidatorSet, payload []byte, hash common.Hash, ttl uint64) {
+	if sb.broadcaster == nil {
+		return
+	}
 	targets := make(map[common.Address]bool)
 	for _, val := range valSet.List() {
 		if val.Address() != sb.Address() {
 			targets[val.Address()] = true
 		}
 	}
-	if sb.broadcaster != nil && len(targets) > 0 {
+	sent := make(map[common.Address]bool)
+	if len(targets) > 0 {
 		ps := sb.broadcaster.FindPeers(targets)
 		for addr, p := range ps {
-			ms, ok := sb.recentMessages.Get(addr)
-			var m *lru.ARCCache
-			if ok {
-				m, _ = ms.(*lru.ARCCache)
-				if _, k := m.Get(hash); k {
-					// This peer had this event, skip it
-					continue
-				}
-			} else {
-				m, _ = lru.NewARC(inmemoryMessages)
+			sent[addr] = true
+			if sb.markPeerMessage(addr, hash) {
+				// This peer had this event, skip it
+				continue
 			}
-
-			m.Add(hash, true)
-			sb.recentMessages.Add(addr, m)
 			go p.Send(istanbulMsg, payload)
 		}
+		// Quorum: a validator whose key is held by a signer plugin connects
+		// with a different node key, so it can't be found by its address. A
+		// node using the signer plugin relays the message a single hop to the
+		// other peers, which non-validators accept even with a stopped engine.
+		if ttl == 0 && sb.privateKey == nil && len(ps) < len(targets) {
+			ttl = 1
+		}
+	}
+	if ttl == 0 {
+		return
+	}
+	relay := &relayMessage{Payload: payload, TTL: ttl}
+	for addr, p := range sb.broadcaster.RelayPeers() {
+		if sent[addr] || sb.markPeerMessage(addr, hash) {
+			continue
+		}
+		go p.Send(istanbulRelayMsg, relay)
 	}
-	return nil
 }
 
 // Commit implements istanbul.Backend.Commit
-func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte) error {
+func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte, committers []common.Address, round *big.Int) error {
 	// Check if the proposal is a valid block
 	block := &types.Block{}
 	block, ok := proposal.(*types.Block)
@@ -172,8 +272,19 @@ func (sb *backend) Commit(proposal istanbul.Proposal, seals [][]byte) error {
 	}
 
 	h := block.Header()
+	// Record the round the seals were given in, they sign it
+	if sb.config.IsCommitRound(h.Number) {
+		if err := writeCommitRound(h, round.Uint64()); err != nil {
+			return err
+		}
+	}
 	// Append seals into extra-data
-	err := writeCommittedSeals(h, seals)
+	var err error
+	if sb.config.IsAggregatedSeal(h.Number) {
+		err = sb.writeAggregatedSeal(h, seals, committers)
+	} else {
+		err = writeCommittedSeals(h, seals)
+	}
 	if err != nil {
 		return err
 	}
@@ -243,7 +354,35 @@ func (sb *backend) Verify(proposal istanbul.Proposal) (time.Duration, error) {
 // Sign implements istanbul.Backend.Sign
 func (sb *backend) Sign(data []byte) ([]byte, error) {
 	hashData := crypto.Keccak256(data)
-	return crypto.Sign(hashData, sb.privateKey)
+	return sb.signer.SignHash(hashData)
+}
+
+// SignCommittedSeal implements istanbul.Backend.SignCommittedSeal
+func (sb *backend) SignCommittedSeal(proposal istanbul.Proposal, round *big.Int) ([]byte, error) {
+	seal := istanbulCore.PrepareCommittedSeal(proposal.Hash())
+	if sb.config.IsCommitRound(proposal.Number()) {
+		seal = istanbulCore.PrepareRoundCommittedSeal(proposal.Hash(), round.Uint64())
+	}
+	if sb.config.IsAggregatedSeal(proposal.Number()) {
+		if sb.blsKey == nil {
+			return nil, errNoBLSKey
+		}
+		return sb.blsKey.Sign(seal), nil
+	}
+	return sb.Sign(seal)
+}
+
+// BLSKey returns the node's BLS public key and its proof of possession, to be
+// registered in the chain config before aggregated seals are activated or along
+// with the votes for the node afterwards. It returns nil if the node has no BLS key.
+func (sb *backend) BLSKey() *istanbul.BLSKey {
+	if sb.blsKey == nil {
+		return nil
+	}
+	return &istanbul.BLSKey{
+		PublicKey: sb.blsKey.PublicKey().Marshal(),
+		Proof:     sb.blsKey.ProofOfPossession(),
+	}
 }
 
 // CheckSignature implements istanbul.Backend.CheckSignature
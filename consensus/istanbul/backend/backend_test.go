@@ -19,15 +19,20 @@ package backend
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"math/big"
 	"sort"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -48,6 +53,68 @@ func TestSign(t *testing.T) {
 	}
 }
 
+// remoteSigner signs with a key the backend doesn't have, as a signer plugin would
+type remoteSigner struct {
+	key *ecdsa.PrivateKey
+}
+
+func (s *remoteSigner) Address() (common.Address, error) {
+	return crypto.PubkeyToAddress(s.key.PublicKey), nil
+}
+
+func (s *remoteSigner) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.key)
+}
+
+func TestNewWithSigner(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(1)
+	memDB := rawdb.NewMemoryDatabase()
+	config := *istanbul.DefaultConfig
+	config.AggregatedSealBlock = big.NewInt(2)
+	b := NewWithSigner(&config, &remoteSigner{nodeKeys[0]}, memDB).(*backend)
+	genesis.MustCommit(memDB)
+	chain, err := core.NewBlockChain(memDB, nil, genesis.Config, b, vm.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chain.Stop()
+	if b.Address() != (common.Address{}) {
+		t.Errorf("address mismatch: have %v, want the zero address before the engine is started", b.Address().Hex())
+	}
+
+	if err := b.Start(chain, chain.CurrentBlock, chain.HasBadBlock); err != nil {
+		t.Fatal(err)
+	}
+	defer b.Stop()
+
+	want := crypto.PubkeyToAddress(nodeKeys[0].PublicKey)
+	if b.Address() != want {
+		t.Errorf("address mismatch: have %v, want %v", b.Address().Hex(), want.Hex())
+	}
+	if !b.core.IsProposer() {
+		t.Errorf("the core must use the validator address of the signer")
+	}
+	data := []byte("Here is a string....")
+	sig, err := b.Sign(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.CheckSignature(data, want, sig); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})
+	if _, err := b.SignCommittedSeal(block, common.Big0); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	aggregated := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(2)})
+	if _, err := b.SignCommittedSeal(aggregated, common.Big0); err != errNoBLSKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoBLSKey)
+	}
+	if key := b.BLSKey(); key != nil {
+		t.Errorf("BLS key mismatch: have %v, want nil", key)
+	}
+}
+
 func TestCheckSignature(t *testing.T) {
 	key, _ := generatePrivateKey()
 	data := []byte("Here is a string....")
@@ -153,7 +220,7 @@ func TestCommit(t *testing.T) {
 		}()
 
 		backend.proposedBlockHash = expBlock.Hash()
-		if err := backend.Commit(expBlock, test.expectedSignature); err != nil {
+		if err := backend.Commit(expBlock, test.expectedSignature, nil, common.Big0); err != nil {
 			if err != test.expectedErr {
 				t.Errorf("error mismatch: have %v, want %v", err, test.expectedErr)
 			}
@@ -234,6 +301,12 @@ func (slice Keys) Swap(i, j int) {
 func newBackend() (b *backend) {
 	_, b = newBlockChain(4)
 	key, _ := generatePrivateKey()
-	b.privateKey = key
+	b.setKey(key)
 	return
 }
+
+// setKey makes the key the validator key of the backend
+func (sb *backend) setKey(key *ecdsa.PrivateKey) {
+	sb.privateKey = key
+	sb.signer = consensus.NewKeySigner(key)
+}
@@ -19,6 +19,7 @@ package backend
 import (
 	"bytes"
 	"errors"
+	"math"
 	"math/big"
 	"math/rand"
 	"time"
@@ -26,11 +27,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	istanbulCore "github.com/ethereum/go-ethereum/consensus/istanbul/core"
 	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -80,6 +83,26 @@ var (
 	errInvalidCommittedSeals = errors.New("invalid committed seals")
 	// errEmptyCommittedSeals is returned if the field of committed seals is zero.
 	errEmptyCommittedSeals = errors.New("zero committed seals")
+	// errInvalidAggregatedSeal is returned if the aggregated seal is missing after
+	// its activation block, present before it, or malformed.
+	errInvalidAggregatedSeal = errors.New("invalid aggregated seal")
+	// errUnknownBLSKey is returned if a committer has no registered BLS key.
+	errUnknownBLSKey = errors.New("unknown validator BLS key")
+	// errInsufficientCommittedSeals is returned if fewer committed seals than the
+	// quorum remain valid to aggregate.
+	errInsufficientCommittedSeals = errors.New("insufficient valid committed seals")
+	// errInvalidCommitRound is returned if the round of the commit is missing after
+	// the commit round fork block, or present before it.
+	errInvalidCommitRound = errors.New("invalid commit round")
+	// errInvalidVoteBLSKey is returned if a block registers a BLS key without voting to
+	// authorize a validator, or the key comes without a valid proof of possession.
+	errInvalidVoteBLSKey = errors.New("invalid vote BLS key")
+	// errMissingVoteBLSKey is returned if a block votes to authorize a validator that has
+	// no BLS key yet without registering one, once aggregated seals are activated.
+	errMissingVoteBLSKey = errors.New("missing vote BLS key")
+	// errNoBLSKey is returned if an aggregated seal is to be signed without a BLS key, which
+	// happens when the validator key is held by a signer plugin.
+	errNoBLSKey = errors.New("no BLS key to sign aggregated seals, the validator key is not local")
 	// errMismatchTxhashes is returned if the TxHash in header is mismatch.
 	errMismatchTxhashes = errors.New("mismatch transactions hashes")
 )
@@ -100,6 +123,9 @@ var (
 // block, which may be different from the header's coinbase if a consensus
 // engine is based on signatures.
 func (sb *backend) Author(header *types.Header) (common.Address, error) {
+	if sb.config.IsRaftBlock(header.Number) {
+		return header.Coinbase, nil
+	}
 	return ecrecover(header)
 }
 
@@ -107,13 +133,31 @@ func (sb *backend) Author(header *types.Header) (common.Address, error) {
 // It will extract for each seal who signed it, regardless of if the seal is
 // repeated
 func (sb *backend) Signers(header *types.Header) ([]common.Address, error) {
+	return sb.signers(sb.chain, header)
+}
+
+func (sb *backend) signers(chain consensus.ChainReader, header *types.Header) ([]common.Address, error) {
 	extra, err := types.ExtractIstanbulExtra(header)
 	if err != nil {
 		return []common.Address{}, err
 	}
+	// Aggregated seals don't reveal their signers, the bitmap does
+	if extra.AggregatedSeal != nil {
+		if chain == nil {
+			return nil, errUnknownBlock
+		}
+		snap, err := sb.snapshot(chain, header.Number.Uint64()-1, header.ParentHash, nil)
+		if err != nil {
+			return nil, err
+		}
+		return aggregatedSealCommitters(snap.ValSet, extra.AggregatedSeal)
+	}
 
 	var addrs []common.Address
-	proposalSeal := istanbulCore.PrepareCommittedSeal(header.Hash())
+	proposalSeal, err := sb.proposalSeal(header, extra)
+	if err != nil {
+		return nil, err
+	}
 
 	// 1. Get committed seals from current header
 	for _, seal := range extra.CommittedSeal {
@@ -143,6 +187,9 @@ func (sb *backend) verifyHeader(chain consensus.ChainReader, header *types.Heade
 	if header.Number == nil {
 		return errUnknownBlock
 	}
+	if sb.config.IsRaftBlock(header.Number) {
+		return sb.verifyRaftHeader(chain, header, parents)
+	}
 
 	// Don't waste time checking blocks from the future (adjusting for allowed threshold)
 	adjustedTimeNow := now().Add(time.Duration(sb.config.AllowedFutureBlockTime) * time.Second).Unix()
@@ -154,6 +201,10 @@ func (sb *backend) verifyHeader(chain consensus.ChainReader, header *types.Heade
 	if _, err := types.ExtractIstanbulExtra(header); err != nil {
 		return errInvalidExtraDataFormat
 	}
+	// Ensure that the chain leads to the checkpoint the node bootstrapped from
+	if record := sb.bootstrapped(); record != nil && header.Number.Uint64() == record.Number && header.Hash() != record.Hash {
+		return errInvalidCheckpoint
+	}
 
 	// Ensure that the coinbase is valid
 	if header.Nonce != (emptyNonce) && !bytes.Equal(header.Nonce[:], nonceAuthVote) && !bytes.Equal(header.Nonce[:], nonceDropVote) {
@@ -195,7 +246,8 @@ func (sb *backend) verifyCascadingFields(chain consensus.ChainReader, header *ty
 	if parent == nil || parent.Number.Uint64() != number-1 || parent.Hash() != header.ParentHash {
 		return consensus.ErrUnknownAncestor
 	}
-	if parent.Time+sb.config.BlockPeriod > header.Time {
+	// Raft timestamps are in nanoseconds, so they can't be compared
+	if parent.Time+sb.config.BlockPeriod > header.Time && !sb.config.IsRaftBlock(parent.Number) {
 		return errInvalidTimestamp
 	}
 	// Verify validators in extraData. Validators in snapshot and extraData should be the same.
@@ -207,6 +259,9 @@ func (sb *backend) verifyCascadingFields(chain consensus.ChainReader, header *ty
 	for i, validator := range snap.validators() {
 		copy(validators[i*common.AddressLength:], validator[:])
 	}
+	if err := sb.verifyVoteBLSKey(snap, header); err != nil {
+		return err
+	}
 	if err := sb.verifySigner(chain, header, parents); err != nil {
 		return err
 	}
@@ -214,6 +269,40 @@ func (sb *backend) verifyCascadingFields(chain consensus.ChainReader, header *ty
 	return sb.verifyCommittedSeals(chain, header, parents)
 }
 
+// verifyVoteBLSKey checks the BLS key a block registers along with its vote to
+// authorize a validator. Once aggregated seals are activated, a validator can
+// only be voted in with a key, unless it has one already.
+func (sb *backend) verifyVoteBLSKey(snap *Snapshot, header *types.Header) error {
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return err
+	}
+	authorize := bytes.Equal(header.Nonce[:], nonceAuthVote)
+	if extra.VoteBLSKey != nil {
+		if !authorize {
+			return errInvalidVoteBLSKey
+		}
+		key, err := bls.UnmarshalPublicKey(extra.VoteBLSKey.PublicKey)
+		if err != nil || !bls.VerifyProofOfPossession(key, extra.VoteBLSKey.Proof) {
+			return errInvalidVoteBLSKey
+		}
+		return nil
+	}
+	if authorize && sb.config.IsAggregatedSeal(header.Number) && sb.blsPublicKey(snap, header.Coinbase) == nil {
+		return errMissingVoteBLSKey
+	}
+	return nil
+}
+
+// blsPublicKey returns the BLS key of the validator, registered by the vote that
+// authorized it or else in the chain config. It returns nil if there is none.
+func (sb *backend) blsPublicKey(snap *Snapshot, address common.Address) *bls.PublicKey {
+	if key := snap.BLSKeys[address]; key != nil {
+		return key
+	}
+	return sb.blsKeys[address]
+}
+
 // VerifyHeaders is similar to VerifyHeader, but verifies a batch of headers
 // concurrently. The method returns a quit channel to abort the operations and
 // a results channel to retrieve the async verifications (the order is that of
@@ -299,6 +388,12 @@ func (sb *backend) verifyCommittedSeals(chain consensus.ChainReader, header *typ
 	if err != nil {
 		return err
 	}
+	if sb.config.IsAggregatedSeal(header.Number) {
+		return sb.verifyAggregatedSeal(snap, header, extra)
+	}
+	if extra.AggregatedSeal != nil {
+		return errInvalidAggregatedSeal
+	}
 	// The length of Committed seals should be larger than 0
 	if len(extra.CommittedSeal) == 0 {
 		return errEmptyCommittedSeals
@@ -327,6 +422,83 @@ func (sb *backend) verifyCommittedSeals(chain consensus.ChainReader, header *typ
 	return nil
 }
 
+// verifyAggregatedSeal checks that the aggregated seal is signed by more than F
+// of the parent's validators with a single pairing check.
+func (sb *backend) verifyAggregatedSeal(snap *Snapshot, header *types.Header, extra *types.IstanbulExtra) error {
+	valSet := snap.ValSet
+	if len(extra.CommittedSeal) != 0 {
+		return errInvalidAggregatedSeal
+	}
+	if extra.AggregatedSeal == nil {
+		return errEmptyCommittedSeals
+	}
+	committers, err := aggregatedSealCommitters(valSet, extra.AggregatedSeal)
+	if err != nil {
+		return err
+	}
+	if len(committers) <= valSet.F() {
+		return errInvalidCommittedSeals
+	}
+	keys := make([]*bls.PublicKey, len(committers))
+	for i, addr := range committers {
+		if keys[i] = sb.blsPublicKey(snap, addr); keys[i] == nil {
+			return errUnknownBLSKey
+		}
+	}
+	proposalSeal, err := sb.proposalSeal(header, extra)
+	if err != nil {
+		return err
+	}
+	if !bls.Verify(bls.AggregatePublicKeys(keys), proposalSeal, extra.AggregatedSeal.Signature) {
+		return errInvalidCommittedSeals
+	}
+	return nil
+}
+
+// quorumSize returns the number of committed seals the core engine requires to
+// commit the block at the given number.
+func (sb *backend) quorumSize(valSet istanbul.ValidatorSet, number *big.Int) int {
+	if sb.config.Ceil2Nby3Block == nil || number.Cmp(sb.config.Ceil2Nby3Block) < 0 {
+		return 2*valSet.F() + 1
+	}
+	return int(math.Ceil(float64(2*valSet.Size()) / 3))
+}
+
+// proposalSeal returns the data the committed seals of the header sign, which
+// includes the round of the commit from the commit round fork block on.
+func (sb *backend) proposalSeal(header *types.Header, extra *types.IstanbulExtra) ([]byte, error) {
+	if !sb.config.IsCommitRound(header.Number) {
+		if extra.Round != nil {
+			return nil, errInvalidCommitRound
+		}
+		return istanbulCore.PrepareCommittedSeal(header.Hash()), nil
+	}
+	if extra.Round == nil {
+		return nil, errInvalidCommitRound
+	}
+	return istanbulCore.PrepareRoundCommittedSeal(header.Hash(), *extra.Round), nil
+}
+
+// aggregatedSealCommitters resolves the committers flagged in the bitmap of an
+// aggregated seal against the validator set of the parent block.
+func aggregatedSealCommitters(valSet istanbul.ValidatorSet, seal *types.IstanbulAggregatedSeal) ([]common.Address, error) {
+	if len(seal.Bitmap) != (valSet.Size()+7)/8 {
+		return nil, errInvalidAggregatedSeal
+	}
+	var committers []common.Address
+	for i := 0; i < len(seal.Bitmap)*8; i++ {
+		if seal.Bitmap[i/8]&(1<<uint(i%8)) == 0 {
+			continue
+		}
+		val := valSet.GetByIndex(uint64(i))
+		if val == nil {
+			return nil, errInvalidAggregatedSeal
+		}
+		committers = append(committers, val.Address())
+	}
+	return committers, nil
+}
+
 // VerifySeal checks whether the crypto seal on a header is valid according to
 // the consensus rules of the given engine.
 func (sb *backend) VerifySeal(chain consensus.ChainReader, header *types.Header) error {
@@ -335,6 +507,9 @@ func (sb *backend) VerifySeal(chain consensus.ChainReader, header *types.Header)
 	if number == 0 {
 		return errUnknownBlock
 	}
+	if sb.config.IsRaftBlock(header.Number) {
+		return nil
+	}
 
 	// ensure that the difficulty equals to defaultDifficulty
 	if header.Difficulty.Cmp(defaultDifficulty) != 0 {
@@ -346,6 +521,9 @@ func (sb *backend) VerifySeal(chain consensus.ChainReader, header *types.Header)
 // Prepare initializes the consensus fields of a block header according to the
 // rules of a particular engine. The changes are executed inline.
 func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) error {
+	if sb.config.IsRaftBlock(header.Number) {
+		return errRaftBlock
+	}
 	// unused fields, force to set to empty
 	header.Coinbase = common.Address{}
 	header.Nonce = emptyNonce
@@ -370,14 +548,29 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 	sb.candidatesLock.RLock()
 	var addresses []common.Address
 	var authorizes []bool
+	var blsKeys []*types.IstanbulBLSKey
 	for address, authorize := range sb.candidates {
-		if snap.checkVote(address, authorize) {
-			addresses = append(addresses, address)
-			authorizes = append(authorizes, authorize)
+		if !snap.checkVote(address, authorize) {
+			continue
+		}
+		blsKey := sb.candidateBLSKeys[address]
+		if authorize && blsKey == nil && sb.config.IsAggregatedSeal(header.Number) && sb.blsPublicKey(snap, address) == nil {
+			sb.logger.Warn("Not voting for candidate without a BLS key", "candidate", address)
+			continue
 		}
+		addresses = append(addresses, address)
+		authorizes = append(authorizes, authorize)
+		blsKeys = append(blsKeys, blsKey)
 	}
 	sb.candidatesLock.RUnlock()
 
+	// add validators in snapshot to extraData's validators section
+	extra, err := prepareExtra(header, snap.validators())
+	if err != nil {
+		return err
+	}
+	header.Extra = extra
+
 	// pick one of the candidates randomly
 	if len(addresses) > 0 {
 		index := rand.Intn(len(addresses))
@@ -385,21 +578,20 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 		header.Coinbase = addresses[index]
 		if authorizes[index] {
 			copy(header.Nonce[:], nonceAuthVote)
+			// register the BLS key of the candidate along with the vote
+			if blsKeys[index] != nil {
+				if err := writeVoteBLSKey(header, blsKeys[index]); err != nil {
+					return err
+				}
+			}
 		} else {
 			copy(header.Nonce[:], nonceDropVote)
 		}
 	}
 
-	// add validators in snapshot to extraData's validators section
-	extra, err := prepareExtra(header, snap.validators())
-	if err != nil {
-		return err
-	}
-	header.Extra = extra
-
 	// set header's timestamp
 	header.Time = parent.Time + sb.config.BlockPeriod
-	if header.Time < uint64(time.Now().Unix()) {
+	if header.Time < uint64(time.Now().Unix()) || sb.config.IsRaftBlock(parent.Number) {
 		header.Time = uint64(time.Now().Unix())
 	}
 	return nil
@@ -412,6 +604,10 @@ func (sb *backend) Prepare(chain consensus.ChainReader, header *types.Header) er
 // consensus rules that happen at finalization (e.g. block rewards).
 func (sb *backend) Finalize(chain consensus.ChainReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
 	uncles []*types.Header) {
+	// Blocks minted by raft carry the rewards of the engine raft nodes run with
+	if sb.config.IsRaftBlock(header.Number) {
+		ethash.AccumulateRewards(chain.Config(), state, header, uncles)
+	}
 	// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
@@ -420,6 +616,9 @@ func (sb *backend) Finalize(chain consensus.ChainReader, header *types.Header, s
 // FinalizeAndAssemble implements consensus.Engine, ensuring no uncles are set,
 // nor block rewards given, and returns the final block.
 func (sb *backend) FinalizeAndAssemble(chain consensus.ChainReader, header *types.Header, state *state.StateDB, txs []*types.Transaction, uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
+	if sb.config.IsRaftBlock(header.Number) {
+		return nil, errRaftBlock
+	}
 	/// No block rewards in Istanbul, so the state remains as is and uncles are dropped
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
@@ -435,12 +634,15 @@ func (sb *backend) Seal(chain consensus.ChainReader, block *types.Block, results
 	// update the block header timestamp and signature and propose the block to core engine
 	header := block.Header()
 	number := header.Number.Uint64()
+	if sb.config.IsRaftBlock(header.Number) {
+		return errRaftBlock
+	}
 	// Bail out if we're unauthorized to sign a block
 	snap, err := sb.snapshot(chain, number-1, header.ParentHash, nil)
 	if err != nil {
 		return err
 	}
-	if _, v := snap.ValSet.GetByAddress(sb.address); v == nil {
+	if _, v := snap.ValSet.GetByAddress(sb.Address()); v == nil {
 		return errUnauthorized
 	}
 
@@ -528,6 +730,10 @@ func (sb *backend) Start(chain consensus.ChainReader, currentBlock func() *types
 	if sb.coreStarted {
 		return istanbul.ErrStartedEngine
 	}
+	if err := sb.resolveAddress(); err != nil {
+		log.Error("Failed to start Istanbul engine", "err", err)
+		return err
+	}
 
 	// clear previous data
 	sb.proposedBlockHash = common.Hash{}
@@ -575,14 +781,32 @@ func (sb *backend) snapshot(chain consensus.ChainReader, number uint64, hash com
 			snap = s.(*Snapshot)
 			break
 		}
-		// If an on-disk checkpoint snapshot can be found, use that
-		if number%checkpointInterval == 0 {
+		// If an on-disk checkpoint snapshot can be found, use that. Epoch blocks
+		// are checked as well since bootstrapped snapshots are stored there
+		if number%checkpointInterval == 0 || number%sb.config.Epoch == 0 {
 			if s, err := loadSnapshot(sb.config.Epoch, sb.db, hash); err == nil {
 				log.Trace("Loaded voting snapshot form disk", "number", number, "hash", hash)
 				snap = s
 				break
 			}
 		}
+		// Before the checkpoint the node bootstrapped from, only the validators
+		// are known, which is all the headers there are verified against
+		if record := sb.bootstrapped(); record != nil && number < record.Number {
+			if len(headers) > 0 {
+				return nil, errInvalidCheckpoint
+			}
+			return record.snapshot(number, hash), nil
+		}
+		// If we're at the last raft block, make a snapshot of the initial validators
+		if sb.config.RaftMigrationBlock != nil && number+1 == sb.config.RaftMigrationBlock.Uint64() {
+			snap = newSnapshot(sb.config.Epoch, number, hash, validator.NewSet(sb.config.Validators, sb.config.ProposerPolicy))
+			if err := snap.store(sb.db); err != nil {
+				return nil, err
+			}
+			log.Trace("Stored raft migration voting snapshot to disk", "number", number, "hash", hash)
+			break
+		}
 		// If we're at block zero, make a snapshot
 		if number == 0 {
 			genesis := chain.GetHeaderByNumber(0)
@@ -728,6 +952,42 @@ func writeSeal(h *types.Header, seal []byte) error {
 	return nil
 }
 
+// writeCommitRound writes the round the block was committed in to the extra-data
+// field of its header.
+func writeCommitRound(h *types.Header, round uint64) error {
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return err
+	}
+
+	istanbulExtra.Round = &round
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return err
+	}
+
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return nil
+}
+
+// writeVoteBLSKey writes the BLS key the vote of the block registers for the
+// candidate to the extra-data field of its header.
+func writeVoteBLSKey(h *types.Header, key *types.IstanbulBLSKey) error {
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return err
+	}
+
+	istanbulExtra.VoteBLSKey = key
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return err
+	}
+
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return nil
+}
+
 // writeCommittedSeals writes the extra-data field of a block header with given committed seals.
 func writeCommittedSeals(h *types.Header, committedSeals [][]byte) error {
 	if len(committedSeals) == 0 {
@@ -756,3 +1016,63 @@ func writeCommittedSeals(h *types.Header, committedSeals [][]byte) error {
 	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
 	return nil
 }
+
+// writeAggregatedSeal writes the extra-data field of a block header with the
+// aggregate of the given committed seals and the bitmap of their committers.
+// Seals failing verification are left out rather than spoiling the aggregate,
+// as long as the remaining seals still reach the quorum.
+func (sb *backend) writeAggregatedSeal(h *types.Header, committedSeals [][]byte, committers []common.Address) error {
+	if len(committedSeals) == 0 || len(committedSeals) != len(committers) {
+		return errInvalidCommittedSeals
+	}
+	snap, err := sb.snapshot(sb.chain, h.Number.Uint64()-1, h.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+	valSet := snap.ValSet
+	istanbulExtra, err := types.ExtractIstanbulExtra(h)
+	if err != nil {
+		return err
+	}
+	proposalSeal, err := sb.proposalSeal(h, istanbulExtra)
+	if err != nil {
+		return err
+	}
+
+	bitmap := make([]byte, (valSet.Size()+7)/8)
+	var seals [][]byte
+	for i, addr := range committers {
+		index, val := valSet.GetByAddress(addr)
+		if val == nil {
+			return errInvalidCommittedSeals
+		}
+		key := sb.blsPublicKey(snap, addr)
+		if key == nil || !bls.Verify(key, proposalSeal, committedSeals[i]) {
+			sb.logger.Warn("Dropping invalid committed seal", "committer", addr, "number", h.Number)
+			continue
+		}
+		bitmap[index/8] |= 1 << uint(index%8)
+		seals = append(seals, committedSeals[i])
+	}
+	if len(seals) < sb.quorumSize(valSet, h.Number) {
+		return errInsufficientCommittedSeals
+	}
+	signature, err := bls.AggregateSignatures(seals)
+	if err != nil {
+		return errInvalidCommittedSeals
+	}
+
+	istanbulExtra.CommittedSeal = [][]byte{}
+	istanbulExtra.AggregatedSeal = &types.IstanbulAggregatedSeal{
+		Bitmap:    bitmap,
+		Signature: signature,
+	}
+
+	payload, err := rlp.EncodeToBytes(&istanbulExtra)
+	if err != nil {
+		return err
+	}
+
+	h.Extra = append(h.Extra[:types.IstanbulExtraVanity], payload...)
+	return nil
+}
@@ -28,11 +28,13 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulCore "github.com/ethereum/go-ethereum/consensus/istanbul/core"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rlp"
 )
@@ -42,8 +44,11 @@ import (
 // other fake events to process Istanbul.
 func newBlockChain(n int) (*core.BlockChain, *backend) {
 	genesis, nodeKeys := getGenesisAndKeys(n)
+	return newBlockChainWithConfig(genesis, nodeKeys, istanbul.DefaultConfig)
+}
+
+func newBlockChainWithConfig(genesis *core.Genesis, nodeKeys []*ecdsa.PrivateKey, config *istanbul.Config) (*core.BlockChain, *backend) {
 	memDB := rawdb.NewMemoryDatabase()
-	config := istanbul.DefaultConfig
 	// Use the first key as private key
 	b, _ := New(config, nodeKeys[0], memDB).(*backend)
 	genesis.MustCommit(memDB)
@@ -65,7 +70,7 @@ func newBlockChain(n int) (*core.BlockChain, *backend) {
 	for _, key := range nodeKeys {
 		addr := crypto.PubkeyToAddress(key.PublicKey)
 		if addr.String() == proposerAddr.String() {
-			b.privateKey = key
+			b.setKey(key)
 			b.address = addr
 		}
 	}
@@ -205,7 +210,7 @@ func TestSealCommittedOtherHash(t *testing.T) {
 		if _, ok := ev.Data.(istanbul.RequestEvent); !ok {
 			t.Errorf("unexpected event comes: %v", reflect.TypeOf(ev.Data))
 		}
-		if err := engine.Commit(otherBlock, [][]byte{expectedCommittedSeal}); err != nil {
+		if err := engine.Commit(otherBlock, [][]byte{expectedCommittedSeal}, nil, common.Big0); err != nil {
 			t.Error(err.Error())
 		}
 		eventSub.Unsubscribe()
@@ -363,7 +368,8 @@ func TestVerifySeal(t *testing.T) {
 	}
 
 	// unauthorized users but still can get correct signer address
-	engine.privateKey, _ = crypto.GenerateKey()
+	key, _ := crypto.GenerateKey()
+	engine.setKey(key)
 	err = engine.VerifySeal(chain, block.Header())
 	if err != nil {
 		t.Errorf("error mismatch: have %v, want nil", err)
@@ -578,3 +584,209 @@ func TestWriteCommittedSeals(t *testing.T) {
 		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommittedSeals)
 	}
 }
+
+func TestAggregatedSeal(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(1)
+	config := *istanbul.DefaultConfig
+	config.AggregatedSealBlock = big.NewInt(2)
+	config.BLSKeys = make(map[common.Address]*istanbul.BLSKey)
+	for _, key := range nodeKeys {
+		blsKey := bls.DeriveSecretKey(crypto.FromECDSA(key))
+		config.BLSKeys[crypto.PubkeyToAddress(key.PublicKey)] = &istanbul.BLSKey{
+			PublicKey: blsKey.PublicKey().Marshal(),
+			Proof:     blsKey.ProofOfPossession(),
+		}
+	}
+	chain, engine := newBlockChainWithConfig(genesis, nodeKeys, &config)
+
+	// Blocks before the fork carry individual committed seals
+	block1 := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block1}); err != nil {
+		t.Fatalf("failed to insert block 1: %v", err)
+	}
+	engine.NewChainHead()
+	extra, _ := types.ExtractIstanbulExtra(block1.Header())
+	if extra.AggregatedSeal != nil || len(extra.CommittedSeal) != 1 {
+		t.Errorf("block 1 should carry individual committed seals")
+	}
+	// Blocks from the fork on carry the aggregated seal
+	block2 := makeBlock(chain, engine, block1)
+	extra, _ = types.ExtractIstanbulExtra(block2.Header())
+	if extra.AggregatedSeal == nil || len(extra.CommittedSeal) != 0 {
+		t.Fatalf("block 2 should carry an aggregated seal")
+	}
+	if len(extra.AggregatedSeal.Signature) != bls.SignatureLength {
+		t.Errorf("signature length mismatch: have %d, want %d", len(extra.AggregatedSeal.Signature), bls.SignatureLength)
+	}
+	if err := engine.VerifyHeader(chain, block2.Header(), false); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	signers, err := engine.Signers(block2.Header())
+	if err != nil || len(signers) != 1 || signers[0] != engine.Address() {
+		t.Errorf("signers mismatch: have %v (%v), want %v", signers, err, engine.Address())
+	}
+
+	// A tampered signature must be rejected
+	header := types.CopyHeader(block2.Header())
+	extra.AggregatedSeal.Signature = bls.DeriveSecretKey([]byte("other")).Sign(istanbulCore.PrepareCommittedSeal(header.Hash()))
+	payload, _ := rlp.EncodeToBytes(extra)
+	header.Extra = append(header.Extra[:types.IstanbulExtraVanity], payload...)
+	if err := engine.VerifyHeader(chain, header, false); err != errInvalidCommittedSeals {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommittedSeals)
+	}
+	// So must individual seals after the fork and an aggregated seal before it
+	header = types.CopyHeader(block2.Header())
+	if err := writeCommittedSeals(header, [][]byte{make([]byte, types.IstanbulExtraSeal)}); err != nil {
+		t.Fatalf("failed to write committed seals: %v", err)
+	}
+	if err := engine.VerifyHeader(chain, header, false); err != errInvalidAggregatedSeal {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidAggregatedSeal)
+	}
+	header = types.CopyHeader(block1.Header())
+	extra, _ = types.ExtractIstanbulExtra(header)
+	extra.AggregatedSeal = &types.IstanbulAggregatedSeal{Bitmap: []byte{1}, Signature: make([]byte, bls.SignatureLength)}
+	payload, _ = rlp.EncodeToBytes(extra)
+	header.Extra = append(header.Extra[:types.IstanbulExtraVanity], payload...)
+	if err := engine.VerifyHeader(chain, header, false); err != errInvalidAggregatedSeal {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidAggregatedSeal)
+	}
+	// Invalid seals are not aggregated below the quorum
+	header = types.CopyHeader(block2.Header())
+	invalidSeal := bls.DeriveSecretKey([]byte("other")).Sign(istanbulCore.PrepareCommittedSeal(header.Hash()))
+	if err := engine.writeAggregatedSeal(header, [][]byte{invalidSeal}, []common.Address{engine.Address()}); err != errInsufficientCommittedSeals {
+		t.Errorf("error mismatch: have %v, want %v", err, errInsufficientCommittedSeals)
+	}
+}
+
+func TestVoteBLSKey(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(1)
+	config := *istanbul.DefaultConfig
+	config.AggregatedSealBlock = big.NewInt(1)
+	config.BLSKeys = make(map[common.Address]*istanbul.BLSKey)
+	for _, key := range nodeKeys {
+		blsKey := bls.DeriveSecretKey(crypto.FromECDSA(key))
+		config.BLSKeys[crypto.PubkeyToAddress(key.PublicKey)] = &istanbul.BLSKey{
+			PublicKey: blsKey.PublicKey().Marshal(),
+			Proof:     blsKey.ProofOfPossession(),
+		}
+	}
+	chain, engine := newBlockChainWithConfig(genesis, nodeKeys, &config)
+	api := &API{chain: chain, istanbul: engine}
+
+	candidateKey, _ := crypto.GenerateKey()
+	candidate := crypto.PubkeyToAddress(candidateKey.PublicKey)
+	blsKey := bls.DeriveSecretKey(crypto.FromECDSA(candidateKey))
+	key := &params.IstanbulBLSKey{PublicKey: blsKey.PublicKey().Marshal(), Proof: blsKey.ProofOfPossession()}
+
+	// Keys without a valid proof of possession are refused
+	if err := api.Propose(candidate, true, &params.IstanbulBLSKey{PublicKey: key.PublicKey, Proof: key.PublicKey}); err != errInvalidVoteBLSKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidVoteBLSKey)
+	}
+	// Once aggregated seals are activated, candidates aren't voted in without a key
+	if err := api.Propose(candidate, true, nil); err != nil {
+		t.Fatalf("failed to propose candidate: %v", err)
+	}
+	header := makeHeader(chain.Genesis(), engine.config)
+	if err := engine.Prepare(chain, header); err != nil {
+		t.Fatalf("failed to prepare header: %v", err)
+	}
+	if header.Coinbase != (common.Address{}) {
+		t.Errorf("vote mismatch: have %v, want none", header.Coinbase.Hex())
+	}
+	header.Coinbase = candidate
+	copy(header.Nonce[:], nonceAuthVote)
+	if err := engine.verifyVoteBLSKey(snapshotAt(t, engine, chain, 0), header); err != errMissingVoteBLSKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errMissingVoteBLSKey)
+	}
+
+	// The key is registered along with the vote
+	if err := api.Propose(candidate, true, key); err != nil {
+		t.Fatalf("failed to propose candidate: %v", err)
+	}
+	block := makeBlock(chain, engine, chain.Genesis())
+	extra, _ := types.ExtractIstanbulExtra(block.Header())
+	if block.Coinbase() != candidate || extra.VoteBLSKey == nil || !bytes.Equal(extra.VoteBLSKey.PublicKey, key.PublicKey) {
+		t.Fatalf("vote mismatch: have %v with key %v, want %v", block.Coinbase().Hex(), extra.VoteBLSKey, candidate.Hex())
+	}
+	if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+		t.Fatalf("failed to insert block: %v", err)
+	}
+	snap := snapshotAt(t, engine, chain, 1)
+	if _, v := snap.ValSet.GetByAddress(candidate); v == nil {
+		t.Fatalf("candidate should be a validator")
+	}
+	if registered := snap.BLSKeys[candidate]; registered == nil || !bytes.Equal(registered.Marshal(), key.PublicKey) {
+		t.Errorf("registered key mismatch: have %v, want %x", registered, key.PublicKey)
+	}
+
+	// Keys can't be registered by drop votes
+	header = types.CopyHeader(block.Header())
+	copy(header.Nonce[:], nonceDropVote)
+	if err := engine.verifyVoteBLSKey(snapshotAt(t, engine, chain, 0), header); err != errInvalidVoteBLSKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidVoteBLSKey)
+	}
+}
+
+// snapshotAt returns the snapshot at the given block of the canonical chain.
+func snapshotAt(t *testing.T, engine *backend, chain *core.BlockChain, number uint64) *Snapshot {
+	header := chain.GetHeaderByNumber(number)
+	snap, err := engine.snapshot(chain, number, header.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to get snapshot at %d: %v", number, err)
+	}
+	return snap
+}
+
+func TestCommitRound(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(1)
+	config := *istanbul.DefaultConfig
+	config.CommitRoundBlock = big.NewInt(2)
+	chain, engine := newBlockChainWithConfig(genesis, nodeKeys, &config)
+
+	// Blocks before the fork don't record the round
+	block1 := makeBlock(chain, engine, chain.Genesis())
+	if _, err := chain.InsertChain(types.Blocks{block1}); err != nil {
+		t.Fatalf("failed to insert block 1: %v", err)
+	}
+	engine.NewChainHead()
+	extra, _ := types.ExtractIstanbulExtra(block1.Header())
+	if extra.Round != nil {
+		t.Errorf("block 1 should not record the round")
+	}
+	// Blocks from the fork on record the round, signed by the committed seals
+	block2 := makeBlock(chain, engine, block1)
+	extra, _ = types.ExtractIstanbulExtra(block2.Header())
+	if extra.Round == nil || *extra.Round != 0 {
+		t.Fatalf("block 2 should record round 0, have %v", extra.Round)
+	}
+	if err := engine.VerifyHeader(chain, block2.Header(), false); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+
+	// A tampered round must be rejected
+	header := types.CopyHeader(block2.Header())
+	if err := writeCommitRound(header, 1); err != nil {
+		t.Fatalf("failed to write round: %v", err)
+	}
+	if header.Hash() != block2.Hash() {
+		t.Errorf("hash mismatch: have %v, want %v", header.Hash().Hex(), block2.Hash().Hex())
+	}
+	if err := engine.VerifyHeader(chain, header, false); err != errInvalidCommittedSeals {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommittedSeals)
+	}
+	// So must a missing round after the fork and a round before it
+	header = types.CopyHeader(block2.Header())
+	extra.Round = nil
+	payload, _ := rlp.EncodeToBytes(extra)
+	header.Extra = append(header.Extra[:types.IstanbulExtraVanity], payload...)
+	if err := engine.VerifyHeader(chain, header, false); err != errInvalidCommitRound {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommitRound)
+	}
+	header = types.CopyHeader(block1.Header())
+	if err := writeCommitRound(header, 0); err != nil {
+		t.Fatalf("failed to write round: %v", err)
+	}
+	if err := engine.VerifyHeader(chain, header, false); err != errInvalidCommitRound {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommitRound)
+	}
+}
@@ -26,15 +26,20 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	istanbulCore "github.com/ethereum/go-ethereum/consensus/istanbul/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/p2p"
 	lru "github.com/hashicorp/golang-lru"
 )
 
 const (
-	istanbulMsg = 0x11
-	NewBlockMsg = 0x07
+	istanbulMsg          = 0x11
+	istanbulRelayMsg     = 0x12
+	getValidatorProofMsg = 0x13
+	validatorProofMsg    = 0x14
+	NewBlockMsg          = 0x07
 )
 
 var (
@@ -42,6 +47,19 @@ var (
 	errDecodeFailed = errors.New("fail to decode istanbul message")
 )
 
+var (
+	duplicateMeter     = metrics.NewRegisteredMeter("consensus/istanbul/backend/duplicates", nil)
+	relayMeter         = metrics.NewRegisteredMeter("consensus/istanbul/backend/relays", nil)
+	unknownSenderMeter = metrics.NewRegisteredMeter("consensus/istanbul/backend/unknownsender", nil)
+)
+
+// relayMessage is an istanbul message forwarded through peers which are not
+// necessarily validators, along with the number of hops it may still travel.
+type relayMessage struct {
+	Payload []byte
+	TTL     uint64
+}
+
 // Protocol implements consensus.Engine.Protocol
 func (sb *backend) Protocol() consensus.Protocol {
 	return consensus.IstanbulProtocol
@@ -58,6 +76,16 @@ func (sb *backend) decode(msg p2p.Msg) ([]byte, common.Hash, error) {
 
 // HandleMsg implements consensus.Handler.HandleMsg
 func (sb *backend) HandleMsg(addr common.Address, msg p2p.Msg) (bool, error) {
+	// Relayed messages are validated and proofs are served without holding
+	// the core lock, so that they don't hold up the consensus messages
+	switch msg.Code {
+	case istanbulRelayMsg:
+		return true, sb.handleRelayMsg(addr, msg)
+	case getValidatorProofMsg:
+		return true, sb.handleGetValidatorProof(addr)
+	case validatorProofMsg:
+		return true, sb.handleValidatorProof(addr, msg)
+	}
 	sb.coreMu.Lock()
 	defer sb.coreMu.Unlock()
 	if msg.Code == istanbulMsg {
@@ -70,18 +98,11 @@ func (sb *backend) HandleMsg(addr common.Address, msg p2p.Msg) (bool, error) {
 			return true, errDecodeFailed
 		}
 		// Mark peer's message
-		ms, ok := sb.recentMessages.Get(addr)
-		var m *lru.ARCCache
-		if ok {
-			m, _ = ms.(*lru.ARCCache)
-		} else {
-			m, _ = lru.NewARC(inmemoryMessages)
-			sb.recentMessages.Add(addr, m)
-		}
-		m.Add(hash, true)
+		sb.markPeerMessage(addr, hash)
 
 		// Mark self known message
 		if _, ok := sb.knownMessages.Get(hash); ok {
+			duplicateMeter.Mark(1)
 			return true, nil
 		}
 		sb.knownMessages.Add(hash, true)
@@ -120,11 +141,83 @@ func (sb *backend) HandleMsg(addr common.Address, msg p2p.Msg) (bool, error) {
 	return false, nil
 }
 
+// handleRelayMsg accepts a relayed istanbul message, also when the engine is
+// not started, and forwards it while it has hops left and relaying is enabled.
+// The core lock is only taken once the sender is verified.
+func (sb *backend) handleRelayMsg(addr common.Address, msg p2p.Msg) error {
+	var relay relayMessage
+	if err := msg.Decode(&relay); err != nil {
+		return errDecodeFailed
+	}
+	hash := istanbul.RLPHash(relay.Payload)
+	sb.markPeerMessage(addr, hash)
+	if _, ok := sb.knownMessages.Get(hash); ok {
+		duplicateMeter.Mark(1)
+		return nil
+	}
+
+	// Only messages signed by a current validator are accepted, so that peers
+	// cannot use the relay to flood the network
+	if sb.chain == nil {
+		unknownSenderMeter.Mark(1)
+		return nil
+	}
+	header := sb.chain.CurrentHeader()
+	valSet := sb.getValidators(header.Number.Uint64(), header.Hash())
+	if _, err := istanbulCore.MessageSender(valSet, relay.Payload); err != nil {
+		log.Trace("Dropped relayed message", "peer", addr, "err", err)
+		unknownSenderMeter.Mark(1)
+		return nil
+	}
+	sb.knownMessages.Add(hash, true)
+
+	sb.coreMu.RLock()
+	if sb.coreStarted {
+		go sb.istanbulEventMux.Post(istanbul.MessageEvent{
+			Payload: relay.Payload,
+		})
+	}
+	sb.coreMu.RUnlock()
+	if relay.TTL > 1 && sb.config.RelayTTL > 0 {
+		// The sender chooses the TTL, relay no further than this node would
+		ttl := relay.TTL - 1
+		if ttl > sb.config.RelayTTL {
+			ttl = sb.config.RelayTTL
+		}
+		relayMeter.Mark(1)
+		sb.gossip(valSet, relay.Payload, hash, ttl)
+	}
+	return nil
+}
+
+// markPeerMessage marks the message as known by the peer and returns whether
+// the peer already knew it.
+func (sb *backend) markPeerMessage(addr common.Address, hash common.Hash) bool {
+	ms, ok := sb.recentMessages.Get(addr)
+	var m *lru.ARCCache
+	if ok {
+		m, _ = ms.(*lru.ARCCache)
+		if _, k := m.Get(hash); k {
+			return true
+		}
+	} else {
+		m, _ = lru.NewARC(inmemoryMessages)
+	}
+	m.Add(hash, true)
+	sb.recentMessages.Add(addr, m)
+	return false
+}
+
 // SetBroadcaster implements consensus.Handler.SetBroadcaster
 func (sb *backend) SetBroadcaster(broadcaster consensus.Broadcaster) {
 	sb.broadcaster = broadcaster
 }
 
+// SetChain implements consensus.Handler.SetChain
+func (sb *backend) SetChain(chain consensus.ChainReader) {
+	sb.chain = chain
+}
+
 func (sb *backend) NewChainHead() error {
 	sb.coreMu.RLock()
 	defer sb.coreMu.RUnlock()
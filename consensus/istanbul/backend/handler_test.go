@@ -18,13 +18,20 @@ package backend
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"io/ioutil"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/rlp"
 	lru "github.com/hashicorp/golang-lru"
@@ -70,6 +77,252 @@ func TestIstanbulMessage(t *testing.T) {
 	}
 }
 
+func TestRelayMessage(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(1)
+	config := *istanbul.DefaultConfig
+	config.RelayTTL = 2
+	_, backend := newBlockChainWithConfig(genesis, nodeKeys, &config)
+
+	sender, next := newTestPeer(), newTestPeer()
+	senderAddr, nextAddr := common.StringToAddress("sender"), common.StringToAddress("next")
+	backend.SetBroadcaster(&testBroadcaster{relays: map[common.Address]consensus.Peer{senderAddr: sender, nextAddr: next}})
+
+	// 1. a message signed by a validator is accepted and relayed with one hop less
+	payload := makeSignedPayload(t, backend.privateKey, []byte("data1"))
+	hash := istanbul.RLPHash(payload)
+	if _, err := backend.HandleMsg(senderAddr, makeMsg(istanbulRelayMsg, &relayMessage{Payload: payload, TTL: 3})); err != nil {
+		t.Fatalf("handle message failed: %v", err)
+	}
+	if _, ok := backend.knownMessages.Get(hash); !ok {
+		t.Fatalf("the cache of messages cannot be found")
+	}
+	select {
+	case relay := <-next.relays:
+		if !bytes.Equal(relay.Payload, payload) || relay.TTL != 2 {
+			t.Errorf("relayed message mismatch: have %x (ttl %d), want %x (ttl %d)", relay.Payload, relay.TTL, payload, 2)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("message was not relayed")
+	}
+
+	// 2. the same message is not relayed again, nor back to its sender
+	if _, err := backend.HandleMsg(nextAddr, makeMsg(istanbulRelayMsg, &relayMessage{Payload: payload, TTL: 3})); err != nil {
+		t.Fatalf("handle message failed: %v", err)
+	}
+	select {
+	case <-sender.relays:
+		t.Errorf("message relayed back to its sender")
+	case <-next.relays:
+		t.Errorf("duplicate message relayed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// 3. a message without hops left is accepted but not relayed
+	payload = makeSignedPayload(t, backend.privateKey, []byte("data2"))
+	if _, err := backend.HandleMsg(senderAddr, makeMsg(istanbulRelayMsg, &relayMessage{Payload: payload, TTL: 1})); err != nil {
+		t.Fatalf("handle message failed: %v", err)
+	}
+	if _, ok := backend.knownMessages.Get(istanbul.RLPHash(payload)); !ok {
+		t.Fatalf("the cache of messages cannot be found")
+	}
+	select {
+	case <-next.relays:
+		t.Errorf("message relayed without hops left")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// 4. a message signed by an unknown sender is dropped
+	key, _ := crypto.GenerateKey()
+	payload = makeSignedPayload(t, key, []byte("data3"))
+	if _, err := backend.HandleMsg(senderAddr, makeMsg(istanbulRelayMsg, &relayMessage{Payload: payload, TTL: 3})); err != nil {
+		t.Fatalf("handle message failed: %v", err)
+	}
+	if _, ok := backend.knownMessages.Get(istanbul.RLPHash(payload)); ok {
+		t.Errorf("message from unknown sender should not be cached")
+	}
+	select {
+	case <-next.relays:
+		t.Errorf("message from unknown sender relayed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// 5. a message is relayed no further than the configured TTL, whatever the sender asks
+	payload = makeSignedPayload(t, backend.privateKey, []byte("data4"))
+	if _, err := backend.HandleMsg(senderAddr, makeMsg(istanbulRelayMsg, &relayMessage{Payload: payload, TTL: 100})); err != nil {
+		t.Fatalf("handle message failed: %v", err)
+	}
+	select {
+	case relay := <-next.relays:
+		if relay.TTL != config.RelayTTL {
+			t.Errorf("ttl mismatch: have %d, want %d", relay.TTL, config.RelayTTL)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("message was not relayed")
+	}
+}
+
+func TestGossipRelay(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(1)
+	config := *istanbul.DefaultConfig
+	config.RelayTTL = 2
+	_, backend := newBlockChainWithConfig(genesis, nodeKeys, &config)
+
+	relayer := newTestPeer()
+	backend.SetBroadcaster(&testBroadcaster{relays: map[common.Address]consensus.Peer{common.StringToAddress("relayer"): relayer}})
+
+	payload := makeSignedPayload(t, backend.privateKey, []byte("data"))
+	header := backend.chain.CurrentHeader()
+	if err := backend.Gossip(backend.getValidators(header.Number.Uint64(), header.Hash()), payload); err != nil {
+		t.Fatalf("gossip failed: %v", err)
+	}
+	select {
+	case relay := <-relayer.relays:
+		if relay.TTL != config.RelayTTL {
+			t.Errorf("ttl mismatch: have %d, want %d", relay.TTL, config.RelayTTL)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("message was not relayed")
+	}
+}
+
+func TestGossip_whenValidatorNotFound(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(2)
+	config := *istanbul.DefaultConfig
+	config.RelayTTL = 0
+	header := genesis.ToBlock(nil).Header()
+
+	// 1. a node using the signer plugin relays the message a single hop, as the
+	// other validator may connect with a node key other than its validator key
+	chain, sender := newSignerBackend(t, genesis, nodeKeys[0], &config)
+	defer chain.Stop()
+	defer sender.Stop()
+	validator, observer := newTestPeer(), newTestPeer()
+	sender.SetBroadcaster(&testBroadcaster{relays: map[common.Address]consensus.Peer{
+		common.StringToAddress("validator"): validator,
+		common.StringToAddress("observer"):  observer,
+	}})
+
+	payload := makeSignedPayload(t, nodeKeys[0], []byte("data"))
+	if err := sender.Gossip(sender.getValidators(header.Number.Uint64(), header.Hash()), payload); err != nil {
+		t.Fatalf("gossip failed: %v", err)
+	}
+	var relay *relayMessage
+	for _, peer := range []*testPeer{validator, observer} {
+		select {
+		case relay = <-peer.relays:
+			if !bytes.Equal(relay.Payload, payload) || relay.TTL != 1 {
+				t.Errorf("relayed message mismatch: have %x (ttl %d), want %x (ttl %d)", relay.Payload, relay.TTL, payload, 1)
+			}
+		case <-peer.msgs:
+			t.Fatalf("message sent directly to a peer which may not be a validator")
+		case <-time.After(time.Second):
+			t.Fatalf("message was not relayed")
+		}
+	}
+
+	// 2. a non-validator, whose engine is stopped, accepts the relayed message
+	// and keeps the peer
+	key, _ := crypto.GenerateKey()
+	memDB := rawdb.NewMemoryDatabase()
+	receiver := New(&config, key, memDB).(*backend)
+	genesis.MustCommit(memDB)
+	receiverChain, err := core.NewBlockChain(memDB, nil, genesis.Config, receiver, vm.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer receiverChain.Stop()
+	receiver.SetChain(receiverChain)
+	if _, err := receiver.HandleMsg(common.StringToAddress("sender"), makeMsg(istanbulRelayMsg, relay)); err != nil {
+		t.Errorf("error mismatch: have %v, want nil", err)
+	}
+	if _, ok := receiver.knownMessages.Get(istanbul.RLPHash(payload)); !ok {
+		t.Errorf("the cache of messages cannot be found")
+	}
+
+	// 3. a node with a local validator key doesn't relay without a relay TTL
+	_, local := newBlockChainWithConfig(genesis, nodeKeys, &config)
+	defer local.Stop()
+	peer := newTestPeer()
+	local.SetBroadcaster(&testBroadcaster{relays: map[common.Address]consensus.Peer{common.StringToAddress("validator"): peer}})
+	if err := local.Gossip(local.getValidators(header.Number.Uint64(), header.Hash()), makeSignedPayload(t, local.privateKey, []byte("data"))); err != nil {
+		t.Fatalf("gossip failed: %v", err)
+	}
+	select {
+	case <-peer.msgs:
+		t.Errorf("message sent to a peer which is not a validator")
+	case <-peer.relays:
+		t.Errorf("message relayed without a relay TTL")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// newSignerBackend creates a started engine whose validator key is held by a signer
+func newSignerBackend(t *testing.T, genesis *core.Genesis, key *ecdsa.PrivateKey, config *istanbul.Config) (*core.BlockChain, *backend) {
+	memDB := rawdb.NewMemoryDatabase()
+	b := NewWithSigner(config, &remoteSigner{key}, memDB).(*backend)
+	genesis.MustCommit(memDB)
+	chain, err := core.NewBlockChain(memDB, nil, genesis.Config, b, vm.Config{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Start(chain, chain.CurrentBlock, chain.HasBadBlock); err != nil {
+		chain.Stop()
+		t.Fatal(err)
+	}
+	return chain, b
+}
+
+type testPeer struct {
+	msgs   chan []byte
+	relays chan *relayMessage
+}
+
+func newTestPeer() *testPeer {
+	return &testPeer{msgs: make(chan []byte, 10), relays: make(chan *relayMessage, 10)}
+}
+
+func (p *testPeer) Send(msgcode uint64, data interface{}) error {
+	switch msgcode {
+	case istanbulMsg:
+		p.msgs <- data.([]byte)
+	case istanbulRelayMsg:
+		p.relays <- data.(*relayMessage)
+	}
+	return nil
+}
+
+type testBroadcaster struct {
+	relays map[common.Address]consensus.Peer
+}
+
+func (b *testBroadcaster) Enqueue(id string, block *types.Block) {}
+
+func (b *testBroadcaster) FindPeers(targets map[common.Address]bool) map[common.Address]consensus.Peer {
+	return map[common.Address]consensus.Peer{}
+}
+
+func (b *testBroadcaster) RelayPeers() map[common.Address]consensus.Peer {
+	return b.relays
+}
+
+// makeSignedPayload encodes an istanbul message signed by the given key
+func makeSignedPayload(t *testing.T, key *ecdsa.PrivateKey, data []byte) []byte {
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	unsigned, err := rlp.EncodeToBytes([]interface{}{uint64(0), data, addr, []byte{}, []byte{}})
+	if err != nil {
+		t.Fatalf("can't encode due to %s", err)
+	}
+	sig, err := crypto.Sign(crypto.Keccak256(unsigned), key)
+	if err != nil {
+		t.Fatalf("can't sign due to %s", err)
+	}
+	payload, err := rlp.EncodeToBytes([]interface{}{uint64(0), data, addr, sig, []byte{}})
+	if err != nil {
+		t.Fatalf("can't encode due to %s", err)
+	}
+	return payload
+}
+
 func makeMsg(msgcode uint64, data interface{}) p2p.Msg {
 	size, r, _ := rlp.EncodeToReader(data)
 	return p2p.Msg{Code: msgcode, Size: uint32(size), Payload: r}
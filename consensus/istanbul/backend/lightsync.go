@@ -0,0 +1,593 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+)
+
+const (
+	dbKeyBootstrap = "istanbul-bootstrap"
+
+	maxProofHeaders = 512              // Maximum number of headers served to replay the checkpoint snapshot
+	proofTimeout    = 10 * time.Second // Time allowance for a peer to answer a validator proof request
+)
+
+var (
+	// errInvalidTransition is returned if a header given as validator set
+	// transition doesn't change the validator set by the vote of its parent or
+	// isn't in ascending order.
+	errInvalidTransition = errors.New("invalid validator set transition")
+	// errUntrustedTransition is returned if a validator set transition isn't
+	// committed by enough validators of the previous validator set.
+	errUntrustedTransition = errors.New("untrusted validator set transition")
+	// errInvalidCheckpoint is returned if the header to bootstrap from is not an
+	// epoch block or doesn't follow the last validator set transition.
+	errInvalidCheckpoint = errors.New("invalid bootstrap checkpoint")
+	// errNoQuietBlock is returned if no block before the checkpoint closes a
+	// full rotation of its validators without a failed proposer, so the proposer
+	// failures at the checkpoint can't be proven.
+	errNoQuietBlock = errors.New("no block without proposer failures before the checkpoint")
+	// errSnapshotExists is returned if the snapshot of the checkpoint to bootstrap
+	// from is already stored.
+	errSnapshotExists = errors.New("snapshot of the bootstrap checkpoint already exists")
+	// errProofTimeout is returned if a peer didn't answer a validator proof request
+	// in time.
+	errProofTimeout = errors.New("validator proof request timed out")
+)
+
+// validatorTransition proves a change of the validator set. The header is the
+// first one sealed by the new set and the vote is its parent, whose vote made
+// the change.
+type validatorTransition struct {
+	Vote   *types.Header
+	Header *types.Header
+}
+
+// validatorProof proves the snapshot of an epoch checkpoint to a new node. The
+// transitions prove the validator sets from the genesis validators on, and the
+// headers, ending with the checkpoint, are replayed to recover the proposer
+// failures and the proposer.
+type validatorProof struct {
+	Transitions []*validatorTransition
+	Headers     []*types.Header
+}
+
+// bootstrapRecord is the checkpoint a node bootstrapped from along with the
+// validator sets proven up to it. The sets are kept as snapshots without votes
+// or proposer history, taken at the parent of the first block each set sealed,
+// and only serve to verify the headers before the checkpoint.
+type bootstrapRecord struct {
+	Number uint64      `json:"number"`
+	Hash   common.Hash `json:"hash"`
+	Sets   []*Snapshot `json:"sets"`
+}
+
+// loadBootstrapRecord loads the bootstrap record from the database, if any.
+func loadBootstrapRecord(epoch uint64, db ethdb.Database) (*bootstrapRecord, error) {
+	blob, err := db.Get([]byte(dbKeyBootstrap))
+	if err != nil {
+		return nil, err
+	}
+	record := new(bootstrapRecord)
+	if err := json.Unmarshal(blob, record); err != nil {
+		return nil, err
+	}
+	for _, set := range record.Sets {
+		set.Epoch = epoch
+	}
+	return record, nil
+}
+
+// store inserts the bootstrap record into the database.
+func (r *bootstrapRecord) store(db ethdb.Database) error {
+	blob, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	return db.Put([]byte(dbKeyBootstrap), blob)
+}
+
+// snapshot returns the validators after the given block before the checkpoint.
+func (r *bootstrapRecord) snapshot(number uint64, hash common.Hash) *Snapshot {
+	set := r.Sets[0]
+	for _, next := range r.Sets[1:] {
+		if next.Number > number {
+			break
+		}
+		set = next
+	}
+	snap := set.copy()
+	snap.Number, snap.Hash = number, hash
+	return snap
+}
+
+// bootstrapped returns the record of the checkpoint the node bootstrapped from,
+// or nil if it synced from the genesis block.
+func (sb *backend) bootstrapped() *bootstrapRecord {
+	sb.bootstrapMu.RLock()
+	defer sb.bootstrapMu.RUnlock()
+	return sb.bootstrap
+}
+
+// Bootstrap implements consensus.Bootstrapper.Bootstrap. It requests the proof
+// of the latest epoch checkpoint from the peer, verifies it from the genesis
+// validators on and stores the snapshot of the checkpoint, so that the snapshots
+// of the headers synced after it don't depend on the ones before.
+//
+// Each validator set transition must be committed by more than F validators of
+// the previous set, so at least one honest validator vouched for the change. A
+// node bootstraps at most once and never overwrites a stored snapshot.
+func (sb *backend) Bootstrap(chain consensus.ChainReader, address common.Address, peer consensus.Peer) error {
+	sb.bootstrapLock.Lock()
+	defer sb.bootstrapLock.Unlock()
+	if sb.bootstrapped() != nil {
+		return nil
+	}
+
+	proofCh := make(chan *validatorProof, 1)
+	sb.proofMu.Lock()
+	sb.proofRequests[address] = proofCh
+	sb.proofMu.Unlock()
+	defer func() {
+		sb.proofMu.Lock()
+		delete(sb.proofRequests, address)
+		sb.proofMu.Unlock()
+	}()
+	if err := peer.Send(getValidatorProofMsg, []interface{}{}); err != nil {
+		return err
+	}
+	var proof *validatorProof
+	select {
+	case proof = <-proofCh:
+	case <-time.After(proofTimeout):
+		return errProofTimeout
+	}
+
+	snap, record, err := sb.verifyValidatorProof(chain, proof)
+	if err != nil {
+		return err
+	}
+	if _, err := loadSnapshot(sb.config.Epoch, sb.db, snap.Hash); err == nil {
+		return errSnapshotExists
+	}
+	if err := snap.store(sb.db); err != nil {
+		return err
+	}
+	if err := record.store(sb.db); err != nil {
+		return err
+	}
+	sb.bootstrapMu.Lock()
+	sb.bootstrap = record
+	sb.bootstrapMu.Unlock()
+	sb.recents.Add(snap.Hash, snap)
+	log.Info("Bootstrapped voting snapshot", "number", snap.Number, "hash", snap.Hash, "transitions", len(proof.Transitions), "peer", address)
+	return nil
+}
+
+// verifyValidatorProof verifies the proof of an epoch checkpoint and returns the
+// snapshot of the checkpoint along with the record of the proven validator sets.
+func (sb *backend) verifyValidatorProof(chain consensus.ChainReader, proof *validatorProof) (*Snapshot, *bootstrapRecord, error) {
+	genesis := chain.GetHeaderByNumber(0)
+	if genesis == nil {
+		return nil, nil, errUnknownBlock
+	}
+	valSet, err := sb.headerValidators(genesis)
+	if err != nil {
+		return nil, nil, err
+	}
+	set := newSnapshot(sb.config.Epoch, 0, genesis.Hash(), valSet)
+	record := &bootstrapRecord{Sets: []*Snapshot{set}}
+
+	for _, transition := range proof.Transitions {
+		vote, header := transition.Vote, transition.Header
+		if vote == nil || header == nil || vote.Number == nil || header.Number == nil {
+			return nil, nil, errInvalidTransition
+		}
+		if vote.Number.Uint64() < set.Number || header.Number.Uint64() != vote.Number.Uint64()+1 || header.ParentHash != vote.Hash() {
+			return nil, nil, errInvalidTransition
+		}
+		next, err := sb.castTransition(set, vote)
+		if err != nil {
+			return nil, nil, err
+		}
+		validators, err := sb.headerValidators(header)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !sameValidators(next.ValSet, validators) {
+			return nil, nil, errInvalidTransition
+		}
+		committers, err := sb.verifyCheckpointSeals(next, header)
+		if err != nil {
+			return nil, nil, err
+		}
+		trusted := 0
+		for _, addr := range committers {
+			if _, v := set.ValSet.GetByAddress(addr); v != nil {
+				trusted++
+			}
+		}
+		if trusted <= set.ValSet.F() {
+			return nil, nil, errUntrustedTransition
+		}
+		set = next
+		record.Sets = append(record.Sets, set)
+	}
+
+	// The headers must lead to the checkpoint, which is sealed by the last set
+	headers := proof.Headers
+	if len(headers) == 0 {
+		return nil, nil, errInvalidCheckpoint
+	}
+	checkpoint := headers[len(headers)-1]
+	if checkpoint.Number == nil || checkpoint.Number.Sign() == 0 || checkpoint.Number.Uint64() <= set.Number || checkpoint.Number.Uint64()%sb.config.Epoch != 0 {
+		return nil, nil, errInvalidCheckpoint
+	}
+	record.Number, record.Hash = checkpoint.Number.Uint64(), checkpoint.Hash()
+	for i, header := range headers {
+		if header.Number == nil {
+			return nil, nil, errInvalidCheckpoint
+		}
+		if i > 0 && (header.Number.Uint64() != headers[i-1].Number.Uint64()+1 || header.ParentHash != headers[i-1].Hash()) {
+			return nil, nil, errInvalidVotingChain
+		}
+		validators, err := sb.headerValidators(header)
+		if err != nil {
+			return nil, nil, err
+		}
+		if header.Number.Sign() == 0 || !sameValidators(record.snapshot(header.Number.Uint64()-1, header.ParentHash).ValSet, validators) {
+			return nil, nil, errInvalidTransition
+		}
+	}
+	if _, err := sb.verifyCheckpointSeals(record.snapshot(record.Number-1, checkpoint.ParentHash), checkpoint); err != nil {
+		return nil, nil, err
+	}
+
+	// Replay the proposers from a block without failures, and apply the
+	// checkpoint on top, which discards the votes
+	quiet, err := lastQuietBlock(headers)
+	if err != nil {
+		return nil, nil, err
+	}
+	author, err := ecrecover(headers[quiet])
+	if err != nil {
+		return nil, nil, err
+	}
+	snap := record.snapshot(headers[quiet].Number.Uint64(), headers[quiet].Hash())
+	snap.Proposer = author
+	for _, header := range headers[quiet+1 : len(headers)-1] {
+		number := header.Number.Uint64()
+		author, err := ecrecover(header)
+		if err != nil {
+			return nil, nil, err
+		}
+		extra, err := types.ExtractIstanbulExtra(header)
+		if err != nil {
+			return nil, nil, err
+		}
+		snap.ValSet = record.snapshot(number-1, header.ParentHash).ValSet
+		if _, v := snap.ValSet.GetByAddress(author); v == nil {
+			return nil, nil, errUnauthorized
+		}
+		snap.ValSet.SetProposerContext(&istanbul.ProposerContext{
+			Number:   number - 1,
+			Hash:     header.ParentHash,
+			Failures: snap.Failures,
+		})
+		snap.recordFailures(number, author, extra.Round)
+		snap.Proposer = author
+	}
+	parent := record.snapshot(record.Number-1, checkpoint.ParentHash)
+	parent.Proposer, parent.Failures = snap.Proposer, snap.Failures
+	parent.updateProposerContext()
+	checkpointSnap, err := parent.apply([]*types.Header{checkpoint})
+	if err != nil {
+		return nil, nil, err
+	}
+	return checkpointSnap, record, nil
+}
+
+// castTransition returns the validators after the given vote changed the set,
+// along with the BLS key the vote registered.
+func (sb *backend) castTransition(set *Snapshot, vote *types.Header) (*Snapshot, error) {
+	author, err := ecrecover(vote)
+	if err != nil {
+		return nil, err
+	}
+	if _, v := set.ValSet.GetByAddress(author); v == nil {
+		return nil, errUnauthorized
+	}
+	extra, err := types.ExtractIstanbulExtra(vote)
+	if err != nil {
+		return nil, err
+	}
+	next := newSnapshot(sb.config.Epoch, vote.Number.Uint64(), vote.Hash(), set.ValSet.Copy())
+	for address, key := range set.BLSKeys {
+		next.BLSKeys[address] = key
+	}
+	switch {
+	case bytes.Equal(vote.Nonce[:], nonceAuthVote):
+		if !next.ValSet.AddValidator(vote.Coinbase) {
+			return nil, errInvalidTransition
+		}
+		if extra.VoteBLSKey != nil {
+			key, err := bls.UnmarshalPublicKey(extra.VoteBLSKey.PublicKey)
+			if err != nil || !bls.VerifyProofOfPossession(key, extra.VoteBLSKey.Proof) {
+				return nil, errInvalidVoteBLSKey
+			}
+			next.BLSKeys[vote.Coinbase] = key
+		}
+	case bytes.Equal(vote.Nonce[:], nonceDropVote):
+		if !next.ValSet.RemoveValidator(vote.Coinbase) {
+			return nil, errInvalidTransition
+		}
+		delete(next.BLSKeys, vote.Coinbase)
+	default:
+		return nil, errInvalidTransition
+	}
+	return next, nil
+}
+
+// lastQuietBlock returns the index of the latest header before the last one
+// which closes a full rotation of its validators committed in the first round.
+// Proposer failures are forgotten after a rotation, so there are none recorded
+// at that block.
+func lastQuietBlock(headers []*types.Header) (int, error) {
+	quiet, run := -1, 0
+	for i, header := range headers[:len(headers)-1] {
+		extra, err := types.ExtractIstanbulExtra(header)
+		if err != nil {
+			return -1, err
+		}
+		if extra.Round != nil && *extra.Round > 0 {
+			run = 0
+			continue
+		}
+		if run++; run >= len(extra.Validators) {
+			quiet = i
+		}
+	}
+	if quiet < 0 {
+		return -1, errNoQuietBlock
+	}
+	return quiet, nil
+}
+
+// validatorProof returns the proof of the latest epoch checkpoint of the chain.
+func (sb *backend) validatorProof(chain consensus.ChainReader) (*validatorProof, error) {
+	head := chain.CurrentHeader().Number.Uint64()
+	checkpoint := head - head%sb.config.Epoch
+	if checkpoint == 0 {
+		return nil, errInvalidCheckpoint
+	}
+	transitions, err := sb.proofTransitions(chain, checkpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	first := uint64(1)
+	if sb.config.RaftMigrationBlock != nil {
+		first = sb.config.RaftMigrationBlock.Uint64()
+	}
+	if checkpoint >= first+maxProofHeaders {
+		first = checkpoint - maxProofHeaders + 1
+	}
+	var headers []*types.Header
+	for number := first; number <= checkpoint; number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, errUnknownBlock
+		}
+		headers = append(headers, header)
+	}
+	quiet, err := lastQuietBlock(headers)
+	if err != nil {
+		return nil, err
+	}
+	extra, err := types.ExtractIstanbulExtra(headers[quiet])
+	if err != nil {
+		return nil, err
+	}
+	return &validatorProof{
+		Transitions: transitions,
+		Headers:     headers[quiet+1-len(extra.Validators):],
+	}, nil
+}
+
+// proofTransitions returns the validator set transitions up to the given block,
+// scanning the chain only past the transitions found before.
+func (sb *backend) proofTransitions(chain consensus.ChainReader, to uint64) ([]*validatorTransition, error) {
+	sb.transitionsMu.Lock()
+	defer sb.transitionsMu.Unlock()
+
+	if to > sb.transitionsTo {
+		headers, err := sb.validatorTransitions(chain, sb.transitionsTo+1, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, header := range headers {
+			vote := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+			if vote == nil {
+				return nil, errUnknownBlock
+			}
+			sb.transitions = append(sb.transitions, &validatorTransition{Vote: vote, Header: header})
+		}
+		sb.transitionsTo = to
+	}
+	var transitions []*validatorTransition
+	for _, transition := range sb.transitions {
+		if transition.Header.Number.Uint64() > to {
+			break
+		}
+		transitions = append(transitions, transition)
+	}
+	return transitions, nil
+}
+
+// handleGetValidatorProof answers the request of a peer for the proof of the
+// latest epoch checkpoint.
+func (sb *backend) handleGetValidatorProof(addr common.Address) error {
+	if sb.chain == nil || sb.broadcaster == nil {
+		return nil
+	}
+	proof, err := sb.validatorProof(sb.chain)
+	if err != nil {
+		log.Debug("Failed to build validator proof", "peer", addr, "err", err)
+		proof = new(validatorProof)
+	}
+	for _, p := range sb.broadcaster.FindPeers(map[common.Address]bool{addr: true}) {
+		return p.Send(validatorProofMsg, proof)
+	}
+	return nil
+}
+
+// handleValidatorProof hands the proof a peer sent to the pending request for
+// it, if any.
+func (sb *backend) handleValidatorProof(addr common.Address, msg p2p.Msg) error {
+	var proof validatorProof
+	if err := msg.Decode(&proof); err != nil {
+		return errDecodeFailed
+	}
+	sb.proofMu.Lock()
+	defer sb.proofMu.Unlock()
+	if proofCh, ok := sb.proofRequests[addr]; ok {
+		select {
+		case proofCh <- &proof:
+		default:
+		}
+	}
+	return nil
+}
+
+// validatorTransitions returns the headers in the given range sealed by a
+// different validator set than their parent.
+func (sb *backend) validatorTransitions(chain consensus.ChainReader, from, to uint64) ([]*types.Header, error) {
+	if from == 0 {
+		from = 1
+	}
+	parent := chain.GetHeaderByNumber(from - 1)
+	if parent == nil {
+		return nil, errUnknownBlock
+	}
+	valSet, err := sb.headerValidators(parent)
+	if err != nil {
+		return nil, err
+	}
+	var transitions []*types.Header
+	for number := from; number <= to; number++ {
+		header := chain.GetHeaderByNumber(number)
+		if header == nil {
+			return nil, errUnknownBlock
+		}
+		next, err := sb.headerValidators(header)
+		if err != nil {
+			return nil, err
+		}
+		if !sameValidators(valSet, next) {
+			transitions = append(transitions, header)
+		}
+		valSet = next
+	}
+	return transitions, nil
+}
+
+// verifyCheckpointSeals checks a header without its ancestors, against the
+// validators which sealed it, and returns the validators that committed it.
+func (sb *backend) verifyCheckpointSeals(snap *Snapshot, header *types.Header) ([]common.Address, error) {
+	if header.MixDigest != types.IstanbulDigest {
+		return nil, errInvalidMixDigest
+	}
+	author, err := ecrecover(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, v := snap.ValSet.GetByAddress(author); v == nil {
+		return nil, errUnauthorized
+	}
+
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	if sb.config.IsAggregatedSeal(header.Number) {
+		if err := sb.verifyAggregatedSeal(snap, header, extra); err != nil {
+			return nil, err
+		}
+		return aggregatedSealCommitters(snap.ValSet, extra.AggregatedSeal)
+	}
+	if extra.AggregatedSeal != nil {
+		return nil, errInvalidAggregatedSeal
+	}
+	if len(extra.CommittedSeal) == 0 {
+		return nil, errEmptyCommittedSeals
+	}
+	committers, err := sb.signers(nil, header)
+	if err != nil {
+		return nil, err
+	}
+	validators := snap.ValSet.Copy()
+	for _, addr := range committers {
+		if !validators.RemoveValidator(addr) {
+			return nil, errInvalidCommittedSeals
+		}
+	}
+	if len(committers) <= snap.ValSet.F() {
+		return nil, errInvalidCommittedSeals
+	}
+	return committers, nil
+}
+
+// headerValidators returns the validator set listed in the extra-data of the
+// header. Raft blocks before a migration are taken as listing the initial
+// validators of the migration.
+func (sb *backend) headerValidators(header *types.Header) (istanbul.ValidatorSet, error) {
+	if sb.config.IsRaftBlock(header.Number) {
+		return validator.NewSet(sb.config.Validators, sb.config.ProposerPolicy), nil
+	}
+	extra, err := types.ExtractIstanbulExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	return validator.NewSet(extra.Validators, sb.config.ProposerPolicy), nil
+}
+
+// sameValidators returns whether both validator sets have the same members.
+func sameValidators(a, b istanbul.ValidatorSet) bool {
+	if a.Size() != b.Size() {
+		return false
+	}
+	for _, val := range a.List() {
+		if _, v := b.GetByAddress(val.Address()); v == nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,203 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// makeCommittedBlock creates a block proposed by the engine and committed by
+// the given keys in the given round, without running the consensus.
+func makeCommittedBlock(t *testing.T, chain *core.BlockChain, engine *backend, parent *types.Block, round uint64, keys ...*ecdsa.PrivateKey) *types.Block {
+	block := makeBlockWithoutSeal(chain, engine, parent)
+	header := block.Header()
+	if engine.config.IsCommitRound(header.Number) {
+		if err := writeCommitRound(header, round); err != nil {
+			t.Fatalf("failed to write round: %v", err)
+		}
+	}
+	block, err := engine.updateBlock(parent.Header(), block.WithSeal(header))
+	if err != nil {
+		t.Fatalf("failed to seal block: %v", err)
+	}
+	header = block.Header()
+	extra, _ := types.ExtractIstanbulExtra(header)
+	seal, err := engine.proposalSeal(header, extra)
+	if err != nil {
+		t.Fatalf("failed to get proposal seal: %v", err)
+	}
+	seals := make([][]byte, len(keys))
+	for i, key := range keys {
+		if seals[i], err = crypto.Sign(crypto.Keccak256(seal), key); err != nil {
+			t.Fatalf("failed to sign committed seal: %v", err)
+		}
+	}
+	if err := writeCommittedSeals(header, seals); err != nil {
+		t.Fatalf("failed to write committed seals: %v", err)
+	}
+	return block.WithSeal(header)
+}
+
+// proofPeer answers the validator proof requests of a node from the chain of
+// another one.
+type proofPeer struct {
+	addr   common.Address
+	chain  *core.BlockChain
+	server *backend
+	client *backend
+}
+
+func (p *proofPeer) Send(msgcode uint64, data interface{}) error {
+	proof, err := p.server.validatorProof(p.chain)
+	if err != nil {
+		return err
+	}
+	return p.client.handleValidatorProof(p.addr, makeMsg(validatorProofMsg, proof))
+}
+
+func TestBootstrap(t *testing.T) {
+	genesis, nodeKeys := getGenesisAndKeys(1)
+	config := *istanbul.DefaultConfig
+	config.Epoch = 8
+	config.BlockPeriod = 0
+	config.CommitRoundBlock = big.NewInt(1)
+	chain, engine := newBlockChainWithConfig(genesis, nodeKeys, &config)
+
+	// Vote in a second validator in block 1, so that block 2 is the first
+	// block sealed by the new validator set. Block 7 is committed in the
+	// second round, so the proposer of the first round failed.
+	newKey, _ := crypto.GenerateKey()
+	engine.candidates[crypto.PubkeyToAddress(newKey.PublicKey)] = true
+	blocks := types.Blocks{makeCommittedBlock(t, chain, engine, chain.Genesis(), 0, engine.privateKey)}
+	delete(engine.candidates, crypto.PubkeyToAddress(newKey.PublicKey))
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert block 1: %v", err)
+	}
+	for i := 1; i < 8; i++ {
+		round := uint64(0)
+		if i == 6 {
+			round = 1
+		}
+		block := makeCommittedBlock(t, chain, engine, blocks[i-1], round, engine.privateKey, newKey)
+		if _, err := chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("failed to insert block %d: %v", i+1, err)
+		}
+		blocks = append(blocks, block)
+	}
+	api := &API{chain: chain, istanbul: engine}
+	transitions, err := api.GetValidatorTransitions(0, nil)
+	if err != nil {
+		t.Fatalf("failed to get transitions: %v", err)
+	}
+	if len(transitions) != 1 || transitions[0].Hash() != blocks[1].Hash() {
+		t.Fatalf("transitions mismatch: have %v, want block 2", transitions)
+	}
+	checkpoint := blocks[7].Header()
+	want := snapshotAt(t, engine, chain, checkpoint.Number.Uint64())
+	if len(want.Failures) == 0 {
+		t.Fatalf("snapshot should record the failed proposer")
+	}
+
+	// A new node only knowing the genesis block
+	fresh, freshEngine := newBlockChainWithConfig(genesis, nodeKeys, &config)
+	proof, err := engine.validatorProof(chain)
+	if err != nil {
+		t.Fatalf("failed to get proof: %v", err)
+	}
+	if len(proof.Transitions) != 1 || proof.Transitions[0].Vote.Hash() != blocks[0].Hash() || proof.Headers[len(proof.Headers)-1].Hash() != checkpoint.Hash() {
+		t.Fatalf("proof mismatch: have %v", proof)
+	}
+
+	// 1. missing transitions and forged votes are rejected
+	if _, _, err := freshEngine.verifyValidatorProof(fresh, &validatorProof{Headers: proof.Headers}); err != errInvalidTransition {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidTransition)
+	}
+	forgedVote := &validatorTransition{Vote: blocks[1].Header(), Header: blocks[2].Header()}
+	if _, _, err := freshEngine.verifyValidatorProof(fresh, &validatorProof{Transitions: []*validatorTransition{forgedVote}, Headers: proof.Headers}); err != errInvalidTransition {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidTransition)
+	}
+	// 2. a transition only committed by the new validator is not trusted
+	untrusted := makeCommittedBlock(t, chain, engine, blocks[0], 0, newKey)
+	transition := &validatorTransition{Vote: blocks[0].Header(), Header: untrusted.Header()}
+	if _, _, err := freshEngine.verifyValidatorProof(fresh, &validatorProof{Transitions: []*validatorTransition{transition}, Headers: proof.Headers}); err != errUntrustedTransition {
+		t.Errorf("error mismatch: have %v, want %v", err, errUntrustedTransition)
+	}
+	// 3. a transition committed by outsiders is rejected
+	otherKey, _ := crypto.GenerateKey()
+	forged := makeCommittedBlock(t, chain, engine, blocks[0], 0, engine.privateKey, otherKey)
+	transition = &validatorTransition{Vote: blocks[0].Header(), Header: forged.Header()}
+	if _, _, err := freshEngine.verifyValidatorProof(fresh, &validatorProof{Transitions: []*validatorTransition{transition}, Headers: proof.Headers}); err != errInvalidCommittedSeals {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCommittedSeals)
+	}
+	// 4. the headers must lead to an epoch block after a block without failures
+	if _, _, err := freshEngine.verifyValidatorProof(fresh, &validatorProof{Transitions: proof.Transitions, Headers: proof.Headers[:len(proof.Headers)-1]}); err != errInvalidCheckpoint {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCheckpoint)
+	}
+	if _, _, err := freshEngine.verifyValidatorProof(fresh, &validatorProof{Transitions: proof.Transitions, Headers: proof.Headers[len(proof.Headers)-3:]}); err != errNoQuietBlock {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoQuietBlock)
+	}
+
+	// 5. the bootstrapped snapshot keeps the votes, failures and proposer
+	peer := &proofPeer{addr: common.Address{1}, chain: chain, server: engine, client: freshEngine}
+	if err := freshEngine.Bootstrap(fresh, peer.addr, peer); err != nil {
+		t.Fatalf("failed to bootstrap: %v", err)
+	}
+	freshEngine.recents.Purge()
+	loaded, err := freshEngine.snapshot(fresh, checkpoint.Number.Uint64(), checkpoint.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to load bootstrapped snapshot: %v", err)
+	}
+	have, _ := json.Marshal(loaded)
+	expected, _ := json.Marshal(want)
+	if string(have) != string(expected) {
+		t.Errorf("snapshot mismatch: have %s, want %s", have, expected)
+	}
+	record, err := loadBootstrapRecord(config.Epoch, freshEngine.db)
+	if err != nil || record.Hash != checkpoint.Hash() || len(record.Sets) != 2 {
+		t.Fatalf("bootstrap record mismatch: have %v, %v", record, err)
+	}
+
+	// 6. the headers before the checkpoint are verified against the proven
+	// validators, and must lead to the checkpoint
+	if _, err := fresh.InsertChain(blocks); err != nil {
+		t.Errorf("failed to sync bootstrapped chain: %v", err)
+	}
+	other := types.CopyHeader(checkpoint)
+	other.Time++
+	if err := freshEngine.VerifyHeader(fresh, other, false); err != errInvalidCheckpoint {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidCheckpoint)
+	}
+
+	// 7. a stored snapshot is never overwritten
+	again, againEngine := newBlockChainWithConfig(genesis, nodeKeys, &config)
+	if err := want.store(againEngine.db); err != nil {
+		t.Fatalf("failed to store snapshot: %v", err)
+	}
+	peer = &proofPeer{addr: common.Address{1}, chain: chain, server: engine, client: againEngine}
+	if err := againEngine.Bootstrap(again, peer.addr, peer); err != errSnapshotExists {
+		t.Errorf("error mismatch: have %v, want %v", err, errSnapshotExists)
+	}
+}
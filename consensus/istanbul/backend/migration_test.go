@@ -0,0 +1,206 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package backend
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// migrationNode is a validator of the in-process network, which delivers the
+// consensus messages and committed blocks of its engine to the other nodes.
+type migrationNode struct {
+	engine  *backend
+	chain   *core.BlockChain
+	network map[common.Address]*migrationNode
+}
+
+func (n *migrationNode) Enqueue(id string, block *types.Block) {
+	go n.insert(block)
+}
+
+func (n *migrationNode) FindPeers(targets map[common.Address]bool) map[common.Address]consensus.Peer {
+	peers := make(map[common.Address]consensus.Peer)
+	for addr := range targets {
+		if node, ok := n.network[addr]; ok {
+			peers[addr] = &migrationPeer{from: n.engine.Address(), to: node}
+		}
+	}
+	return peers
+}
+
+func (n *migrationNode) RelayPeers() map[common.Address]consensus.Peer {
+	return nil
+}
+
+func (n *migrationNode) insert(block *types.Block) {
+	if _, err := n.chain.InsertChain(types.Blocks{block}); err == nil {
+		n.engine.NewChainHead()
+	}
+}
+
+type migrationPeer struct {
+	from common.Address
+	to   *migrationNode
+}
+
+func (p *migrationPeer) Send(msgcode uint64, data interface{}) error {
+	_, err := p.to.engine.HandleMsg(p.from, makeMsg(msgcode, data))
+	return err
+}
+
+// TestRaftMigration runs the steps of migrating a raft network to Istanbul:
+// the Istanbul engine of the nodes verifies the raft chain up to the block
+// before the migration block, then it is started like the node does once it
+// imported that block, and takes over block production from the migration
+// block on.
+func TestRaftMigration(t *testing.T) {
+	const migrationBlock = 3
+
+	keys := make([]*ecdsa.PrivateKey, 4)
+	validators := make([]common.Address, len(keys))
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		validators[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	chainConfig := *params.TestChainConfig
+	chainConfig.Ethash = nil
+	chainConfig.Istanbul = &params.IstanbulConfig{
+		RaftMigrationBlock: big.NewInt(migrationBlock),
+		Validators:         validators,
+	}
+	genesis := &core.Genesis{Config: &chainConfig, GasLimit: 4700000, Difficulty: big.NewInt(1)}
+
+	// 1. raft mints the blocks before the migration with nanosecond timestamps,
+	// running with the full faker engine
+	raftDB := rawdb.NewMemoryDatabase()
+	raftBlocks, _ := core.GenerateChain(&chainConfig, genesis.MustCommit(raftDB), ethash.NewFullFaker(), raftDB, migrationBlock-1, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(common.BigToAddress(big.NewInt(int64(i + 1))))
+		b.SetExtra(make([]byte, 32))
+		b.OffsetTime(time.Now().UnixNano())
+	})
+
+	// 2. the Istanbul engine of the nodes verifies the raft chain
+	network := make(map[common.Address]*migrationNode)
+	for _, key := range keys {
+		config := *istanbul.DefaultConfig
+		config.BlockPeriod = 0
+		config.RaftMigrationBlock = chainConfig.Istanbul.RaftMigrationBlock
+		config.Validators = validators
+
+		db := rawdb.NewMemoryDatabase()
+		genesis.MustCommit(db)
+		engine := New(&config, key, db).(*backend)
+		chain, err := core.NewBlockChain(db, nil, &chainConfig, engine, vm.Config{}, nil)
+		if err != nil {
+			t.Fatalf("failed to create chain: %v", err)
+		}
+		defer chain.Stop()
+		if _, err := chain.InsertChain(raftBlocks); err != nil {
+			t.Fatalf("failed to import raft blocks: %v", err)
+		}
+		node := &migrationNode{engine: engine, chain: chain, network: network}
+		engine.SetBroadcaster(node)
+		network[engine.Address()] = node
+	}
+	for _, node := range network {
+		if err := node.engine.Start(node.chain, node.chain.CurrentBlock, node.chain.HasBadBlock); err != nil {
+			t.Fatalf("failed to start engine: %v", err)
+		}
+		defer node.engine.Stop()
+	}
+
+	// Raft blocks are rejected by the Istanbul block production
+	node := network[validators[0]]
+	header := &types.Header{ParentHash: raftBlocks[0].Hash(), Number: big.NewInt(migrationBlock - 1)}
+	if err := node.engine.Prepare(node.chain, header); err != errRaftBlock {
+		t.Errorf("error mismatch: have %v, want %v", err, errRaftBlock)
+	}
+
+	// 3. every validator seals the migration block, which is committed by the
+	// initial validators and imported by all nodes
+	for _, node := range network {
+		parent := node.chain.CurrentBlock()
+		header := &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     new(big.Int).Add(parent.Number(), common.Big1),
+			GasLimit:   parent.GasLimit(),
+		}
+		if err := node.engine.Prepare(node.chain, header); err != nil {
+			t.Fatalf("failed to prepare migration block: %v", err)
+		}
+		state, _, err := node.chain.StateAt(parent.Root())
+		if err != nil {
+			t.Fatalf("failed to get state: %v", err)
+		}
+		block, err := node.engine.FinalizeAndAssemble(node.chain, header, state, nil, nil, nil)
+		if err != nil {
+			t.Fatalf("failed to assemble migration block: %v", err)
+		}
+		results := make(chan *types.Block, 1)
+		if err := node.engine.Seal(node.chain, block, results, make(chan struct{})); err != nil {
+			t.Fatalf("failed to seal migration block: %v", err)
+		}
+		go func(node *migrationNode) {
+			if block := <-results; block != nil {
+				node.insert(block)
+			}
+		}(node)
+	}
+	deadline := time.Now().Add(30 * time.Second)
+	for _, node := range network {
+		for node.chain.CurrentBlock().NumberU64() < migrationBlock {
+			if time.Now().After(deadline) {
+				t.Fatalf("migration block not imported by %v", node.engine.Address().Hex())
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	head := node.chain.CurrentBlock()
+	for addr, node := range network {
+		if node.chain.CurrentBlock().Hash() != head.Hash() {
+			t.Errorf("head mismatch on %v: have %v, want %v", addr.Hex(), node.chain.CurrentBlock().Hash().Hex(), head.Hash().Hex())
+		}
+	}
+	extra, err := types.ExtractIstanbulExtra(head.Header())
+	if err != nil {
+		t.Fatalf("failed to extract istanbul extra: %v", err)
+	}
+	if len(extra.Validators) != len(validators) || len(extra.CommittedSeal) <= node.engine.getValidators(head.NumberU64(), head.Hash()).F() {
+		t.Errorf("migration block not sealed by the initial validators: %v", extra)
+	}
+	author, err := node.engine.Author(head.Header())
+	if err != nil {
+		t.Fatalf("failed to get author: %v", err)
+	}
+	if _, ok := network[author]; !ok {
+		t.Errorf("migration block proposed by %v, not a validator", author.Hex())
+	}
+}
@@ -21,9 +21,11 @@ import (
 	"encoding/json"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	"github.com/ethereum/go-ethereum/consensus/istanbul/validator"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 	"github.com/ethereum/go-ethereum/ethdb"
 )
 
@@ -43,8 +45,9 @@ type Vote struct {
 // Tally is a simple vote tally to keep the current score of votes. Votes that
 // go against the proposal aren't counted since it's equivalent to not voting.
 type Tally struct {
-	Authorize bool `json:"authorize"` // Whether the vote it about authorizing or kicking someone
-	Votes     int  `json:"votes"`     // Number of votes until now wanting to pass the proposal
+	Authorize bool          `json:"authorize"`        // Whether the vote it about authorizing or kicking someone
+	Votes     int           `json:"votes"`            // Number of votes until now wanting to pass the proposal
+	BLSKey    hexutil.Bytes `json:"blsKey,omitempty"` // BLS key the votes register for the authorized validator
 }
 
 // Snapshot is the state of the authorization voting at a given point in time.
@@ -56,6 +59,11 @@ type Snapshot struct {
 	Votes  []*Vote                  // List of votes cast in chronological order
 	Tally  map[common.Address]Tally // Current vote tally to avoid recalculating
 	ValSet istanbul.ValidatorSet    // Set of authorized validators at this moment
+
+	Proposer common.Address            // Proposer of the block where the snapshot was created
+	Failures map[common.Address]uint64 // Validators that recently failed to propose and the block they missed
+
+	BLSKeys map[common.Address]*bls.PublicKey // BLS keys registered by the votes that authorized the validators
 }
 
 // newSnapshot create a new snapshot with the specified startup parameters. This
@@ -68,7 +76,10 @@ func newSnapshot(epoch uint64, number uint64, hash common.Hash, valSet istanbul.
 		Hash:   hash,
 		ValSet: valSet,
 		Tally:  make(map[common.Address]Tally),
+
+		BLSKeys: make(map[common.Address]*bls.PublicKey),
 	}
+	snap.updateProposerContext()
 	return snap
 }
 
@@ -105,12 +116,23 @@ func (s *Snapshot) copy() *Snapshot {
 		ValSet: s.ValSet.Copy(),
 		Votes:  make([]*Vote, len(s.Votes)),
 		Tally:  make(map[common.Address]Tally),
+
+		Proposer: s.Proposer,
+		Failures: make(map[common.Address]uint64, len(s.Failures)),
+		BLSKeys:  make(map[common.Address]*bls.PublicKey, len(s.BLSKeys)),
 	}
 
 	for address, tally := range s.Tally {
 		cpy.Tally[address] = tally
 	}
+	for address, number := range s.Failures {
+		cpy.Failures[address] = number
+	}
+	for address, key := range s.BLSKeys {
+		cpy.BLSKeys[address] = key
+	}
 	copy(cpy.Votes, s.Votes)
+	cpy.updateProposerContext()
 
 	return cpy
 }
@@ -121,18 +143,22 @@ func (s *Snapshot) checkVote(address common.Address, authorize bool) bool {
 	return (validator != nil && !authorize) || (validator == nil && authorize)
 }
 
-// cast adds a new vote into the tally.
-func (s *Snapshot) cast(address common.Address, authorize bool) bool {
+// cast adds a new vote into the tally. Votes to authorize a validator only add
+// up if they register the same BLS key.
+func (s *Snapshot) cast(address common.Address, authorize bool, blsKey []byte) bool {
 	// Ensure the vote is meaningful
 	if !s.checkVote(address, authorize) {
 		return false
 	}
 	// Cast the vote into an existing or new tally
 	if old, ok := s.Tally[address]; ok {
+		if !bytes.Equal(old.BLSKey, blsKey) {
+			return false
+		}
 		old.Votes++
 		s.Tally[address] = old
 	} else {
-		s.Tally[address] = Tally{Authorize: authorize, Votes: 1}
+		s.Tally[address] = Tally{Authorize: authorize, Votes: 1, BLSKey: blsKey}
 	}
 	return true
 }
@@ -192,6 +218,18 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 		if _, v := snap.ValSet.GetByAddress(validator); v == nil {
 			return nil, errUnauthorized
 		}
+		// Record the validators whose rounds passed before the block was committed
+		extra, err := types.ExtractIstanbulExtra(header)
+		if err != nil {
+			return nil, err
+		}
+		snap.ValSet.SetProposerContext(&istanbul.ProposerContext{
+			Number:   number - 1,
+			Hash:     header.ParentHash,
+			Failures: snap.Failures,
+		})
+		snap.recordFailures(number, validator, extra.Round)
+		snap.Proposer = validator
 
 		// Header authorized, discard any previous votes from the validator
 		for i, vote := range snap.Votes {
@@ -214,7 +252,11 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 		default:
 			return nil, errInvalidVote
 		}
-		if snap.cast(header.Coinbase, authorize) {
+		var blsKey []byte
+		if extra.VoteBLSKey != nil {
+			blsKey = extra.VoteBLSKey.PublicKey
+		}
+		if snap.cast(header.Coinbase, authorize, blsKey) {
 			snap.Votes = append(snap.Votes, &Vote{
 				Validator: validator,
 				Block:     number,
@@ -226,8 +268,16 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 		if tally := snap.Tally[header.Coinbase]; tally.Votes > snap.ValSet.Size()/2 {
 			if tally.Authorize {
 				snap.ValSet.AddValidator(header.Coinbase)
+				if len(tally.BLSKey) > 0 {
+					key, err := bls.UnmarshalPublicKey(tally.BLSKey)
+					if err != nil {
+						return nil, errInvalidVoteBLSKey
+					}
+					snap.BLSKeys[header.Coinbase] = key
+				}
 			} else {
 				snap.ValSet.RemoveValidator(header.Coinbase)
+				delete(snap.BLSKeys, header.Coinbase)
 
 				// Discard any previous votes the deauthorized validator cast
 				for i := 0; i < len(snap.Votes); i++ {
@@ -254,10 +304,45 @@ func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
 	}
 	snap.Number += uint64(len(headers))
 	snap.Hash = headers[len(headers)-1].Hash()
+	snap.updateProposerContext()
 
 	return snap, nil
 }
 
+// recordFailures marks the proposers of the rounds before the one the given
+// block was committed in as failed, except the author of the block. The round
+// is recorded in the header from the commit round fork block on, no failure is
+// recorded before. Any failure older than a full rotation of the validator set
+// is forgotten.
+func (s *Snapshot) recordFailures(number uint64, author common.Address, round *uint64) {
+	if s.Failures == nil {
+		s.Failures = make(map[common.Address]uint64)
+	}
+	valSet := s.ValSet.Copy()
+	window := uint64(valSet.Size())
+	for r := uint64(0); round != nil && r < *round && r < window; r++ {
+		valSet.CalcProposer(s.Proposer, r)
+		if expected := valSet.GetProposer(); expected != nil && expected.Address() != author {
+			s.Failures[expected.Address()] = number
+		}
+	}
+	for address, failed := range s.Failures {
+		if _, v := s.ValSet.GetByAddress(address); v == nil || number-failed >= window {
+			delete(s.Failures, address)
+		}
+	}
+}
+
+// updateProposerContext hands the chain state at the snapshot's block to the
+// validator set for the proposer policy to use.
+func (s *Snapshot) updateProposerContext() {
+	s.ValSet.SetProposerContext(&istanbul.ProposerContext{
+		Number:   s.Number,
+		Hash:     s.Hash,
+		Failures: s.Failures,
+	})
+}
+
 // validators retrieves the list of authorized validators in ascending order.
 func (s *Snapshot) validators() []common.Address {
 	validators := make([]common.Address, 0, s.ValSet.Size())
@@ -284,9 +369,23 @@ type snapshotJSON struct {
 	// for validator set
 	Validators []common.Address        `json:"validators"`
 	Policy     istanbul.ProposerPolicy `json:"policy"`
+
+	// for proposer policies
+	Proposer common.Address            `json:"proposer"`
+	Failures map[common.Address]uint64 `json:"failures,omitempty"`
+
+	// for aggregated seals
+	BLSKeys map[common.Address]hexutil.Bytes `json:"blsKeys,omitempty"`
 }
 
 func (s *Snapshot) toJSONStruct() *snapshotJSON {
+	var blsKeys map[common.Address]hexutil.Bytes
+	if len(s.BLSKeys) > 0 {
+		blsKeys = make(map[common.Address]hexutil.Bytes, len(s.BLSKeys))
+		for address, key := range s.BLSKeys {
+			blsKeys[address] = key.Marshal()
+		}
+	}
 	return &snapshotJSON{
 		Epoch:      s.Epoch,
 		Number:     s.Number,
@@ -295,6 +394,9 @@ func (s *Snapshot) toJSONStruct() *snapshotJSON {
 		Tally:      s.Tally,
 		Validators: s.validators(),
 		Policy:     s.ValSet.Policy(),
+		Proposer:   s.Proposer,
+		Failures:   s.Failures,
+		BLSKeys:    blsKeys,
 	}
 }
 
@@ -311,6 +413,17 @@ func (s *Snapshot) UnmarshalJSON(b []byte) error {
 	s.Votes = j.Votes
 	s.Tally = j.Tally
 	s.ValSet = validator.NewSet(j.Validators, j.Policy)
+	s.Proposer = j.Proposer
+	s.Failures = j.Failures
+	s.BLSKeys = make(map[common.Address]*bls.PublicKey, len(j.BLSKeys))
+	for address, blob := range j.BLSKeys {
+		key, err := bls.UnmarshalPublicKey(blob)
+		if err != nil {
+			return err
+		}
+		s.BLSKeys[address] = key
+	}
+	s.updateProposerContext()
 	return nil
 }
 
@@ -19,6 +19,7 @@ package backend
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"encoding/json"
 	"math/big"
 	"reflect"
 	"testing"
@@ -31,6 +32,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/crypto/bls"
 )
 
 type testerVote struct {
@@ -453,3 +455,125 @@ func TestSaveAndLoad(t *testing.T) {
 		t.Errorf("validator set mismatch: have %v, want %v", snap1.ValSet, snap.ValSet)
 	}
 }
+
+func TestVoteBLSKeyTally(t *testing.T) {
+	validators := []common.Address{common.StringToAddress("1"), common.StringToAddress("2"), common.StringToAddress("3")}
+	snap := newSnapshot(istanbul.DefaultConfig.Epoch, 0, common.Hash{}, validator.NewSet(validators, istanbul.RoundRobin))
+	candidate := common.StringToAddress("4")
+	key := bls.DeriveSecretKey([]byte("candidate")).PublicKey()
+
+	// Votes registering different keys for the candidate don't add up
+	if !snap.cast(candidate, true, key.Marshal()) {
+		t.Fatalf("first vote should be counted")
+	}
+	if snap.cast(candidate, true, bls.DeriveSecretKey([]byte("other")).PublicKey().Marshal()) {
+		t.Errorf("vote with another key should not be counted")
+	}
+	if snap.cast(candidate, true, nil) {
+		t.Errorf("vote without the key should not be counted")
+	}
+	if !snap.cast(candidate, true, key.Marshal()) || snap.Tally[candidate].Votes != 2 {
+		t.Errorf("tally mismatch: have %v, want 2 votes", snap.Tally[candidate])
+	}
+
+	// The key survives storing the snapshot
+	snap.BLSKeys[candidate] = key
+	blob, err := json.Marshal(snap)
+	if err != nil {
+		t.Fatalf("failed to marshal snapshot: %v", err)
+	}
+	loaded := new(Snapshot)
+	if err := json.Unmarshal(blob, loaded); err != nil {
+		t.Fatalf("failed to unmarshal snapshot: %v", err)
+	}
+	if loaded.BLSKeys[candidate] == nil || !bytes.Equal(loaded.BLSKeys[candidate].Marshal(), key.Marshal()) {
+		t.Errorf("key mismatch: have %v, want %x", loaded.BLSKeys[candidate], key.Marshal())
+	}
+}
+
+func TestProposerFailures(t *testing.T) {
+	accounts := newTesterAccountPool()
+	names := []string{"A", "B", "C", "D"}
+	validators := make([]common.Address, len(names))
+	for i, name := range names {
+		validators[i] = accounts.address(name)
+	}
+	valSet := validator.NewSet(validators, istanbul.Reputation)
+	order := make([]string, len(names))
+	for i, val := range valSet.List() {
+		for _, name := range names {
+			if accounts.address(name) == val.Address() {
+				order[i] = name
+			}
+		}
+	}
+	snap := newSnapshot(istanbul.DefaultConfig.Epoch, 0, common.Hash{}, valSet)
+
+	// Block 1 goes to the second validator in round 0, block 2 should have
+	// been proposed by the third but was taken over by the fourth in round 1,
+	// as recorded in its header.
+	proposers := []string{order[0], order[1], order[3]}
+	rounds := []uint64{0, 0, 1}
+	headers := make([]*types.Header, len(proposers))
+	for i, proposer := range proposers {
+		headers[i] = &types.Header{
+			Number:     big.NewInt(int64(i) + 1),
+			Difficulty: defaultDifficulty,
+			MixDigest:  types.IstanbulDigest,
+		}
+		headers[i].Extra, _ = prepareExtra(headers[i], validators)
+		if err := writeCommitRound(headers[i], rounds[i]); err != nil {
+			t.Fatalf("failed to write round: %v", err)
+		}
+		if i > 0 {
+			headers[i].ParentHash = headers[i-1].Hash()
+		}
+		accounts.sign(headers[i], proposer)
+	}
+	result, err := snap.apply(headers)
+	if err != nil {
+		t.Fatalf("failed to apply headers: %v", err)
+	}
+	failed := accounts.address(order[2])
+	if len(result.Failures) != 1 || result.Failures[failed] != 3 {
+		t.Errorf("failures mismatch: have %v, want %x at block 3", result.Failures, failed)
+	}
+	if result.Proposer != accounts.address(order[3]) {
+		t.Errorf("proposer mismatch: have %x, want %x", result.Proposer, accounts.address(order[3]))
+	}
+	// The failed validator is skipped when picking the proposer of block 4
+	result.ValSet.CalcProposer(result.Proposer, 1)
+	if have := result.ValSet.GetProposer().Address(); have != accounts.address(order[1]) {
+		t.Errorf("proposer mismatch: have %x, want %x", have, accounts.address(order[1]))
+	}
+	// Failures survive a round trip through the database
+	db := rawdb.NewMemoryDatabase()
+	if err := result.store(db); err != nil {
+		t.Fatalf("failed to store snapshot: %v", err)
+	}
+	loaded, err := loadSnapshot(result.Epoch, db, result.Hash)
+	if err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Failures, result.Failures) {
+		t.Errorf("failures mismatch: have %v, want %v", loaded.Failures, result.Failures)
+	}
+	if ctx := loaded.ValSet.ProposerContext(); ctx == nil || ctx.Hash != result.Hash || ctx.Number != result.Number {
+		t.Errorf("proposer context mismatch: have %v", ctx)
+	}
+
+	// Without recorded rounds, a proposer other than the expected one is no failure
+	for i, header := range headers {
+		header.Extra, _ = prepareExtra(header, validators)
+		if i > 0 {
+			header.ParentHash = headers[i-1].Hash()
+		}
+		accounts.sign(header, proposers[i])
+	}
+	if result, err = snap.apply(headers); err != nil {
+		t.Fatalf("failed to apply headers: %v", err)
+	}
+	if len(result.Failures) != 0 {
+		t.Errorf("failures mismatch: have %v, want none", result.Failures)
+	}
+}
@@ -16,13 +16,19 @@
 
 package istanbul
 
-import "math/big"
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
 
 type ProposerPolicy uint64
 
 const (
 	RoundRobin ProposerPolicy = iota
 	Sticky
+	Random     // pseudo-random selection seeded from the parent block hash
+	Reputation // round robin skipping validators that recently failed to propose
 )
 
 type Config struct {
@@ -32,6 +38,39 @@ type Config struct {
 	Epoch                  uint64         `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
 	Ceil2Nby3Block         *big.Int       `toml:",omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
 	AllowedFutureBlockTime uint64         `toml:",omitempty"` // Max time (in seconds) from current time allowed for blocks, before they're considered future blocks
+	AggregatedSealBlock    *big.Int       `toml:",omitempty"` // Block from which committed seals are aggregated into a single BLS signature
+	CommitRoundBlock       *big.Int       `toml:",omitempty"` // Block from which committed seals sign the round of the commit, recorded in the header
+	RelayTTL               uint64         `toml:",omitempty"` // Number of hops consensus messages are relayed through non-validator peers (0 = disabled)
+
+	RaftMigrationBlock *big.Int `toml:",omitempty"` // Block from which Istanbul takes over block production from raft
+
+	BLSKeys    map[common.Address]*BLSKey `toml:"-"` // BLS keys of the validators at the aggregated seal fork
+	Validators []common.Address           `toml:"-"` // Initial validators at the raft migration block
+}
+
+// BLSKey is the registered BLS public key of a validator and the proof that
+// the validator holds the matching secret key.
+type BLSKey struct {
+	PublicKey []byte
+	Proof     []byte
+}
+
+// IsAggregatedSeal returns whether committed seals are aggregated at the given
+// block number.
+func (c *Config) IsAggregatedSeal(number *big.Int) bool {
+	return c.AggregatedSealBlock != nil && number != nil && number.Cmp(c.AggregatedSealBlock) >= 0
+}
+
+// IsCommitRound returns whether the header of the block at the given number
+// records the round it was committed in.
+func (c *Config) IsCommitRound(number *big.Int) bool {
+	return c.CommitRoundBlock != nil && number != nil && number.Cmp(c.CommitRoundBlock) >= 0
+}
+
+// IsRaftBlock returns whether the block at the given number was minted by raft,
+// before Istanbul took over block production.
+func (c *Config) IsRaftBlock(number *big.Int) bool {
+	return c.RaftMigrationBlock != nil && number != nil && number.Cmp(c.RaftMigrationBlock) < 0
 }
 
 var DefaultConfig = &Config{
@@ -18,6 +18,7 @@ package core
 
 import (
 	"bytes"
+	"encoding/binary"
 	"math"
 	"math/big"
 	"sync"
@@ -25,7 +26,6 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	metrics "github.com/ethereum/go-ethereum/metrics"
@@ -108,8 +108,7 @@ func (c *core) finalizeMessage(msg *message) ([]byte, error) {
 	msg.CommittedSeal = []byte{}
 	// Assign the CommittedSeal if it's a COMMIT message and proposal is not nil
 	if msg.Code == msgCommit && c.current.Proposal() != nil {
-		seal := PrepareCommittedSeal(c.current.Proposal().Hash())
-		msg.CommittedSeal, err = c.backend.Sign(seal)
+		msg.CommittedSeal, err = c.backend.SignCommittedSeal(c.current.Proposal(), c.current.Round())
 		if err != nil {
 			return nil, err
 		}
@@ -175,12 +174,13 @@ func (c *core) commit() {
 	proposal := c.current.Proposal()
 	if proposal != nil {
 		committedSeals := make([][]byte, c.current.Commits.Size())
+		committers := make([]common.Address, c.current.Commits.Size())
 		for i, v := range c.current.Commits.Values() {
-			committedSeals[i] = make([]byte, types.IstanbulExtraSeal)
-			copy(committedSeals[i][:], v.CommittedSeal[:])
+			committedSeals[i] = common.CopyBytes(v.CommittedSeal)
+			committers[i] = v.Address
 		}
 
-		if err := c.backend.Commit(proposal, committedSeals); err != nil {
+		if err := c.backend.Commit(proposal, committedSeals, committers, c.current.Round()); err != nil {
 			c.current.UnlockHash() //Unlock block when insertion fails
 			c.sendNextRoundChange()
 			return
@@ -357,3 +357,13 @@ func PrepareCommittedSeal(hash common.Hash) []byte {
 	buf.Write([]byte{byte(msgCommit)})
 	return buf.Bytes()
 }
+
+// PrepareRoundCommittedSeal returns a committed seal for the given hash which
+// also certifies the round the proposal was committed in.
+func PrepareRoundCommittedSeal(hash common.Hash, round uint64) []byte {
+	var buf bytes.Buffer
+	buf.Write(hash.Bytes())
+	binary.Write(&buf, binary.BigEndian, round)
+	buf.Write([]byte{byte(msgCommit)})
+	return buf.Bytes()
+}
@@ -93,7 +93,7 @@ func (self *testSystemBackend) Gossip(valSet istanbul.ValidatorSet, message []by
 	return nil
 }
 
-func (self *testSystemBackend) Commit(proposal istanbul.Proposal, seals [][]byte) error {
+func (self *testSystemBackend) Commit(proposal istanbul.Proposal, seals [][]byte, committers []common.Address, round *big.Int) error {
 	testLogger.Info("commit message", "address", self.Address())
 	self.committedMsgs = append(self.committedMsgs, testCommittedMsgs{
 		commitProposal: proposal,
@@ -114,6 +114,10 @@ func (self *testSystemBackend) Sign(data []byte) ([]byte, error) {
 	return self.address.Bytes(), nil
 }
 
+func (self *testSystemBackend) SignCommittedSeal(proposal istanbul.Proposal, round *big.Int) ([]byte, error) {
+	return self.Sign(PrepareCommittedSeal(proposal.Hash()))
+}
+
 func (self *testSystemBackend) CheckSignature([]byte, common.Address, []byte) error {
 	return nil
 }
@@ -22,6 +22,7 @@ import (
 	"io"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -149,6 +150,18 @@ func (m *message) FromPayload(b []byte, validateFn func([]byte, []byte) (common.
 	return nil
 }
 
+// MessageSender decodes an encoded istanbul message and returns its sender if
+// the message is signed by a validator of the given set.
+func MessageSender(valSet istanbul.ValidatorSet, payload []byte) (common.Address, error) {
+	msg := new(message)
+	if err := msg.FromPayload(payload, func(data []byte, sig []byte) (common.Address, error) {
+		return istanbul.CheckValidatorSignature(valSet, data, sig)
+	}); err != nil {
+		return common.Address{}, err
+	}
+	return msg.Address, nil
+}
+
 func (m *message) Payload() ([]byte, error) {
 	return rlp.EncodeToBytes(m)
 }
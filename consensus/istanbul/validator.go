@@ -73,6 +73,47 @@ type ValidatorSet interface {
 	F() int
 	// Get proposer policy
 	Policy() ProposerPolicy
+	// Set the chain context used by the proposer policy
+	SetProposerContext(ctx *ProposerContext)
+	// Get the chain context used by the proposer policy
+	ProposerContext() *ProposerContext
+}
+
+// ----------------------------------------------------------------------------
+
+// ProposerContext is the chain state at the block a validator set was derived
+// from. Policies other than round robin and sticky use it to pick the proposer
+// of the next block.
+type ProposerContext struct {
+	Number   uint64                    // Block number the validator set was derived at
+	Hash     common.Hash               // Block hash the validator set was derived at
+	Failures map[common.Address]uint64 // Validators that failed to propose, mapped to the block they missed
+}
+
+// Copy returns a deep copy of the proposer context.
+func (ctx *ProposerContext) Copy() *ProposerContext {
+	if ctx == nil {
+		return nil
+	}
+	cpy := &ProposerContext{
+		Number:   ctx.Number,
+		Hash:     ctx.Hash,
+		Failures: make(map[common.Address]uint64, len(ctx.Failures)),
+	}
+	for addr, number := range ctx.Failures {
+		cpy.Failures[addr] = number
+	}
+	return cpy
+}
+
+// RecentlyFailed reports whether the validator missed a proposal within the
+// last window blocks.
+func (ctx *ProposerContext) RecentlyFailed(addr common.Address, window uint64) bool {
+	if ctx == nil {
+		return false
+	}
+	number, ok := ctx.Failures[addr]
+	return ok && ctx.Number-number < window
 }
 
 // ----------------------------------------------------------------------------
@@ -47,6 +47,7 @@ type defaultSet struct {
 	proposer    istanbul.Validator
 	validatorMu sync.RWMutex
 	selector    istanbul.ProposalSelector
+	context     *istanbul.ProposerContext
 }
 
 func newDefaultSet(addrs []common.Address, policy istanbul.ProposerPolicy) *defaultSet {
@@ -65,8 +66,8 @@ func newDefaultSet(addrs []common.Address, policy istanbul.ProposerPolicy) *defa
 		valSet.proposer = valSet.GetByIndex(0)
 	}
 	valSet.selector = roundRobinProposer
-	if policy == istanbul.Sticky {
-		valSet.selector = stickyProposer
+	if selector, ok := ProposerSelector(policy); ok {
+		valSet.selector = selector
 	}
 
 	return valSet
@@ -193,9 +194,23 @@ func (valSet *defaultSet) Copy() istanbul.ValidatorSet {
 	for _, v := range valSet.validators {
 		addresses = append(addresses, v.Address())
 	}
-	return NewSet(addresses, valSet.policy)
+	newSet := newDefaultSet(addresses, valSet.policy)
+	newSet.context = valSet.context.Copy()
+	return newSet
 }
 
 func (valSet *defaultSet) F() int { return int(math.Ceil(float64(valSet.Size())/3)) - 1 }
 
 func (valSet *defaultSet) Policy() istanbul.ProposerPolicy { return valSet.policy }
+
+func (valSet *defaultSet) SetProposerContext(ctx *istanbul.ProposerContext) {
+	valSet.validatorMu.Lock()
+	defer valSet.validatorMu.Unlock()
+	valSet.context = ctx
+}
+
+func (valSet *defaultSet) ProposerContext() *istanbul.ProposerContext {
+	valSet.validatorMu.RLock()
+	defer valSet.validatorMu.RUnlock()
+	return valSet.context
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package validator
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// ErrPolicyRegistered is returned if a selector is already registered for
+	// the given proposer policy.
+	ErrPolicyRegistered = errors.New("proposer policy already registered")
+
+	selectorsMu sync.RWMutex
+	selectors   = map[istanbul.ProposerPolicy]istanbul.ProposalSelector{
+		istanbul.RoundRobin: roundRobinProposer,
+		istanbul.Sticky:     stickyProposer,
+		istanbul.Random:     randomProposer,
+		istanbul.Reputation: reputationProposer,
+	}
+)
+
+// RegisterProposerPolicy makes a proposal selector available under the given
+// policy. Selectors must be deterministic, every validator has to agree on the
+// proposer of each round.
+func RegisterProposerPolicy(policy istanbul.ProposerPolicy, selector istanbul.ProposalSelector) error {
+	selectorsMu.Lock()
+	defer selectorsMu.Unlock()
+	if _, ok := selectors[policy]; ok {
+		return ErrPolicyRegistered
+	}
+	selectors[policy] = selector
+	return nil
+}
+
+// ProposerSelector returns the proposal selector registered for the policy.
+func ProposerSelector(policy istanbul.ProposerPolicy) (istanbul.ProposalSelector, bool) {
+	selectorsMu.RLock()
+	defer selectorsMu.RUnlock()
+	selector, ok := selectors[policy]
+	return selector, ok
+}
+
+// randomProposer picks a pseudo-random starting validator from the hash of the
+// block the set was derived at and moves forward one validator per round, so
+// that every validator gets a turn before anyone is picked twice in a height.
+func randomProposer(valSet istanbul.ValidatorSet, proposer common.Address, round uint64) istanbul.Validator {
+	if valSet.Size() == 0 {
+		return nil
+	}
+	ctx := valSet.ProposerContext()
+	if ctx == nil {
+		return roundRobinProposer(valSet, proposer, round)
+	}
+	seed := binary.BigEndian.Uint64(crypto.Keccak256(ctx.Hash.Bytes())[:8])
+	pick := (seed%uint64(valSet.Size()) + round) % uint64(valSet.Size())
+	return valSet.GetByIndex(pick)
+}
+
+// reputationProposer behaves like roundRobinProposer but skips validators that
+// missed a proposal within the last full rotation of the set. If every validator
+// recently failed, none is skipped.
+func reputationProposer(valSet istanbul.ValidatorSet, proposer common.Address, round uint64) istanbul.Validator {
+	if valSet.Size() == 0 {
+		return nil
+	}
+	ctx := valSet.ProposerContext()
+	window := uint64(valSet.Size())
+
+	// Collect the eligible validators, remembering where the last proposer sits
+	var (
+		eligible []istanbul.Validator
+		start    int
+	)
+	offset, last := valSet.GetByAddress(proposer)
+	for i, val := range valSet.List() {
+		if last != nil && i == offset+1 {
+			start = len(eligible)
+		}
+		if !ctx.RecentlyFailed(val.Address(), window) {
+			eligible = append(eligible, val)
+		}
+	}
+	if len(eligible) == 0 {
+		return roundRobinProposer(valSet, proposer, round)
+	}
+	if last == nil {
+		return eligible[round%uint64(len(eligible))]
+	}
+	return eligible[(uint64(start)+round)%uint64(len(eligible))]
+}
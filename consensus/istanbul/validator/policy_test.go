@@ -0,0 +1,148 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package validator
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/istanbul"
+)
+
+var policyTestAddrs = []common.Address{
+	common.HexToAddress("0x01"),
+	common.HexToAddress("0x02"),
+	common.HexToAddress("0x03"),
+	common.HexToAddress("0x04"),
+}
+
+func TestRandomProposer(t *testing.T) {
+	valSet := NewSet(policyTestAddrs, istanbul.Random)
+	valSet.SetProposerContext(&istanbul.ProposerContext{Number: 10, Hash: common.HexToHash("0xabcdef")})
+
+	// The same context must always produce the same proposer
+	valSet.CalcProposer(policyTestAddrs[0], 0)
+	first := valSet.GetProposer()
+	for i := 0; i < 10; i++ {
+		other := valSet.Copy()
+		other.CalcProposer(policyTestAddrs[0], 0)
+		if other.GetProposer().Address() != first.Address() {
+			t.Fatalf("proposer mismatch: have %v, want %v", other.GetProposer(), first)
+		}
+	}
+	// Every validator has to get a turn within a full rotation of rounds
+	seen := make(map[common.Address]bool)
+	for round := uint64(0); round < uint64(valSet.Size()); round++ {
+		valSet.CalcProposer(policyTestAddrs[0], round)
+		seen[valSet.GetProposer().Address()] = true
+	}
+	if len(seen) != valSet.Size() {
+		t.Errorf("distinct proposers mismatch: have %d, want %d", len(seen), valSet.Size())
+	}
+	// Different parent hashes should spread the first proposer around
+	picks := make(map[common.Address]bool)
+	for i := 0; i < 32; i++ {
+		valSet.SetProposerContext(&istanbul.ProposerContext{Hash: common.BytesToHash([]byte{byte(i)})})
+		valSet.CalcProposer(common.Address{}, 0)
+		picks[valSet.GetProposer().Address()] = true
+	}
+	if len(picks) < 2 {
+		t.Errorf("proposer does not depend on the parent hash")
+	}
+}
+
+func TestReputationProposer(t *testing.T) {
+	valSet := NewSet(policyTestAddrs, istanbul.Reputation)
+
+	// Without failures it must behave like round robin
+	valSet.CalcProposer(policyTestAddrs[0], 0)
+	if have := valSet.GetProposer().Address(); have != policyTestAddrs[1] {
+		t.Errorf("proposer mismatch: have %v, want %v", have.Hex(), policyTestAddrs[1].Hex())
+	}
+	// A validator that failed within the window is skipped
+	valSet.SetProposerContext(&istanbul.ProposerContext{
+		Number:   10,
+		Failures: map[common.Address]uint64{policyTestAddrs[1]: 9},
+	})
+	tests := []struct {
+		round uint64
+		want  common.Address
+	}{
+		{0, policyTestAddrs[2]},
+		{1, policyTestAddrs[3]},
+		{2, policyTestAddrs[0]},
+		{3, policyTestAddrs[2]},
+	}
+	for i, tt := range tests {
+		valSet.CalcProposer(policyTestAddrs[0], tt.round)
+		if have := valSet.GetProposer().Address(); have != tt.want {
+			t.Errorf("test %d: proposer mismatch: have %v, want %v", i, have.Hex(), tt.want.Hex())
+		}
+	}
+	// The last proposer being the final validator wraps around
+	valSet.CalcProposer(policyTestAddrs[3], 0)
+	if have := valSet.GetProposer().Address(); have != policyTestAddrs[0] {
+		t.Errorf("proposer mismatch: have %v, want %v", have.Hex(), policyTestAddrs[0].Hex())
+	}
+	// Failures older than a full rotation are ignored
+	valSet.SetProposerContext(&istanbul.ProposerContext{
+		Number:   10,
+		Failures: map[common.Address]uint64{policyTestAddrs[1]: 6},
+	})
+	valSet.CalcProposer(policyTestAddrs[0], 0)
+	if have := valSet.GetProposer().Address(); have != policyTestAddrs[1] {
+		t.Errorf("proposer mismatch: have %v, want %v", have.Hex(), policyTestAddrs[1].Hex())
+	}
+	// If every validator failed recently, nobody is skipped
+	failures := make(map[common.Address]uint64)
+	for _, addr := range policyTestAddrs {
+		failures[addr] = 10
+	}
+	valSet.SetProposerContext(&istanbul.ProposerContext{Number: 10, Failures: failures})
+	valSet.CalcProposer(policyTestAddrs[0], 0)
+	if have := valSet.GetProposer().Address(); have != policyTestAddrs[1] {
+		t.Errorf("proposer mismatch: have %v, want %v", have.Hex(), policyTestAddrs[1].Hex())
+	}
+}
+
+func TestRegisterProposerPolicy(t *testing.T) {
+	const custom = istanbul.ProposerPolicy(100)
+
+	first := func(valSet istanbul.ValidatorSet, proposer common.Address, round uint64) istanbul.Validator {
+		return valSet.GetByIndex(0)
+	}
+	if err := RegisterProposerPolicy(custom, first); err != nil {
+		t.Fatalf("failed to register policy: %v", err)
+	}
+	if err := RegisterProposerPolicy(custom, first); err != ErrPolicyRegistered {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrPolicyRegistered)
+	}
+	if err := RegisterProposerPolicy(istanbul.RoundRobin, first); err != ErrPolicyRegistered {
+		t.Errorf("error mismatch: have %v, want %v", err, ErrPolicyRegistered)
+	}
+	valSet := NewSet(policyTestAddrs, custom)
+	valSet.CalcProposer(policyTestAddrs[2], 5)
+	if have := valSet.GetProposer().Address(); have != policyTestAddrs[0] {
+		t.Errorf("proposer mismatch: have %v, want %v", have.Hex(), policyTestAddrs[0].Hex())
+	}
+	// Unknown policies fall back to round robin
+	valSet = NewSet(policyTestAddrs, istanbul.ProposerPolicy(101))
+	valSet.CalcProposer(policyTestAddrs[2], 0)
+	if have := valSet.GetProposer().Address(); have != policyTestAddrs[3] {
+		t.Errorf("proposer mismatch: have %v, want %v", have.Hex(), policyTestAddrs[3].Hex())
+	}
+}
@@ -10,18 +10,22 @@ import (
 // istanbul/99 was added to accommodate new eth/64 handshake status data with fork id
 // this is for backward compatibility which allows a mixed old/new istanbul node network
 // istanbul/64 will continue using old status data as eth/63
+// istanbul/100 adds the message for consensus messages relayed by non-validators
+// istanbul/101 adds the messages to bootstrap new nodes from validator set proofs
 const (
-	eth63      = 63
-	eth64      = 64
-	Istanbul64 = 64
-	Istanbul99 = 99
+	eth63       = 63
+	eth64       = 64
+	Istanbul64  = 64
+	Istanbul99  = 99
+	Istanbul100 = 100
+	Istanbul101 = 101
 )
 
 var (
 	IstanbulProtocol = Protocol{
 		Name:     "istanbul",
-		Versions: []uint{Istanbul99, Istanbul64},
-		Lengths:  map[uint]uint64{Istanbul99: 18, Istanbul64: 18},
+		Versions: []uint{Istanbul101, Istanbul100, Istanbul99, Istanbul64},
+		Lengths:  map[uint]uint64{Istanbul101: 21, Istanbul100: 19, Istanbul99: 18, Istanbul64: 18},
 	}
 
 	CliqueProtocol = Protocol{
@@ -60,6 +64,8 @@ type Broadcaster interface {
 	Enqueue(id string, block *types.Block)
 	// FindPeers retrives peers by addresses
 	FindPeers(map[common.Address]bool) map[common.Address]Peer
+	// RelayPeers retrieves the peers able to relay consensus messages
+	RelayPeers() map[common.Address]Peer
 }
 
 // Peer defines the interface to communicate with peer
@@ -0,0 +1,39 @@
+// Quorum
+package consensus
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs consensus messages and block seals with the validator key of
+// the node. The validator key is the node key unless it is held by a signer
+// plugin, in which case only these signatures go to the plugin: devp2p keeps
+// using the node key for the identity of the node and the encryption of its
+// connections.
+type Signer interface {
+	// Address returns the address of the validator key
+	Address() (common.Address, error)
+	// SignHash signs the 32 byte hash, returning the signature in the
+	// [R || S || V] format where V is 0 or 1
+	SignHash(hash []byte) ([]byte, error)
+}
+
+type keySigner struct {
+	key *ecdsa.PrivateKey
+}
+
+// NewKeySigner returns the signer signing with the given key
+func NewKeySigner(key *ecdsa.PrivateKey) Signer {
+	return &keySigner{key: key}
+}
+
+func (s *keySigner) Address() (common.Address, error) {
+	return crypto.PubkeyToAddress(s.key.PublicKey), nil
+}
+
+func (s *keySigner) SignHash(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.key)
+}
@@ -64,6 +64,14 @@ func (v *BlockValidator) ValidateBody(block *types.Block) error {
 	if hash := types.DeriveSha(block.Transactions()); hash != header.TxHash {
 		return fmt.Errorf("transaction root hash mismatch: have %x, want %x", hash, header.TxHash)
 	}
+	// Quorum - apply the custom rules of the transaction validator plugin
+	if BlockTransactionValidationFunc != nil {
+		for _, tx := range block.Transactions() {
+			if err := BlockTransactionValidationFunc(tx, tx.From(), header.Number.Uint64()); err != nil {
+				return fmt.Errorf("transaction %x: %v", tx.Hash(), err)
+			}
+		}
+	}
 	if !v.bc.HasBlockAndState(block.ParentHash(), block.NumberU64()-1) {
 		if !v.bc.HasBlock(block.ParentHash(), block.NumberU64()-1) {
 			return consensus.ErrUnknownAncestor
@@ -17,14 +17,19 @@
 package core
 
 import (
+	"errors"
+	"math/big"
 	"runtime"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -197,3 +202,49 @@ func testHeaderConcurrentAbortion(t *testing.T, threads int) {
 		t.Errorf("verification count too large: have %d, want below %d", verified, 2*threads)
 	}
 }
+
+// Tests that the block transactions denied by the transaction validator plugin
+// make the block invalid.
+func TestValidateBody_whenBlockTransactionDenied(t *testing.T) {
+	var (
+		testdb  = rawdb.NewMemoryDatabase()
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		address = crypto.PubkeyToAddress(key.PublicKey)
+		gspec   = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc:  GenesisAlloc{address: {Balance: big.NewInt(1000000000)}},
+		}
+		genesis = gspec.MustCommit(testdb)
+		signer  = types.NewEIP155Signer(gspec.Config.ChainID)
+	)
+	blocks, _ := GenerateChain(gspec.Config, genesis, ethash.NewFaker(), testdb, 1, func(i int, block *BlockGen) {
+		tx, err := types.SignTx(types.NewTransaction(block.TxNonce(address), common.Address{0x01}, big.NewInt(1000), params.TxGas, nil, nil), signer, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		block.AddTx(tx)
+	})
+	chain, _ := NewBlockChain(testdb, nil, gspec.Config, ethash.NewFaker(), vm.Config{}, nil)
+	defer chain.Stop()
+
+	defer func() { BlockTransactionValidationFunc = nil }()
+	var gotFrom common.Address
+	var gotBlockNumber uint64
+	BlockTransactionValidationFunc = func(tx *types.Transaction, from common.Address, blockNumber uint64) error {
+		gotFrom, gotBlockNumber = from, blockNumber
+		return errors.New("transaction denied: sender has not passed KYC")
+	}
+
+	err := chain.Validator().ValidateBody(blocks[0])
+	if err == nil || !strings.Contains(err.Error(), "sender has not passed KYC") {
+		t.Fatalf("ValidateBody() = %v, want the transaction denied", err)
+	}
+	if gotFrom != address || gotBlockNumber != 1 {
+		t.Errorf("validated from %x at block %d, want %x at block 1", gotFrom, gotBlockNumber, address)
+	}
+
+	BlockTransactionValidationFunc = func(*types.Transaction, common.Address, uint64) error { return nil }
+	if err := chain.Validator().ValidateBody(blocks[0]); err != nil {
+		t.Errorf("ValidateBody() = %v, want nil", err)
+	}
+}
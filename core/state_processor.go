@@ -117,7 +117,7 @@ func ApplyTransaction(config *params.ChainConfig, bc *BlockChain, author *common
 
 	// Quorum - check for account permissions to execute the transaction
 	if core.IsV2Permission() {
-		if err := core.CheckAccountPermission(tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
+		if err := core.CheckAccountPermission(header, tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
 			return nil, nil, err
 		}
 	}
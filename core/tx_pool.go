@@ -85,6 +85,20 @@ var (
 	ErrEtherValueUnsupported = errors.New("ether value is not supported for private transactions")
 )
 
+// Quorum
+// TransactionValidationFunc applies custom rules to the transactions entering
+// the pool, with blockNumber 0, and to the transactions of blocks the node
+// produces. It is set if the transaction validator plugin is configured. The
+// rules are local to the node and never decide on the validity of blocks.
+var TransactionValidationFunc func(tx *types.Transaction, from common.Address, blockNumber uint64) error
+
+// BlockTransactionValidationFunc applies custom rules to the transactions of
+// the blocks being validated. It is set if the transaction validator plugin
+// is configured to validate blocks, which every node of the network must then
+// do with the same rules. It is only given consensus data, so that every node
+// comes to the same verdict.
+var BlockTransactionValidationFunc func(tx *types.Transaction, from common.Address, blockNumber uint64) error
+
 var (
 	evictionInterval    = time.Minute     // Time interval to check for evictable transactions
 	statsReportInterval = 8 * time.Second // Time interval to report transaction pool stats
@@ -561,7 +575,10 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 			return ErrEtherValueUnsupported
 		}
 		// Quorum - check if the sender account is authorized to perform the transaction
-		if err := pcore.CheckAccountPermission(tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
+		if err := pcore.CheckAccountPermission(pool.pendingHeader(), tx.From(), tx.To(), tx.Value(), tx.Data(), tx.Gas(), tx.GasPrice()); err != nil {
+			return pcore.ExplainDenial(tx.From(), err)
+		}
+		if err := pcore.CheckContractFrozen(tx.To()); err != nil {
 			return err
 		}
 	} else {
@@ -588,9 +605,27 @@ func (pool *TxPool) validateTx(tx *types.Transaction, local bool) error {
 	if tx.Gas() < intrGas {
 		return ErrIntrinsicGas
 	}
+	// Quorum - apply the custom rules of the transaction validator plugin
+	if TransactionValidationFunc != nil {
+		if err := TransactionValidationFunc(tx, from, 0); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// pendingHeader returns the number and the earliest timestamp of the block
+// the transactions entering the pool go in next, which the permission
+// validity windows are checked against
+func (pool *TxPool) pendingHeader() *types.Header {
+	head := pool.chain.CurrentBlock().Header()
+	timestamp := uint64(time.Now().Unix())
+	if timestamp <= head.Time {
+		timestamp = head.Time + 1
+	}
+	return &types.Header{Number: new(big.Int).Add(head.Number, common.Big1), Time: timestamp}
+}
+
 // add validates a transaction and inserts it into the non-executable queue for later
 // pending promotion and execution. If the transaction is a replacement for an already
 // pending or queued one, it overwrites the previous transaction if its price is higher.
@@ -18,6 +18,7 @@ package core
 
 import (
 	"crypto/ecdsa"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"math/big"
@@ -34,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
 )
 
 // testTxPoolConfig is a transaction pool configuration without stateful disk
@@ -314,6 +316,38 @@ func TestInvalidTransactions(t *testing.T) {
 	}
 }
 
+// Quorum - transactions denied by the transaction validator plugin are rejected
+func TestTransactionValidationFunc(t *testing.T) {
+	pool, key := setupTxPool()
+	defer pool.Stop()
+	defer func() { TransactionValidationFunc = nil }()
+
+	tx := transaction(0, 100000, key)
+	from, _ := deriveSender(tx)
+	pool.currentState.AddBalance(from, big.NewInt(0xffffffffffffff))
+
+	denied := errors.New("transaction denied: recipient is blocked")
+	var (
+		validatedFrom  common.Address
+		validatedBlock uint64 = 1
+	)
+	TransactionValidationFunc = func(tx *types.Transaction, from common.Address, blockNumber uint64) error {
+		validatedFrom, validatedBlock = from, blockNumber
+		return denied
+	}
+	if err := pool.AddRemote(tx); err != denied {
+		t.Error("expected", denied, "; got", err)
+	}
+	if validatedFrom != from || validatedBlock != 0 {
+		t.Errorf("validated transaction from %x in block %d, want %x in block 0", validatedFrom, validatedBlock, from)
+	}
+
+	TransactionValidationFunc = func(*types.Transaction, common.Address, uint64) error { return nil }
+	if err := pool.AddRemote(tx); err != nil {
+		t.Error("expected", nil, "; got", err)
+	}
+}
+
 //Test for transactions that are only invalid on Quorum
 func TestQuorumInvalidTransactions(t *testing.T) {
 	pool, key := setupQuorumTxPool()
@@ -326,6 +360,77 @@ func TestQuorumInvalidTransactions(t *testing.T) {
 
 }
 
+// headBlockChain is a test chain whose head has the given number and time
+type headBlockChain struct {
+	*testBlockChain
+	number, time uint64
+}
+
+func (bc *headBlockChain) CurrentBlock() *types.Block {
+	return types.NewBlock(&types.Header{
+		Number:   new(big.Int).SetUint64(bc.number),
+		Time:     bc.time,
+		GasLimit: bc.gasLimit,
+	}, nil, nil, nil)
+}
+
+// Tests that the validity window of the sender is checked against the block
+// the transaction goes in next rather than the chain head
+func TestValidateTx_whenAccountValidityWindowBoundary(t *testing.T) {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()))
+	// the head is ahead of the clock so the pending block time is head time + 1
+	head := uint64(time.Now().Add(time.Hour).Unix())
+	blockchain := &headBlockChain{&testBlockChain{statedb, statedb, 1000000, new(event.Feed)}, 10, head}
+	pool := NewTxPool(testTxPoolConfig, params.QuorumTestChainConfig, blockchain)
+	defer pool.Stop()
+
+	// permissions stay disabled for the other tests once the model is reset
+	model, allowed := pcore.PermissionModel, pcore.PermissionTransactionAllowedFunc
+	pcore.PermissionModel = pcore.V2
+	pcore.SetQIP714BlockReached()
+	pcore.PermissionTransactionAllowedFunc = func(common.Address, common.Address, *big.Int, *big.Int, *big.Int, []byte, pcore.TransactionType) error {
+		return nil
+	}
+	defer func() {
+		pcore.PermissionModel, pcore.PermissionTransactionAllowedFunc = model, allowed
+	}()
+
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	defer pcore.ValidityMap.RemoveValidity(&from, "")
+
+	tests := []struct {
+		name     string
+		validity pcore.ValidityInfo
+		valid    bool
+	}{
+		{"from pending block", pcore.ValidityInfo{FromBlock: 11}, true},
+		{"from after pending block", pcore.ValidityInfo{FromBlock: 12}, false},
+		{"until pending block", pcore.ValidityInfo{UntilBlock: 11}, true},
+		{"until head", pcore.ValidityInfo{UntilBlock: 10}, false},
+		{"from pending block time", pcore.ValidityInfo{FromTime: head + 1}, true},
+		{"from after pending block time", pcore.ValidityInfo{FromTime: head + 2}, false},
+		{"until pending block time", pcore.ValidityInfo{UntilTime: head + 1}, true},
+		{"until head time", pcore.ValidityInfo{UntilTime: head}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.validity.Account = &from
+			if err := pcore.ValidityMap.UpsertValidity(tt.validity); err != nil {
+				t.Fatal(err)
+			}
+			tx, _ := types.SignTx(types.NewTransaction(0, common.Address{}, common.Big0, 100000, common.Big0, nil), types.HomesteadSigner{}, key)
+			err := pool.validateTx(tx, false)
+			if tt.valid && err != nil {
+				t.Errorf("expected transaction to be valid, got %v", err)
+			}
+			if !tt.valid && err != pcore.ErrAccountExpired {
+				t.Errorf("expected %v, got %v", pcore.ErrAccountExpired, err)
+			}
+		})
+	}
+}
+
 func TestValidateTx_whenValueZeroTransferForPrivateTransaction(t *testing.T) {
 	pool, key := setupQuorumTxPool()
 	defer pool.Stop()
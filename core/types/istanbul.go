@@ -17,6 +17,7 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"io"
 
@@ -37,18 +38,55 @@ var (
 )
 
 type IstanbulExtra struct {
-	Validators    []common.Address
-	Seal          []byte
-	CommittedSeal [][]byte
+	Validators     []common.Address
+	Seal           []byte
+	CommittedSeal  [][]byte
+	AggregatedSeal *IstanbulAggregatedSeal
+	// Round the block was committed in, certified by the committed seals. It is
+	// recorded from the commit round fork block on, nil before.
+	Round *uint64
+	// VoteBLSKey is the BLS key of the candidate the block votes to authorize,
+	// registered for its aggregated seals once the vote passes.
+	VoteBLSKey *IstanbulBLSKey
 }
 
-// EncodeRLP serializes ist into the Ethereum RLP format.
+// IstanbulBLSKey is the BLS public key of a validator along with the proof that
+// it holds the matching secret key.
+type IstanbulBLSKey struct {
+	PublicKey []byte
+	Proof     []byte
+}
+
+// IstanbulAggregatedSeal replaces the individual committed seals once aggregated
+// seals are activated. Bitmap flags the committers by their index in the sorted
+// validator set of the parent block, Signature is the BLS aggregate of their
+// committed seals.
+type IstanbulAggregatedSeal struct {
+	Bitmap    []byte
+	Signature []byte
+}
+
+// EncodeRLP serializes ist into the Ethereum RLP format. The aggregated seal, the
+// round and the vote BLS key are only appended if present, so that headers without
+// them keep their encoding. An empty list stands for a missing field followed by
+// a present one.
 func (ist *IstanbulExtra) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
+	fields := []interface{}{
 		ist.Validators,
 		ist.Seal,
 		ist.CommittedSeal,
-	})
+	}
+	optional, last := []interface{}{[]interface{}{}, []interface{}{}, []interface{}{}}, 0
+	if ist.AggregatedSeal != nil {
+		optional[0], last = ist.AggregatedSeal, 1
+	}
+	if ist.Round != nil {
+		optional[1], last = *ist.Round, 2
+	}
+	if ist.VoteBLSKey != nil {
+		optional[2], last = ist.VoteBLSKey, 3
+	}
+	return rlp.Encode(w, append(fields, optional[:last]...))
 }
 
 // DecodeRLP implements rlp.Decoder, and load the istanbul fields from a RLP stream.
@@ -57,11 +95,38 @@ func (ist *IstanbulExtra) DecodeRLP(s *rlp.Stream) error {
 		Validators    []common.Address
 		Seal          []byte
 		CommittedSeal [][]byte
+		Optional      []rlp.RawValue `rlp:"tail"`
 	}
 	if err := s.Decode(&istanbulExtra); err != nil {
 		return err
 	}
 	ist.Validators, ist.Seal, ist.CommittedSeal = istanbulExtra.Validators, istanbulExtra.Seal, istanbulExtra.CommittedSeal
+	ist.AggregatedSeal, ist.Round, ist.VoteBLSKey = nil, nil, nil
+	optional := istanbulExtra.Optional
+	if len(optional) > 3 {
+		return ErrInvalidIstanbulHeaderExtra
+	}
+	present := func(i int) bool {
+		return len(optional) > i && !bytes.Equal(optional[i], rlp.EmptyList)
+	}
+	if present(0) {
+		ist.AggregatedSeal = new(IstanbulAggregatedSeal)
+		if err := rlp.DecodeBytes(optional[0], ist.AggregatedSeal); err != nil {
+			return err
+		}
+	}
+	if present(1) {
+		ist.Round = new(uint64)
+		if err := rlp.DecodeBytes(optional[1], ist.Round); err != nil {
+			return err
+		}
+	}
+	if present(2) {
+		ist.VoteBLSKey = new(IstanbulBLSKey)
+		if err := rlp.DecodeBytes(optional[2], ist.VoteBLSKey); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -95,6 +160,8 @@ func IstanbulFilteredHeader(h *Header, keepSeal bool) *Header {
 		istanbulExtra.Seal = []byte{}
 	}
 	istanbulExtra.CommittedSeal = [][]byte{}
+	istanbulExtra.AggregatedSeal = nil
+	istanbulExtra.Round = nil
 
 	payload, err := rlp.EncodeToBytes(&istanbulExtra)
 	if err != nil {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 func TestHeaderHash(t *testing.T) {
@@ -86,3 +87,103 @@ func TestExtractToIstanbul(t *testing.T) {
 		}
 	}
 }
+
+func TestIstanbulAggregatedSeal(t *testing.T) {
+	extra := &IstanbulExtra{
+		Validators:    []common.Address{common.HexToAddress("0x44add0ec310f115a0e603b2d7db9f067778eaf8a")},
+		Seal:          []byte{},
+		CommittedSeal: [][]byte{},
+	}
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatalf("failed to encode extra: %v", err)
+	}
+	header := &Header{MixDigest: IstanbulDigest, Extra: append(make([]byte, IstanbulExtraVanity), payload...)}
+	hash := header.Hash()
+
+	// Adding the aggregated seal must round trip without changing the hash
+	extra.AggregatedSeal = &IstanbulAggregatedSeal{Bitmap: []byte{0x01}, Signature: []byte{0x02, 0x03}}
+	if payload, err = rlp.EncodeToBytes(extra); err != nil {
+		t.Fatalf("failed to encode extra: %v", err)
+	}
+	header.Extra = append(make([]byte, IstanbulExtraVanity), payload...)
+	decoded, err := ExtractIstanbulExtra(header)
+	if err != nil {
+		t.Fatalf("failed to decode extra: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, extra) {
+		t.Errorf("extra mismatch: have %v, want %v", decoded, extra)
+	}
+	if header.Hash() != hash {
+		t.Errorf("hash mismatch: have %v, want %v", header.Hash().Hex(), hash.Hex())
+	}
+}
+
+func TestIstanbulRound(t *testing.T) {
+	round := uint64(2)
+	for _, aggregatedSeal := range []*IstanbulAggregatedSeal{nil, {Bitmap: []byte{0x01}, Signature: []byte{0x02, 0x03}}} {
+		extra := &IstanbulExtra{
+			Validators:    []common.Address{common.HexToAddress("0x44add0ec310f115a0e603b2d7db9f067778eaf8a")},
+			Seal:          []byte{},
+			CommittedSeal: [][]byte{},
+		}
+		payload, err := rlp.EncodeToBytes(extra)
+		if err != nil {
+			t.Fatalf("failed to encode extra: %v", err)
+		}
+		header := &Header{MixDigest: IstanbulDigest, Extra: append(make([]byte, IstanbulExtraVanity), payload...)}
+		hash := header.Hash()
+
+		// Recording the round must round trip without changing the hash
+		extra.AggregatedSeal = aggregatedSeal
+		extra.Round = &round
+		if payload, err = rlp.EncodeToBytes(extra); err != nil {
+			t.Fatalf("failed to encode extra: %v", err)
+		}
+		header.Extra = append(make([]byte, IstanbulExtraVanity), payload...)
+		decoded, err := ExtractIstanbulExtra(header)
+		if err != nil {
+			t.Fatalf("failed to decode extra: %v", err)
+		}
+		if !reflect.DeepEqual(decoded, extra) {
+			t.Errorf("extra mismatch: have %v, want %v", decoded, extra)
+		}
+		if header.Hash() != hash {
+			t.Errorf("hash mismatch: have %v, want %v", header.Hash().Hex(), hash.Hex())
+		}
+	}
+}
+
+func TestIstanbulVoteBLSKey(t *testing.T) {
+	round := uint64(1)
+	key := &IstanbulBLSKey{PublicKey: []byte{0x01, 0x02}, Proof: []byte{0x03}}
+	for _, r := range []*uint64{nil, &round} {
+		extra := &IstanbulExtra{
+			Validators:    []common.Address{common.HexToAddress("0x44add0ec310f115a0e603b2d7db9f067778eaf8a")},
+			Seal:          []byte{},
+			CommittedSeal: [][]byte{},
+			Round:         r,
+			VoteBLSKey:    key,
+		}
+		payload, err := rlp.EncodeToBytes(extra)
+		if err != nil {
+			t.Fatalf("failed to encode extra: %v", err)
+		}
+		header := &Header{MixDigest: IstanbulDigest, Extra: append(make([]byte, IstanbulExtraVanity), payload...)}
+		decoded, err := ExtractIstanbulExtra(header)
+		if err != nil {
+			t.Fatalf("failed to decode extra: %v", err)
+		}
+		if !reflect.DeepEqual(decoded, extra) {
+			t.Errorf("extra mismatch: have %v, want %v", decoded, extra)
+		}
+		// The vote is part of the proposal, so it is kept by the filtered header
+		filtered, err := ExtractIstanbulExtra(IstanbulFilteredHeader(header, true))
+		if err != nil {
+			t.Fatalf("failed to decode filtered extra: %v", err)
+		}
+		if !reflect.DeepEqual(filtered.VoteBLSKey, key) || filtered.Round != nil {
+			t.Errorf("filtered extra mismatch: have %v", filtered)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package bls implements BLS signatures over the BLS12-381 curve, with
+// signatures in G1 and public keys in G2, following the minimal-signature-size
+// proof of possession ciphersuite of the IETF BLS signature draft
+// (BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_). The curve arithmetic, point
+// encoding and hashing to the curve are those of github.com/kilic/bls12-381.
+// Signatures by different keys over the same message can be aggregated into a
+// single signature which is checked against the sum of the public keys with one
+// pairing check.
+//
+// Aggregation is only safe against rogue key attacks if every public key that
+// takes part was registered together with a valid proof of possession.
+package bls
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+const (
+	// SignatureLength is the length of a marshalled signature (a compressed G1 point).
+	SignatureLength = 48
+	// PublicKeyLength is the length of a marshalled public key (a compressed G2 point).
+	PublicKeyLength = 96
+)
+
+var (
+	errInvalidSignature = errors.New("bls: invalid signature")
+	errInvalidPublicKey = errors.New("bls: invalid public key")
+	errNoSignatures     = errors.New("bls: no signatures to aggregate")
+
+	// Domain separation tags of the ciphersuite, so that a proof of possession
+	// can never be replayed as a signature over a message and vice versa.
+	messageDomain = []byte("BLS_SIG_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+	proofDomain   = []byte("BLS_POP_BLS12381G1_XMD:SHA-256_SSWU_RO_POP_")
+
+	keyGenSalt = []byte("BLS-SIG-KEYGEN-SALT-")
+)
+
+// SecretKey is a BLS secret key.
+type SecretKey struct {
+	x *big.Int
+}
+
+// PublicKey is a BLS public key.
+type PublicKey struct {
+	p *bls12381.PointG2
+}
+
+// DeriveSecretKey deterministically derives a secret key from the given seed,
+// which must hold enough entropy on its own (e.g. an existing private key). The
+// derivation is the KeyGen procedure of the IETF BLS signature draft.
+func DeriveSecretKey(seed []byte) *SecretKey {
+	var (
+		order = bls12381.NewG1().Q()
+		ikm   = append(append([]byte{}, seed...), 0)
+		salt  = keyGenSalt
+	)
+	for {
+		digest := sha256.Sum256(salt)
+		salt = digest[:]
+
+		// HKDF-Extract followed by HKDF-Expand into 48 bytes, with an empty key info
+		prk := hmacSHA256(salt, ikm)
+		t1 := hmacSHA256(prk, []byte{0, 48, 1})
+		t2 := hmacSHA256(prk, t1, []byte{0, 48, 2})
+
+		x := new(big.Int).SetBytes(append(t1, t2[:16]...))
+		if x.Mod(x, order).Sign() != 0 {
+			return &SecretKey{x: x}
+		}
+	}
+}
+
+// PublicKey returns the public key belonging to the secret key.
+func (sk *SecretKey) PublicKey() *PublicKey {
+	g2 := bls12381.NewG2()
+	return &PublicKey{p: g2.MulScalarBig(g2.New(), g2.One(), sk.x)}
+}
+
+// Sign signs the message and returns the marshalled signature.
+func (sk *SecretKey) Sign(msg []byte) []byte {
+	return sk.sign(messageDomain, msg)
+}
+
+// ProofOfPossession signs the secret key's own public key, proving that whoever
+// registers the public key also holds the secret key.
+func (sk *SecretKey) ProofOfPossession() []byte {
+	return sk.sign(proofDomain, sk.PublicKey().Marshal())
+}
+
+func (sk *SecretKey) sign(domain, msg []byte) []byte {
+	g1 := bls12381.NewG1()
+	h := hashToG1(domain, msg)
+	return g1.ToCompressed(g1.MulScalarBig(h, h, sk.x))
+}
+
+// UnmarshalPublicKey decodes a public key produced by PublicKey.Marshal. Points
+// off the curve or outside the prime order subgroup are rejected.
+func UnmarshalPublicKey(b []byte) (*PublicKey, error) {
+	if len(b) != PublicKeyLength {
+		return nil, errInvalidPublicKey
+	}
+	p, err := bls12381.NewG2().FromCompressed(b)
+	if err != nil {
+		return nil, errInvalidPublicKey
+	}
+	return &PublicKey{p: p}, nil
+}
+
+// Marshal encodes the public key.
+func (pk *PublicKey) Marshal() []byte {
+	return bls12381.NewG2().ToCompressed(pk.p)
+}
+
+// AggregatePublicKeys sums up the given public keys.
+func AggregatePublicKeys(pks []*PublicKey) *PublicKey {
+	g2 := bls12381.NewG2()
+	sum := g2.Zero()
+	for _, pk := range pks {
+		g2.Add(sum, sum, pk.p)
+	}
+	return &PublicKey{p: sum}
+}
+
+// AggregateSignatures sums up the given marshalled signatures.
+func AggregateSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errNoSignatures
+	}
+	g1 := bls12381.NewG1()
+	sum := g1.Zero()
+	for _, sig := range sigs {
+		p, err := unmarshalSignature(sig)
+		if err != nil {
+			return nil, err
+		}
+		g1.Add(sum, sum, p)
+	}
+	return g1.ToCompressed(sum), nil
+}
+
+// Verify checks that sig is a valid signature of msg by pk. If pk is the
+// aggregate of several public keys, sig has to be the aggregate of all their
+// signatures over msg.
+func Verify(pk *PublicKey, msg []byte, sig []byte) bool {
+	return verify(pk, messageDomain, msg, sig)
+}
+
+// VerifyProofOfPossession checks a proof generated by ProofOfPossession.
+func VerifyProofOfPossession(pk *PublicKey, proof []byte) bool {
+	return verify(pk, proofDomain, pk.Marshal(), proof)
+}
+
+func verify(pk *PublicKey, domain, msg, sig []byte) bool {
+	// The point at infinity would verify against an empty key set
+	if pk == nil || bls12381.NewG2().IsZero(pk.p) {
+		return false
+	}
+	s, err := unmarshalSignature(sig)
+	if err != nil || bls12381.NewG1().IsZero(s) {
+		return false
+	}
+	// e(sig, g2) == e(H(m), pk)  <=>  e(-sig, g2) * e(H(m), pk) == 1
+	engine := bls12381.NewEngine()
+	engine.AddPairInv(s, engine.G2.One())
+	engine.AddPair(hashToG1(domain, msg), pk.p)
+	return engine.Check()
+}
+
+func unmarshalSignature(sig []byte) (*bls12381.PointG1, error) {
+	if len(sig) != SignatureLength {
+		return nil, errInvalidSignature
+	}
+	p, err := bls12381.NewG1().FromCompressed(sig)
+	if err != nil {
+		return nil, errInvalidSignature
+	}
+	return p, nil
+}
+
+// hashToG1 maps a message onto G1 with the BLS12381G1_XMD:SHA-256_SSWU_RO_
+// hash to curve suite.
+func hashToG1(domain, msg []byte) *bls12381.PointG1 {
+	p, err := bls12381.NewG1().HashToCurve(msg, domain)
+	if err != nil {
+		// Only reachable with a domain tag longer than 255 bytes
+		panic(err)
+	}
+	return p
+}
+
+func hmacSHA256(key []byte, data ...[]byte) []byte {
+	h := hmac.New(sha256.New, key)
+	for _, b := range data {
+		h.Write(b)
+	}
+	return h.Sum(nil)
+}
@@ -0,0 +1,177 @@
+// Copyright 2020 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package bls
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	bls12381 "github.com/kilic/bls12-381"
+)
+
+func testKeys(n int) []*SecretKey {
+	keys := make([]*SecretKey, n)
+	for i := range keys {
+		keys[i] = DeriveSecretKey([]byte(fmt.Sprintf("seed %d", i)))
+	}
+	return keys
+}
+
+func TestSignVerify(t *testing.T) {
+	sk := testKeys(1)[0]
+	msg := []byte("hello")
+
+	sig := sk.Sign(msg)
+	if len(sig) != SignatureLength {
+		t.Fatalf("signature length mismatch: have %d, want %d", len(sig), SignatureLength)
+	}
+	if !Verify(sk.PublicKey(), msg, sig) {
+		t.Errorf("valid signature rejected")
+	}
+	if Verify(sk.PublicKey(), []byte("world"), sig) {
+		t.Errorf("signature accepted for another message")
+	}
+	if Verify(testKeys(2)[1].PublicKey(), msg, sig) {
+		t.Errorf("signature accepted for another key")
+	}
+	if Verify(sk.PublicKey(), msg, sig[:SignatureLength-1]) {
+		t.Errorf("truncated signature accepted")
+	}
+	if Verify(AggregatePublicKeys(nil), msg, make([]byte, SignatureLength)) {
+		t.Errorf("empty aggregate accepted")
+	}
+}
+
+func TestAggregate(t *testing.T) {
+	keys := testKeys(4)
+	msg := []byte("block hash")
+
+	var (
+		sigs [][]byte
+		pks  []*PublicKey
+	)
+	for _, sk := range keys {
+		sigs = append(sigs, sk.Sign(msg))
+		pks = append(pks, sk.PublicKey())
+	}
+	agg, err := AggregateSignatures(sigs)
+	if err != nil {
+		t.Fatalf("failed to aggregate: %v", err)
+	}
+	if !Verify(AggregatePublicKeys(pks), msg, agg) {
+		t.Errorf("valid aggregate signature rejected")
+	}
+	if Verify(AggregatePublicKeys(pks[:3]), msg, agg) {
+		t.Errorf("aggregate signature accepted for a subset of keys")
+	}
+	if _, err := AggregateSignatures(nil); err != errNoSignatures {
+		t.Errorf("error mismatch: have %v, want %v", err, errNoSignatures)
+	}
+}
+
+func TestProofOfPossession(t *testing.T) {
+	keys := testKeys(2)
+	proof := keys[0].ProofOfPossession()
+	if !VerifyProofOfPossession(keys[0].PublicKey(), proof) {
+		t.Errorf("valid proof rejected")
+	}
+	if VerifyProofOfPossession(keys[1].PublicKey(), proof) {
+		t.Errorf("proof accepted for another key")
+	}
+	// A proof must not double as a signature over the public key
+	if Verify(keys[0].PublicKey(), keys[0].PublicKey().Marshal(), proof) {
+		t.Errorf("proof accepted as message signature")
+	}
+}
+
+func TestPublicKeyEncoding(t *testing.T) {
+	pk := testKeys(1)[0].PublicKey()
+	dec, err := UnmarshalPublicKey(pk.Marshal())
+	if err != nil {
+		t.Fatalf("failed to decode public key: %v", err)
+	}
+	if !bytes.Equal(dec.Marshal(), pk.Marshal()) {
+		t.Errorf("public key mismatch after round trip")
+	}
+	if _, err := UnmarshalPublicKey(pk.Marshal()[1:]); err != errInvalidPublicKey {
+		t.Errorf("error mismatch: have %v, want %v", err, errInvalidPublicKey)
+	}
+}
+
+// Test vectors of the BLS12381G1_XMD:SHA-256_SSWU_RO_ suite, from appendix J.9.1
+// of RFC 9380.
+func TestHashToG1(t *testing.T) {
+	domain := []byte("QUUX-V01-CS02-with-BLS12381G1_XMD:SHA-256_SSWU_RO_")
+	tests := []struct {
+		msg  string
+		x, y string
+	}{
+		{
+			msg: "",
+			x:   "052926add2207b76ca4fa57a8734416c8dc95e24501772c814278700eed6d1e4e8cf62d9c09db0fac349612b759e79a1",
+			y:   "08ba738453bfed09cb546dbb0783dbb3a5f1f566ed67bb6be0e8c67e2e81a4cc68ee29813bb7994998f3eae0c9c6a265",
+		},
+		{
+			msg: "abc",
+			x:   "03567bc5ef9c690c2ab2ecdf6a96ef1c139cc0b2f284dca0a9a7943388a49a3aee664ba5379a7655d3c68900be2f6903",
+			y:   "0b9c15f3fe6e5cf4211f346271d7b01c8f3b28be689c8429c85b67af215533311f0b8dfaaa154fa6b88176c229f2885d",
+		},
+	}
+	for _, tt := range tests {
+		have := bls12381.NewG1().ToUncompressed(hashToG1(domain, []byte(tt.msg)))
+		if want := common.FromHex(tt.x + tt.y); !bytes.Equal(have, want) {
+			t.Errorf("msg %q: point mismatch: have %x, want %x", tt.msg, have, want)
+		}
+	}
+}
+
+func TestPublicKeyKnownAnswer(t *testing.T) {
+	// The public key of the secret key one is the standard G2 generator
+	sk := &SecretKey{x: big.NewInt(1)}
+	want := common.FromHex("93e02b6052719f607dacd3a088274f65596bd0d09920b61ab5da61bbdc7f5049334cf11213945d57e5ac7d055d042b7e024aa2b2f08f0a91260805272dc51051c6e47ad4fa403b02b4510b647ae3d1770bac0326a805bbefd48056c8c121bdb8")
+	if have := sk.PublicKey().Marshal(); !bytes.Equal(have, want) {
+		t.Errorf("public key mismatch: have %x, want %x", have, want)
+	}
+}
+
+// TestRogueKey checks that a rogue key, chosen so that the aggregate of the
+// victim's key and its own is a key of the attacker's, can't be registered.
+func TestRogueKey(t *testing.T) {
+	var (
+		g2       = bls12381.NewG2()
+		victim   = testKeys(1)[0]
+		attacker = DeriveSecretKey([]byte("attacker"))
+		msg      = []byte("block hash")
+	)
+	rogue := &PublicKey{p: g2.Sub(g2.New(), attacker.PublicKey().p, victim.PublicKey().p)}
+
+	// Without proofs of possession the attacker forges a signature of both
+	forged := attacker.Sign(msg)
+	if !Verify(AggregatePublicKeys([]*PublicKey{victim.PublicKey(), rogue}), msg, forged) {
+		t.Fatalf("forged aggregate signature rejected, rogue key construction broken")
+	}
+	// But it can't prove possession of the rogue key
+	if VerifyProofOfPossession(rogue, attacker.ProofOfPossession()) {
+		t.Errorf("attacker's proof accepted for rogue key")
+	}
+	if VerifyProofOfPossession(rogue, attacker.sign(proofDomain, rogue.Marshal())) {
+		t.Errorf("attacker's signature over rogue key accepted as proof")
+	}
+}
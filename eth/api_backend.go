@@ -107,7 +107,7 @@ func (b *EthAPIBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*ty
 func (b *EthAPIBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
 	// Pending block is only known by the miner
 	if number == rpc.PendingBlockNumber {
-		if b.eth.protocolManager.raftMode {
+		if b.eth.protocolManager.isRaftMode() {
 			// Use latest instead.
 			return b.eth.blockchain.CurrentBlock(), nil
 		}
@@ -150,7 +150,7 @@ func (b *EthAPIBackend) StateAndHeaderByNumber(ctx context.Context, number rpc.B
 	// Pending state is only known by the miner
 	if number == rpc.PendingBlockNumber {
 		// Quorum
-		if b.eth.protocolManager.raftMode {
+		if b.eth.protocolManager.isRaftMode() {
 			// Use latest instead.
 			header, err := b.HeaderByNumber(ctx, rpc.LatestBlockNumber)
 			if header == nil || err != nil {
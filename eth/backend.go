@@ -294,8 +294,23 @@ func CreateConsensusEngine(ctx *node.ServiceContext, chainConfig *params.ChainCo
 		}
 		config.Istanbul.ProposerPolicy = istanbul.ProposerPolicy(chainConfig.Istanbul.ProposerPolicy)
 		config.Istanbul.Ceil2Nby3Block = chainConfig.Istanbul.Ceil2Nby3Block
+		config.Istanbul.AggregatedSealBlock = chainConfig.Istanbul.AggregatedSealBlock
+		config.Istanbul.CommitRoundBlock = chainConfig.Istanbul.CommitRoundBlock
+		if config.Istanbul.ProposerPolicy == istanbul.Reputation && config.Istanbul.CommitRoundBlock == nil {
+			log.Warn("The reputation proposer policy needs the commit round fork block to learn proposer failures, it behaves as round robin without it")
+		}
+		config.Istanbul.RaftMigrationBlock = chainConfig.Istanbul.RaftMigrationBlock
+		config.Istanbul.Validators = chainConfig.Istanbul.Validators
+		config.Istanbul.BLSKeys = make(map[common.Address]*istanbul.BLSKey)
+		for addr, key := range chainConfig.Istanbul.BLSKeys {
+			config.Istanbul.BLSKeys[addr] = &istanbul.BLSKey{PublicKey: key.PublicKey, Proof: key.Proof}
+		}
 		config.Istanbul.AllowedFutureBlockTime = config.Miner.AllowedFutureBlockTime //Quorum
 
+		// Quorum: the validator key may be held by a signer plugin
+		if config.ConsensusSigner != nil {
+			return istanbulBackend.NewWithSigner(&config.Istanbul, config.ConsensusSigner, db)
+		}
 		return istanbulBackend.New(&config.Istanbul, ctx.NodeKey(), db)
 	}
 
@@ -509,6 +524,19 @@ func (s *Ethereum) StartMining(threads int) error {
 			log.Error("Cannot start mining without etherbase", "err", err)
 			return fmt.Errorf("etherbase missing: %v", err)
 		}
+		// Quorum: the validator key must be reachable before mining, there is
+		// no falling back to the node key
+		if signer := s.config.ConsensusSigner; signer != nil {
+			if _, err := signer.Address(); err != nil {
+				log.Error("Validator key of the signer plugin unavailable", "err", err)
+				return fmt.Errorf("signer plugin unavailable: %v", err)
+			}
+			// the BLS key of aggregated seals can't be held by the signer plugin
+			if _, ok := s.engine.(consensus.Istanbul); ok && s.config.Istanbul.AggregatedSealBlock != nil {
+				log.Error("Aggregated seals can't be signed with the validator key of the signer plugin")
+				return errors.New("aggregated seals are not supported with the signer plugin")
+			}
+		}
 		if clique, ok := s.engine.(*clique.Clique); ok {
 			wallet, err := s.accountManager.Find(accounts.Account{Address: eb})
 			if wallet == nil || err != nil {
@@ -556,6 +584,12 @@ func (s *Ethereum) Downloader() *downloader.Downloader { return s.protocolManage
 func (s *Ethereum) Synced() bool                       { return atomic.LoadUint32(&s.protocolManager.acceptTxs) == 1 }
 func (s *Ethereum) ArchiveMode() bool                  { return s.config.NoPruning }
 
+// Quorum
+//
+// ConsensusSigner returns the signer holding the validator key, nil if the
+// node key is the validator key
+func (s *Ethereum) ConsensusSigner() consensus.Signer { return s.config.ConsensusSigner }
+
 // Protocols implements node.Service, returning all the currently configured
 // network protocols to start.
 func (s *Ethereum) Protocols() []p2p.Protocol {
@@ -594,9 +628,44 @@ func (s *Ethereum) Start(srvr *p2p.Server) error {
 	if s.lesServer != nil {
 		s.lesServer.Start(srvr)
 	}
+	// Quorum
+	if s.protocolManager.isRaftMode() && s.blockchain.Config().Istanbul != nil && s.blockchain.Config().Istanbul.RaftMigrationBlock != nil {
+		go s.watchRaftMigration()
+	}
 	return nil
 }
 
+// Quorum
+//
+// watchRaftMigration hands block production over from raft to Istanbul once the
+// chain reaches the last block minted by raft. From then on, the node runs like
+// any Istanbul node: the Istanbul engine, which already verified the raft blocks,
+// seals the blocks if the node is a validator.
+func (s *Ethereum) watchRaftMigration() {
+	heads := make(chan core.ChainHeadEvent, 16)
+	sub := s.blockchain.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	config := s.blockchain.Config()
+	for head := s.blockchain.CurrentBlock(); !config.IsRaftMigration(new(big.Int).Add(head.Number(), big.NewInt(1))); {
+		select {
+		case ev := <-heads:
+			head = ev.Block
+		case <-sub.Err():
+			return
+		}
+	}
+	log.Info("Raft migration block reached, Istanbul takes over block production", "number", config.Istanbul.RaftMigrationBlock)
+	s.protocolManager.leaveRaftMode()
+
+	// Istanbul sets the coinbase of the blocks it seals itself
+	eb, err := s.Etherbase()
+	if err != nil {
+		log.Debug("Sealing Istanbul blocks without etherbase", "err", err)
+	}
+	go s.miner.Start(eb)
+}
+
 // Stop implements node.Service, terminating all internal goroutines used by the
 // Ethereum protocol.
 func (s *Ethereum) Stop() error {
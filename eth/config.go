@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/consensus/istanbul"
 	"github.com/ethereum/go-ethereum/core"
@@ -144,6 +145,9 @@ type Config struct {
 	EnableNodePermission bool
 	// Istanbul options
 	Istanbul istanbul.Config
+	// Quorum: signs with the validator key held by the signer plugin, nil if
+	// the node key is the validator key
+	ConsensusSigner consensus.Signer `toml:"-"`
 
 	// Miscellaneous options
 	DocRoot string `toml:"-"`
@@ -102,7 +102,8 @@ type ProtocolManager struct {
 	wg sync.WaitGroup
 
 	// Quorum
-	raftMode bool
+	raftMode uint32     // 1 while raft orders the blocks, cleared once Istanbul takes over (atomic)
+	raftMu   sync.Mutex // protects leaving raft mode against stopping
 	engine   consensus.Engine
 }
 
@@ -122,13 +123,16 @@ func NewProtocolManager(config *params.ChainConfig, checkpoint *params.TrustedCh
 		noMorePeers: make(chan struct{}),
 		txsyncCh:    make(chan *txsync),
 		quitSync:    make(chan struct{}),
-		raftMode:    raftMode,
 		engine:      engine,
 	}
+	if raftMode {
+		manager.raftMode = 1
+	}
 
 	// Quorum
 	if handler, ok := manager.engine.(consensus.Handler); ok {
 		handler.SetBroadcaster(manager)
+		handler.SetChain(blockchain)
 	}
 	// /Quorum
 
@@ -270,7 +274,7 @@ func (pm *ProtocolManager) Start(maxPeers int) {
 	go pm.txBroadcastLoop()
 
 	// Quorum
-	if !pm.raftMode {
+	if !pm.isRaftMode() {
 		// broadcast mined blocks
 		pm.minedBlockSub = pm.eventMux.Subscribe(core.NewMinedBlockEvent{})
 		go pm.minedBroadcastLoop()
@@ -287,13 +291,36 @@ func (pm *ProtocolManager) Start(maxPeers int) {
 	go pm.txsyncLoop()
 }
 
+// Quorum
+func (pm *ProtocolManager) isRaftMode() bool {
+	return atomic.LoadUint32(&pm.raftMode) == 1
+}
+
+// leaveRaftMode makes the node handle consensus messages, sync and broadcast
+// mined blocks like any Istanbul node, once Istanbul took over block production
+// from raft.
+func (pm *ProtocolManager) leaveRaftMode() {
+	pm.raftMu.Lock()
+	defer pm.raftMu.Unlock()
+	if !pm.isRaftMode() {
+		return
+	}
+	pm.minedBlockSub = pm.eventMux.Subscribe(core.NewMinedBlockEvent{})
+	go pm.minedBroadcastLoop()
+	atomic.StoreUint32(&pm.raftMode, 0)
+}
+
+// /Quorum
+
 func (pm *ProtocolManager) Stop() {
 	log.Info("Stopping Ethereum protocol")
 
 	pm.txsSub.Unsubscribe() // quits txBroadcastLoop
-	if !pm.raftMode {
+	pm.raftMu.Lock()
+	if pm.minedBlockSub != nil {
 		pm.minedBlockSub.Unsubscribe() // quits blockBroadcastLoop
 	}
+	pm.raftMu.Unlock()
 
 	// Quit the sync loop.
 	// After this send has completed, no new peers will be accepted.
@@ -405,7 +432,7 @@ func (pm *ProtocolManager) handleMsg(p *peer) error {
 	defer msg.Discard()
 
 	// Quorum
-	if pm.raftMode {
+	if pm.isRaftMode() {
 		if msg.Code != TxMsg &&
 			msg.Code != GetBlockHeadersMsg && msg.Code != BlockHeadersMsg &&
 			msg.Code != GetBlockBodiesMsg && msg.Code != BlockBodiesMsg {
@@ -918,7 +945,7 @@ func (pm *ProtocolManager) NodeInfo() *NodeInfo {
 // Quorum
 func (pm *ProtocolManager) getConsensusAlgorithm() string {
 	var consensusAlgo string
-	if pm.raftMode { // raft does not use consensus interface
+	if pm.isRaftMode() { // raft does not use consensus interface
 		consensusAlgo = "raft"
 	} else {
 		switch pm.engine.(type) {
@@ -946,3 +973,15 @@ func (self *ProtocolManager) FindPeers(targets map[common.Address]bool) map[comm
 	}
 	return m
 }
+
+func (self *ProtocolManager) RelayPeers() map[common.Address]consensus.Peer {
+	m := make(map[common.Address]consensus.Peer)
+	for _, p := range self.peers.Peers() {
+		if p.version < consensus.Istanbul100 {
+			continue
+		}
+		pubKey := p.Node().Pubkey()
+		m[crypto.PubkeyToAddress(*pubKey)] = p
+	}
+	return m
+}
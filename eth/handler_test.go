@@ -51,7 +51,7 @@ func TestNodeInfo(t *testing.T) {
 	}{
 		{"ethash", nil, nil, false},
 		{"raft", nil, nil, true},
-		{"istanbul", nil, &params.IstanbulConfig{1, 1, big.NewInt(0)}, false},
+		{"istanbul", nil, &params.IstanbulConfig{Epoch: 1, ProposerPolicy: 1, Ceil2Nby3Block: big.NewInt(0)}, false},
 		{"clique", &params.CliqueConfig{1, 1, 0}, nil, false},
 	}
 
@@ -78,6 +78,26 @@ func TestNodeInfo(t *testing.T) {
 	}
 }
 
+// Tests that a raft node runs as an Istanbul node once Istanbul took over.
+func TestLeaveRaftMode(t *testing.T) {
+	pm, _, err := newTestProtocolManagerConsensus("istanbul", nil, &params.IstanbulConfig{Epoch: 1, ProposerPolicy: 1, Ceil2Nby3Block: big.NewInt(0)}, true)
+	if err != nil {
+		t.Fatalf("failed to create protocol manager: %v", err)
+	}
+	defer pm.Stop()
+
+	if have := pm.getConsensusAlgorithm(); have != "raft" {
+		t.Errorf("consensus mismatch: have %v, want raft", have)
+	}
+	pm.leaveRaftMode()
+	if have := pm.getConsensusAlgorithm(); have != "istanbul" {
+		t.Errorf("consensus mismatch: have %v, want istanbul", have)
+	}
+	if pm.minedBlockSub == nil {
+		t.Errorf("mined blocks should be broadcast")
+	}
+}
+
 // Tests that block headers can be retrieved from a remote chain based on user queries.
 func TestGetBlockHeaders63(t *testing.T) { testGetBlockHeaders(t, 63) }
 func TestGetBlockHeaders64(t *testing.T) { testGetBlockHeaders(t, 64) }
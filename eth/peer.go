@@ -369,7 +369,7 @@ func (p *peer) Handshake(network uint64, td *big.Int, head common.Hash, genesis
 		status63    statusData63 // safe to read after two values have been received from errc
 		status      statusData   // safe to read after two values have been received from errc
 		istanbulOld = protocolName == "istanbul" && p.version == consensus.Istanbul64
-		istanbulNew = protocolName == "istanbul" && p.version == consensus.Istanbul99
+		istanbulNew = protocolName == "istanbul" && (p.version == consensus.Istanbul99 || p.version == consensus.Istanbul100 || p.version == consensus.Istanbul101)
 	)
 	go func() {
 		switch {
@@ -22,7 +22,9 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -149,12 +151,12 @@ func (pm *ProtocolManager) syncer() {
 			if pm.peers.Len() < minDesiredPeerCount {
 				break
 			}
-			if !pm.raftMode {
+			if !pm.isRaftMode() {
 				go pm.synchronise(pm.peers.BestPeer())
 			}
 
 		case <-forceSync.C:
-			if !pm.raftMode {
+			if !pm.isRaftMode() {
 				// Force a sync even if not enough peers are present
 				go pm.synchronise(pm.peers.BestPeer())
 			}
@@ -183,6 +185,17 @@ func (pm *ProtocolManager) synchronise(peer *peer) {
 		core.SetSyncStatus()
 		return
 	}
+	// Quorum
+	// a new node bootstraps the consensus state from the peer if it can, and
+	// falls back to deriving it from every header if it fails
+	if bootstrapper, ok := pm.engine.(consensus.Bootstrapper); ok && !pm.isRaftMode() && currentBlock.NumberU64() == 0 && peer.version >= consensus.Istanbul101 {
+		addr := crypto.PubkeyToAddress(*peer.Node().Pubkey())
+		if err := bootstrapper.Bootstrap(pm.blockchain, addr, peer); err != nil {
+			log.Warn("Failed to bootstrap consensus state", "peer", peer.id, "err", err)
+		}
+	}
+	// /Quorum
+
 	// Otherwise try to sync with the downloader
 	mode := downloader.FullSync
 	if atomic.LoadUint32(&pm.fastSync) == 1 {
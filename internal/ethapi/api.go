@@ -1558,6 +1558,10 @@ func (args *SendTxArgs) setDefaults(ctx context.Context, b Backend) error {
 		if err != nil {
 			return err
 		}
+		// Quorum: skip the nonces reserved by the transactions awaiting approval
+		if reserved, ok := approvalNonce(b, args.From); ok && reserved > nonce {
+			nonce = reserved
+		}
 		args.Nonce = (*hexutil.Uint64)(&nonce)
 	}
 	if args.Data != nil && args.Input != nil && !bytes.Equal(*args.Data, *args.Input) {
@@ -0,0 +1,109 @@
+// Quorum
+package ethapi
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/pluggable"
+	"github.com/ethereum/go-ethereum/common"
+	plugin "github.com/ethereum/go-ethereum/plugin/account"
+)
+
+// interval at which AwaitSigningApproval polls the account plugin
+var approvalPollInterval = time.Second
+
+// PublicSigningApprovalAPI follows the transactions which the account plugin holds until they are
+// approved out of band, and submits them once approved.
+//
+// The nonce of a transaction awaiting approval is reserved, a transaction sent from the same account in
+// the meantime without an explicit nonce takes the following one, see approvalNonce.
+type PublicSigningApprovalAPI struct {
+	b  Backend
+	mu sync.Mutex // a ticket is resolved and its transaction submitted once
+}
+
+func NewPublicSigningApprovalAPI(b Backend) *PublicSigningApprovalAPI {
+	return &PublicSigningApprovalAPI{b: b}
+}
+
+// SigningApprovalResult is the status of the approval of a ticket
+type SigningApprovalResult struct {
+	Status string         `json:"status"`
+	From   common.Address `json:"from"`
+	// hash of the transaction once approved and submitted
+	Hash   *common.Hash `json:"hash,omitempty"`
+	Reason string       `json:"reason,omitempty"`
+}
+
+// GetSigningApproval returns the status of the approval of a ticket returned when sending a transaction,
+// submitting the transaction if it has been approved. The ticket is forgotten once the transaction
+// is submitted or rejected.
+func (s *PublicSigningApprovalAPI) GetSigningApproval(ctx context.Context, ticketID string) (*SigningApprovalResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	b, err := s.pluggableBackend()
+	if err != nil {
+		return nil, err
+	}
+	approval, err := b.Approval(ticketID)
+	if err != nil {
+		return nil, err
+	}
+	result := &SigningApprovalResult{
+		Status: approval.Status.String(),
+		From:   approval.Account.Address,
+		Reason: approval.Reason,
+	}
+	switch approval.Status {
+	case plugin.ApprovalApproved:
+		// the ticket is kept if the submission fails so that it can be retried
+		hash, err := SubmitTransaction(ctx, s.b, approval.Tx)
+		if err != nil {
+			return nil, err
+		}
+		result.Hash = &hash
+		b.ForgetApproval(ticketID)
+	case plugin.ApprovalRejected:
+		b.ForgetApproval(ticketID)
+	}
+	return result, nil
+}
+
+// AwaitSigningApproval waits until the transaction of a ticket is approved or rejected, see GetSigningApproval
+func (s *PublicSigningApprovalAPI) AwaitSigningApproval(ctx context.Context, ticketID string) (*SigningApprovalResult, error) {
+	ticker := time.NewTicker(approvalPollInterval)
+	defer ticker.Stop()
+	for {
+		result, err := s.GetSigningApproval(ctx, ticketID)
+		if err != nil || result.Status != plugin.ApprovalPending.String() {
+			return result, err
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (s *PublicSigningApprovalAPI) pluggableBackend() (*pluggable.Backend, error) {
+	backends := s.b.AccountManager().Backends(pluggable.BackendType)
+	if len(backends) == 0 {
+		return nil, pluggable.ErrUnknownTicket
+	}
+	return backends[0].(*pluggable.Backend), nil
+}
+
+// approvalNonce returns the nonce following the transactions of the account awaiting approval by the
+// account plugin, false if there is none. These are not in the transaction pool until approved.
+func approvalNonce(b Backend, address common.Address) (uint64, bool) {
+	for _, backend := range b.AccountManager().Backends(pluggable.BackendType) {
+		if nonce, ok := backend.(*pluggable.Backend).PendingNonce(address); ok {
+			return nonce, true
+		}
+	}
+	return 0, false
+}
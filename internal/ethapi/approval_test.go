@@ -0,0 +1,124 @@
+package ethapi
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/pluggable"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	plugin "github.com/ethereum/go-ethereum/plugin/account"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubApprovalService holds every transaction for approval and signs it once approved
+type stubApprovalService struct {
+	plugin.Service
+	status plugin.ApprovalStatus
+	sig    []byte
+}
+
+func (s *stubApprovalService) SignTx(_ context.Context, _ accounts.Account, _ *types.Transaction, _ *big.Int, _ []byte, _ string) ([]byte, string, error) {
+	return nil, "ticket", nil
+}
+
+func (s *stubApprovalService) Approval(_ context.Context, _ string) (*plugin.Approval, error) {
+	return &plugin.Approval{Status: s.status, Sig: s.sig}, nil
+}
+
+type approvalStubBackend struct {
+	StubBackend
+	am   *accounts.Manager
+	sent []*types.Transaction
+}
+
+func (b *approvalStubBackend) AccountManager() *accounts.Manager {
+	return b.am
+}
+
+func (b *approvalStubBackend) SendTx(_ context.Context, signedTx *types.Transaction) error {
+	b.sent = append(b.sent, signedTx)
+	return nil
+}
+
+func TestPublicSigningApprovalAPI_GetSigningApproval(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	from := accounts.Account{Address: crypto.PubkeyToAddress(key.PublicKey)}
+	tx := types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+	signer := types.HomesteadSigner{}
+	sig, err := crypto.Sign(signer.Hash(tx).Bytes(), key)
+	require.NoError(t, err)
+
+	service := &stubApprovalService{}
+	pb := pluggable.NewBackend("")
+	require.NoError(t, pb.SetPluginService(service))
+	require.NoError(t, pb.SetApprovalService(service))
+	b := &approvalStubBackend{am: accounts.NewManager(&accounts.Config{}, pb)}
+	testObject := NewPublicSigningApprovalAPI(b)
+
+	_, err = pb.Wallets()[0].SignTx(from, tx, nil)
+	require.Equal(t, &pluggable.PendingApprovalError{TicketID: "ticket"}, err)
+
+	result, err := testObject.GetSigningApproval(context.Background(), "ticket")
+	require.NoError(t, err)
+	assert.Equal(t, &SigningApprovalResult{Status: "pending", From: from.Address}, result)
+	assert.Empty(t, b.sent)
+
+	service.status, service.sig = plugin.ApprovalApproved, sig
+	result, err = testObject.AwaitSigningApproval(context.Background(), "ticket")
+	require.NoError(t, err)
+	require.Len(t, b.sent, 1)
+	hash := b.sent[0].Hash()
+	assert.Equal(t, &SigningApprovalResult{Status: "approved", From: from.Address, Hash: &hash}, result)
+
+	_, err = testObject.GetSigningApproval(context.Background(), "ticket")
+	assert.Equal(t, pluggable.ErrUnknownTicket, err)
+}
+
+func TestPublicSigningApprovalAPI_GetSigningApproval_whenRejected(t *testing.T) {
+	service := &stubApprovalService{status: plugin.ApprovalRejected}
+	pb := pluggable.NewBackend("")
+	require.NoError(t, pb.SetPluginService(service))
+	require.NoError(t, pb.SetApprovalService(service))
+	b := &approvalStubBackend{am: accounts.NewManager(&accounts.Config{}, pb)}
+	testObject := NewPublicSigningApprovalAPI(b)
+	tx := types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+
+	_, err := pb.Wallets()[0].SignTx(accounts.Account{}, tx, nil)
+	require.IsType(t, &pluggable.PendingApprovalError{}, err)
+
+	result, err := testObject.GetSigningApproval(context.Background(), "ticket")
+	require.NoError(t, err)
+	assert.Equal(t, "rejected", result.Status)
+	assert.Empty(t, b.sent)
+
+	_, err = testObject.GetSigningApproval(context.Background(), "ticket")
+	assert.Equal(t, pluggable.ErrUnknownTicket, err)
+}
+
+func TestApprovalNonce(t *testing.T) {
+	service := &stubApprovalService{}
+	pb := pluggable.NewBackend("")
+	require.NoError(t, pb.SetPluginService(service))
+	require.NoError(t, pb.SetApprovalService(service))
+	b := &approvalStubBackend{am: accounts.NewManager(&accounts.Config{}, pb)}
+	from := accounts.Account{Address: common.HexToAddress("0x2")}
+
+	_, ok := approvalNonce(b, from.Address)
+	assert.False(t, ok)
+
+	tx := types.NewTransaction(5, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(1), nil)
+	_, err := pb.Wallets()[0].SignTx(from, tx, nil)
+	require.IsType(t, &pluggable.PendingApprovalError{}, err)
+
+	nonce, ok := approvalNonce(b, from.Address)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(6), nonce)
+	_, ok = approvalNonce(b, common.HexToAddress("0x1"))
+	assert.False(t, ok)
+}
@@ -124,6 +124,11 @@ func GetAPIs(apiBackend Backend) []rpc.API {
 			Version:   "1.0",
 			Service:   NewPublicAccountAPI(apiBackend.AccountManager()),
 			Public:    true,
+		}, {
+			Namespace: "eth",
+			Version:   "1.0",
+			Service:   NewPublicSigningApprovalAPI(apiBackend),
+			Public:    true,
 		}, {
 			Namespace: "personal",
 			Version:   "1.0",
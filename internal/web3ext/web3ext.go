@@ -38,6 +38,7 @@ var Modules = map[string]string{
 	"quorumPermission": QUORUM_NODE_JS,
 	"quorumExtension":  Extension_JS,
 	"plugin_account":   Account_Plugin_Js,
+	"eventstream":      EventStream_Js,
 }
 
 const ChequebookJs = `
@@ -174,6 +175,27 @@ web3._extend({
 			call: 'admin_removeTrustedPeer',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'addPermissionedNode',
+			call: 'admin_addPermissionedNode',
+			params: 2,
+			inputFormatter: [null, null]
+		}),
+		new web3._extend.Method({
+			name: 'removePermissionedNode',
+			call: 'admin_removePermissionedNode',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'addDisallowedNode',
+			call: 'admin_addDisallowedNode',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'removeDisallowedNode',
+			call: 'admin_removeDisallowedNode',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'exportChain',
 			call: 'admin_exportChain',
@@ -220,10 +242,18 @@ web3._extend({
 			name: 'peers',
 			getter: 'admin_peers'
 		}),
+		new web3._extend.Property({
+			name: 'permissionedNodes',
+			getter: 'admin_permissionedNodes'
+		}),
 		new web3._extend.Property({
 			name: 'datadir',
 			getter: 'admin_datadir'
 		}),
+		new web3._extend.Property({
+			name: 'plugins',
+			getter: 'admin_plugins'
+		}),
 	]
 });
 `
@@ -580,6 +610,16 @@ web3._extend({
 			params: 1,
 			inputFormatter: [null]
 		}),
+		new web3._extend.Method({
+			name: 'getSigningApproval',
+			call: 'eth_getSigningApproval',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'awaitSigningApproval',
+			call: 'eth_awaitSigningApproval',
+			params: 1
+		}),
 		// END-QUORUM
 	],
 	properties: [
@@ -960,6 +1000,101 @@ web3._extend({
                        params: 1,
                        inputFormatter: [null]
                }),
+               new web3._extend.Method({
+                       name: 'addContractAccess',
+                       call: 'quorumPermission_addContractAccess',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'removeContractAccess',
+                       call: 'quorumPermission_removeContractAccess',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'setValidity',
+                       call: 'quorumPermission_setValidity',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'removeValidity',
+                       call: 'quorumPermission_removeValidity',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'addOrgCA',
+                       call: 'quorumPermission_addOrgCA',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'removeOrgCA',
+                       call: 'quorumPermission_removeOrgCA',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'upcomingExpiries',
+                       call: 'quorumPermission_upcomingExpiries',
+                       params: 2
+               }),
+               new web3._extend.Method({
+                       name: 'setApprovalPolicy',
+                       call: 'quorumPermission_setApprovalPolicy',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'removeApprovalPolicy',
+                       call: 'quorumPermission_removeApprovalPolicy',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'planReconcile',
+                       call: 'quorumPermission_planReconcile',
+                       params: 1,
+                       inputFormatter: [null]
+               }),
+               new web3._extend.Method({
+                       name: 'reconcile',
+                       call: 'quorumPermission_reconcile',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'migrate',
+                       call: 'quorumPermission_migrate',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'scheduleMigration',
+                       call: 'quorumPermission_scheduleMigration',
+                       params: 2,
+                       inputFormatter: [null, web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'cancelMigration',
+                       call: 'quorumPermission_cancelMigration',
+                       params: 1,
+                       inputFormatter: [web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'getHistory',
+                       call: 'quorumPermission_getHistory',
+                       params: 1,
+                       inputFormatter: [null]
+               }),
+               new web3._extend.Method({
+                       name: 'exportHistory',
+                       call: 'quorumPermission_exportHistory',
+                       params: 2,
+                       inputFormatter: [null, null]
+               }),
                new web3._extend.Method({
                        name: 'transactionAllowed',
                        call: 'quorumPermission_transactionAllowed',
@@ -972,6 +1107,18 @@ web3._extend({
                        params: 4,
                        inputFormatter: [null, null, null, null]
                }),
+               new web3._extend.Method({
+                       name: 'explainTransaction',
+                       call: 'quorumPermission_explainTransaction',
+                       params: 1,
+                       inputFormatter: [web3._extend.formatters.inputTransactionFormatter]
+               }),
+               new web3._extend.Method({
+                       name: 'explainConnection',
+                       call: 'quorumPermission_explainConnection',
+                       params: 4,
+                       inputFormatter: [null, null, null, null]
+               }),
 
        ],
        properties:
@@ -992,6 +1139,34 @@ web3._extend({
 					   name: 'acctList',
 				       getter: 'quorumPermission_acctList'
 			  }), 
+              new web3._extend.Property({
+					   name: 'contractAccessList',
+				       getter: 'quorumPermission_contractAccessList'
+			  }),
+              new web3._extend.Property({
+					   name: 'validityList',
+				       getter: 'quorumPermission_validityList'
+			  }),
+              new web3._extend.Property({
+					   name: 'orgCAList',
+				       getter: 'quorumPermission_orgCAList'
+			  }),
+              new web3._extend.Property({
+					   name: 'rpcAccess',
+				       getter: 'quorumPermission_rpcAccess'
+			  }),
+              new web3._extend.Property({
+					   name: 'pendingOps',
+				       getter: 'quorumPermission_pendingOps'
+			  }),
+              new web3._extend.Property({
+					   name: 'approvalPolicies',
+				       getter: 'quorumPermission_approvalPolicies'
+			  }),
+              new web3._extend.Property({
+					   name: 'migrationStatus',
+				       getter: 'quorumPermission_migrationStatus'
+			  }),
        ]
 })
 `
@@ -1026,7 +1201,8 @@ web3._extend({
 		new web3._extend.Method({
 			name: 'propose',
 			call: 'istanbul_propose',
-			params: 2
+			params: 3,
+			inputFormatter: [null, null, null]
 		}),
 		new web3._extend.Method({
 			name: 'discard',
@@ -1045,6 +1221,12 @@ web3._extend({
 			call: 'istanbul_getSignersFromBlockByHash',
 			params: 1
 		}),
+		new web3._extend.Method({
+			name: 'getValidatorTransitions',
+			call: 'istanbul_getValidatorTransitions',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
 	],
 	properties:
 	[
@@ -1056,6 +1238,10 @@ web3._extend({
 			name: 'nodeAddress',
 			getter: 'istanbul_nodeAddress'
 		}),
+		new web3._extend.Property({
+			name: 'nodeBLSKey',
+			getter: 'istanbul_nodeBLSKey'
+		}),
 	]
 });
 `
@@ -1188,3 +1374,25 @@ web3._extend({
 	]
 });
 `
+
+const EventStream_Js = `
+web3._extend({
+	property: 'eventstream',
+	methods:
+	[
+		new web3._extend.Method({
+			name: 'replay',
+			call: 'eventstream_replay',
+			params: 1,
+			inputFormatter: [web3._extend.utils.fromDecimal]
+		})
+	],
+	properties:
+	[
+		new web3._extend.Property({
+			name: 'status',
+			getter: 'eventstream_status'
+		})
+	]
+});
+`
@@ -848,6 +848,15 @@ func (w *worker) commitTransactions(txs *types.TransactionsByPriceAndNonce, coin
 			txs.Pop()
 			continue
 		}
+		// Quorum - apply the custom rules of the transaction validator plugin
+		// to the block being produced
+		if core.TransactionValidationFunc != nil {
+			if err := core.TransactionValidationFunc(tx, from, w.current.header.Number.Uint64()); err != nil {
+				log.Debug("Transaction denied by the validator plugin, account skipped", "hash", tx.Hash(), "err", err)
+				txs.Pop()
+				continue
+			}
+		}
 		// Start executing the transaction
 		w.current.state.Prepare(tx.Hash(), common.Hash{}, w.current.tcount)
 		w.current.privateState.Prepare(tx.Hash(), common.Hash{}, w.current.tcount)
@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/permission/core"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -104,6 +105,85 @@ func (api *PrivateAdminAPI) RemoveTrustedPeer(url string) (bool, error) {
 	return true, nil
 }
 
+// allowList returns the node allow list of the running, permissioned server
+func (api *PrivateAdminAPI) allowList() (*p2p.Server, *core.AllowList, error) {
+	server := api.node.Server()
+	if server == nil {
+		return nil, nil, ErrNodeStopped
+	}
+	if !server.EnableNodePermission {
+		return nil, nil, ErrNodeNotPermissioned
+	}
+	return server, core.NodeAllowList(server.DataDir), nil
+}
+
+// PermissionedNodes returns the nodes of permissioned-nodes.json and
+// disallowed-nodes.json
+func (api *PrivateAdminAPI) PermissionedNodes() (*core.AllowListInfo, error) {
+	_, allowList, err := api.allowList()
+	if err != nil {
+		return nil, err
+	}
+	info := allowList.List()
+	return &info, nil
+}
+
+// AddPermissionedNode adds a node to permissioned-nodes.json, optionally
+// restricting the networks it may connect from
+func (api *PrivateAdminAPI) AddPermissionedNode(url string, cidrs *[]string) (bool, error) {
+	_, allowList, err := api.allowList()
+	if err != nil {
+		return false, err
+	}
+	var networks []string
+	if cidrs != nil {
+		networks = *cidrs
+	}
+	if err := allowList.AddNode(url, networks); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// RemovePermissionedNode removes a node from permissioned-nodes.json and
+// disconnects it
+func (api *PrivateAdminAPI) RemovePermissionedNode(url string) (bool, error) {
+	server, allowList, err := api.allowList()
+	if err != nil {
+		return false, err
+	}
+	if err := allowList.RemoveNode(url); err != nil {
+		return false, err
+	}
+	server.DropUnpermissionedPeers()
+	return true, nil
+}
+
+// AddDisallowedNode adds a node to disallowed-nodes.json and disconnects it
+func (api *PrivateAdminAPI) AddDisallowedNode(url string) (bool, error) {
+	server, allowList, err := api.allowList()
+	if err != nil {
+		return false, err
+	}
+	if err := allowList.AddDisallowedNode(url); err != nil {
+		return false, err
+	}
+	server.DropUnpermissionedPeers()
+	return true, nil
+}
+
+// RemoveDisallowedNode removes a node from disallowed-nodes.json
+func (api *PrivateAdminAPI) RemoveDisallowedNode(url string) (bool, error) {
+	_, allowList, err := api.allowList()
+	if err != nil {
+		return false, err
+	}
+	if err := allowList.RemoveDisallowedNode(url); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // PeerEvents creates an RPC subscription which receives peer events from the
 // node's p2p.Server
 func (api *PrivateAdminAPI) PeerEvents(ctx context.Context) (*rpc.Subscription, error) {
@@ -48,6 +48,8 @@ const (
 	datadirStaticNodes     = "static-nodes.json"  // Path within the datadir to the static node list
 	datadirTrustedNodes    = "trusted-nodes.json" // Path within the datadir to the trusted node list
 	datadirNodeDatabase    = "nodes"              // Path within the datadir to store the node infos
+
+	datadirPluginApprovals = "plugin-approvals.json" // Quorum: path within the datadir to the transactions awaiting approval by the account plugin
 )
 
 // Config represents a small collection of configuration values to fine tune the
@@ -564,7 +566,7 @@ func makeAccountManager(conf *Config) (*accounts.Manager, string, error) {
 		}
 		if conf.Plugins != nil {
 			if _, ok := conf.Plugins.Providers[plugin.AccountPluginInterfaceName]; ok {
-				pluginBackend := pluggable.NewBackend()
+				pluginBackend := pluggable.NewBackend(conf.ResolvePath(datadirPluginApprovals))
 				backends = append(backends, pluginBackend)
 			}
 		}
@@ -29,6 +29,8 @@ var (
 	ErrNodeRunning    = errors.New("node already running")
 	ErrServiceUnknown = errors.New("unknown service")
 
+	ErrNodeNotPermissioned = errors.New("node permissioning is not enabled")
+
 	datadirInUseErrnos = map[uint]bool{11: true, 32: true, 35: true}
 )
 
@@ -75,6 +75,12 @@ type Node struct {
 
 	pluginManager *plugin.PluginManager // Manage all plugins for this node. If plugin is not enabled, an EmptyPluginManager is set.
 
+	// Quorum: security supports of the security plugin shared by the HTTP and WS endpoints, resolved once
+	// so that both endpoints follow the same TLS configuration reloads and share the token cache
+	securitySupportsResolved bool
+	tlsConfigSource          security.TLSConfigurationSource
+	authManager              security.AuthenticationManager
+
 	stop chan struct{} // Channel to wait for termination notifications
 	lock sync.RWMutex
 
@@ -393,6 +399,15 @@ func (n *Node) wsScheme() string {
 }
 
 func (n *Node) getSecuritySupports() (tlsConfigSource security.TLSConfigurationSource, authManager security.AuthenticationManager, err error) {
+	if n.securitySupportsResolved {
+		return n.tlsConfigSource, n.authManager, nil
+	}
+	defer func() {
+		if err == nil {
+			n.securitySupportsResolved = true
+			n.tlsConfigSource, n.authManager = tlsConfigSource, authManager
+		}
+	}()
 	if n.pluginManager.IsEnabled(plugin.SecurityPluginInterfaceName) {
 		sp := new(plugin.SecurityPluginTemplate)
 		if err = n.pluginManager.GetPluginTemplate(plugin.SecurityPluginInterfaceName, sp); err != nil {
@@ -410,6 +425,16 @@ func (n *Node) getSecuritySupports() (tlsConfigSource security.TLSConfigurationS
 	return
 }
 
+// releaseSecuritySupports stops reloading the TLS configuration, the security supports are resolved again
+// when the endpoints are started next
+func (n *Node) releaseSecuritySupports() {
+	if reloader, ok := n.tlsConfigSource.(*security.TLSConfigurationReloader); ok {
+		reloader.Stop()
+	}
+	n.securitySupportsResolved = false
+	n.tlsConfigSource, n.authManager = nil, nil
+}
+
 // startHTTP initializes and starts the HTTP RPC endpoint.
 func (n *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts) error {
 	// Short circuit if the HTTP endpoint isn't being exposed
@@ -501,6 +526,7 @@ func (n *Node) Stop() error {
 	n.stopWS()
 	n.stopHTTP()
 	n.stopIPC()
+	n.releaseSecuritySupports()
 	n.rpcAPIs = nil
 	failure := &StopError{
 		Services: make(map[reflect.Type]error),
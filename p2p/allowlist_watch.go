@@ -0,0 +1,35 @@
+// +build darwin,!ios,cgo freebsd linux,!arm64 netbsd solaris
+
+package p2p
+
+import (
+	"github.com/rjeczalik/notify"
+)
+
+// watchDataDir signals the changes to the files of the data directory on the
+// changed channel, dropping the signals which find it full, until stop is
+// called.
+func watchDataDir(dataDir string, changed chan<- struct{}) (stop func(), err error) {
+	ev := make(chan notify.EventInfo, 10)
+	if err := notify.Watch(dataDir, ev, notify.All); err != nil {
+		return nil, err
+	}
+	quit := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ev:
+				select {
+				case changed <- struct{}{}:
+				default:
+				}
+			case <-quit:
+				return
+			}
+		}
+	}()
+	return func() {
+		notify.Stop(ev)
+		close(quit)
+	}, nil
+}
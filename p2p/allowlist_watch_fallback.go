@@ -0,0 +1,13 @@
+// +build darwin,!cgo ios linux,arm64 windows !darwin,!freebsd,!linux,!netbsd,!solaris
+
+// This is the fallback implementation of data directory watching, used on the
+// platforms the notify library is not used on. The allow list is only
+// refreshed periodically there.
+
+package p2p
+
+import "errors"
+
+func watchDataDir(string, chan<- struct{}) (func(), error) {
+	return nil, errors.New("file system notifications are not supported on this platform")
+}
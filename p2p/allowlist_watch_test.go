@@ -0,0 +1,37 @@
+// +build darwin,!ios,cgo freebsd linux,!arm64 netbsd solaris
+
+package p2p
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestWatchDataDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "p2p-allowlist")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	changed := make(chan struct{}, 1)
+	stop, err := watchDataDir(dir, changed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stop()
+
+	if err := ioutil.WriteFile(filepath.Join(dir, params.PERMISSIONED_CONFIG), []byte("[]"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no change signalled for the allow list file")
+	}
+}
@@ -65,9 +65,36 @@ type protoHandshake struct {
 	ID         []byte // secp256k1 public key
 
 	// Ignore additional fields (for forward compatibility).
+	// Quorum: the first one is the certificate chain of the node, leaf first.
 	Rest []rlp.RawValue `rlp:"tail"`
 }
 
+// setCertificates presents the certificate chain in the handshake
+func (h *protoHandshake) setCertificates(certs [][]byte) error {
+	if len(certs) == 0 {
+		h.Rest = nil
+		return nil
+	}
+	enc, err := rlp.EncodeToBytes(certs)
+	if err != nil {
+		return err
+	}
+	h.Rest = []rlp.RawValue{enc}
+	return nil
+}
+
+// certificates returns the certificate chain presented in the handshake
+func (h *protoHandshake) certificates() [][]byte {
+	if len(h.Rest) == 0 {
+		return nil
+	}
+	var certs [][]byte
+	if err := rlp.DecodeBytes(h.Rest[0], &certs); err != nil {
+		return nil
+	}
+	return certs
+}
+
 // PeerEventType is the type of peer events emitted by a p2p.Server
 type PeerEventType string
 
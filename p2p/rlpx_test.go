@@ -264,6 +264,37 @@ func TestProtocolHandshakeErrors(t *testing.T) {
 	}
 }
 
+// Quorum
+func TestProtocolHandshakeCertificates(t *testing.T) {
+	prv, _ := crypto.GenerateKey()
+	pub := crypto.FromECDSAPub(&prv.PublicKey)[1:]
+	certs := [][]byte{{0x30, 0x01}, {0x30, 0x02}}
+	our := &protoHandshake{Version: 5, Name: "quorum", ID: pub}
+	if err := our.setCertificates(certs); err != nil {
+		t.Fatal(err)
+	}
+
+	p1, p2 := MsgPipe()
+	go Send(p1, handshakeMsg, our)
+	their, err := readProtocolHandshake(p2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(their.certificates(), certs) {
+		t.Errorf("certificates mismatch: got %x, want %x", their.certificates(), certs)
+	}
+
+	// peers which present no certificate, or something else, have none
+	their.Rest = nil
+	if c := their.certificates(); c != nil {
+		t.Errorf("expected no certificates, got %x", c)
+	}
+	their.Rest = []rlp.RawValue{{0x01}}
+	if c := their.certificates(); c != nil {
+		t.Errorf("expected no certificates, got %x", c)
+	}
+}
+
 func TestRLPXFrameFake(t *testing.T) {
 	buf := new(bytes.Buffer)
 	hash := fakeHash([]byte{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1})
@@ -24,6 +24,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path/filepath"
 	"sort"
 	"sync"
 	"sync/atomic"
@@ -40,6 +41,7 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/ethereum/go-ethereum/p2p/nat"
 	"github.com/ethereum/go-ethereum/p2p/netutil"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/permission/core"
 )
 
@@ -65,6 +67,10 @@ const (
 
 	// Maximum amount of time allowed for writing a complete message.
 	frameWriteTimeout = 20 * time.Second
+
+	// Default interval at which the node allow list files are checked for
+	// changes besides the file system notifications.
+	DefaultAllowListRefreshInterval = 30 * time.Second
 )
 
 var errServerStopped = errors.New("server stopped")
@@ -157,6 +163,12 @@ type Config struct {
 
 	EnableNodePermission bool `toml:",omitempty"`
 
+	// AllowListRefreshInterval is the interval at which the node allow list
+	// files are checked for changes. Changes are picked up right away on
+	// platforms with file system notifications, elsewhere only on refresh.
+	// Zero means DefaultAllowListRefreshInterval.
+	AllowListRefreshInterval time.Duration `toml:",omitempty"`
+
 	DataDir string `toml:",omitempty"`
 	// Logger is a custom logger to use with the p2p.Server.
 	Logger log.Logger `toml:",omitempty"`
@@ -211,6 +223,8 @@ type Server struct {
 
 	// permissions - check if node is permissioned
 	isNodePermissionedFunc func(node *enode.Node, nodename string, currentNode string, datadir string, direction string) bool
+	// permissions - check the certificate chain a node presented in the protocol handshake
+	nodeCertificateFunc func(node *enode.Node, certs [][]byte) bool
 }
 
 type peerOpFunc func(map[enode.ID]*Peer)
@@ -240,6 +254,7 @@ type conn struct {
 	cont  chan error // The run loop uses cont to signal errors to SetupConn.
 	caps  []Cap      // valid after the protocol handshake
 	name  string     // valid after the protocol handshake
+	certs [][]byte   // valid after the protocol handshake
 }
 
 type transport interface {
@@ -492,6 +507,10 @@ func (srv *Server) Start() (err error) {
 	dialer := newDialState(srv.localnode.ID(), dynPeers, &srv.Config)
 	srv.loopWG.Add(1)
 	go srv.run(dialer)
+	if srv.EnableNodePermission {
+		srv.loopWG.Add(1)
+		go srv.watchAllowList()
+	}
 	return nil
 }
 
@@ -503,6 +522,17 @@ func (srv *Server) setupLocalNode() error {
 		srv.ourHandshake.Caps = append(srv.ourHandshake.Caps, p.cap())
 	}
 	sort.Sort(capsByNameAndVersion(srv.ourHandshake.Caps))
+	// Quorum
+	// present the certificate binding the node to its org, if there is one
+	if srv.EnableNodePermission && srv.DataDir != "" {
+		certs, err := core.ReadNodeCertificate(filepath.Join(srv.DataDir, params.NODE_CERT_FILE))
+		if err != nil {
+			return fmt.Errorf("failed to read node certificate: %v", err)
+		}
+		if err := srv.ourHandshake.setCertificates(certs); err != nil {
+			return err
+		}
+	}
 
 	// Create the local node.
 	db, err := enode.OpenDB(srv.Config.NodeDatabase)
@@ -1019,11 +1049,7 @@ func (srv *Server) setupConn(c *conn, flags connFlag, dialDest *enode.Node) erro
 			log.Trace("Node Permissioning", "Connection Direction", direction)
 		}
 
-		if srv.isNodePermissionedFunc == nil {
-			if !core.IsNodePermissioned(nodeId, currentNode, srv.DataDir, direction) {
-				return newPeerError(errPermissionDenied, "id=%s…%s %s id=%s…%s", currentNode[:4], currentNode[len(currentNode)-4:], direction, nodeId[:4], nodeId[len(nodeId)-4:])
-			}
-		} else if !srv.isNodePermissionedFunc(node, nodeId, currentNode, srv.DataDir, direction) {
+		if !srv.isNodePermissioned(node, currentNode, direction) {
 			return newPeerError(errPermissionDenied, "id=%s…%s %s id=%s…%s", currentNode[:4], currentNode[len(currentNode)-4:], direction, nodeId[:4], nodeId[len(nodeId)-4:])
 		}
 	} else {
@@ -1052,7 +1078,19 @@ func (srv *Server) setupConn(c *conn, flags connFlag, dialDest *enode.Node) erro
 		clog.Trace("Wrong devp2p handshake identity", "phsid", hex.EncodeToString(phs.ID))
 		return DiscUnexpectedIdentity
 	}
-	c.caps, c.name = phs.Caps, phs.Name
+	c.caps, c.name, c.certs = phs.Caps, phs.Name, phs.certificates()
+	// Quorum
+	if srv.EnableNodePermission && srv.nodeCertificateFunc != nil {
+		node := c.node
+		if dialDest != nil {
+			node = dialDest
+		}
+		if !srv.nodeCertificateFunc(node, c.certs) {
+			nodeId := node.ID().String()
+			clog.Trace("Node certificate rejected")
+			return newPeerError(errPermissionDenied, "id=%s…%s certificate id=%s…%s", currentNode[:4], currentNode[len(currentNode)-4:], nodeId[:4], nodeId[len(nodeId)-4:])
+		}
+	}
 	err = srv.checkpoint(c, srv.checkpointAddPeer)
 	if err != nil {
 		clog.Trace("Rejected peer", "err", err)
@@ -1194,6 +1232,83 @@ func (srv *Server) SetCheckPeerInRaft(f func(*enode.Node) bool) {
 	srv.checkPeerInRaft = f
 }
 
+// checks if the node is permissioned to connect, using the permission model
+// hook if one is set and the node allow list of the data directory otherwise
+func (srv *Server) isNodePermissioned(node *enode.Node, currentNode string, direction string) bool {
+	nodeId := node.ID().String()
+	if srv.isNodePermissionedFunc == nil {
+		return core.IsNodePermissioned(node, nodeId, currentNode, srv.DataDir, direction)
+	}
+	return srv.isNodePermissionedFunc(node, nodeId, currentNode, srv.DataDir, direction)
+}
+
+// watchAllowList reloads the node allow list when its files change and drops
+// the peers which are no longer permissioned. File system events trigger the
+// reload right away, the periodic refresh covers platforms and file systems
+// without them and events lost while a file was being written.
+func (srv *Server) watchAllowList() {
+	defer srv.loopWG.Done()
+	allowList := core.NodeAllowList(srv.DataDir)
+	interval := srv.AllowListRefreshInterval
+	if interval <= 0 {
+		interval = DefaultAllowListRefreshInterval
+	}
+	events := make(chan struct{}, 1)
+	if stop, err := watchDataDir(srv.DataDir, events); err != nil {
+		srv.log.Warn("Polling the node allow list for changes", "interval", interval, "err", err)
+	} else {
+		defer stop()
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-events:
+		case <-ticker.C:
+		case <-srv.quit:
+			return
+		}
+		changed, err := allowList.Refresh()
+		if err != nil {
+			srv.log.Error("Invalid node allow list, keeping the previous one", "err", err)
+		} else if changed {
+			srv.DropUnpermissionedPeers()
+		}
+	}
+}
+
+// DropUnpermissionedPeers disconnects the peers which are no longer
+// permissioned to connect
+func (srv *Server) DropUnpermissionedPeers() {
+	if !srv.EnableNodePermission {
+		return
+	}
+	currentNode := srv.NodeInfo().ID
+	for _, p := range srv.Peers() {
+		direction := "OUTGOING"
+		if p.Inbound() {
+			direction = "INCOMING"
+		}
+		permissioned := srv.isNodePermissioned(p.Node(), currentNode, direction)
+		if permissioned && srv.nodeCertificateFunc != nil {
+			permissioned = srv.nodeCertificateFunc(p.Node(), p.rw.certs)
+		}
+		if !permissioned {
+			srv.log.Info("Dropping peer which is no longer permissioned", "id", p.ID())
+			p.Disconnect(DiscUselessPeer)
+		}
+	}
+}
+
+// SetNodeCertificateCheck sets the check of the certificate chains nodes
+// present in the protocol handshake. It only runs for nodes the permission
+// model allows, which must also pass it to be admitted.
+func (srv *Server) SetNodeCertificateCheck(f func(node *enode.Node, certs [][]byte) bool) {
+	if srv.nodeCertificateFunc == nil {
+		srv.nodeCertificateFunc = f
+	}
+}
+
 func (srv *Server) SetIsNodePermissioned(f func(*enode.Node, string, string, string, string) bool) {
 	if srv.isNodePermissionedFunc == nil {
 		srv.isNodePermissionedFunc = f
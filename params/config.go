@@ -17,12 +17,14 @@
 package params
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
@@ -345,8 +347,22 @@ func (c *CliqueConfig) String() string {
 // IstanbulConfig is the consensus engine configs for Istanbul based sealing.
 type IstanbulConfig struct {
 	Epoch          uint64   `json:"epoch"`                    // Epoch length to reset votes and checkpoint
-	ProposerPolicy uint64   `json:"policy"`                   // The policy for proposer selection
+	ProposerPolicy uint64   `json:"policy"`                   // The policy for proposer selection (0: round robin, 1: sticky, 2: random, 3: reputation)
 	Ceil2Nby3Block *big.Int `json:"ceil2Nby3Block,omitempty"` // Number of confirmations required to move from one state to next [2F + 1 to Ceil(2N/3)]
+
+	AggregatedSealBlock *big.Int                           `json:"aggregatedSealBlock,omitempty"` // Block from which committed seals are aggregated into a single BLS signature
+	CommitRoundBlock    *big.Int                           `json:"commitRoundBlock,omitempty"`    // Block from which headers record the round they were committed in, required by the reputation policy
+	BLSKeys             map[common.Address]*IstanbulBLSKey `json:"blsKeys,omitempty"`             // BLS keys of the validators at the aggregated seal fork, validators voted in later register theirs with the votes
+
+	RaftMigrationBlock *big.Int         `json:"raftMigrationBlock,omitempty"` // Block from which Istanbul takes over block production from raft
+	Validators         []common.Address `json:"validators,omitempty"`         // Initial validators at the raft migration block
+}
+
+// IstanbulBLSKey is the BLS public key a validator signs aggregated committed
+// seals with, along with the proof that it holds the matching secret key.
+type IstanbulBLSKey struct {
+	PublicKey hexutil.Bytes `json:"publicKey"`
+	Proof     hexutil.Bytes `json:"proof"`
 }
 
 // String implements the stringer interface, returning the consensus engine details.
@@ -574,6 +590,20 @@ func (c *ChainConfig) IsPrivacyEnhancementsEnabled(num *big.Int) bool {
 	return isForked(c.PrivacyEnhancementsBlock, num)
 }
 
+// IsRaftMigration returns whether num represents a block number from which Istanbul
+// produces the blocks of a network that started on raft
+func (c *ChainConfig) IsRaftMigration(num *big.Int) bool {
+	return c.Istanbul != nil && isForked(c.Istanbul.RaftMigrationBlock, num)
+}
+
+// raftMigrationBlock returns the block Istanbul takes over from raft, if any
+func (c *ChainConfig) raftMigrationBlock() *big.Int {
+	if c.Istanbul == nil {
+		return nil
+	}
+	return c.Istanbul.RaftMigrationBlock
+}
+
 // /Quorum
 
 // CheckCompatible checks whether scheduled fork transitions have been imported
@@ -684,6 +714,18 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, head *big.Int, isQuor
 	if c.Istanbul != nil && newcfg.Istanbul != nil && isForkIncompatible(c.Istanbul.Ceil2Nby3Block, newcfg.Istanbul.Ceil2Nby3Block, head) {
 		return newCompatError("Ceil 2N/3 fork block", c.Istanbul.Ceil2Nby3Block, newcfg.Istanbul.Ceil2Nby3Block)
 	}
+	if c.Istanbul != nil && newcfg.Istanbul != nil && isForkIncompatible(c.Istanbul.AggregatedSealBlock, newcfg.Istanbul.AggregatedSealBlock, head) {
+		return newCompatError("aggregated seal fork block", c.Istanbul.AggregatedSealBlock, newcfg.Istanbul.AggregatedSealBlock)
+	}
+	if c.Istanbul != nil && newcfg.Istanbul != nil && isForked(c.Istanbul.AggregatedSealBlock, head) && !sameBLSKeys(c.Istanbul.BLSKeys, newcfg.Istanbul.BLSKeys) {
+		return newCompatError("aggregated seal BLS keys", c.Istanbul.AggregatedSealBlock, c.Istanbul.AggregatedSealBlock)
+	}
+	if c.Istanbul != nil && newcfg.Istanbul != nil && isForkIncompatible(c.Istanbul.CommitRoundBlock, newcfg.Istanbul.CommitRoundBlock, head) {
+		return newCompatError("commit round fork block", c.Istanbul.CommitRoundBlock, newcfg.Istanbul.CommitRoundBlock)
+	}
+	if isForkIncompatible(c.raftMigrationBlock(), newcfg.raftMigrationBlock(), head) {
+		return newCompatError("raft migration fork block", c.raftMigrationBlock(), newcfg.raftMigrationBlock())
+	}
 	if isForkIncompatible(c.QIP714Block, newcfg.QIP714Block, head) {
 		return newCompatError("permissions fork block", c.QIP714Block, newcfg.QIP714Block)
 	}
@@ -702,6 +744,24 @@ func isForkIncompatible(s1, s2, head *big.Int) bool {
 	return (isForked(s1, head) || isForked(s2, head)) && !configNumEqual(s1, s2)
 }
 
+// sameBLSKeys returns whether both configs register the same BLS keys. The keys
+// verify every aggregated seal, so they can't change once the fork is active.
+func sameBLSKeys(x, y map[common.Address]*IstanbulBLSKey) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for addr, key := range x {
+		other, ok := y[addr]
+		if !ok || (key == nil) != (other == nil) {
+			return false
+		}
+		if key != nil && (!bytes.Equal(key.PublicKey, other.PublicKey) || !bytes.Equal(key.Proof, other.Proof)) {
+			return false
+		}
+	}
+	return true
+}
+
 // isForked returns whether a fork scheduled at block s is active at the given head block.
 func isForked(s, head *big.Int) bool {
 	if s == nil || head == nil {
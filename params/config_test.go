@@ -20,6 +20,8 @@ import (
 	"math/big"
 	"reflect"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
 )
 
 // Quorum - test code size and transaction size limit in chain config
@@ -116,6 +118,14 @@ func TestCheckCompatible(t *testing.T) {
 	passedValidMaxConfig1 = append(passedValidMaxConfig1, rec1)
 	passedValidMaxConfig1 = append(passedValidMaxConfig1, rec3)
 
+	blsKeys1 := map[common.Address]*IstanbulBLSKey{
+		common.HexToAddress("0x1"): {PublicKey: []byte{1}, Proof: []byte{2}},
+	}
+	blsKeys2 := map[common.Address]*IstanbulBLSKey{
+		common.HexToAddress("0x1"): {PublicKey: []byte{1}, Proof: []byte{2}},
+		common.HexToAddress("0x2"): {PublicKey: []byte{3}, Proof: []byte{4}},
+	}
+
 	tests := []test{
 		{stored: AllEthashProtocolChanges, new: AllEthashProtocolChanges, head: 0, wantErr: nil},
 		{stored: AllEthashProtocolChanges, new: AllEthashProtocolChanges, head: 100, wantErr: nil},
@@ -175,6 +185,23 @@ func TestCheckCompatible(t *testing.T) {
 				RewindTo:     9,
 			},
 		},
+		{
+			stored:  &ChainConfig{IsQuorum: true},
+			new:     &ChainConfig{IsQuorum: true, Istanbul: &IstanbulConfig{RaftMigrationBlock: big.NewInt(20)}},
+			head:    10,
+			wantErr: nil,
+		},
+		{
+			stored: &ChainConfig{IsQuorum: true},
+			new:    &ChainConfig{IsQuorum: true, Istanbul: &IstanbulConfig{RaftMigrationBlock: big.NewInt(20)}},
+			head:   30,
+			wantErr: &ConfigCompatError{
+				What:         "raft migration fork block",
+				StoredConfig: nil,
+				NewConfig:    big.NewInt(20),
+				RewindTo:     19,
+			},
+		},
 		{
 			stored: &ChainConfig{MaxCodeSizeChangeBlock: big.NewInt(10)},
 			new:    &ChainConfig{MaxCodeSizeChangeBlock: big.NewInt(20)},
@@ -278,6 +305,29 @@ func TestCheckCompatible(t *testing.T) {
 			head:    15,
 			wantErr: nil,
 		},
+		{
+			stored:  &ChainConfig{Istanbul: &IstanbulConfig{AggregatedSealBlock: big.NewInt(10), BLSKeys: blsKeys1}},
+			new:     &ChainConfig{Istanbul: &IstanbulConfig{AggregatedSealBlock: big.NewInt(10), BLSKeys: blsKeys2}},
+			head:    9,
+			wantErr: nil,
+		},
+		{
+			stored:  &ChainConfig{Istanbul: &IstanbulConfig{AggregatedSealBlock: big.NewInt(10), BLSKeys: blsKeys1}},
+			new:     &ChainConfig{Istanbul: &IstanbulConfig{AggregatedSealBlock: big.NewInt(10), BLSKeys: blsKeys1}},
+			head:    15,
+			wantErr: nil,
+		},
+		{
+			stored: &ChainConfig{Istanbul: &IstanbulConfig{AggregatedSealBlock: big.NewInt(10), BLSKeys: blsKeys1}},
+			new:    &ChainConfig{Istanbul: &IstanbulConfig{AggregatedSealBlock: big.NewInt(10), BLSKeys: blsKeys2}},
+			head:   15,
+			wantErr: &ConfigCompatError{
+				What:         "aggregated seal BLS keys",
+				StoredConfig: big.NewInt(10),
+				NewConfig:    big.NewInt(10),
+				RewindTo:     9,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -1,12 +1,15 @@
 package params
 
 const (
-	PERMISSIONED_CONFIG       = "permissioned-nodes.json"
-	BLACKLIST_CONFIG          = "disallowed-nodes.json"
-	PERMISSION_MODEL_CONFIG   = "permission-config.json"
-	DEFAULT_ORGCACHE_SIZE     = 2000
-	DEFAULT_ROLECACHE_SIZE    = 2500
-	DEFAULT_NODECACHE_SIZE    = 1000
-	DEFAULT_ACCOUNTCACHE_SIZE = 6000
-	NODE_NAME_LENGTH          = 32
+	PERMISSIONED_CONFIG         = "permissioned-nodes.json"
+	BLACKLIST_CONFIG            = "disallowed-nodes.json"
+	PERMISSION_MODEL_CONFIG     = "permission-config.json"
+	PERMISSION_MIGRATION_CONFIG = "permission-migration.json"
+	RPC_ACCESS_CONFIG           = "permission-rpc-access.json"
+	NODE_CERT_FILE              = "node-cert.pem"
+	DEFAULT_ORGCACHE_SIZE       = 2000
+	DEFAULT_ROLECACHE_SIZE      = 2500
+	DEFAULT_NODECACHE_SIZE      = 1000
+	DEFAULT_ACCOUNTCACHE_SIZE   = 6000
+	NODE_NAME_LENGTH            = 32
 )
@@ -7,6 +7,7 @@ import (
 	"regexp"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -44,6 +45,14 @@ const (
 	InitiateAccountRecovery
 	ApproveNodeRecovery
 	ApproveAccountRecovery
+	AddContractAccess
+	RemoveContractAccess
+	SetValidity
+	RemoveValidity
+	SetApprovalPolicy
+	RemoveApprovalPolicy
+	AddOrgCA
+	RemoveOrgCA
 )
 
 type AccountUpdateAction int
@@ -78,9 +87,16 @@ type QuorumControlsAPI struct {
 	permCtrl *PermissionCtrl
 }
 
+// PendingOpInfo describes the operation pending approval of the network
+// admins, with the admins who have and have not voted on it
 type PendingOpInfo struct {
-	PendingKey string `json:"pendingKey"`
-	PendingOp  string `json:"pendingOp"`
+	PendingKey string           `json:"pendingKey"`
+	PendingOp  string           `json:"pendingOp"`
+	EnodeId    string           `json:"enodeId,omitempty"`
+	Account    *common.Address  `json:"account,omitempty"`
+	Voted      []common.Address `json:"voted"`
+	NotVoted   []common.Address `json:"notVoted"`
+	Required   int              `json:"required"` // votes needed by the approval policy
 }
 
 var actionSuccess = "Action completed successfully"
@@ -473,10 +489,243 @@ func (q *QuorumControlsAPI) ApproveBlackListedAccountRecovery(orgId string, acct
 	return actionSuccess, nil
 }
 
-func (q *QuorumControlsAPI) TransactionAllowed(txa ethapi.SendTxArgs) bool {
-	var value, gasPrice, gasLimit *big.Int
-	var payload []byte
-	var to, from common.Address
+func (q *QuorumControlsAPI) ContractAccessList() []core.ContractAccessInfo {
+	return core.ContractAccessMap.GetContractAccessList()
+}
+
+// AddContractAccess allows an account or an org role to call the contract,
+// optionally restricted to the given function selectors
+func (q *QuorumControlsAPI) AddContractAccess(access core.ContractAccessInfo, txa ethapi.SendTxArgs) (string, error) {
+	accountService, err := q.permCtrl.NewPermissionAccountService(txa)
+	if err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{Access: access, Txa: txa}
+
+	if err := q.valContractAccess(access, txa); err != nil {
+		return "", err
+	}
+	if access.AcctId == nil {
+		if org, _ := core.OrgInfoMap.GetOrg(access.OrgId); org == nil {
+			return "", ptype.ErrOrgDoesNotExists
+		}
+	}
+	tx, err := accountService.AddContractAccess(args)
+	if err != nil {
+		return reportExecError(AddContractAccess, err)
+	}
+	log.Debug("executed permission action", "action", AddContractAccess, "tx", tx)
+	return actionSuccess, nil
+}
+
+// RemoveContractAccess removes the access of an account or an org role to the contract
+func (q *QuorumControlsAPI) RemoveContractAccess(access core.ContractAccessInfo, txa ethapi.SendTxArgs) (string, error) {
+	accountService, err := q.permCtrl.NewPermissionAccountService(txa)
+	if err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{Access: access, Txa: txa}
+
+	if err := q.valContractAccess(access, txa); err != nil {
+		return "", err
+	}
+	tx, err := accountService.RemoveContractAccess(args)
+	if err != nil {
+		return reportExecError(RemoveContractAccess, err)
+	}
+	log.Debug("executed permission action", "action", RemoveContractAccess, "tx", tx)
+	return actionSuccess, nil
+}
+
+func (q *QuorumControlsAPI) ValidityList() []core.ValidityInfo {
+	return core.ValidityMap.GetValidityList()
+}
+
+// SetValidity limits the role assignment of an account or the approval of a
+// node to the given block height and/or timestamp window
+func (q *QuorumControlsAPI) SetValidity(validity core.ValidityInfo, txa ethapi.SendTxArgs) (string, error) {
+	if err := validity.Validate(); err != nil {
+		return "", err
+	}
+	return q.setValidity(validity, txa, SetValidity)
+}
+
+// RemoveValidity removes the validity window of an account or node
+func (q *QuorumControlsAPI) RemoveValidity(validity core.ValidityInfo, txa ethapi.SendTxArgs) (string, error) {
+	if (validity.Account == nil) == (validity.EnodeId == "") {
+		return "", core.ErrInvalidValidity
+	}
+	// the contracts remove the window when it is set to an empty one
+	return q.setValidity(core.ValidityInfo{Account: validity.Account, EnodeId: validity.EnodeId}, txa, RemoveValidity)
+}
+
+// submits the validity window of an account or node to the contracts
+func (q *QuorumControlsAPI) setValidity(validity core.ValidityInfo, txa ethapi.SendTxArgs, action PermAction) (string, error) {
+	orgId, err := q.valValidity(validity, txa)
+	if err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{OrgId: orgId, Validity: validity, Txa: txa}
+
+	var tx *types.Transaction
+	if validity.Account != nil {
+		accountService, err := q.permCtrl.NewPermissionAccountService(txa)
+		if err != nil {
+			return "", err
+		}
+		tx, err = accountService.SetAccountValidity(args)
+	} else {
+		nodeService, err := q.permCtrl.NewPermissionNodeService(txa)
+		if err != nil {
+			return "", err
+		}
+		tx, err = nodeService.SetNodeValidity(args)
+	}
+	if err != nil {
+		return reportExecError(action, err)
+	}
+	log.Debug("executed permission action", "action", action, "tx", tx)
+	return actionSuccess, nil
+}
+
+// UpcomingExpiries returns the account and node validity windows ending
+// within the given number of blocks or seconds from the chain head
+func (q *QuorumControlsAPI) UpcomingExpiries(blocks, seconds uint64) []core.ValidityInfo {
+	return core.ValidityMap.UpcomingExpiries(blocks, seconds)
+}
+
+func (q *QuorumControlsAPI) OrgCAList() []core.OrgCAInfo {
+	return core.NodeCertMap.GetOrgCAList()
+}
+
+// AddOrgCA adds a certificate authority issuing the certificates of the nodes
+// of an org and its sub orgs. Once an org has one, its nodes must present a
+// certificate for the org in addition to being approved in the contracts.
+func (q *QuorumControlsAPI) AddOrgCA(ca core.OrgCAInfo, txa ethapi.SendTxArgs) (string, error) {
+	return q.changeOrgCA(ca, txa, AddOrgCA)
+}
+
+// RemoveOrgCA removes the certificate authority with the given fingerprint
+// from an org
+func (q *QuorumControlsAPI) RemoveOrgCA(ca core.OrgCAInfo, txa ethapi.SendTxArgs) (string, error) {
+	return q.changeOrgCA(ca, txa, RemoveOrgCA)
+}
+
+// submits the change of a certificate authority of an org to the contracts
+func (q *QuorumControlsAPI) changeOrgCA(ca core.OrgCAInfo, txa ethapi.SendTxArgs, action PermAction) (string, error) {
+	orgService, err := q.permCtrl.NewPermissionOrgService(txa)
+	if err != nil {
+		return "", err
+	}
+	if err := q.valOrgCA(ca.OrgId, txa); err != nil {
+		return "", err
+	}
+	args := ptype.TxArgs{OrgId: ca.OrgId, OrgCA: ca, Txa: txa}
+
+	var tx *types.Transaction
+	if action == AddOrgCA {
+		tx, err = orgService.AddOrgCA(args)
+	} else {
+		tx, err = orgService.RemoveOrgCA(args)
+	}
+	if err != nil {
+		return reportExecError(action, err)
+	}
+	log.Debug("executed permission action", "action", action, "tx", tx)
+	return actionSuccess, nil
+}
+
+// RpcAccess returns the rules restricting rpc calls by on-chain permissions
+// and the accounts the identities of rpc clients map to
+func (q *QuorumControlsAPI) RpcAccess() core.RpcAccessConfig {
+	return core.RpcAccessMap.Config()
+}
+
+// PendingOps returns the operation pending approval of the network admins
+// with the admins who have and have not approved it
+func (q *QuorumControlsAPI) PendingOps() ([]PendingOpInfo, error) {
+	info, err := q.permCtrl.pendingOp()
+	if err != nil || info == nil {
+		return []PendingOpInfo{}, err
+	}
+	return []PendingOpInfo{*info}, nil
+}
+
+// ApprovalPolicies returns the approval policies set in the voter contract
+func (q *QuorumControlsAPI) ApprovalPolicies() ([]ApprovalPolicy, error) {
+	return q.permCtrl.approvalPolicies()
+}
+
+// SetApprovalPolicy sets how many network admins must approve an action
+func (q *QuorumControlsAPI) SetApprovalPolicy(policy ApprovalPolicy, txa ethapi.SendTxArgs) (string, error) {
+	if err := policy.validate(); err != nil {
+		return "", err
+	}
+	return q.setApprovalPolicy(policy.Action, policy.contractValue(), txa, SetApprovalPolicy)
+}
+
+// RemoveApprovalPolicy restores the majority vote of the contracts for an action
+func (q *QuorumControlsAPI) RemoveApprovalPolicy(action string, txa ethapi.SendTxArgs) (string, error) {
+	if len(actionOpTypes(action)) == 0 {
+		return "", ErrUnknownPolicyAction
+	}
+	policies, err := q.permCtrl.approvalPolicies()
+	if err != nil {
+		return "", err
+	}
+	found := false
+	for _, p := range policies {
+		found = found || p.Action == action
+	}
+	if !found {
+		return "", ErrPolicyMissing
+	}
+	return q.setApprovalPolicy(action, big.NewInt(0), txa, RemoveApprovalPolicy)
+}
+
+// setApprovalPolicy sets the number of approvals in the voter contract for
+// all the operation types of the action
+func (q *QuorumControlsAPI) setApprovalPolicy(action string, required *big.Int, txa ethapi.SendTxArgs, op PermAction) (string, error) {
+	orgService, err := q.permCtrl.NewPermissionOrgService(txa)
+	if err != nil {
+		return "", err
+	}
+	if err := q.valApprovalPolicyChange(txa); err != nil {
+		return "", err
+	}
+	for _, opType := range actionOpTypes(action) {
+		args := ptype.TxArgs{Action: uint8(opType), Required: required, Txa: txa}
+		tx, err := orgService.SetApprovalPolicy(args)
+		if err != nil {
+			return reportExecError(op, err)
+		}
+		log.Debug("executed permission action", "action", op, "tx", tx)
+	}
+	return actionSuccess, nil
+}
+
+// GetHistory returns the permission contract events matching the filter,
+// with the block, transaction and account which caused them
+func (q *QuorumControlsAPI) GetHistory(filter HistoryFilter) ([]PermissionEvent, error) {
+	if q.permCtrl.history == nil {
+		return nil, ErrHistoryNotReady
+	}
+	return q.permCtrl.history.query(filter)
+}
+
+// ExportHistory returns the permission contract events matching the filter
+// encoded as json or csv
+func (q *QuorumControlsAPI) ExportHistory(filter HistoryFilter, format string) (string, error) {
+	events, err := q.GetHistory(filter)
+	if err != nil {
+		return "", err
+	}
+	return exportHistory(events, format)
+}
+
+// transactionCheckArgs returns the arguments of the transaction permission
+// check for the transaction
+func transactionCheckArgs(txa ethapi.SendTxArgs) (from, to common.Address, value, gasPrice, gasLimit *big.Int, payload []byte, transactionType core.TransactionType) {
 	if txa.Value != nil {
 		value = txa.Value.ToInt()
 	} else {
@@ -505,21 +754,31 @@ func (q *QuorumControlsAPI) TransactionAllowed(txa ethapi.SendTxArgs) bool {
 		payload = *txa.Data
 	}
 
-	transactionType := core.ValueTransferTxn
+	transactionType = core.ValueTransferTxn
 
 	if txa.To == nil {
 		transactionType = core.ContractDeployTxn
 	} else if txa.Data != nil {
 		transactionType = core.ContractCallTxn
 	}
+	return
+}
 
-	if err := core.IsTransactionAllowed(from, to, value, gasPrice, gasLimit, payload, transactionType); err != nil {
+func (q *QuorumControlsAPI) TransactionAllowed(txa ethapi.SendTxArgs) bool {
+	if err := core.IsTransactionAllowed(transactionCheckArgs(txa)); err != nil {
 		return false
 	} else {
 		return true
 	}
 }
 
+// ExplainTransaction returns the decision path of the permission check of
+// the transaction: the org chain of the sender up to the ultimate parent, its
+// role, the status values and access type found and the check which failed
+func (q *QuorumControlsAPI) ExplainTransaction(txa ethapi.SendTxArgs) *core.PermissionDecision {
+	return core.ExplainTransaction(transactionCheckArgs(txa))
+}
+
 func (q *QuorumControlsAPI) ConnectionAllowed(enodeId, ip string, port, raftPort uint16) bool {
 	controlService, err := q.permCtrl.NewPermissionControlService()
 	if err != nil {
@@ -533,6 +792,18 @@ func (q *QuorumControlsAPI) ConnectionAllowed(enodeId, ip string, port, raftPort
 	}
 }
 
+// ExplainConnection returns the decision path of the permission check of a
+// connection from the node: its org chain up to the ultimate parent, the
+// status values found and the check which failed
+func (q *QuorumControlsAPI) ExplainConnection(enodeId, ip string, port, raftPort uint16) (*core.PermissionDecision, error) {
+	controlService, err := q.permCtrl.NewPermissionControlService()
+	if err != nil {
+		return nil, err
+	}
+	allowed, err := controlService.ConnectionAllowed(enodeId, ip, port, raftPort)
+	return core.ExplainConnection(enodeId, allowed, err), nil
+}
+
 // check if the account is network admin
 func (q *QuorumControlsAPI) isNetworkAdmin(account common.Address) bool {
 	ac, _ := core.AcctInfoMap.GetAccount(account)
@@ -1093,3 +1364,80 @@ func (q *QuorumControlsAPI) valRecoverAccount(args ptype.TxArgs, action PermActi
 	}
 	return nil
 }
+
+// validates contract access changes, which are part of the V2 model and can
+// be done by network admin accounts of this node only
+// validityOrg returns the org of the account or node the validity window is for
+func validityOrg(validity core.ValidityInfo) (string, error) {
+	if validity.Account != nil {
+		ac, _ := core.AcctInfoMap.GetAccount(*validity.Account)
+		if ac == nil {
+			return "", ptype.ErrAccountNotThere
+		}
+		return ac.OrgId, nil
+	}
+	for _, n := range core.NodeInfoMap.GetNodeList() {
+		if node, err := enode.ParseV4(n.Url); err == nil && node.EnodeID() == validity.EnodeId {
+			return n.OrgId, nil
+		}
+	}
+	return "", ptype.ErrNodeDoesNotExists
+}
+
+// checks that the caller can change the validity window and returns the org
+// of the account or node
+func (q *QuorumControlsAPI) valValidity(validity core.ValidityInfo, txa ethapi.SendTxArgs) (string, error) {
+	if !q.permCtrl.IsV2Permission() {
+		return "", ptype.ErrOpNotAllowed
+	}
+	if _, err := q.permCtrl.validateAccount(txa.From); err != nil {
+		return "", ptype.ErrInvalidAccount
+	}
+	orgId, err := validityOrg(validity)
+	if err != nil {
+		return "", err
+	}
+	if q.isNetworkAdmin(txa.From) {
+		return orgId, nil
+	}
+	return orgId, q.isOrgAdmin(txa.From, orgId)
+}
+
+func (q *QuorumControlsAPI) valOrgCA(orgId string, txa ethapi.SendTxArgs) error {
+	if _, err := q.permCtrl.validateAccount(txa.From); err != nil {
+		return ptype.ErrInvalidAccount
+	}
+	if q.isNetworkAdmin(txa.From) {
+		if _, err := core.OrgInfoMap.GetOrg(orgId); err != nil {
+			return ptype.ErrOrgDoesNotExists
+		}
+		return nil
+	}
+	return q.isOrgAdmin(txa.From, orgId)
+}
+
+func (q *QuorumControlsAPI) valApprovalPolicyChange(txa ethapi.SendTxArgs) error {
+	if !q.permCtrl.IsV2Permission() {
+		return ptype.ErrOpNotAllowed
+	}
+	if _, err := q.permCtrl.validateAccount(txa.From); err != nil {
+		return ptype.ErrInvalidAccount
+	}
+	if !q.isNetworkAdmin(txa.From) {
+		return ptype.ErrNotNetworkAdmin
+	}
+	return nil
+}
+
+func (q *QuorumControlsAPI) valContractAccess(access core.ContractAccessInfo, txa ethapi.SendTxArgs) error {
+	if !q.permCtrl.IsV2Permission() {
+		return ptype.ErrOpNotAllowed
+	}
+	if _, err := q.permCtrl.validateAccount(txa.From); err != nil {
+		return ptype.ErrInvalidAccount
+	}
+	if !q.isNetworkAdmin(txa.From) {
+		return ptype.ErrNotNetworkAdmin
+	}
+	return access.Validate()
+}
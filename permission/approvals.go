@@ -0,0 +1,387 @@
+package permission
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	v1bind "github.com/ethereum/go-ethereum/permission/v1/bind"
+	v2bind "github.com/ethereum/go-ethereum/permission/v2/bind"
+)
+
+// actions which are decided by a vote of the network admins
+const (
+	ActionApproveOrgStatus       = "approveOrgStatus"
+	ActionApproveNodeRecovery    = "approveBlackListedNodeRecovery"
+	ActionApproveAccountRecovery = "approveBlackListedAccountRecovery"
+)
+
+// number of blocks searched back for the start of the pending vote when the
+// node starts
+const approvalBackfillBlocks = 100000
+
+var (
+	ErrApprovalsNotReady   = errors.New("approval tracking is not ready yet")
+	ErrInvalidPolicy       = errors.New("approval policy must require a number of approvals or be unanimous")
+	ErrUnknownPolicyAction = errors.New("approval policies are supported for network admin votes only")
+	ErrPolicyMissing       = errors.New("approval policy does not exist")
+)
+
+// actions of the pending operation types of the voter contract
+var pendingOpActions = map[int64]string{
+	1: ActionApproveOrg,
+	2: ActionApproveOrgStatus,
+	3: ActionApproveOrgStatus,
+	4: ActionApproveAdminRole,
+	5: ActionApproveNodeRecovery,
+	6: ActionApproveAccountRecovery,
+}
+
+// actions of the permission interface methods which cast a vote
+var voteMethodActions = map[string]string{
+	"approveOrg":                        ActionApproveOrg,
+	"approveOrgStatus":                  ActionApproveOrgStatus,
+	"approveAdminRole":                  ActionApproveAdminRole,
+	"approveBlacklistedNodeRecovery":    ActionApproveNodeRecovery,
+	"approveBlacklistedAccountRecovery": ActionApproveAccountRecovery,
+}
+
+// ApprovalPolicy sets how many network admins must approve an action. The
+// policy is kept by the voter contract, which completes the action once the
+// required number of network admins voted for it. A policy requires at least
+// the majority of the network admins.
+type ApprovalPolicy struct {
+	Action    string `json:"action"`
+	Required  int    `json:"required,omitempty"`
+	Unanimous bool   `json:"unanimous,omitempty"`
+}
+
+func (ap ApprovalPolicy) validate() error {
+	if len(actionOpTypes(ap.Action)) == 0 {
+		return ErrUnknownPolicyAction
+	}
+	if (ap.Required > 0) == ap.Unanimous || ap.Required < 0 {
+		return ErrInvalidPolicy
+	}
+	return nil
+}
+
+// contractValue returns the number of approvals as stored by the voter
+// contract, which marks unanimous policies with the maximum value
+func (ap ApprovalPolicy) contractValue() *big.Int {
+	if ap.Unanimous {
+		return math.MaxBig256
+	}
+	return big.NewInt(int64(ap.Required))
+}
+
+// policyFromContract returns the policy of the action for the number of
+// approvals stored by the voter contract, or nil if the action completes on
+// a majority
+func policyFromContract(action string, required *big.Int) *ApprovalPolicy {
+	switch {
+	case required == nil || required.Sign() == 0:
+		return nil
+	case !required.IsInt64():
+		// the maximum value, or more approvals than there can be voters
+		return &ApprovalPolicy{Action: action, Unanimous: true}
+	}
+	return &ApprovalPolicy{Action: action, Required: int(required.Int64())}
+}
+
+// actionOpTypes returns the pending operation types of the action in
+// ascending order
+func actionOpTypes(action string) []int64 {
+	var opTypes []int64
+	for opType, a := range pendingOpActions {
+		if a == action {
+			opTypes = append(opTypes, opType)
+		}
+	}
+	sort.Slice(opTypes, func(i, j int) bool { return opTypes[i] < opTypes[j] })
+	return opTypes
+}
+
+// required returns the number of approvals needed with the given number of
+// voters, as the voter contract computes it
+func (ap *ApprovalPolicy) required(voters int) int {
+	majority := voters/2 + 1
+	if ap == nil {
+		return majority
+	}
+	required := ap.Required
+	if ap.Unanimous || required > voters {
+		required = voters
+	}
+	if required < majority {
+		return majority
+	}
+	return required
+}
+
+// approvalTracker follows the votes on the pending operation of the network
+// admin org. The voter contract records neither votes nor voters in events,
+// so the votes are taken from the successful permission interface
+// transactions.
+type approvalTracker struct {
+	interfAddr common.Address
+	voterAddr  common.Address
+	interfABI  abi.ABI
+	itemAdded  common.Hash // VotingItemAdded event
+
+	mux   sync.RWMutex
+	votes map[string]map[common.Address]bool // voters by vote key
+}
+
+func newApprovalTracker(interfAddr, voterAddr common.Address, interfABI, voterABI string) (*approvalTracker, error) {
+	parsedInterf, err := abi.JSON(strings.NewReader(interfABI))
+	if err != nil {
+		return nil, err
+	}
+	parsedVoter, err := abi.JSON(strings.NewReader(voterABI))
+	if err != nil {
+		return nil, err
+	}
+	return &approvalTracker{
+		interfAddr: interfAddr,
+		voterAddr:  voterAddr,
+		interfABI:  parsedInterf,
+		itemAdded:  parsedVoter.Events["VotingItemAdded"].ID(),
+		votes:      make(map[string]map[common.Address]bool),
+	}, nil
+}
+
+func voteKey(action, orgId string) string {
+	return action + "/" + orgId
+}
+
+// addBlock records the votes cast in the transactions of a block. The votes
+// are forgotten when the next operation is added, which the contract allows
+// only once the previous one completed.
+func (a *approvalTracker) addBlock(number uint64, txs types.Transactions, receipts types.Receipts) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+
+	for i, tx := range txs {
+		if i >= len(receipts) || receipts[i].Status != types.ReceiptStatusSuccessful {
+			continue
+		}
+		for _, l := range receipts[i].Logs {
+			if l.Address == a.voterAddr && len(l.Topics) > 0 && l.Topics[0] == a.itemAdded {
+				a.votes = make(map[string]map[common.Address]bool)
+			}
+		}
+		if tx.To() != nil && *tx.To() == a.interfAddr && len(tx.Data()) >= 4 {
+			a.addVote(tx)
+		}
+	}
+}
+
+func (a *approvalTracker) addVote(tx *types.Transaction) {
+	method, err := a.interfABI.MethodById(tx.Data()[:4])
+	if err != nil {
+		return
+	}
+	action, ok := voteMethodActions[method.Name]
+	if !ok {
+		return
+	}
+	args, err := method.Inputs.UnpackValues(tx.Data()[4:])
+	if err != nil || len(args) == 0 {
+		log.Debug("Failed to decode permission vote", "tx", tx.Hash(), "err", err)
+		return
+	}
+	orgId, _ := args[0].(string)
+	key := voteKey(action, orgId)
+	if a.votes[key] == nil {
+		a.votes[key] = make(map[common.Address]bool)
+	}
+	a.votes[key][tx.From()] = true
+}
+
+// pendingOpInfo returns the votes on the pending operation and the approvals
+// its policy requires
+func (a *approvalTracker) pendingOpInfo(orgId, enodeId string, account common.Address, opType int64, voters []common.Address, policy *ApprovalPolicy) PendingOpInfo {
+	a.mux.RLock()
+	defer a.mux.RUnlock()
+
+	action := pendingOpActions[opType]
+	info := PendingOpInfo{
+		PendingKey: orgId,
+		PendingOp:  action,
+		EnodeId:    enodeId,
+		Voted:      []common.Address{},
+		NotVoted:   []common.Address{},
+		Required:   policy.required(len(voters)),
+	}
+	if account != (common.Address{}) {
+		info.Account = &account
+	}
+	key := voteKey(action, orgId)
+	for _, voter := range voters {
+		if a.votes[key][voter] {
+			info.Voted = append(info.Voted, voter)
+		} else {
+			info.NotVoted = append(info.NotVoted, voter)
+		}
+	}
+	return info
+}
+
+// returns the active network admin accounts, which are the voters of the
+// network admin org
+func (p *PermissionCtrl) voters() []common.Address {
+	var voters []common.Address
+	for _, a := range pcore.AcctInfoMap.GetAcctList() {
+		if a.OrgId == p.permConfig.NwAdminOrg && a.RoleId == p.permConfig.NwAdminRole && a.Status == pcore.AcctActive {
+			voters = append(voters, a.AcctId)
+		}
+	}
+	sort.Slice(voters, func(i, j int) bool { return bytes.Compare(voters[i][:], voters[j][:]) < 0 })
+	return voters
+}
+
+// approvalPolicy returns the policy of the voter contract for the pending
+// operation type, or nil if it completes on a majority
+func (p *PermissionCtrl) approvalPolicy(opType int64) (*ApprovalPolicy, error) {
+	if !p.IsV2Permission() {
+		return nil, nil
+	}
+	auditService, err := p.NewPermissionAuditService()
+	if err != nil {
+		return nil, err
+	}
+	required, err := auditService.GetApprovalPolicy(opType)
+	if err != nil {
+		return nil, err
+	}
+	return policyFromContract(pendingOpActions[opType], required), nil
+}
+
+// approvalPolicies returns the policies set in the voter contract
+func (p *PermissionCtrl) approvalPolicies() ([]ApprovalPolicy, error) {
+	var opTypes []int64
+	for opType := range pendingOpActions {
+		opTypes = append(opTypes, opType)
+	}
+	sort.Slice(opTypes, func(i, j int) bool { return opTypes[i] < opTypes[j] })
+
+	list := []ApprovalPolicy{}
+	seen := make(map[string]bool)
+	for _, opType := range opTypes {
+		action := pendingOpActions[opType]
+		if seen[action] {
+			continue
+		}
+		seen[action] = true
+		policy, err := p.approvalPolicy(opType)
+		if err != nil {
+			return nil, err
+		}
+		if policy != nil {
+			list = append(list, *policy)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Action < list[j].Action })
+	return list, nil
+}
+
+// pendingOp returns the operation pending in the network admin org, if any
+func (p *PermissionCtrl) pendingOp() (*PendingOpInfo, error) {
+	if p.approvals == nil {
+		return nil, ErrApprovalsNotReady
+	}
+	auditService, err := p.NewPermissionAuditService()
+	if err != nil {
+		return nil, err
+	}
+	orgId, enodeId, account, opType, err := auditService.GetPendingOp(p.permConfig.NwAdminOrg)
+	if err != nil {
+		return nil, err
+	}
+	if opType == 0 {
+		return nil, nil
+	}
+	policy, err := p.approvalPolicy(opType)
+	if err != nil {
+		return nil, err
+	}
+	info := p.approvals.pendingOpInfo(orgId, enodeId, account, opType, p.voters(), policy)
+	return &info, nil
+}
+
+// trackApprovals starts following the votes of the network admins, from the
+// block the pending operation was added in
+func (p *PermissionCtrl) trackApprovals() error {
+	interfABI, voterABI := v1bind.PermInterfaceABI, v1bind.VoterManagerABI
+	if p.IsV2Permission() {
+		interfABI, voterABI = v2bind.PermInterfaceABI, v2bind.VoterManagerABI
+	}
+	a, err := newApprovalTracker(p.permConfig.InterfAddress, p.permConfig.VoterAddress, interfABI, voterABI)
+	if err != nil {
+		return err
+	}
+
+	chain := p.eth.BlockChain()
+	addBlock := func(number uint64) {
+		block := chain.GetBlockByNumber(number)
+		if block == nil {
+			return
+		}
+		receipts := rawdb.ReadReceipts(p.eth.ChainDb(), block.Hash(), number, chain.Config())
+		a.addBlock(number, block.Transactions(), receipts)
+	}
+	// search back for the block which added the pending operation
+	head := chain.CurrentBlock().NumberU64()
+	from := head + 1
+	if auditService, err := p.NewPermissionAuditService(); err == nil {
+		if _, _, _, opType, err := auditService.GetPendingOp(p.permConfig.NwAdminOrg); err == nil && opType != 0 {
+			for n := head; n+approvalBackfillBlocks > head; n-- {
+				header := chain.GetHeaderByNumber(n)
+				if header != nil && types.BloomLookup(header.Bloom, a.voterAddr) && types.BloomLookup(header.Bloom, a.itemAdded) {
+					from = n
+					break
+				}
+				if n == 0 {
+					break
+				}
+			}
+		}
+	}
+	chainHeadCh := make(chan core.ChainHeadEvent, 10)
+	headSub := chain.SubscribeChainHeadEvent(chainHeadCh)
+	for n := from; n <= head; n++ {
+		addBlock(n)
+	}
+	p.approvals = a
+
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
+	go func() {
+		defer headSub.Unsubscribe()
+		defer stopSubscription.Unsubscribe()
+		next := head + 1
+		for {
+			select {
+			case ev := <-chainHeadCh:
+				for ; next <= ev.Block.NumberU64(); next++ {
+					addBlock(next)
+				}
+			case <-stopChan:
+				return
+			}
+		}
+	}()
+	return nil
+}
@@ -0,0 +1,199 @@
+package permission
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	"github.com/ethereum/go-ethereum/permission/v2"
+	v2bind "github.com/ethereum/go-ethereum/permission/v2/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApprovalTracker(t *testing.T) {
+	interfAddr := common.BytesToAddress([]byte("interface"))
+	voterAddr := common.BytesToAddress([]byte("voter"))
+	a, err := newApprovalTracker(interfAddr, voterAddr, v2bind.PermInterfaceABI, v2bind.VoterManagerABI)
+	if err != nil {
+		t.Fatal(err)
+	}
+	interfABI, _ := abi.JSON(strings.NewReader(v2bind.PermInterfaceABI))
+
+	keys := make([]*ecdsa.PrivateKey, 5)
+	voters := make([]common.Address, 5)
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		voters[i] = crypto.PubkeyToAddress(keys[i].PublicKey)
+	}
+	sort.Slice(voters, func(i, j int) bool { return bytes.Compare(voters[i][:], voters[j][:]) < 0 })
+	byAddress := make(map[common.Address]*ecdsa.PrivateKey)
+	for _, key := range keys {
+		byAddress[crypto.PubkeyToAddress(key.PublicKey)] = key
+	}
+	account := common.BytesToAddress([]byte("admin"))
+	vote := func(voter common.Address, nonce uint64) *types.Transaction {
+		data, err := interfABI.Pack("approveOrg", arbitraryOrgToAdd, arbitraryNode1, "127.0.0.1", uint16(21000), uint16(0), account)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tx, _ := types.SignTx(types.NewTransaction(nonce, interfAddr, big.NewInt(0), 1000000, big.NewInt(0), data), types.HomesteadSigner{}, byAddress[voter])
+		return tx
+	}
+	success := func(logs ...*types.Log) *types.Receipt {
+		return &types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: logs}
+	}
+	itemAdded := &types.Log{Address: voterAddr, Topics: []common.Hash{a.itemAdded}}
+	processed := &types.Log{Address: voterAddr, Topics: []common.Hash{crypto.Keccak256Hash([]byte("VoteProcessed(string)"))}}
+
+	// the proposal and first two votes, one of them failed
+	proposal, _ := types.SignTx(types.NewTransaction(0, interfAddr, big.NewInt(0), 1000000, big.NewInt(0), []byte{0x01, 0x02, 0x03, 0x04}), types.HomesteadSigner{}, byAddress[voters[0]])
+	a.addBlock(10, types.Transactions{proposal, vote(voters[0], 1)}, types.Receipts{success(itemAdded), success()})
+	a.addBlock(11, types.Transactions{vote(voters[1], 0), vote(voters[2], 0)}, types.Receipts{success(), {Status: types.ReceiptStatusFailed}})
+
+	info := a.pendingOpInfo(arbitraryOrgToAdd, "enode", account, 1, voters, nil)
+	assert.Equal(t, ActionApproveOrg, info.PendingOp)
+	assert.Equal(t, []common.Address{voters[0], voters[1]}, info.Voted)
+	assert.Equal(t, []common.Address{voters[2], voters[3], voters[4]}, info.NotVoted)
+	assert.Equal(t, 3, info.Required)
+
+	// votes are kept while the operation is pending
+	a.addBlock(12, types.Transactions{vote(voters[2], 1)}, types.Receipts{success(processed)})
+	info = a.pendingOpInfo(arbitraryOrgToAdd, "enode", account, 1, voters, &ApprovalPolicy{Action: ActionApproveOrg, Unanimous: true})
+	assert.Equal(t, []common.Address{voters[0], voters[1], voters[2]}, info.Voted)
+	assert.Equal(t, 5, info.Required)
+
+	// and forgotten when the next operation is added
+	a.addBlock(13, types.Transactions{proposal}, types.Receipts{success(itemAdded)})
+	info = a.pendingOpInfo(arbitraryOrgToAdd, "enode", account, 1, voters, nil)
+	assert.Empty(t, info.Voted)
+}
+
+func TestApprovalPolicy(t *testing.T) {
+	assert.Equal(t, ErrInvalidPolicy, ApprovalPolicy{Action: ActionApproveOrg, Required: 2, Unanimous: true}.validate())
+	assert.Equal(t, ErrInvalidPolicy, ApprovalPolicy{Action: ActionApproveOrg}.validate())
+	assert.Equal(t, ErrUnknownPolicyAction, ApprovalPolicy{Action: ActionAddOrg, Unanimous: true}.validate())
+	assert.NoError(t, ApprovalPolicy{Action: ActionApproveOrg, Required: 4}.validate())
+	assert.Equal(t, []int64{2, 3}, actionOpTypes(ActionApproveOrgStatus))
+
+	// the number of approvals is bounded by the majority and the voters
+	var majority *ApprovalPolicy
+	assert.Equal(t, 3, majority.required(5))
+	assert.Equal(t, 3, (&ApprovalPolicy{Required: 1}).required(5))
+	assert.Equal(t, 4, (&ApprovalPolicy{Required: 4}).required(5))
+	assert.Equal(t, 5, (&ApprovalPolicy{Required: 9}).required(5))
+	assert.Equal(t, 5, (&ApprovalPolicy{Unanimous: true}).required(5))
+
+	// policies round trip through the values of the voter contract
+	for _, p := range []ApprovalPolicy{{Action: ActionApproveOrg, Required: 4}, {Action: ActionApproveOrg, Unanimous: true}} {
+		assert.Equal(t, &p, policyFromContract(p.Action, p.contractValue()))
+	}
+	assert.Nil(t, policyFromContract(ActionApproveOrg, big.NewInt(0)))
+}
+
+// deployV2Contracts deploys the v2 permission contracts on a simulated
+// backend of their own and boots the network with the key as network admin.
+// The backend of the test permission service is not used, as its v1 model
+// applies to the transactions mined in it.
+func deployV2Contracts(t *testing.T, key *ecdsa.PrivateKey) (*backends.SimulatedBackend, *ptype.PermissionConfig) {
+	admin := crypto.PubkeyToAddress(key.PublicKey)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		admin: {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)},
+	}, 100000000)
+	auth := bind.NewKeyedTransactor(key)
+	mined := func(tx *types.Transaction, err error) {
+		t.Helper()
+		require.NoError(t, err)
+		backend.Commit()
+		receipt, err := backend.TransactionReceipt(context.Background(), tx.Hash())
+		require.NoError(t, err)
+		require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status, "transaction reverted")
+	}
+	deployed := func(addr common.Address, tx *types.Transaction, _ interface{}, err error) common.Address {
+		mined(tx, err)
+		return addr
+	}
+
+	config := &ptype.PermissionConfig{
+		PermissionsModel: ptype.PERMISSION_V2,
+		NwAdminOrg:       arbitraryNetworkAdminOrg,
+		NwAdminRole:      arbitraryNetworkAdminRole,
+		OrgAdminRole:     arbitraryOrgAdminRole,
+	}
+	var (
+		upgr *v2bind.PermUpgr
+		tx   *types.Transaction
+		err  error
+	)
+	config.UpgrdAddress, tx, upgr, err = v2bind.DeployPermUpgr(auth, backend, admin)
+	mined(tx, err)
+	config.InterfAddress = deployed(v2bind.DeployPermInterface(auth, backend, config.UpgrdAddress))
+	config.NodeAddress = deployed(v2bind.DeployNodeManager(auth, backend, config.UpgrdAddress))
+	config.RoleAddress = deployed(v2bind.DeployRoleManager(auth, backend, config.UpgrdAddress))
+	config.AccountAddress = deployed(v2bind.DeployAcctManager(auth, backend, config.UpgrdAddress))
+	config.OrgAddress = deployed(v2bind.DeployOrgManager(auth, backend, config.UpgrdAddress))
+	config.VoterAddress = deployed(v2bind.DeployVoterManager(auth, backend, config.UpgrdAddress))
+	config.ImplAddress = deployed(v2bind.DeployPermImpl(auth, backend, config.UpgrdAddress, config.OrgAddress,
+		config.RoleAddress, config.AccountAddress, config.VoterAddress, config.NodeAddress))
+	mined(upgr.Init(auth, config.InterfAddress, config.ImplAddress))
+
+	init := NewPermissionContractService(backend, true, key, config, false, false)
+	require.NoError(t, init.BindContracts())
+	mined(init.SetPolicy(config.NwAdminOrg, config.NwAdminRole, config.OrgAdminRole))
+	mined(init.Init(big.NewInt(4), big.NewInt(4)))
+	mined(init.AddAdminAccount(admin))
+	mined(init.UpdateNetworkBootStatus())
+	return backend, config
+}
+
+func TestPermissionCtrl_approvalPolicies(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	backend, config := deployV2Contracts(t, key)
+	defer backend.Close()
+	p := &PermissionCtrl{ethClnt: backend, key: key, permConfig: config, backend: &v2.Backend{}}
+
+	// no policy is set, every vote completes on a majority
+	policies, err := p.approvalPolicies()
+	require.NoError(t, err)
+	assert.Empty(t, policies)
+
+	// policies set in the voter contract, for all the operation types of
+	// the action
+	orgService, err := p.backend.GetOrgService(bind.NewKeyedTransactor(key), p.getContractBackend())
+	require.NoError(t, err)
+	for _, policy := range []ApprovalPolicy{
+		{Action: ActionApproveOrgStatus, Required: 3},
+		{Action: ActionApproveAccountRecovery, Unanimous: true},
+	} {
+		for _, opType := range actionOpTypes(policy.Action) {
+			_, err := orgService.SetApprovalPolicy(ptype.TxArgs{Action: uint8(opType), Required: policy.contractValue()})
+			require.NoError(t, err)
+			backend.Commit()
+		}
+	}
+
+	policies, err = p.approvalPolicies()
+	require.NoError(t, err)
+	assert.Equal(t, []ApprovalPolicy{
+		{Action: ActionApproveAccountRecovery, Unanimous: true},
+		{Action: ActionApproveOrgStatus, Required: 3},
+	}, policies)
+	policy, err := p.approvalPolicy(3)
+	require.NoError(t, err)
+	assert.Equal(t, &ApprovalPolicy{Action: ActionApproveOrgStatus, Required: 3}, policy)
+	policy, err = p.approvalPolicy(1)
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+}
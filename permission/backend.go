@@ -3,6 +3,7 @@ package permission
 import (
 	"crypto/ecdsa"
 	"errors"
+	"fmt"
 	"math/big"
 	"sync"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/permission/core"
 	ptype "github.com/ethereum/go-ethereum/permission/core/types"
 	"github.com/ethereum/go-ethereum/permission/v1"
@@ -37,6 +39,12 @@ type PermissionCtrl struct {
 	errorChan          chan error      // channel to capture error when starting aysnc
 	networkInitialized bool
 	controlService     ptype.ControlService
+	history            *historyIndexer  // permission contract events for the history api
+	approvals          *approvalTracker // network admin votes for the approval policies
+
+	migrateMux   sync.Mutex         // serializes migration runs
+	migrationMux sync.Mutex         // protects migration
+	migration    *MigrationSchedule // permission contract migration of the node
 }
 
 var permissionService *PermissionCtrl
@@ -72,6 +80,14 @@ func NewQuorumPermissionCtrl(stack *node.Node, pconfig *ptype.PermissionConfig,
 	if err != nil {
 		return nil, err
 	}
+	// restrict rpc calls by on-chain permissions if rules are configured,
+	// denying the restricted calls until the permissions are loaded
+	if err := core.RpcAccessMap.Load(p.rpcAccessPath()); err != nil {
+		return nil, fmt.Errorf("failed to load %s: %v", params.RPC_ACCESS_CONFIG, err)
+	}
+	if core.RpcAccessMap.Enabled() {
+		rpc.SetAuthorizeCallFunc(p.authorizeRpcCall)
+	}
 	stopChan, stopSubscription := ptype.SubscribeStopEvent()
 	inProcRPCServerSub := stack.EventMux().Subscribe(rpc.InProcServerReadyEvent{})
 	log.Debug("permission service: waiting for InProcRPC Server")
@@ -117,6 +133,8 @@ func (p *PermissionCtrl) APIs() []rpc.API {
 
 func (p *PermissionCtrl) Stop() error {
 	log.Info("permission service: stopping")
+	rpc.SetAuthorizeCallFunc(nil)
+	ptype.ContractStopFeed.Send(ptype.StopEvent{})
 	ptype.StopFeed.Send(ptype.StopEvent{})
 	log.Info("permission service: stopped")
 	return nil
@@ -148,6 +166,9 @@ func NewPermissionContractService(ethClnt bind.ContractBackend, permissionV2 boo
 }
 
 func (p *PermissionCtrl) NewPermissionRoleService(txa ethapi.SendTxArgs) (ptype.RoleService, error) {
+	if err := p.checkNotMigrating(); err != nil {
+		return nil, err
+	}
 	transactOpts, err := p.getTxParams(txa)
 	if err != nil {
 		return nil, err
@@ -156,6 +177,9 @@ func (p *PermissionCtrl) NewPermissionRoleService(txa ethapi.SendTxArgs) (ptype.
 }
 
 func (p *PermissionCtrl) NewPermissionOrgService(txa ethapi.SendTxArgs) (ptype.OrgService, error) {
+	if err := p.checkNotMigrating(); err != nil {
+		return nil, err
+	}
 	transactOpts, err := p.getTxParams(txa)
 	if err != nil {
 		return nil, err
@@ -164,6 +188,9 @@ func (p *PermissionCtrl) NewPermissionOrgService(txa ethapi.SendTxArgs) (ptype.O
 }
 
 func (p *PermissionCtrl) NewPermissionNodeService(txa ethapi.SendTxArgs) (ptype.NodeService, error) {
+	if err := p.checkNotMigrating(); err != nil {
+		return nil, err
+	}
 	transactOpts, err := p.getTxParams(txa)
 	if err != nil {
 		return nil, err
@@ -172,6 +199,9 @@ func (p *PermissionCtrl) NewPermissionNodeService(txa ethapi.SendTxArgs) (ptype.
 }
 
 func (p *PermissionCtrl) NewPermissionAccountService(txa ethapi.SendTxArgs) (ptype.AccountService, error) {
+	if err := p.checkNotMigrating(); err != nil {
+		return nil, err
+	}
 	transactOpts, err := p.getTxParams(txa)
 	if err != nil {
 		return nil, err
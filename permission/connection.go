@@ -34,6 +34,11 @@ func isNodePermissionedV2(node *enode.Node, nodename string, currentNode string,
 		log.Error("isNodePermissionedV2 connection not allowed", "err", err)
 		return false
 	}
+	// approved nodes outside their validity window are treated as inactive
+	if allowed && !core.ValidityMap.IsNodeValid(node.EnodeID()) {
+		log.Debug("isNodePermissionedV2 node outside validity window", "url", node.String())
+		allowed = false
+	}
 	if allowed {
 		log.Debug("isNodePermissionedV2", "connection", direction, "nodename", nodename[:params.NODE_NAME_LENGTH], "ALLOWED-BY", currentNode[:params.NODE_NAME_LENGTH])
 	} else {
@@ -48,12 +53,12 @@ func IsNodePermissioned(node *enode.Node, nodename string, currentNode string, d
 
 	//if we have not reached QIP714 block return full access
 	if !core.PermissionsEnabled() {
-		return core.IsNodePermissioned(nodename, currentNode, datadir, direction)
+		return core.IsNodePermissioned(node, nodename, currentNode, datadir, direction)
 	}
 
 	switch core.PermissionModel {
 	case core.Default:
-		return core.IsNodePermissioned(nodename, currentNode, datadir, direction)
+		return core.IsNodePermissioned(node, nodename, currentNode, datadir, direction)
 
 	case core.V1:
 		return isNodePermissionedV1(node.EnodeID(), nodename, currentNode, direction)
@@ -63,3 +68,33 @@ func IsNodePermissioned(node *enode.Node, nodename string, currentNode string, d
 	}
 	return false
 }
+
+// nodeRecord returns the record of the node in the permission model, if any
+func nodeRecord(node *enode.Node) *core.NodeInfo {
+	for _, n := range core.NodeInfoMap.GetNodeList() {
+		if recNode, err := enode.ParseV4(n.Url); err == nil && recNode.ID() == node.ID() {
+			return &n
+		}
+	}
+	return nil
+}
+
+// IsNodeCertified checks the certificate chain presented by a node the
+// permission model allows. Nodes of orgs with a certificate authority must
+// present a certificate for the org owning them in the node registry.
+func IsNodeCertified(node *enode.Node, certs [][]byte) bool {
+	if !core.PermissionsEnabled() || core.PermissionModel == core.Default {
+		return true
+	}
+	// the certificate requirements come from the org owning the node
+	rec := nodeRecord(node)
+	if rec == nil {
+		log.Debug("IsNodeCertified node not in the node registry", "url", node.String())
+		return false
+	}
+	if err := core.NodeCertMap.CheckNodeCertificate(node.EnodeID(), rec.OrgId, certs); err != nil {
+		log.Debug("IsNodeCertified node certificate rejected", "url", node.String(), "org", rec.OrgId, "err", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,420 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	ErrNodeAlreadyListed = errors.New("node is already in the list")
+	ErrNodeNotListed     = errors.New("node is not in the list")
+)
+
+// NodeListEntry is an entry of permissioned-nodes.json or
+// disallowed-nodes.json. Entries are either an enode url or an object with
+// the url and the networks the node may connect from.
+type NodeListEntry struct {
+	Enode string   `json:"enode"`
+	CIDRs []string `json:"cidrs,omitempty"`
+}
+
+func (e NodeListEntry) MarshalJSON() ([]byte, error) {
+	if len(e.CIDRs) == 0 {
+		return json.Marshal(e.Enode)
+	}
+	type entry NodeListEntry
+	return json.Marshal(entry(e))
+}
+
+func (e *NodeListEntry) UnmarshalJSON(input []byte) error {
+	if len(input) > 0 && input[0] == '"' {
+		e.CIDRs = nil
+		return json.Unmarshal(input, &e.Enode)
+	}
+	type entry NodeListEntry
+	return json.Unmarshal(input, (*entry)(e))
+}
+
+// parse validates the entry and returns its node and networks
+func (e NodeListEntry) parse() (*enode.Node, []*net.IPNet, error) {
+	node, err := enode.ParseV4(e.Enode)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid node %q: %v", e.Enode, err)
+	}
+	nets := make([]*net.IPNet, 0, len(e.CIDRs))
+	for _, cidr := range e.CIDRs {
+		// plain addresses restrict the node to that single address
+		if ip := net.ParseIP(cidr); ip != nil {
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipnet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid network %q for node %q: %v", cidr, e.Enode, err)
+		}
+		nets = append(nets, ipnet)
+	}
+	return node, nets, nil
+}
+
+// readNodeListEntries reads the entries of a node list file. Entries which
+// cannot be decoded are skipped with a warning, an empty file has no entries.
+func readNodeListEntries(path string) ([]NodeListEntry, error) {
+	blob, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(blob)) == 0 {
+		return nil, nil
+	}
+	var raw []json.RawMessage
+	if err := json.Unmarshal(blob, &raw); err != nil {
+		return nil, err
+	}
+	entries := make([]NodeListEntry, 0, len(raw))
+	for _, r := range raw {
+		var e NodeListEntry
+		if err := json.Unmarshal(r, &e); err != nil {
+			log.Warn("Skipping undecodable node list entry", "file", path, "entry", string(r), "err", err)
+			continue
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// ReadNodeList reads a node list file and returns its valid entries. Blank,
+// invalid and duplicate entries are skipped with a warning, only a file which
+// cannot be read or is not a list is rejected.
+func ReadNodeList(path string) ([]NodeListEntry, error) {
+	entries, err := readNodeListEntries(path)
+	if err != nil {
+		return nil, err
+	}
+	valid := entries[:0]
+	seen := make(map[enode.ID]bool)
+	for _, e := range entries {
+		node, _, err := e.parse()
+		if err != nil {
+			log.Warn("Skipping invalid node list entry", "file", path, "err", err)
+			continue
+		}
+		if seen[node.ID()] {
+			log.Warn("Skipping duplicate node list entry", "file", path, "enode", e.Enode)
+			continue
+		}
+		seen[node.ID()] = true
+		valid = append(valid, e)
+	}
+	return valid, nil
+}
+
+// nodeListMux serializes the updates of the node list files
+var nodeListMux sync.Mutex
+
+// UpdateNodeList adds or removes the entry in the node list file, creating
+// the file if missing. Entries are matched by node id; other entries are
+// written back as read.
+func UpdateNodeList(path string, entry NodeListEntry, add bool) error {
+	node, _, err := entry.parse()
+	if err != nil {
+		return err
+	}
+	nodeListMux.Lock()
+	defer nodeListMux.Unlock()
+
+	entries, err := readNodeListEntries(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	index := -1
+	for i, e := range entries {
+		if n, _, err := e.parse(); err == nil && n.ID() == node.ID() {
+			index = i
+			break
+		}
+	}
+	switch {
+	case add && index >= 0:
+		return ErrNodeAlreadyListed
+	case add:
+		entries = append(entries, entry)
+	case index < 0:
+		return ErrNodeNotListed
+	default:
+		entries = append(entries[:index], entries[index+1:]...)
+	}
+	return WriteNodeList(path, entries)
+}
+
+// WriteNodeList replaces the node list file, so that readers never see a
+// partially written list
+func WriteNodeList(path string, entries []NodeListEntry) error {
+	if entries == nil {
+		entries = []NodeListEntry{}
+	}
+	blob, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(blob); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+type allowedNode struct {
+	entry NodeListEntry
+	nets  []*net.IPNet
+}
+
+// allows checks if the node may connect from the given address
+func (a *allowedNode) allows(ip net.IP) bool {
+	if len(a.nets) == 0 {
+		return true
+	}
+	for _, n := range a.nets {
+		if ip != nil && n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// fileStamp identifies a version of a file
+type fileStamp struct {
+	exists  bool
+	modTime time.Time
+	size    int64
+}
+
+func stampFile(path string) fileStamp {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return fileStamp{}
+	}
+	return fileStamp{exists: true, modTime: fi.ModTime(), size: fi.Size()}
+}
+
+// AllowListInfo is the content of the node allow list
+type AllowListInfo struct {
+	Allowed    []NodeListEntry `json:"allowed"`
+	Disallowed []NodeListEntry `json:"disallowed"`
+}
+
+// AllowList caches permissioned-nodes.json and disallowed-nodes.json of a data
+// directory. Refresh reloads the files when they change. Invalid entries are
+// skipped; an unreadable allow list keeps the previous list, while an
+// unreadable deny list denies every node until it is fixed.
+type AllowList struct {
+	dataDir string
+
+	mux             sync.RWMutex
+	allowed         map[enode.ID]*allowedNode
+	disallowed      map[enode.ID]NodeListEntry
+	denyAll         bool // the deny list could not be read
+	allowedStamp    fileStamp
+	disallowedStamp fileStamp
+}
+
+var (
+	allowListsMux sync.Mutex
+	allowLists    = make(map[string]*AllowList)
+)
+
+// NodeAllowList returns the allow list of the data directory, loading it on
+// first use
+func NodeAllowList(dataDir string) *AllowList {
+	allowListsMux.Lock()
+	defer allowListsMux.Unlock()
+
+	if a, ok := allowLists[dataDir]; ok {
+		return a
+	}
+	a := &AllowList{
+		dataDir:    dataDir,
+		allowed:    make(map[enode.ID]*allowedNode),
+		disallowed: make(map[enode.ID]NodeListEntry),
+	}
+	if _, err := a.Refresh(); err != nil {
+		log.Error("Failed to load node allow list", "dataDir", dataDir, "err", err)
+	}
+	allowLists[dataDir] = a
+	return a
+}
+
+func (a *AllowList) allowedPath() string {
+	return filepath.Join(a.dataDir, params.PERMISSIONED_CONFIG)
+}
+
+func (a *AllowList) disallowedPath() string {
+	return filepath.Join(a.dataDir, params.BLACKLIST_CONFIG)
+}
+
+// Refresh reloads the lists if either file changed since the last load and
+// reports whether the lists were reloaded
+func (a *AllowList) Refresh() (bool, error) {
+	allowedStamp, disallowedStamp := stampFile(a.allowedPath()), stampFile(a.disallowedPath())
+	a.mux.RLock()
+	unchanged := allowedStamp == a.allowedStamp && disallowedStamp == a.disallowedStamp
+	a.mux.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+	return true, a.reload(allowedStamp, disallowedStamp)
+}
+
+func (a *AllowList) reload(allowedStamp, disallowedStamp fileStamp) error {
+	var (
+		allowed            map[enode.ID]*allowedNode
+		disallowed         = make(map[enode.ID]NodeListEntry)
+		denyAll            bool
+		allowedErr, disErr error
+	)
+	// a missing allow list permits no node, a missing deny list denies none
+	if allowedStamp.exists {
+		var entries []NodeListEntry
+		if entries, allowedErr = ReadNodeList(a.allowedPath()); allowedErr == nil {
+			allowed = make(map[enode.ID]*allowedNode)
+			for _, e := range entries {
+				node, nets, _ := e.parse()
+				allowed[node.ID()] = &allowedNode{entry: e, nets: nets}
+			}
+		}
+	} else {
+		allowed = make(map[enode.ID]*allowedNode)
+	}
+	if disallowedStamp.exists {
+		var entries []NodeListEntry
+		if entries, disErr = ReadNodeList(a.disallowedPath()); disErr != nil {
+			denyAll = true
+		}
+		for _, e := range entries {
+			node, _, _ := e.parse()
+			disallowed[node.ID()] = e
+		}
+	}
+
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	// remember the failed version too, so it is reported once
+	a.allowedStamp, a.disallowedStamp = allowedStamp, disallowedStamp
+	if allowed != nil {
+		a.allowed = allowed
+	}
+	a.disallowed, a.denyAll = disallowed, denyAll
+	switch {
+	case disErr != nil:
+		log.Error("Denying all nodes until the deny list is fixed", "dataDir", a.dataDir, "err", disErr)
+		return fmt.Errorf("%s: %v", params.BLACKLIST_CONFIG, disErr)
+	case allowedErr != nil:
+		return fmt.Errorf("%s: %v", params.PERMISSIONED_CONFIG, allowedErr)
+	}
+	log.Debug("Loaded node allow list", "dataDir", a.dataDir, "allowed", len(a.allowed), "disallowed", len(disallowed))
+	return nil
+}
+
+// IsAllowed checks if the node is in the allow list, not in the deny list
+// and connecting from one of its permitted networks
+func (a *AllowList) IsAllowed(node *enode.Node) bool {
+	a.mux.RLock()
+	defer a.mux.RUnlock()
+
+	if a.denyAll {
+		return false
+	}
+	if _, ok := a.disallowed[node.ID()]; ok {
+		return false
+	}
+	n, ok := a.allowed[node.ID()]
+	return ok && n.allows(node.IP())
+}
+
+func (a *AllowList) List() AllowListInfo {
+	a.mux.RLock()
+	defer a.mux.RUnlock()
+
+	info := AllowListInfo{
+		Allowed:    make([]NodeListEntry, 0, len(a.allowed)),
+		Disallowed: make([]NodeListEntry, 0, len(a.disallowed)),
+	}
+	for _, n := range a.allowed {
+		info.Allowed = append(info.Allowed, n.entry)
+	}
+	for _, e := range a.disallowed {
+		info.Disallowed = append(info.Disallowed, e)
+	}
+	sort.Slice(info.Allowed, func(i, j int) bool { return info.Allowed[i].Enode < info.Allowed[j].Enode })
+	sort.Slice(info.Disallowed, func(i, j int) bool { return info.Disallowed[i].Enode < info.Disallowed[j].Enode })
+	return info
+}
+
+// AddNode adds the node to permissioned-nodes.json, optionally restricted to
+// the given networks
+func (a *AllowList) AddNode(url string, cidrs []string) error {
+	return a.update(a.allowedPath(), NodeListEntry{Enode: url, CIDRs: cidrs}, true)
+}
+
+// RemoveNode removes the node from permissioned-nodes.json
+func (a *AllowList) RemoveNode(url string) error {
+	return a.update(a.allowedPath(), NodeListEntry{Enode: url}, false)
+}
+
+// AddDisallowedNode adds the node to disallowed-nodes.json
+func (a *AllowList) AddDisallowedNode(url string) error {
+	return a.update(a.disallowedPath(), NodeListEntry{Enode: url}, true)
+}
+
+// RemoveDisallowedNode removes the node from disallowed-nodes.json
+func (a *AllowList) RemoveDisallowedNode(url string) error {
+	return a.update(a.disallowedPath(), NodeListEntry{Enode: url}, false)
+}
+
+// update adds or removes the entry in the given file and reloads the lists
+func (a *AllowList) update(path string, entry NodeListEntry, add bool) error {
+	if err := UpdateNodeList(path, entry, add); err != nil {
+		return err
+	}
+	return a.reload(stampFile(a.allowedPath()), stampFile(a.disallowedPath()))
+}
+
+// check if a given node is permissioned to connect to the change
+func IsNodePermissioned(node *enode.Node, nodename string, currentNode string, datadir string, direction string) bool {
+	if NodeAllowList(datadir).IsAllowed(node) {
+		log.Debug("IsNodePermissioned", "connection", direction, "nodename", nodename[:params.NODE_NAME_LENGTH], "ALLOWED-BY", currentNode[:params.NODE_NAME_LENGTH])
+		return true
+	}
+	log.Debug("IsNodePermissioned", "connection", direction, "nodename", nodename[:params.NODE_NAME_LENGTH], "DENIED-BY", currentNode[:params.NODE_NAME_LENGTH])
+	return false
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+func TestNodeListEntry_JSON(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	var entries []NodeListEntry
+	assert.NoError(json.Unmarshal([]byte(`["`+node1+`", {"enode": "`+node2+`", "cidrs": ["10.0.0.0/8"]}]`), &entries))
+	assert.Equal([]NodeListEntry{{Enode: node1}, {Enode: node2, CIDRs: []string{"10.0.0.0/8"}}}, entries)
+
+	// entries without networks are written as plain urls
+	blob, err := json.Marshal(entries)
+	assert.NoError(err)
+	var raw []interface{}
+	assert.NoError(json.Unmarshal(blob, &raw))
+	assert.Equal(node1, raw[0])
+	assert.Equal(map[string]interface{}{"enode": node2, "cidrs": []interface{}{"10.0.0.0/8"}}, raw[1])
+}
+
+func TestAllowList(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	d, _ := ioutil.TempDir("", "qdata")
+	defer os.RemoveAll(d)
+	n1, _ := enode.ParseV4(node1)
+	n2, _ := enode.ParseV4(node2)
+	n3, _ := enode.ParseV4(node3)
+	local := func(n *enode.Node, ip string) *enode.Node {
+		return enode.NewV4(n.Pubkey(), net.ParseIP(ip), 0, 0)
+	}
+
+	// without files no node is allowed
+	a := NodeAllowList(d)
+	assert.True(a == NodeAllowList(d), "Expected one allow list per data directory")
+	assert.False(a.IsAllowed(n1), "Expected node to be denied without allow list")
+
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, node1)
+	changed, err := a.Refresh()
+	assert.True(changed && err == nil, fmt.Sprintf("Expected reload, got %v %v", changed, err))
+	assert.True(a.IsAllowed(n1), "Expected node to be allowed after reload")
+	changed, _ = a.Refresh()
+	assert.False(changed, "Expected unchanged files not to be reloaded")
+
+	// invalid and duplicate entries are skipped
+	assert.NoError(ioutil.WriteFile(filepath.Join(d, params.PERMISSIONED_CONFIG), []byte(`["`+node1+`", "enode://bad", "", 7, "`+node1+`"]`), 0644))
+	_, err = a.Refresh()
+	assert.NoError(err)
+	assert.True(a.IsAllowed(n1), "Expected valid entry to be loaded")
+	assert.Len(a.List().Allowed, 1)
+
+	// an unreadable allow list keeps the previous list
+	assert.NoError(ioutil.WriteFile(filepath.Join(d, params.PERMISSIONED_CONFIG), []byte(`["`+node1+`",`), 0644))
+	_, err = a.Refresh()
+	assert.Error(err)
+	assert.True(a.IsAllowed(n1), "Expected previous list to be kept")
+	assert.NoError(WriteNodeList(filepath.Join(d, params.PERMISSIONED_CONFIG), []NodeListEntry{{Enode: node1}}))
+
+	// an unreadable deny list denies every node
+	assert.NoError(ioutil.WriteFile(filepath.Join(d, params.BLACKLIST_CONFIG), []byte(`{`), 0644))
+	_, err = a.Refresh()
+	assert.Error(err)
+	assert.False(a.IsAllowed(n1), "Expected nodes to be denied with an unreadable deny list")
+	assert.NoError(os.Remove(filepath.Join(d, params.BLACKLIST_CONFIG)))
+	_, err = a.Refresh()
+	assert.NoError(err)
+	assert.True(a.IsAllowed(n1), "Expected node to be allowed once the deny list is fixed")
+
+	// updates
+	assert.NoError(a.AddNode(node2, []string{"10.0.0.0/8", "192.168.1.1"}))
+	assert.Equal(ErrNodeAlreadyListed, a.AddNode(node2, nil))
+	assert.Error(a.AddNode(node3, []string{"10.0.0/33"}))
+	assert.True(a.IsAllowed(local(n2, "10.1.2.3")), "Expected node in its network to be allowed")
+	assert.True(a.IsAllowed(local(n2, "192.168.1.1")), "Expected node at its address to be allowed")
+	assert.False(a.IsAllowed(local(n2, "192.168.1.2")), "Expected node outside its networks to be denied")
+
+	assert.NoError(a.AddDisallowedNode(node1))
+	assert.False(a.IsAllowed(n1), "Expected disallowed node to be denied")
+	assert.NoError(a.RemoveDisallowedNode(node1))
+	assert.True(a.IsAllowed(n1), "Expected node to be allowed again")
+
+	assert.NoError(a.RemoveNode(node1))
+	assert.Equal(ErrNodeNotListed, a.RemoveNode(node1))
+	assert.False(a.IsAllowed(n1), "Expected removed node to be denied")
+	assert.False(a.IsAllowed(n3), "Expected unknown node to be denied")
+
+	entries, err := ReadNodeList(filepath.Join(d, params.PERMISSIONED_CONFIG))
+	assert.NoError(err)
+	assert.Equal(a.List().Allowed, entries)
+}
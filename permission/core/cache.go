@@ -607,8 +607,8 @@ func ValidateNodeForTxn(hexnodeId string, from common.Address) bool {
 		}
 		if orgRec.UltimateParent == acOrgRec.UltimateParent {
 			recEnodeId, _ := enode.ParseV4(n.Url)
-			if recEnodeId.ID() == passedEnodeId.ID() && n.Status == NodeApproved {
-				return true
+			if recEnodeId.ID() == passedEnodeId.ID() && n.Status == NodeApproved && ValidityMap.IsNodeValid(recEnodeId.EnodeID()) {
+				return IsNodeCertifiedFor(recEnodeId.EnodeID(), n.OrgId)
 			}
 		}
 	}
@@ -619,16 +619,41 @@ func ValidateNodeForTxn(hexnodeId string, from common.Address) bool {
 	return false
 }
 
+// IsNodeCertifiedFor checks that the recorded certificate of the node is
+// issued for the org owning the node, if the org requires certificates
+func IsNodeCertifiedFor(enodeId, ownerOrg string) bool {
+	return NodeCertMap.CheckNodeCertificate(enodeId, ownerOrg, NodeCertMap.NodeCertificate(enodeId)) == nil
+}
+
 func IsV2Permission() bool {
 	return PermissionModel == V2
 }
 
 //  checks if the account permission allows the transaction to be executed
 func IsTransactionAllowed(from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType TransactionType) error {
+	number, time := chainHeadFunc()
+	return IsTransactionAllowedAt(number, time, from, to, value, gasPrice, gasLimit, payload, transactionType)
+}
+
+// IsTransactionAllowedAt checks if the account permission allows the
+// transaction to be executed in the block with the given number and time
+func IsTransactionAllowedAt(number, time uint64, from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType TransactionType) error {
 	//if we have not reached QIP714 block return full access
 	if !PermissionsEnabled() {
 		return nil
 	}
 
-	return PermissionTransactionAllowedFunc(from, to, value, gasPrice, gasLimit, payload, transactionType)
+	// accounts outside their validity window are treated as inactive
+	if IsV2Permission() && !ValidityMap.IsAccountValidAt(from, number, time) {
+		return ErrAccountExpired
+	}
+	if err := PermissionTransactionAllowedFunc(from, to, value, gasPrice, gasLimit, payload, transactionType); err != nil {
+		// the reason is only looked up by callers reporting it, see ExplainDenial
+		return &PermissionError{Err: err}
+	}
+	// contract level allow lists are part of the V2 model
+	if IsV2Permission() && transactionType != ContractDeployTxn {
+		return ContractAccessMap.CheckContractAccess(from, to, payload)
+	}
+	return nil
 }
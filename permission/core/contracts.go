@@ -0,0 +1,175 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// selector length of a contract function call
+const SelectorLength = 4
+
+var (
+	ErrNoContractAccess      = errors.New("account does not have permission to call the contract")
+	ErrInvalidContractAccess = errors.New("contract access must be given to either an account or an org role")
+	ErrInvalidSelector       = errors.New("function selector must be 4 bytes")
+	ErrContractAccessMissing = errors.New("contract access does not exist")
+)
+
+// ContractAccessInfo allows an account, or all accounts linked to a role of an
+// org or its sub orgs, to call a contract. If selectors are given only the
+// functions with those 4 byte selectors can be called.
+type ContractAccessInfo struct {
+	Contract  common.Address  `json:"contract"`
+	AcctId    *common.Address `json:"acctId,omitempty"`
+	OrgId     string          `json:"orgId,omitempty"`
+	RoleId    string          `json:"roleId,omitempty"`
+	Selectors []hexutil.Bytes `json:"selectors,omitempty"`
+}
+
+// Validate checks that the access is given to exactly one of an account or
+// an org role and that the selectors are well formed
+func (ca *ContractAccessInfo) Validate() error {
+	if (ca.AcctId == nil) == (ca.OrgId == "" || ca.RoleId == "") {
+		return ErrInvalidContractAccess
+	}
+	for _, s := range ca.Selectors {
+		if len(s) != SelectorLength {
+			return ErrInvalidSelector
+		}
+	}
+	return nil
+}
+
+// sameGrantee returns whether both entries give access to the same account or role
+func (ca *ContractAccessInfo) sameGrantee(o *ContractAccessInfo) bool {
+	if ca.AcctId != nil || o.AcctId != nil {
+		return ca.AcctId != nil && o.AcctId != nil && *ca.AcctId == *o.AcctId
+	}
+	return ca.OrgId == o.OrgId && ca.RoleId == o.RoleId
+}
+
+// allowsSelector checks if the call data is for one of the allowed functions
+func (ca *ContractAccessInfo) allowsSelector(payload []byte) bool {
+	if len(ca.Selectors) == 0 {
+		return true
+	}
+	if len(payload) < SelectorLength {
+		return false
+	}
+	for _, s := range ca.Selectors {
+		if bytes.Equal(s, payload[:SelectorLength]) {
+			return true
+		}
+	}
+	return false
+}
+
+// ContractCache holds the contract level allow lists. Contracts without any
+// entry can be called by every account with contract call access.
+type ContractCache struct {
+	mux      sync.RWMutex
+	accesses map[common.Address][]*ContractAccessInfo
+}
+
+var ContractAccessMap = NewContractCache()
+
+func NewContractCache() *ContractCache {
+	return &ContractCache{accesses: make(map[common.Address][]*ContractAccessInfo)}
+}
+
+// UpsertContractAccess adds the access or replaces the selectors of an
+// existing access for the same account or role
+func (c *ContractCache) UpsertContractAccess(access ContractAccessInfo) error {
+	if err := access.Validate(); err != nil {
+		return err
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	list := c.accesses[access.Contract]
+	for i, ca := range list {
+		if ca.sameGrantee(&access) {
+			list[i] = &access
+			return nil
+		}
+	}
+	c.accesses[access.Contract] = append(list, &access)
+	return nil
+}
+
+// RemoveContractAccess removes the access of the account or role to the contract
+func (c *ContractCache) RemoveContractAccess(access ContractAccessInfo) error {
+	if err := access.Validate(); err != nil {
+		return err
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	list := c.accesses[access.Contract]
+	for i, ca := range list {
+		if ca.sameGrantee(&access) {
+			list = append(list[:i], list[i+1:]...)
+			if len(list) == 0 {
+				delete(c.accesses, access.Contract)
+			} else {
+				c.accesses[access.Contract] = list
+			}
+			return nil
+		}
+	}
+	return ErrContractAccessMissing
+}
+
+func (c *ContractCache) GetContractAccessList() []ContractAccessInfo {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	var clist []ContractAccessInfo
+	for _, list := range c.accesses {
+		for _, ca := range list {
+			clist = append(clist, *ca)
+		}
+	}
+	sort.SliceStable(clist, func(i, j int) bool {
+		return bytes.Compare(clist[i].Contract[:], clist[j].Contract[:]) < 0
+	})
+	return clist
+}
+
+// CheckContractAccess checks if the account can call the function of the
+// contract given in the payload
+func (c *ContractCache) CheckContractAccess(from common.Address, contract common.Address, payload []byte) error {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	list, ok := c.accesses[contract]
+	if !ok {
+		return nil
+	}
+	a, _ := AcctInfoMap.GetAccount(from)
+	for _, ca := range list {
+		if !ca.allowsSelector(payload) {
+			continue
+		}
+		if ca.AcctId != nil {
+			if *ca.AcctId == from {
+				return nil
+			}
+			continue
+		}
+		if a != nil && a.Status == AcctActive && a.RoleId == ca.RoleId && checkIfOrgActive(a.OrgId) {
+			if a.OrgId == ca.OrgId {
+				return nil
+			}
+			if o, _ := OrgInfoMap.GetOrg(a.OrgId); o != nil && o.UltimateParent == ca.OrgId {
+				return nil
+			}
+		}
+	}
+	return ErrNoContractAccess
+}
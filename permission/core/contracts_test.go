@@ -0,0 +1,62 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+func TestContractCache_CheckContractAccess(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	OrgInfoMap = NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	AcctInfoMap = NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	ContractAccessMap = NewContractCache()
+
+	OrgInfoMap.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgApproved)
+	OrgInfoMap.UpsertOrg("SUB1", ORGADMIN, ORGADMIN, big.NewInt(2), OrgApproved)
+	AcctInfoMap.UpsertAccount(ORGADMIN+".SUB1", "ROLE1", Acct1, false, AcctActive)
+
+	contract := common.BytesToAddress([]byte("contract"))
+	transfer := hexutil.Bytes{0xa9, 0x05, 0x9c, 0xbb}
+	approve := hexutil.Bytes{0x09, 0x5e, 0xa7, 0xb3}
+	payload := append(transfer, make([]byte, 32)...)
+
+	// contracts without allow list can be called by everyone
+	assert.True(ContractAccessMap.CheckContractAccess(Acct2, contract, payload) == nil, "Expected unrestricted contract")
+
+	// invalid entries are rejected
+	err := ContractAccessMap.UpsertContractAccess(ContractAccessInfo{Contract: contract, OrgId: ORGADMIN})
+	assert.True(err == ErrInvalidContractAccess, fmt.Sprintf("Expected %v, got %v", ErrInvalidContractAccess, err))
+	err = ContractAccessMap.UpsertContractAccess(ContractAccessInfo{Contract: contract, AcctId: &Acct2, Selectors: []hexutil.Bytes{{0x01}}})
+	assert.True(err == ErrInvalidSelector, fmt.Sprintf("Expected %v, got %v", ErrInvalidSelector, err))
+
+	// role access covers the accounts of the sub orgs, restricted to the selectors
+	err = ContractAccessMap.UpsertContractAccess(ContractAccessInfo{Contract: contract, OrgId: ORGADMIN, RoleId: "ROLE1", Selectors: []hexutil.Bytes{transfer}})
+	assert.True(err == nil, "errors encountered")
+	assert.True(ContractAccessMap.CheckContractAccess(Acct1, contract, payload) == nil, "Expected role to have access")
+	assert.True(ContractAccessMap.CheckContractAccess(Acct1, contract, approve) == ErrNoContractAccess, "Expected selector to be denied")
+	assert.True(ContractAccessMap.CheckContractAccess(Acct1, contract, nil) == ErrNoContractAccess, "Expected plain transfer to be denied")
+	assert.True(ContractAccessMap.CheckContractAccess(Acct2, contract, payload) == ErrNoContractAccess, "Expected account without role to be denied")
+
+	// account access without selectors allows every function
+	err = ContractAccessMap.UpsertContractAccess(ContractAccessInfo{Contract: contract, AcctId: &Acct2})
+	assert.True(err == nil, "errors encountered")
+	assert.True(ContractAccessMap.CheckContractAccess(Acct2, contract, approve) == nil, "Expected account to have access")
+	assert.True(len(ContractAccessMap.GetContractAccessList()) == 2, "Expected 2 entries")
+
+	// suspended accounts lose the role access
+	AcctInfoMap.UpsertAccount(ORGADMIN+".SUB1", "ROLE1", Acct1, false, AcctSuspended)
+	assert.True(ContractAccessMap.CheckContractAccess(Acct1, contract, payload) == ErrNoContractAccess, "Expected suspended account to be denied")
+
+	// removing the last entry lifts the restriction
+	assert.True(ContractAccessMap.RemoveContractAccess(ContractAccessInfo{Contract: contract, OrgId: ORGADMIN, RoleId: "ROLE1"}) == nil)
+	assert.True(ContractAccessMap.RemoveContractAccess(ContractAccessInfo{Contract: contract, OrgId: ORGADMIN, RoleId: "ROLE1"}) == ErrContractAccessMissing)
+	assert.True(ContractAccessMap.RemoveContractAccess(ContractAccessInfo{Contract: contract, AcctId: &Acct2}) == nil)
+	assert.True(ContractAccessMap.CheckContractAccess(Acct1, contract, payload) == nil, "Expected unrestricted contract")
+}
@@ -0,0 +1,306 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+var orgStatusNames = map[uint8]string{
+	uint8(OrgPendingApproval):   "pending approval",
+	uint8(OrgApproved):          "approved",
+	uint8(OrgPendingSuspension): "pending suspension",
+	uint8(OrgSuspended):         "suspended",
+}
+
+var nodeStatusNames = map[uint8]string{
+	uint8(NodePendingApproval):   "pending approval",
+	uint8(NodeApproved):          "approved",
+	uint8(NodeDeactivated):       "deactivated",
+	uint8(NodeBlackListed):       "blacklisted",
+	uint8(NodeRecoveryInitiated): "recovery initiated",
+}
+
+var acctStatusNames = map[uint8]string{
+	uint8(AcctPendingApproval):   "pending approval",
+	uint8(AcctActive):            "active",
+	uint8(AcctInactive):          "inactive",
+	uint8(AcctSuspended):         "suspended",
+	uint8(AcctBlacklisted):       "blacklisted",
+	uint8(AdminRevoked):          "admin revoked",
+	uint8(AcctRecoveryInitiated): "recovery initiated",
+	uint8(AcctRecoveryCompleted): "recovery completed",
+}
+
+var accessTypeNames = map[uint8]string{
+	uint8(ReadOnly):                  "read only",
+	uint8(Transact):                  "transact",
+	uint8(ContractDeploy):            "contract deploy",
+	uint8(FullAccess):                "full access",
+	uint8(ContractCall):              "contract call",
+	uint8(TransactAndContractCall):   "transact and contract call",
+	uint8(TransactAndContractDeploy): "transact and contract deploy",
+	uint8(ContractCallAndDeploy):     "contract call and deploy",
+}
+
+var txnTypeNames = map[uint8]string{
+	uint8(ValueTransferTxn):  "value transfer",
+	uint8(ContractCallTxn):   "contract call",
+	uint8(ContractDeployTxn): "contract deploy",
+}
+
+// valueName returns the name of a status or type value
+func valueName(names map[uint8]string, v uint8) string {
+	if n, ok := names[v]; ok {
+		return n
+	}
+	return fmt.Sprintf("unknown (%d)", v)
+}
+
+// DecisionStep is one check on the path of a permission decision
+type DecisionStep struct {
+	Check   string `json:"check"`
+	Subject string `json:"subject,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Passed  bool   `json:"passed"`
+	Reason  string `json:"reason,omitempty"`
+}
+
+// DecisionOrg is an org on the path from the org of an account or node up
+// to its ultimate parent
+type DecisionOrg struct {
+	OrgId  string    `json:"orgId"`
+	Status OrgStatus `json:"status"`
+}
+
+// PermissionDecision explains the decision on a transaction or connection:
+// the records it is based on, the checks in the order they are made and the
+// reason of the first one failing
+type PermissionDecision struct {
+	Allowed  bool           `json:"allowed"`
+	Reason   string         `json:"reason,omitempty"`
+	Account  *AccountInfo   `json:"account,omitempty"`
+	Node     *NodeInfo      `json:"node,omitempty"`
+	OrgChain []DecisionOrg  `json:"orgChain,omitempty"`
+	Role     *RoleInfo      `json:"role,omitempty"`
+	Access   *AccessType    `json:"access,omitempty"`
+	Steps    []DecisionStep `json:"steps"`
+}
+
+// check adds a step and records its reason as the reason of the decision if
+// it is the first one failing
+func (d *PermissionDecision) check(step DecisionStep) bool {
+	d.Steps = append(d.Steps, step)
+	if !step.Passed && d.Reason == "" {
+		d.Reason = step.Reason
+	}
+	return step.Passed
+}
+
+// orgChain returns the orgs from the given one up to its ultimate parent
+func orgChain(orgId string) []DecisionOrg {
+	var chain []DecisionOrg
+	for id := orgId; id != ""; {
+		if o, _ := OrgInfoMap.GetOrg(id); o != nil {
+			chain = append(chain, DecisionOrg{OrgId: id, Status: o.Status})
+		} else {
+			chain = append(chain, DecisionOrg{OrgId: id})
+		}
+		i := strings.LastIndex(id, ".")
+		if i < 0 {
+			break
+		}
+		id = id[:i]
+	}
+	return chain
+}
+
+// checkOrgs adds the checks of the org and its ultimate parent, which must
+// exist and not be suspended
+func (d *PermissionDecision) checkOrgs(orgId string) bool {
+	d.OrgChain = orgChain(orgId)
+	o, _ := OrgInfoMap.GetOrg(orgId)
+	if o == nil {
+		return d.check(DecisionStep{Check: "org", Subject: orgId, Reason: fmt.Sprintf("org %s does not exist", orgId)})
+	}
+	status := valueName(orgStatusNames, uint8(o.Status))
+	if !d.check(DecisionStep{Check: "org", Subject: orgId, Status: status, Passed: o.Status != OrgSuspended,
+		Reason: fmt.Sprintf("org %s is %s", orgId, status)}) {
+		return false
+	}
+	u, _ := OrgInfoMap.GetOrg(o.UltimateParent)
+	if u == nil || o.UltimateParent == orgId {
+		return true
+	}
+	status = valueName(orgStatusNames, uint8(u.Status))
+	return d.check(DecisionStep{Check: "ultimateParent", Subject: u.OrgId, Status: status, Passed: u.Status != OrgSuspended,
+		Reason: fmt.Sprintf("ultimate parent org %s is %s", u.OrgId, status)})
+}
+
+// ExplainTransaction returns the decision path of the transaction permission
+// check. The verdict comes from the permission model, the records of the
+// sender in the permission cache explain it.
+func ExplainTransaction(from common.Address, to common.Address, value *big.Int, gasPrice *big.Int, gasLimit *big.Int, payload []byte, transactionType TransactionType) *PermissionDecision {
+	d := &PermissionDecision{}
+	if !PermissionsEnabled() {
+		d.check(DecisionStep{Check: "permissions", Status: "not enabled", Passed: true})
+		d.Allowed = true
+		return d
+	}
+	d.accountRecords(from)
+
+	allowed := true
+	enforce := func(step DecisionStep, err error) {
+		step.Passed = err == nil
+		if err != nil {
+			step.Reason = err.Error()
+		}
+		allowed = d.check(step) && allowed
+	}
+	// accounts outside their validity window are treated as inactive
+	if IsV2Permission() && !ValidityMap.IsAccountValid(from) {
+		enforce(DecisionStep{Check: "validity", Subject: from.Hex()}, ErrAccountExpired)
+	}
+	if PermissionTransactionAllowedFunc != nil {
+		err := PermissionTransactionAllowedFunc(from, to, value, gasPrice, gasLimit, payload, transactionType)
+		if err != nil {
+			err = &PermissionError{Err: err, Reason: d.denialReason(from)}
+		}
+		enforce(DecisionStep{Check: "permissionModel"}, err)
+	}
+	if IsV2Permission() && transactionType != ContractDeployTxn {
+		enforce(DecisionStep{Check: "contractAccess", Subject: to.Hex()}, ContractAccessMap.CheckContractAccess(from, to, payload))
+	}
+	d.Allowed = allowed
+	if allowed {
+		d.Reason = ""
+	}
+	return d
+}
+
+// ExplainDenial adds the reason found in the permission cache to a denial of
+// the permission model for a transaction of the account
+func ExplainDenial(from common.Address, err error) error {
+	if pe, ok := err.(*PermissionError); ok && pe.Reason == "" {
+		d := &PermissionDecision{}
+		d.accountRecords(from)
+		return &PermissionError{Err: pe.Err, Reason: d.denialReason(from)}
+	}
+	return err
+}
+
+// accountRecords adds the records of the account, its orgs and its role
+// found in the permission cache, and the access the cache gives the account
+func (d *PermissionDecision) accountRecords(from common.Address) {
+	access := GetAcctAccess(from)
+	d.Access = &access
+	a, _ := AcctInfoMap.GetAccount(from)
+	if a == nil {
+		return
+	}
+	d.Account = a
+	d.OrgChain = orgChain(a.OrgId)
+	r, _ := RoleInfoMap.GetRole(a.OrgId, a.RoleId)
+	if r == nil || !r.Active {
+		if o, _ := OrgInfoMap.GetOrg(a.OrgId); o != nil {
+			if ur, _ := RoleInfoMap.GetRole(o.UltimateParent, a.RoleId); ur != nil && (r == nil || ur.Active) {
+				r = ur
+			}
+		}
+	}
+	d.Role = r
+}
+
+// denialReason describes the first record of the account which is not in
+// good standing, or the access the account has
+func (d *PermissionDecision) denialReason(from common.Address) string {
+	access := valueName(accessTypeNames, uint8(*d.Access))
+	if d.Account == nil {
+		return fmt.Sprintf("account %s is not in any org and has access %s", from.Hex(), access)
+	}
+	if d.Account.Status != AcctActive {
+		return fmt.Sprintf("account %s is %s", from.Hex(), valueName(acctStatusNames, uint8(d.Account.Status)))
+	}
+	for _, o := range d.OrgChain {
+		if o.Status != OrgApproved {
+			return fmt.Sprintf("org %s is %s", o.OrgId, valueName(orgStatusNames, uint8(o.Status)))
+		}
+	}
+	if d.Account.RoleId != networkAdminRole && d.Account.RoleId != orgAdminRole {
+		if d.Role == nil {
+			return fmt.Sprintf("role %s of org %s does not exist, account %s has access %s", d.Account.RoleId, d.Account.OrgId, from.Hex(), access)
+		}
+		if !d.Role.Active {
+			return fmt.Sprintf("role %s of org %s is inactive, account %s has access %s", d.Role.RoleId, d.Role.OrgId, from.Hex(), access)
+		}
+	}
+	return fmt.Sprintf("account %s has access %s", from.Hex(), access)
+}
+
+// ExplainConnection returns the decision path of the connection permission
+// check of a node, given the verdict of the permission model
+func ExplainConnection(enodeId string, allowed bool, modelErr error) *PermissionDecision {
+	d := &PermissionDecision{}
+	if !PermissionsEnabled() {
+		d.check(DecisionStep{Check: "permissions", Status: "not enabled", Passed: true})
+		d.Allowed = true
+		return d
+	}
+	node, err := enode.ParseV4(enodeId)
+	if err != nil {
+		d.check(DecisionStep{Check: "node", Subject: enodeId, Reason: fmt.Sprintf("invalid node: %v", err)})
+		return d
+	}
+	for _, n := range NodeInfoMap.GetNodeList() {
+		if recNode, err := enode.ParseV4(n.Url); err == nil && recNode.ID() == node.ID() {
+			n := n
+			d.Node = &n
+			break
+		}
+	}
+	if d.Node == nil {
+		d.check(DecisionStep{Check: "node", Subject: node.EnodeID(), Status: "not in any org",
+			Reason: fmt.Sprintf("node %s is not in any org", node.EnodeID())})
+	} else {
+		status := valueName(nodeStatusNames, uint8(d.Node.Status))
+		if d.check(DecisionStep{Check: "node", Subject: node.EnodeID(), Status: status, Passed: d.Node.Status == NodeApproved,
+			Reason: fmt.Sprintf("node %s is %s", node.EnodeID(), status)}) && d.checkOrgs(d.Node.OrgId) && NodeCertMap.HasOrgCA(d.Node.OrgId) {
+			d.check(DecisionStep{Check: "certificate", Subject: d.Node.OrgId, Status: "required", Passed: true})
+		}
+	}
+
+	// the checks above explain the verdict, the ones below make it
+	step := DecisionStep{Check: "permissionModel", Passed: modelErr == nil && allowed}
+	if modelErr != nil {
+		step.Reason = modelErr.Error()
+	} else if !allowed {
+		step.Reason = "permission contracts do not allow the connection"
+	}
+	d.Allowed = d.check(step)
+	// approved nodes outside their validity window are treated as inactive
+	if IsV2Permission() && !ValidityMap.IsNodeValid(node.EnodeID()) {
+		d.Allowed = d.check(DecisionStep{Check: "validity", Subject: node.EnodeID(),
+			Reason: "node permission is outside its validity window"}) && d.Allowed
+	}
+	if d.Allowed {
+		d.Reason = ""
+	}
+	return d
+}
+
+// PermissionError is a transaction denied by the permission model, with the
+// reason found in the permission cache once it is explained
+type PermissionError struct {
+	Err    error
+	Reason string
+}
+
+func (e *PermissionError) Error() string {
+	if e.Reason == "" {
+		return e.Err.Error()
+	}
+	return e.Err.Error() + ": " + e.Reason
+}
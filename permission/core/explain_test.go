@@ -0,0 +1,116 @@
+package core
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+var errTestNoPermission = errors.New("account does not have permission for the transaction")
+
+func TestExplainTransaction(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	SetDefaults(NETWORKADMIN, ORGADMIN, false)
+	SetQIP714BlockReached()
+	SetNetworkBootUpCompleted()
+	OrgInfoMap = NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	RoleInfoMap = NewRoleCache(params.DEFAULT_ROLECACHE_SIZE)
+	AcctInfoMap = NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	OrgInfoMap.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgApproved)
+	OrgInfoMap.UpsertOrg("SUB1", ORGADMIN, ORGADMIN, big.NewInt(2), OrgApproved)
+	RoleInfoMap.UpsertRole(ORGADMIN, "ROLE1", false, false, Transact, true)
+	AcctInfoMap.UpsertAccount(ORGADMIN+".SUB1", "ROLE1", Acct1, false, AcctActive)
+
+	// the model only allows value transfers to accounts with access
+	saved := PermissionTransactionAllowedFunc
+	defer func() { PermissionTransactionAllowedFunc = saved }()
+	PermissionTransactionAllowedFunc = func(from common.Address, _ common.Address, _ *big.Int, _ *big.Int, _ *big.Int, _ []byte, transactionType TransactionType) error {
+		if access := GetAcctAccess(from); transactionType != ValueTransferTxn || (access != Transact && access != FullAccess) {
+			return errTestNoPermission
+		}
+		return nil
+	}
+	explain := func(from common.Address, transactionType TransactionType) *PermissionDecision {
+		return ExplainTransaction(from, Acct2, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, transactionType)
+	}
+	denied := func(reason string) string {
+		return errTestNoPermission.Error() + ": " + reason
+	}
+
+	// the role of the ultimate parent applies to the sub org
+	d := explain(Acct1, ValueTransferTxn)
+	assert.True(d.Allowed)
+	assert.Empty(d.Reason)
+	assert.Equal([]DecisionOrg{{ORGADMIN + ".SUB1", OrgApproved}, {ORGADMIN, OrgApproved}}, d.OrgChain)
+	assert.Equal(ORGADMIN, d.Role.OrgId)
+	assert.Equal(Transact, *d.Access)
+
+	// denied by the model for the access the account has
+	d = explain(Acct1, ContractDeployTxn)
+	assert.False(d.Allowed)
+	assert.Equal(denied("account "+Acct1.Hex()+" has access transact"), d.Reason)
+	assert.Equal("permissionModel", d.Steps[len(d.Steps)-1].Check)
+
+	// the permission check itself does not look up the reason
+	err := IsTransactionAllowed(Acct1, common.Address{}, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, ContractDeployTxn)
+	assert.EqualError(err, errTestNoPermission.Error())
+	err = ExplainDenial(Acct1, err)
+	assert.EqualError(err, denied("account "+Acct1.Hex()+" has access transact"))
+	assert.Equal(errTestNoPermission, err.(*PermissionError).Err)
+	assert.Equal(ErrAccountExpired, ExplainDenial(Acct1, ErrAccountExpired))
+
+	// inactive role
+	RoleInfoMap.UpsertRole(ORGADMIN, "ROLE1", false, false, Transact, false)
+	d = explain(Acct1, ValueTransferTxn)
+	assert.False(d.Allowed)
+	assert.Equal(denied("role ROLE1 of org "+ORGADMIN+" is inactive, account "+Acct1.Hex()+" has access read only"), d.Reason)
+	RoleInfoMap.UpsertRole(ORGADMIN, "ROLE1", false, false, Transact, true)
+
+	// suspended ultimate parent
+	OrgInfoMap.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgSuspended)
+	d = explain(Acct1, ValueTransferTxn)
+	assert.False(d.Allowed)
+	assert.Equal(denied("org "+ORGADMIN+" is suspended"), d.Reason)
+	OrgInfoMap.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgApproved)
+
+	// suspended account
+	AcctInfoMap.UpsertAccount(ORGADMIN+".SUB1", "ROLE1", Acct1, false, AcctSuspended)
+	d = explain(Acct1, ValueTransferTxn)
+	assert.False(d.Allowed)
+	assert.Equal(denied("account "+Acct1.Hex()+" is suspended"), d.Reason)
+
+	// accounts outside of orgs get the default access
+	d = explain(Acct2, ValueTransferTxn)
+	assert.False(d.Allowed)
+	assert.Equal(denied("account "+Acct2.Hex()+" is not in any org and has access read only"), d.Reason)
+}
+
+func TestExplainConnection(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	SetDefaults(NETWORKADMIN, ORGADMIN, false)
+	SetQIP714BlockReached()
+	SetNetworkBootUpCompleted()
+	OrgInfoMap = NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	NodeInfoMap = NewNodeCache(params.DEFAULT_NODECACHE_SIZE)
+	OrgInfoMap.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgApproved)
+	NodeInfoMap.UpsertNode(ORGADMIN, NODE1, NodeApproved)
+	NodeInfoMap.UpsertNode(ORGADMIN, NODE2, NodeBlackListed)
+
+	d := ExplainConnection(NODE1, true, nil)
+	assert.True(d.Allowed)
+	assert.Equal(ORGADMIN, d.Node.OrgId)
+
+	d = ExplainConnection(NODE2, false, nil)
+	assert.False(d.Allowed)
+	assert.Contains(d.Reason, "is blacklisted")
+
+	d = ExplainConnection(NODE1, false, errors.New("contract call failed"))
+	assert.False(d.Allowed)
+	assert.Equal("contract call failed", d.Reason)
+}
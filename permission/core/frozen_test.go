@@ -0,0 +1,26 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestCheckContractFrozen(t *testing.T) {
+	t.Cleanup(func() {
+		frozenContractsMux.Lock()
+		frozenContracts = make(map[common.Address]bool)
+		frozenContractsMux.Unlock()
+	})
+	frozen, other := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	FreezeContracts(frozen, common.Address{})
+	if err := CheckContractFrozen(&frozen); err != ErrFrozenContract {
+		t.Errorf("expected frozen contract to be rejected, got %v", err)
+	}
+	if err := CheckContractFrozen(&other); err != nil {
+		t.Errorf("expected other contract to be accepted, got %v", err)
+	}
+	if err := CheckContractFrozen(nil); err != nil {
+		t.Errorf("expected contract creation to be accepted, got %v", err)
+	}
+}
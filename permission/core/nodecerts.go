@@ -0,0 +1,325 @@
+package core
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// scheme of the certificate URI naming the node a certificate is issued to,
+// e.g. enode://<hex public key>
+const nodeCertURIScheme = "enode"
+
+var (
+	ErrInvalidOrgCA        = errors.New("certificate authority must be a PEM encoded CA certificate for an org")
+	ErrOrgCAExists         = errors.New("certificate authority already exists for the org")
+	ErrOrgCAMissing        = errors.New("certificate authority does not exist")
+	ErrNodeCertMissing     = errors.New("node did not present a certificate")
+	ErrNodeCertNotForNode  = errors.New("node certificate is not issued to the node")
+	ErrNodeCertNoOrg       = errors.New("node certificate does not name an org")
+	ErrNodeCertOrgMismatch = errors.New("node certificate is not for the org owning the node")
+)
+
+// OrgCAInfo is a certificate authority issuing the certificates of the nodes
+// of an org and its sub orgs
+type OrgCAInfo struct {
+	OrgId       string `json:"orgId"`
+	Certificate string `json:"certificate"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// parse validates the certificate authority and returns its certificate
+func (ci *OrgCAInfo) parse() (*x509.Certificate, error) {
+	if ci.OrgId == "" {
+		return nil, ErrInvalidOrgCA
+	}
+	block, _ := pem.Decode([]byte(ci.Certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, ErrInvalidOrgCA
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", ErrInvalidOrgCA, err)
+	}
+	if !cert.IsCA {
+		return nil, ErrInvalidOrgCA
+	}
+	return cert, nil
+}
+
+// DER validates the certificate authority and returns its DER encoding, which
+// the permission contracts store
+func (ci *OrgCAInfo) DER() ([]byte, error) {
+	cert, err := ci.parse()
+	if err != nil {
+		return nil, err
+	}
+	return cert.Raw, nil
+}
+
+// OrgCAFromDER returns the certificate authority of the org stored in the
+// permission contracts as DER certificate
+func OrgCAFromDER(orgId string, der []byte) OrgCAInfo {
+	return OrgCAInfo{
+		OrgId:       orgId,
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+	}
+}
+
+// certFingerprint returns the hex SHA-256 digest of a DER certificate
+func certFingerprint(der []byte) string {
+	digest := sha256.Sum256(der)
+	return hex.EncodeToString(digest[:])
+}
+
+// ParseCertFingerprint decodes the hex SHA-256 digest of a certificate
+func ParseCertFingerprint(fingerprint string) ([32]byte, error) {
+	var digest [32]byte
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.ToLower(fingerprint), "0x"))
+	if err != nil || len(b) != len(digest) {
+		return digest, ErrOrgCAMissing
+	}
+	copy(digest[:], b)
+	return digest, nil
+}
+
+// NodeCertCache holds the certificate authorities of orgs and the certificates
+// nodes presented. A certificate binds a node to an org: it is issued to the
+// node's public key as URI SAN enode://<hex public key>, names the org as
+// subject organization and chains to a certificate authority of the org or
+// one of its parent orgs.
+type NodeCertCache struct {
+	mux   sync.RWMutex
+	cas   map[string][]*OrgCAInfo
+	roots map[string][]*x509.Certificate
+	nodes map[string][][]byte
+}
+
+var NodeCertMap = NewNodeCertCache()
+
+func NewNodeCertCache() *NodeCertCache {
+	return &NodeCertCache{
+		cas:   make(map[string][]*OrgCAInfo),
+		roots: make(map[string][]*x509.Certificate),
+		nodes: make(map[string][][]byte),
+	}
+}
+
+// AddOrgCA adds a certificate authority of the org. Orgs may have more than
+// one, so that the authority can be rotated.
+func (c *NodeCertCache) AddOrgCA(ca OrgCAInfo) error {
+	cert, err := ca.parse()
+	if err != nil {
+		return err
+	}
+	ca.Fingerprint = certFingerprint(cert.Raw)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	for _, e := range c.cas[ca.OrgId] {
+		if e.Fingerprint == ca.Fingerprint {
+			return ErrOrgCAExists
+		}
+	}
+	c.cas[ca.OrgId] = append(c.cas[ca.OrgId], &ca)
+	c.roots[ca.OrgId] = append(c.roots[ca.OrgId], cert)
+	return nil
+}
+
+// RemoveOrgCA removes the certificate authority with the given fingerprint
+// from the org
+func (c *NodeCertCache) RemoveOrgCA(orgId, fingerprint string) error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	fingerprint = strings.ToLower(strings.TrimPrefix(fingerprint, "0x"))
+	for i, e := range c.cas[orgId] {
+		if e.Fingerprint != fingerprint {
+			continue
+		}
+		c.cas[orgId] = append(c.cas[orgId][:i], c.cas[orgId][i+1:]...)
+		c.roots[orgId] = append(c.roots[orgId][:i], c.roots[orgId][i+1:]...)
+		if len(c.cas[orgId]) == 0 {
+			delete(c.cas, orgId)
+			delete(c.roots, orgId)
+		}
+		return nil
+	}
+	return ErrOrgCAMissing
+}
+
+func (c *NodeCertCache) GetOrgCAList() []OrgCAInfo {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	list := make([]OrgCAInfo, 0, len(c.cas))
+	for _, cas := range c.cas {
+		for _, ca := range cas {
+			list = append(list, *ca)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].OrgId != list[j].OrgId {
+			return list[i].OrgId < list[j].OrgId
+		}
+		return list[i].Fingerprint < list[j].Fingerprint
+	})
+	return list
+}
+
+// orgRoots returns the certificate authorities covering the org, which are
+// the ones of the org and of its parent orgs
+func (c *NodeCertCache) orgRoots(orgId string) *x509.CertPool {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	var pool *x509.CertPool
+	for id := orgId; id != ""; {
+		for _, cert := range c.roots[id] {
+			if pool == nil {
+				pool = x509.NewCertPool()
+			}
+			pool.AddCert(cert)
+		}
+		i := strings.LastIndex(id, ".")
+		if i < 0 {
+			break
+		}
+		id = id[:i]
+	}
+	return pool
+}
+
+// HasOrgCA checks if certificates are issued for the nodes of the org
+func (c *NodeCertCache) HasOrgCA(orgId string) bool {
+	return c.orgRoots(orgId) != nil
+}
+
+// VerifyNodeCertificate checks that the chain, leaf first, is a valid
+// certificate of the node for an approved org and returns the org
+func (c *NodeCertCache) VerifyNodeCertificate(enodeId string, chain [][]byte) (string, error) {
+	if len(chain) == 0 {
+		return "", ErrNodeCertMissing
+	}
+	certs := make([]*x509.Certificate, len(chain))
+	for i, der := range chain {
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return "", fmt.Errorf("invalid node certificate: %v", err)
+		}
+		certs[i] = cert
+	}
+	leaf := certs[0]
+	issuedToNode := false
+	for _, uri := range leaf.URIs {
+		if uri.Scheme == nodeCertURIScheme && strings.EqualFold(uri.Host, enodeId) {
+			issuedToNode = true
+			break
+		}
+	}
+	if !issuedToNode {
+		return "", ErrNodeCertNotForNode
+	}
+	if len(leaf.Subject.Organization) == 0 || leaf.Subject.Organization[0] == "" {
+		return "", ErrNodeCertNoOrg
+	}
+	orgId := leaf.Subject.Organization[0]
+	org, err := OrgInfoMap.GetOrg(orgId)
+	if err != nil || org == nil {
+		return "", fmt.Errorf("node certificate is for unknown org %s", orgId)
+	}
+	if org.Status != OrgApproved {
+		return "", fmt.Errorf("node certificate is for org %s which is not approved", orgId)
+	}
+	roots := c.orgRoots(orgId)
+	if roots == nil {
+		return "", fmt.Errorf("org %s has no certificate authority", orgId)
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   time.Now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return "", fmt.Errorf("invalid node certificate: %v", err)
+	}
+	return orgId, nil
+}
+
+// CheckNodeCertificate checks the chain presented by a node owned by the
+// given org. Certificates are only required for the nodes of orgs with a
+// certificate authority, and must then be issued for the owning org.
+func (c *NodeCertCache) CheckNodeCertificate(enodeId, ownerOrg string, chain [][]byte) error {
+	if !c.HasOrgCA(ownerOrg) {
+		return nil
+	}
+	orgId, err := c.VerifyNodeCertificate(enodeId, chain)
+	if err != nil {
+		return err
+	}
+	if orgId != ownerOrg {
+		return ErrNodeCertOrgMismatch
+	}
+	return nil
+}
+
+// SetNodeCertificate records the chain presented by a node
+func (c *NodeCertCache) SetNodeCertificate(enodeId string, chain [][]byte) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if len(chain) == 0 {
+		delete(c.nodes, enodeId)
+		return
+	}
+	c.nodes[enodeId] = chain
+}
+
+// NodeCertificate returns the chain recorded for the node
+func (c *NodeCertCache) NodeCertificate(enodeId string) [][]byte {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	return c.nodes[enodeId]
+}
+
+// ReadNodeCertificate reads the PEM encoded certificate chain of a node, leaf
+// first. A missing file is no chain.
+func ReadNodeCertificate(path string) ([][]byte, error) {
+	blob, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var chain [][]byte
+	for {
+		var block *pem.Block
+		block, blob = pem.Decode(blob)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, err
+		}
+		chain = append(chain, block.Bytes)
+	}
+	if len(chain) == 0 {
+		return nil, fmt.Errorf("%s holds no certificate", path)
+	}
+	return chain, nil
+}
@@ -0,0 +1,144 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/params"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+const certNodeId = "ac6b1096ca56b9f6d004b779ae3728bf83f8e22453404cc3cef16a3d9b96608bc67c4b30db88e0a5a6c6390213f7acbe1153ff6d23ce57380104288ae19373ef"
+
+// newTestCert issues a certificate, self signed if no parent is given
+func newTestCert(t *testing.T, org string, nodeId string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{Organization: []string{org}, CommonName: org},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if nodeId != "" {
+		tmpl.URIs = []*url.URL{{Scheme: nodeCertURIScheme, Host: nodeId}}
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func certPEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+func TestNodeCertCache_VerifyNodeCertificate(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	OrgInfoMap = NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	OrgInfoMap.UpsertOrg(ORGADMIN, "", ORGADMIN, big.NewInt(1), OrgApproved)
+	OrgInfoMap.UpsertOrg("SUB1", ORGADMIN, ORGADMIN, big.NewInt(2), OrgApproved)
+	OrgInfoMap.UpsertOrg("OTHER", "", "OTHER", big.NewInt(1), OrgApproved)
+	certs := NewNodeCertCache()
+
+	ca, caKey := newTestCert(t, ORGADMIN, "", true, nil, nil)
+	inter, interKey := newTestCert(t, ORGADMIN, "", true, ca, caKey)
+	leaf, _ := newTestCert(t, ORGADMIN+".SUB1", certNodeId, false, inter, interKey)
+	chain := [][]byte{leaf.Raw, inter.Raw}
+
+	// only CA certificates are accepted as authorities
+	assert.Equal(ErrInvalidOrgCA, certs.AddOrgCA(OrgCAInfo{OrgId: ORGADMIN, Certificate: certPEM(leaf)}))
+	assert.Equal(ErrInvalidOrgCA, certs.AddOrgCA(OrgCAInfo{OrgId: ORGADMIN, Certificate: "garbage"}))
+
+	// orgs without authority do not require certificates
+	assert.False(certs.HasOrgCA(ORGADMIN + ".SUB1"))
+	assert.NoError(certs.CheckNodeCertificate(certNodeId, ORGADMIN+".SUB1", nil))
+	_, err := certs.VerifyNodeCertificate(certNodeId, chain)
+	assert.Error(err)
+
+	// the authority of the master org covers its sub orgs
+	assert.NoError(certs.AddOrgCA(OrgCAInfo{OrgId: ORGADMIN, Certificate: certPEM(ca)}))
+	assert.Equal(ErrOrgCAExists, certs.AddOrgCA(OrgCAInfo{OrgId: ORGADMIN, Certificate: certPEM(ca)}))
+	assert.True(certs.HasOrgCA(ORGADMIN + ".SUB1"))
+	orgId, err := certs.VerifyNodeCertificate(certNodeId, chain)
+	assert.NoError(err)
+	assert.Equal(ORGADMIN+".SUB1", orgId)
+	assert.NoError(certs.CheckNodeCertificate(certNodeId, ORGADMIN+".SUB1", chain))
+
+	// the certificate must be for the node and the org owning it
+	assert.Equal(ErrNodeCertMissing, certs.CheckNodeCertificate(certNodeId, ORGADMIN+".SUB1", nil))
+	assert.Equal(ErrNodeCertOrgMismatch, certs.CheckNodeCertificate(certNodeId, ORGADMIN, chain))
+	_, err = certs.VerifyNodeCertificate(certNodeId[2:]+"00", chain)
+	assert.Equal(ErrNodeCertNotForNode, err)
+	_, err = certs.VerifyNodeCertificate(certNodeId, chain[:1])
+	assert.Error(err, "Expected chain without intermediate to fail")
+
+	// certificates of another org's authority are rejected
+	otherCA, otherKey := newTestCert(t, "OTHER", "", true, nil, nil)
+	assert.NoError(certs.AddOrgCA(OrgCAInfo{OrgId: "OTHER", Certificate: certPEM(otherCA)}))
+	forged, _ := newTestCert(t, ORGADMIN, certNodeId, false, otherCA, otherKey)
+	_, err = certs.VerifyNodeCertificate(certNodeId, [][]byte{forged.Raw})
+	assert.Error(err)
+
+	// the recorded certificate stops being valid with its authority
+	certs.SetNodeCertificate(certNodeId, chain)
+	assert.NoError(certs.CheckNodeCertificate(certNodeId, ORGADMIN+".SUB1", certs.NodeCertificate(certNodeId)))
+
+	// authorities round trip through their DER encoding in the contracts
+	list := certs.GetOrgCAList()
+	der, err := list[0].DER()
+	assert.NoError(err)
+	stored := OrgCAFromDER(list[0].OrgId, der)
+	assert.Equal(ErrOrgCAExists, certs.AddOrgCA(stored))
+	fingerprint, err := ParseCertFingerprint(list[0].Fingerprint)
+	assert.NoError(err)
+	assert.Equal(sha256.Sum256(der), fingerprint)
+	_, err = ParseCertFingerprint("00")
+	assert.Equal(ErrOrgCAMissing, err)
+
+	assert.Len(list, 2)
+	assert.Equal(ErrOrgCAMissing, certs.RemoveOrgCA(ORGADMIN, "00"))
+	assert.NoError(certs.RemoveOrgCA(ORGADMIN, list[0].Fingerprint))
+	_, err = certs.VerifyNodeCertificate(certNodeId, certs.NodeCertificate(certNodeId))
+	assert.Error(err)
+
+	dir, err := ioutil.TempDir("", "nodecerts")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+
+	// node certificate files hold the chain leaf first
+	certFile := filepath.Join(dir, params.NODE_CERT_FILE)
+	read, err := ReadNodeCertificate(certFile)
+	assert.NoError(err)
+	assert.Nil(read)
+	assert.NoError(ioutil.WriteFile(certFile, []byte(certPEM(leaf)+certPEM(inter)), 0600))
+	read, err = ReadNodeCertificate(certFile)
+	assert.NoError(err)
+	assert.Equal(chain, read)
+}
@@ -1,112 +1,51 @@
 package core
 
 import (
-	"encoding/json"
-	"io/ioutil"
+	"errors"
 	"math/big"
-	"os"
-	"path/filepath"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/log"
-	"github.com/ethereum/go-ethereum/p2p/enode"
-	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
-// check if a given node is permissioned to connect to the change
-func IsNodePermissioned(nodename string, currentNode string, datadir string, direction string) bool {
-	var permissionedList []string
-	nodes := ParsePermissionedNodes(datadir)
-	for _, v := range nodes {
-		permissionedList = append(permissionedList, v.ID().String())
-	}
-
-	log.Debug("IsNodePermissioned", "permissionedList", permissionedList)
-	for _, v := range permissionedList {
-		if v == nodename {
-			log.Debug("IsNodePermissioned", "connection", direction, "nodename", nodename[:params.NODE_NAME_LENGTH], "ALLOWED-BY", currentNode[:params.NODE_NAME_LENGTH])
-			// check if the node is blacklisted
-			return !isNodeBlackListed(nodename, datadir)
-		}
-	}
-	log.Debug("IsNodePermissioned", "connection", direction, "nodename", nodename[:params.NODE_NAME_LENGTH], "DENIED-BY", currentNode[:params.NODE_NAME_LENGTH])
-	return false
-}
-
-//this is a shameless copy from the config.go. It is a duplication of the code
-//for the timebeing to allow reload of the permissioned nodes while the server is running
+var ErrFrozenContract = errors.New("permission contract was replaced by a migration and is frozen")
 
-func ParsePermissionedNodes(DataDir string) []*enode.Node {
-
-	log.Debug("parsePermissionedNodes", "DataDir", DataDir, "file", params.PERMISSIONED_CONFIG)
-
-	path := filepath.Join(DataDir, params.PERMISSIONED_CONFIG)
-	if _, err := os.Stat(path); err != nil {
-		log.Error("Read Error for permissioned-nodes.json file. This is because 'permissioned' flag is specified but no permissioned-nodes.json file is present.", "err", err)
-		return nil
-	}
-	// Load the nodes from the config file
-	blob, err := ioutil.ReadFile(path)
-	if err != nil {
-		log.Error("parsePermissionedNodes: Failed to access nodes", "err", err)
-		return nil
-	}
+var (
+	frozenContractsMux sync.RWMutex
+	frozenContracts    = make(map[common.Address]bool)
+)
 
-	nodelist := []string{}
-	if err := json.Unmarshal(blob, &nodelist); err != nil {
-		log.Error("parsePermissionedNodes: Failed to load nodes", "err", err)
-		return nil
-	}
-	// Interpret the list as a discovery node array
-	var nodes []*enode.Node
-	for _, url := range nodelist {
-		if url == "" {
-			log.Error("parsePermissionedNodes: Node URL blank")
-			continue
+// FreezeContracts marks the permission contracts replaced by a migration.
+// Transactions calling them are no longer accepted by the node.
+func FreezeContracts(addrs ...common.Address) {
+	frozenContractsMux.Lock()
+	defer frozenContractsMux.Unlock()
+	for _, addr := range addrs {
+		if addr != (common.Address{}) {
+			frozenContracts[addr] = true
 		}
-		node, err := enode.ParseV4(url)
-		if err != nil {
-			log.Error("parsePermissionedNodes: Node URL", "url", url, "err", err)
-			continue
-		}
-		nodes = append(nodes, node)
 	}
-	return nodes
 }
 
-// This function checks if the node is black-listed
-func isNodeBlackListed(nodeName, dataDir string) bool {
-	log.Debug("isNodeBlackListed", "DataDir", dataDir, "file", params.BLACKLIST_CONFIG)
-
-	path := filepath.Join(dataDir, params.BLACKLIST_CONFIG)
-	if _, err := os.Stat(path); err != nil {
-		log.Debug("Read Error for disallowed-nodes.json file. disallowed-nodes.json file is not present.", "err", err)
-		return false
-	}
-	// Load the nodes from the config file
-	blob, err := ioutil.ReadFile(path)
-	if err != nil {
-		log.Debug("isNodeBlackListed: Failed to access nodes", "err", err)
-		return true
-	}
-
-	nodelist := []string{}
-	if err := json.Unmarshal(blob, &nodelist); err != nil {
-		log.Debug("parsePermissionedNodes: Failed to load nodes", "err", err)
-		return true
+// CheckContractFrozen checks that the transaction does not call a frozen
+// permission contract. It is checked on submission only, as nodes switch to
+// the migrated contracts independently.
+func CheckContractFrozen(to *common.Address) error {
+	if to == nil {
+		return nil
 	}
-
-	for _, v := range nodelist {
-		n, _ := enode.ParseV4(v)
-		if nodeName == n.ID().String() {
-			return true
-		}
+	frozenContractsMux.RLock()
+	defer frozenContractsMux.RUnlock()
+	if frozenContracts[*to] {
+		return ErrFrozenContract
 	}
-	return false
+	return nil
 }
 
-// function checks for account access to execute the transaction
-func CheckAccountPermission(from common.Address, to *common.Address, value *big.Int, data []byte, gas uint64, gasPrice *big.Int) error {
+// function checks for account access to execute the transaction in the block
+// with the given header
+func CheckAccountPermission(header *types.Header, from common.Address, to *common.Address, value *big.Int, data []byte, gas uint64, gasPrice *big.Int) error {
 	transactionType := ValueTransferTxn
 
 	if to == nil {
@@ -123,5 +62,5 @@ func CheckAccountPermission(from common.Address, to *common.Address, value *big.
 		toAcct = *to
 	}
 
-	return IsTransactionAllowed(from, toAcct, value, gasPrice, big.NewInt(int64(gas)), data, transactionType)
+	return IsTransactionAllowedAt(header.Number.Uint64(), header.Time, from, toAcct, value, gasPrice, big.NewInt(int64(gas)), data, transactionType)
 }
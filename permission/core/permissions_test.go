@@ -19,6 +19,7 @@ const (
 
 func TestIsNodePermissioned(t *testing.T) {
 	type args struct {
+		node        *enode.Node
 		nodename    string
 		currentNode string
 		datadir     string
@@ -40,24 +41,24 @@ func TestIsNodePermissioned(t *testing.T) {
 	}{
 		{
 			name: "node present",
-			args: args{n1.ID().String(), n2.EnodeID(), d, "INWARD"},
+			args: args{n1, n1.ID().String(), n2.EnodeID(), d, "INWARD"},
 			want: true,
 		},
 		{
 			name: "node not present",
-			args: args{n2.ID().String(), n1.EnodeID(), d, "OUTWARD"},
+			args: args{n2, n2.ID().String(), n1.EnodeID(), d, "OUTWARD"},
 			want: false,
 		},
 		{
 			name: "blacklisted node",
-			args: args{n3.ID().String(), n1.EnodeID(), d, "INWARD"},
+			args: args{n3, n3.ID().String(), n1.EnodeID(), d, "INWARD"},
 			want: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := IsNodePermissioned(tt.args.nodename, tt.args.currentNode, tt.args.datadir, tt.args.direction); got != tt.want {
+			if got := IsNodePermissioned(tt.args.node, tt.args.nodename, tt.args.currentNode, tt.args.datadir, tt.args.direction); got != tt.want {
 				t.Errorf("IsNodePermissioned() = %v, want %v", got, tt.want)
 			}
 		})
@@ -67,12 +68,15 @@ func TestIsNodePermissioned(t *testing.T) {
 
 func Test_isNodeBlackListed(t *testing.T) {
 	type args struct {
-		nodeName string
-		dataDir  string
+		node    *enode.Node
+		dataDir string
 	}
 
 	d, _ := ioutil.TempDir("", "qdata")
 	defer os.RemoveAll(d)
+	// both nodes are permissioned so only the deny list decides
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, node1)
+	writeNodeToFile(d, params.PERMISSIONED_CONFIG, node2)
 	writeNodeToFile(d, params.BLACKLIST_CONFIG, node1)
 	n1, _ := enode.ParseV4(node1)
 	n2, _ := enode.ParseV4(node2)
@@ -84,19 +88,19 @@ func Test_isNodeBlackListed(t *testing.T) {
 	}{
 		{
 			name: "blacklisted node",
-			args: args{n1.ID().String(), d},
+			args: args{n1, d},
 			want: true,
 		},
 		{
 			name: "blacklisted node",
-			args: args{n2.ID().String(), d},
+			args: args{n2, d},
 			want: false,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := isNodeBlackListed(tt.args.nodeName, tt.args.dataDir); got != tt.want {
+			if got := !NodeAllowList(tt.args.dataDir).IsAllowed(tt.args.node); got != tt.want {
 				t.Errorf("isNodeBlackListed() = %v, want %v", got, tt.want)
 			}
 		})
@@ -0,0 +1,224 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// separator of the namespace and method of an rpc call
+const rpcMethodSeparator = "_"
+
+var (
+	ErrInvalidRpcRule     = errors.New("rpc rule must be for *, <namespace>_* or <namespace>_<method>")
+	ErrDuplicateRpcRule   = errors.New("rpc rule is given more than once")
+	ErrUnknownRpcIdentity = errors.New("identity is not mapped to an account")
+)
+
+// RpcRule restricts calls of all methods, the methods of a namespace or a
+// single method to accounts with the given on-chain permissions. Conditions
+// which are given must all hold.
+type RpcRule struct {
+	Method       string   `json:"method"`
+	Orgs         []string `json:"orgs,omitempty"`
+	Roles        []string `json:"roles,omitempty"`
+	OrgAdmin     bool     `json:"orgAdmin,omitempty"`
+	NetworkAdmin bool     `json:"networkAdmin,omitempty"`
+}
+
+// Validate checks that the rule is for all methods, a namespace or a method
+func (r *RpcRule) Validate() error {
+	if r.Method == "*" {
+		return nil
+	}
+	elem := strings.SplitN(r.Method, rpcMethodSeparator, 2)
+	if len(elem) != 2 || elem[0] == "" || elem[1] == "" || strings.Contains(elem[0], "*") ||
+		(elem[1] != "*" && strings.Contains(elem[1], "*")) {
+		return ErrInvalidRpcRule
+	}
+	return nil
+}
+
+// allows checks if the account satisfies the conditions of the rule
+func (r *RpcRule) allows(ac *AccountInfo) bool {
+	if r.NetworkAdmin && ac.RoleId != networkAdminRole {
+		return false
+	}
+	if r.OrgAdmin && !ac.IsOrgAdmin {
+		return false
+	}
+	if len(r.Roles) > 0 && !containsString(r.Roles, ac.RoleId) {
+		return false
+	}
+	if len(r.Orgs) > 0 {
+		// an org covers its sub orgs
+		for _, o := range r.Orgs {
+			if ac.OrgId == o || strings.HasPrefix(ac.OrgId, o+".") {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
+// RpcAccessConfig is the content of permission-rpc-access.json. Identities
+// map the identity of an authenticated rpc client to the account its calls
+// are authorized as.
+type RpcAccessConfig struct {
+	Identities map[string]common.Address `json:"identities"`
+	Rules      []RpcRule                 `json:"rules"`
+}
+
+// Validate checks the rules and that no method has more than one rule
+func (c *RpcAccessConfig) Validate() error {
+	seen := make(map[string]bool)
+	for i := range c.Rules {
+		if err := c.Rules[i].Validate(); err != nil {
+			return fmt.Errorf("%s: %v", c.Rules[i].Method, err)
+		}
+		if seen[c.Rules[i].Method] {
+			return fmt.Errorf("%s: %v", c.Rules[i].Method, ErrDuplicateRpcRule)
+		}
+		seen[c.Rules[i].Method] = true
+	}
+	return nil
+}
+
+// RpcAccessCache holds the rules mapping rpc calls to on-chain permissions.
+// Calls without a matching rule are left to the security plugin.
+type RpcAccessCache struct {
+	mux        sync.RWMutex
+	identities map[string]common.Address
+	rules      map[string]*RpcRule
+}
+
+var RpcAccessMap = NewRpcAccessCache()
+
+func NewRpcAccessCache() *RpcAccessCache {
+	return &RpcAccessCache{
+		identities: make(map[string]common.Address),
+		rules:      make(map[string]*RpcRule),
+	}
+}
+
+// Enabled reports whether any rpc call is restricted
+func (c *RpcAccessCache) Enabled() bool {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	return len(c.rules) > 0
+}
+
+// rule returns the most specific rule for the call, if any
+func (c *RpcAccessCache) rule(namespace, method string) *RpcRule {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	for _, m := range []string{namespace + rpcMethodSeparator + method, namespace + rpcMethodSeparator + "*", "*"} {
+		if r, ok := c.rules[m]; ok {
+			return r
+		}
+	}
+	return nil
+}
+
+// Account returns the account the identity is mapped to
+func (c *RpcAccessCache) Account(identity string) (common.Address, bool) {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+	acct, ok := c.identities[identity]
+	return acct, ok
+}
+
+// Authorize checks if the identity may call the method of the namespace,
+// based on the org and role of the account it is mapped to. Rules are
+// enforced from the start of the service, also before the QIP714 block.
+func (c *RpcAccessCache) Authorize(identity, namespace, method string) error {
+	r := c.rule(namespace, method)
+	if r == nil {
+		return nil
+	}
+	call := namespace + rpcMethodSeparator + method
+	if AcctInfoMap == nil {
+		return fmt.Errorf("%s - access denied: permissions are not loaded yet", call)
+	}
+	acct, ok := c.Account(identity)
+	if !ok {
+		return fmt.Errorf("%s - access denied: %v", call, ErrUnknownRpcIdentity)
+	}
+	ac, _ := AcctInfoMap.GetAccount(acct)
+	if ac == nil || (ac.Status != AcctActive && ac.Status != AcctRecoveryCompleted) {
+		return fmt.Errorf("%s - access denied: account %s is not active", call, acct.Hex())
+	}
+	if IsV2Permission() && !ValidityMap.IsAccountValid(acct) {
+		return fmt.Errorf("%s - access denied: %v", call, ErrAccountExpired)
+	}
+	if !r.allows(ac) {
+		return fmt.Errorf("%s - access denied: account %s does not satisfy rule %s", call, acct.Hex(), r.Method)
+	}
+	return nil
+}
+
+// Config returns the identities and rules in use
+func (c *RpcAccessCache) Config() RpcAccessConfig {
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	cfg := RpcAccessConfig{
+		Identities: make(map[string]common.Address, len(c.identities)),
+		Rules:      make([]RpcRule, 0, len(c.rules)),
+	}
+	for id, acct := range c.identities {
+		cfg.Identities[id] = acct
+	}
+	for _, r := range c.rules {
+		cfg.Rules = append(cfg.Rules, *r)
+	}
+	sort.Slice(cfg.Rules, func(i, j int) bool { return cfg.Rules[i].Method < cfg.Rules[j].Method })
+	return cfg
+}
+
+// Load replaces the identities and rules with the ones stored in the given
+// file. A missing file leaves all calls to the security plugin.
+func (c *RpcAccessCache) Load(path string) error {
+	blob, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	var cfg RpcAccessConfig
+	if err := json.Unmarshal(blob, &cfg); err != nil {
+		return err
+	}
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	identities := make(map[string]common.Address, len(cfg.Identities))
+	for id, acct := range cfg.Identities {
+		identities[id] = acct
+	}
+	rules := make(map[string]*RpcRule, len(cfg.Rules))
+	for i := range cfg.Rules {
+		rules[cfg.Rules[i].Method] = &cfg.Rules[i]
+	}
+	c.mux.Lock()
+	c.identities, c.rules = identities, rules
+	c.mux.Unlock()
+	return nil
+}
@@ -0,0 +1,82 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/params"
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+func TestRpcAccessCache_Authorize(t *testing.T) {
+	assert := testifyassert.New(t)
+	retired := common.BytesToAddress([]byte("retired"))
+
+	SetDefaults(NETWORKADMIN, ORGADMIN, false)
+	SetQIP714BlockReached()
+	SetNetworkBootUpCompleted()
+	AcctInfoMap = NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+	AcctInfoMap.UpsertAccount(NETWORKADMIN, NETWORKADMIN, Acct1, true, AcctActive)
+	AcctInfoMap.UpsertAccount(ORGADMIN+".SUB1", "ROLE1", Acct2, false, AcctActive)
+
+	dir, err := ioutil.TempDir("", "rpcaccess")
+	assert.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, params.RPC_ACCESS_CONFIG)
+
+	// without rules all calls are left to the security plugin
+	RpcAccessMap = NewRpcAccessCache()
+	assert.NoError(RpcAccessMap.Load(path))
+	assert.False(RpcAccessMap.Enabled())
+	assert.NoError(RpcAccessMap.Authorize("", "admin", "addPeer"))
+
+	// invalid and duplicate rules are rejected
+	assert.NoError(ioutil.WriteFile(path, []byte(`{"rules": [{"method": "admin*"}]}`), 0644))
+	assert.Error(RpcAccessMap.Load(path))
+	assert.NoError(ioutil.WriteFile(path, []byte(`{"rules": [{"method": "admin_*"}, {"method": "admin_*"}]}`), 0644))
+	assert.Error(RpcAccessMap.Load(path))
+
+	cfg := `{
+		"identities": {"ops": "` + Acct1.Hex() + `", "app": "` + Acct2.Hex() + `", "gone": "` + retired.Hex() + `"},
+		"rules": [
+			{"method": "quorumPermission_*", "orgAdmin": true},
+			{"method": "quorumPermission_orgList"},
+			{"method": "admin_*", "networkAdmin": true},
+			{"method": "eth_sendTransaction", "orgs": ["` + ORGADMIN + `"], "roles": ["ROLE1"]}
+		]
+	}`
+	assert.NoError(ioutil.WriteFile(path, []byte(cfg), 0644))
+	assert.NoError(RpcAccessMap.Load(path))
+	assert.True(RpcAccessMap.Enabled())
+	assert.Len(RpcAccessMap.Config().Rules, 4)
+
+	// methods without a rule are not restricted
+	assert.NoError(RpcAccessMap.Authorize("unknown", "eth", "blockNumber"))
+
+	// namespace rules, with the method rule taking precedence
+	assert.NoError(RpcAccessMap.Authorize("ops", "quorumPermission", "addOrg"))
+	assert.Error(RpcAccessMap.Authorize("app", "quorumPermission", "addOrg"))
+	assert.NoError(RpcAccessMap.Authorize("app", "quorumPermission", "orgList"))
+	assert.NoError(RpcAccessMap.Authorize("ops", "admin", "addPeer"))
+	assert.Error(RpcAccessMap.Authorize("app", "admin", "addPeer"))
+
+	// orgs cover their sub orgs
+	assert.NoError(RpcAccessMap.Authorize("app", "eth", "sendTransaction"))
+	assert.Error(RpcAccessMap.Authorize("ops", "eth", "sendTransaction"))
+
+	// unmapped identities and accounts without permissions are denied
+	assert.EqualError(RpcAccessMap.Authorize("unknown", "admin", "addPeer"), "admin_addPeer - access denied: "+ErrUnknownRpcIdentity.Error())
+	assert.Error(RpcAccessMap.Authorize("gone", "quorumPermission", "orgList"))
+	AcctInfoMap.UpsertAccount(ORGADMIN+".SUB1", "ROLE1", Acct2, false, AcctSuspended)
+	assert.Error(RpcAccessMap.Authorize("app", "eth", "sendTransaction"))
+
+	// restricted calls are denied until the permissions are loaded
+	accounts := AcctInfoMap
+	AcctInfoMap = nil
+	assert.Error(RpcAccessMap.Authorize("ops", "admin", "addPeer"))
+	assert.NoError(RpcAccessMap.Authorize("ops", "eth", "blockNumber"))
+	AcctInfoMap = accounts
+}
@@ -9,7 +9,6 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"sync"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -18,6 +17,7 @@ import (
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/permission/core"
 	"github.com/ethereum/go-ethereum/raft"
 )
 
@@ -46,6 +46,16 @@ type PermissionConfig struct {
 	Accounts      []common.Address `json:"accounts"` //initial list of account that need full access
 	SubOrgDepth   *big.Int         `json:"subOrgDepth"`
 	SubOrgBreadth *big.Int         `json:"subOrgBreadth"`
+
+	// contracts replaced by migrations, which the node no longer accepts
+	// transactions for
+	FrozenContracts []common.Address `json:"frozenContracts,omitempty"`
+}
+
+// Contracts returns the addresses of the permission contracts
+func (pc *PermissionConfig) Contracts() []common.Address {
+	return []common.Address{pc.UpgrdAddress, pc.InterfAddress, pc.ImplAddress, pc.NodeAddress,
+		pc.AccountAddress, pc.RoleAddress, pc.VoterAddress, pc.OrgAddress}
 }
 
 var (
@@ -117,7 +127,6 @@ type StopEvent struct {
 
 // broadcasting stopEvent when service is being stopped
 var StopFeed event.Feed
-var mux sync.Mutex
 
 type NodeOperation uint8
 
@@ -155,45 +164,16 @@ type Backend interface {
 
 // adds or deletes and entry from a given file
 func UpdateFile(fileName, enodeId string, operation NodeOperation, createFile bool) error {
-	// Load the nodes from the config file
-	var nodeList []string
-	index := 0
-	// if createFile is false means the file is already existing. read the file
+	// if createFile is false means the file is already existing
 	if !createFile {
-		blob, err := ioutil.ReadFile(fileName)
-		if err != nil && !createFile {
-			return err
-		}
-
-		if err := json.Unmarshal(blob, &nodeList); err != nil {
+		if _, err := os.Stat(fileName); err != nil {
 			return err
 		}
-
-		// logic to update the permissioned-nodes.json file based on action
-
-		recExists := false
-		for i, eid := range nodeList {
-			if eid == enodeId {
-				index = i
-				recExists = true
-				break
-			}
-		}
-		if (operation == NodeAdd && recExists) || (operation == NodeDelete && !recExists) {
-			return nil
-		}
 	}
-	if operation == NodeAdd {
-		nodeList = append(nodeList, enodeId)
-	} else {
-		nodeList = append(nodeList[:index], nodeList[index+1:]...)
+	err := core.UpdateNodeList(fileName, core.NodeListEntry{Enode: enodeId}, operation == NodeAdd)
+	if err == core.ErrNodeAlreadyListed || err == core.ErrNodeNotListed {
+		return nil
 	}
-	blob, _ := json.Marshal(nodeList)
-
-	mux.Lock()
-	defer mux.Unlock()
-
-	err := ioutil.WriteFile(fileName, blob, 0644)
 	return err
 }
 
@@ -278,6 +258,39 @@ func SubscribeStopEvent() (chan StopEvent, event.Subscription) {
 	return c, s
 }
 
+// broadcasting stopEvent to the watchers of the permission contracts in use,
+// when the service is stopped or the node switches to migrated contracts
+var ContractStopFeed event.Feed
+
+func SubscribeContractStopEvent() (chan StopEvent, event.Subscription) {
+	c := make(chan StopEvent)
+	s := ContractStopFeed.Subscribe(c)
+	return c, s
+}
+
+// ContractWatch collects the event subscriptions of a contract watcher, so
+// that they end with the watcher
+type ContractWatch struct {
+	subs []event.Subscription
+}
+
+// Add records the subscription returned by a Watch call
+func (w *ContractWatch) Add(sub event.Subscription, err error) error {
+	if err != nil {
+		return err
+	}
+	w.subs = append(w.subs, sub)
+	return nil
+}
+
+// Stop ends the recorded subscriptions
+func (w *ContractWatch) Stop() {
+	for _, sub := range w.subs {
+		sub.Unsubscribe()
+	}
+	w.subs = nil
+}
+
 // function reads the permissions config file passed and populates the
 // config structure accordingly
 func ParsePermissionConfig(dir string) (PermissionConfig, error) {
@@ -23,6 +23,10 @@ type TxArgs struct {
 	AcctId     common.Address
 	AccessType uint8
 	Action     uint8
+	Access     core.ContractAccessInfo
+	Validity   core.ValidityInfo
+	OrgCA      core.OrgCAInfo
+	Required   *big.Int // approvals required for the operation type in Action
 	Txa        ethapi.SendTxArgs
 }
 
@@ -46,6 +50,9 @@ type OrgService interface {
 	ApproveOrg(_args TxArgs) (*types.Transaction, error)
 	UpdateOrgStatus(_args TxArgs) (*types.Transaction, error)
 	ApproveOrgStatus(_args TxArgs) (*types.Transaction, error)
+	SetApprovalPolicy(_args TxArgs) (*types.Transaction, error)
+	AddOrgCA(_args TxArgs) (*types.Transaction, error)
+	RemoveOrgCA(_args TxArgs) (*types.Transaction, error)
 }
 
 // Node services
@@ -54,6 +61,7 @@ type NodeService interface {
 	UpdateNodeStatus(_args TxArgs) (*types.Transaction, error)
 	StartBlacklistedNodeRecovery(_args TxArgs) (*types.Transaction, error)
 	ApproveBlacklistedNodeRecovery(_args TxArgs) (*types.Transaction, error)
+	SetNodeValidity(_args TxArgs) (*types.Transaction, error)
 }
 
 // Account services
@@ -64,6 +72,9 @@ type AccountService interface {
 	UpdateAccountStatus(_args TxArgs) (*types.Transaction, error)
 	StartBlacklistedAccountRecovery(_args TxArgs) (*types.Transaction, error)
 	ApproveBlacklistedAccountRecovery(_args TxArgs) (*types.Transaction, error)
+	AddContractAccess(_args TxArgs) (*types.Transaction, error)
+	RemoveContractAccess(_args TxArgs) (*types.Transaction, error)
+	SetAccountValidity(_args TxArgs) (*types.Transaction, error)
 }
 
 // Control services
@@ -76,6 +87,8 @@ type ControlService interface {
 type AuditService interface {
 	ValidatePendingOp(authOrg, orgId, url string, account common.Address, pendingOp int64) bool
 	CheckPendingOp(_orgId string) bool
+	GetPendingOp(_authOrg string) (string, string, common.Address, int64, error)
+	GetApprovalPolicy(_pendingOp int64) (*big.Int, error)
 }
 
 type InitService interface {
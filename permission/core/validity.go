@@ -0,0 +1,191 @@
+package core
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	ErrAccountExpired    = errors.New("account permission is outside its validity window")
+	ErrInvalidValidity   = errors.New("validity window must be given for either an account or a node")
+	ErrEmptyValidity     = errors.New("validity window must have a start or an end")
+	ErrValidityMissing   = errors.New("validity window does not exist")
+	ErrInvalidValidRange = errors.New("validity window ends before it starts")
+)
+
+// ValidityInfo bounds the role assignment of an account or the approval of a
+// node by block height and/or block timestamp. Zero values leave the window
+// open on that side.
+type ValidityInfo struct {
+	Account    *common.Address `json:"account,omitempty"`
+	EnodeId    string          `json:"enodeId,omitempty"`
+	FromBlock  uint64          `json:"fromBlock,omitempty"`
+	UntilBlock uint64          `json:"untilBlock,omitempty"`
+	FromTime   uint64          `json:"fromTime,omitempty"`
+	UntilTime  uint64          `json:"untilTime,omitempty"`
+}
+
+// Validate checks that the window is given for exactly one of an account or
+// a node and is not empty
+func (vi *ValidityInfo) Validate() error {
+	if (vi.Account == nil) == (vi.EnodeId == "") {
+		return ErrInvalidValidity
+	}
+	if vi.FromBlock == 0 && vi.UntilBlock == 0 && vi.FromTime == 0 && vi.UntilTime == 0 {
+		return ErrEmptyValidity
+	}
+	if (vi.UntilBlock != 0 && vi.UntilBlock < vi.FromBlock) || (vi.UntilTime != 0 && vi.UntilTime < vi.FromTime) {
+		return ErrInvalidValidRange
+	}
+	return nil
+}
+
+// valid checks if the block with the given number and time is in the window
+func (vi *ValidityInfo) valid(number, time uint64) bool {
+	if number < vi.FromBlock || (vi.UntilBlock != 0 && number > vi.UntilBlock) {
+		return false
+	}
+	if time < vi.FromTime || (vi.UntilTime != 0 && time > vi.UntilTime) {
+		return false
+	}
+	return true
+}
+
+// expiresWithin checks if the window ends in the given number of blocks or
+// seconds after the block with the given number and time
+func (vi *ValidityInfo) expiresWithin(number, time, blocks, seconds uint64) bool {
+	if vi.UntilBlock != 0 && vi.UntilBlock >= number && vi.UntilBlock <= number+blocks {
+		return true
+	}
+	return vi.UntilTime != 0 && vi.UntilTime >= time && vi.UntilTime <= time+seconds
+}
+
+// returns the number and time of the current chain head, which the validity
+// windows of peers and rpc callers are checked against
+var chainHeadFunc = func() (uint64, uint64) { return 0, 0 }
+
+// SetChainHeadFunc sets the function returning the current chain head
+func SetChainHeadFunc(f func() (uint64, uint64)) {
+	chainHeadFunc = f
+}
+
+// ValidityCache holds the validity windows of accounts and nodes. Accounts
+// and nodes without a window stay valid until their status changes.
+type ValidityCache struct {
+	mux      sync.RWMutex
+	accounts map[common.Address]*ValidityInfo
+	nodes    map[string]*ValidityInfo
+}
+
+var ValidityMap = NewValidityCache()
+
+func NewValidityCache() *ValidityCache {
+	return &ValidityCache{
+		accounts: make(map[common.Address]*ValidityInfo),
+		nodes:    make(map[string]*ValidityInfo),
+	}
+}
+
+// UpsertValidity sets or replaces the validity window of an account or node
+func (v *ValidityCache) UpsertValidity(vi ValidityInfo) error {
+	if err := vi.Validate(); err != nil {
+		return err
+	}
+	v.mux.Lock()
+	defer v.mux.Unlock()
+	if vi.Account != nil {
+		v.accounts[*vi.Account] = &vi
+	} else {
+		v.nodes[vi.EnodeId] = &vi
+	}
+	return nil
+}
+
+// RemoveValidity removes the validity window of an account or node
+func (v *ValidityCache) RemoveValidity(account *common.Address, enodeId string) error {
+	v.mux.Lock()
+	defer v.mux.Unlock()
+	if account != nil {
+		if _, ok := v.accounts[*account]; !ok {
+			return ErrValidityMissing
+		}
+		delete(v.accounts, *account)
+		return nil
+	}
+	if _, ok := v.nodes[enodeId]; !ok {
+		return ErrValidityMissing
+	}
+	delete(v.nodes, enodeId)
+	return nil
+}
+
+func (v *ValidityCache) GetValidityList() []ValidityInfo {
+	v.mux.RLock()
+	defer v.mux.RUnlock()
+
+	vlist := make([]ValidityInfo, 0, len(v.accounts)+len(v.nodes))
+	for _, vi := range v.accounts {
+		vlist = append(vlist, *vi)
+	}
+	for _, vi := range v.nodes {
+		vlist = append(vlist, *vi)
+	}
+	sort.SliceStable(vlist, func(i, j int) bool {
+		if vlist[i].Account != nil && vlist[j].Account != nil {
+			return bytes.Compare(vlist[i].Account[:], vlist[j].Account[:]) < 0
+		}
+		if vlist[i].Account != nil || vlist[j].Account != nil {
+			return vlist[i].Account != nil
+		}
+		return vlist[i].EnodeId < vlist[j].EnodeId
+	})
+	return vlist
+}
+
+// IsAccountValid checks if the account is in its validity window at the
+// current chain head
+func (v *ValidityCache) IsAccountValid(account common.Address) bool {
+	number, time := chainHeadFunc()
+	return v.IsAccountValidAt(account, number, time)
+}
+
+// IsAccountValidAt checks if the account is in its validity window in the
+// block with the given number and time
+func (v *ValidityCache) IsAccountValidAt(account common.Address, number, time uint64) bool {
+	v.mux.RLock()
+	vi, ok := v.accounts[account]
+	v.mux.RUnlock()
+	if !ok {
+		return true
+	}
+	return vi.valid(number, time)
+}
+
+// IsNodeValid checks if the node is in its validity window at the current
+// chain head
+func (v *ValidityCache) IsNodeValid(enodeId string) bool {
+	v.mux.RLock()
+	vi, ok := v.nodes[enodeId]
+	v.mux.RUnlock()
+	if !ok {
+		return true
+	}
+	return vi.valid(chainHeadFunc())
+}
+
+// UpcomingExpiries returns the windows ending within the given number of
+// blocks or seconds from the current chain head
+func (v *ValidityCache) UpcomingExpiries(blocks, seconds uint64) []ValidityInfo {
+	number, time := chainHeadFunc()
+	var vlist []ValidityInfo
+	for _, vi := range v.GetValidityList() {
+		if vi.expiresWithin(number, time, blocks, seconds) {
+			vlist = append(vlist, vi)
+		}
+	}
+	return vlist
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+
+	testifyassert "github.com/stretchr/testify/assert"
+)
+
+func TestValidityCache(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	ValidityMap = NewValidityCache()
+	defer SetChainHeadFunc(func() (uint64, uint64) { return 0, 0 })
+	setChainHead := func(number, time uint64) {
+		SetChainHeadFunc(func() (uint64, uint64) { return number, time })
+	}
+	enodeId := "ac6b1096ca56b9f6d004b779ae3728bf83f8e22453404cc3cef16a3d9b96608bc67c4b30db88e0a5a6c6390213f7acbe1153ff6d23ce57380104288ae19373ef"
+
+	// invalid windows are rejected
+	err := ValidityMap.UpsertValidity(ValidityInfo{UntilBlock: 10})
+	assert.True(err == ErrInvalidValidity, fmt.Sprintf("Expected %v, got %v", ErrInvalidValidity, err))
+	err = ValidityMap.UpsertValidity(ValidityInfo{Account: &Acct1})
+	assert.True(err == ErrEmptyValidity, fmt.Sprintf("Expected %v, got %v", ErrEmptyValidity, err))
+	err = ValidityMap.UpsertValidity(ValidityInfo{Account: &Acct1, FromBlock: 20, UntilBlock: 10})
+	assert.True(err == ErrInvalidValidRange, fmt.Sprintf("Expected %v, got %v", ErrInvalidValidRange, err))
+
+	assert.NoError(ValidityMap.UpsertValidity(ValidityInfo{Account: &Acct1, FromBlock: 5, UntilBlock: 10}))
+	assert.NoError(ValidityMap.UpsertValidity(ValidityInfo{EnodeId: enodeId, UntilTime: 1000}))
+
+	// entries without a window are always valid
+	setChainHead(1, 100)
+	assert.True(ValidityMap.IsAccountValid(Acct2), "Expected account without window to be valid")
+	assert.False(ValidityMap.IsAccountValid(Acct1), "Expected account before its window to be invalid")
+	assert.True(ValidityMap.IsNodeValid(enodeId), "Expected node in its window to be valid")
+
+	setChainHead(8, 990)
+	assert.True(ValidityMap.IsAccountValid(Acct1), "Expected account in its window to be valid")
+	assert.Len(ValidityMap.UpcomingExpiries(2, 0), 1)
+	assert.Len(ValidityMap.UpcomingExpiries(2, 10), 2)
+	assert.Len(ValidityMap.UpcomingExpiries(1, 5), 0)
+
+	setChainHead(11, 1001)
+	assert.False(ValidityMap.IsAccountValid(Acct1), "Expected expired account to be invalid")
+	assert.False(ValidityMap.IsNodeValid(enodeId), "Expected expired node to be invalid")
+	assert.Len(ValidityMap.UpcomingExpiries(100, 100), 0)
+
+	// blocks are checked against their own header rather than the head
+	assert.True(ValidityMap.IsAccountValidAt(Acct1, 10, 0), "Expected account in its window to be valid")
+	assert.False(ValidityMap.IsAccountValidAt(Acct1, 4, 0), "Expected account before its window to be invalid")
+
+	assert.NoError(ValidityMap.RemoveValidity(&Acct1, ""))
+	assert.True(ValidityMap.IsAccountValid(Acct1), "Expected account without window to be valid")
+	err = ValidityMap.RemoveValidity(nil, "unknown")
+	assert.True(err == ErrValidityMissing, fmt.Sprintf("Expected %v, got %v", ErrValidityMissing, err))
+}
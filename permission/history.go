@@ -0,0 +1,443 @@
+package permission
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	goethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	v1bind "github.com/ethereum/go-ethereum/permission/v1/bind"
+	v2bind "github.com/ethereum/go-ethereum/permission/v2/bind"
+)
+
+const (
+	HistoryFormatJSON = "json"
+	HistoryFormatCSV  = "csv"
+)
+
+var (
+	ErrHistoryNotReady          = errors.New("permission history is not indexed yet")
+	ErrInvalidHistoryFormat     = errors.New("export format must be json or csv")
+	ErrInvalidHistoryBlockRange = errors.New("invalid block range")
+)
+
+// PermissionEvent is an event emitted by the permission contracts along with
+// the transaction which caused it
+type PermissionEvent struct {
+	BlockNumber uint64                 `json:"blockNumber"`
+	TxHash      common.Hash            `json:"txHash"`
+	LogIndex    uint                   `json:"logIndex"`
+	Actor       common.Address         `json:"actor"`
+	Contract    common.Address         `json:"contract"`
+	Event       string                 `json:"event"`
+	OrgId       string                 `json:"orgId,omitempty"`
+	EnodeId     string                 `json:"enodeId,omitempty"`
+	Account     *common.Address        `json:"account,omitempty"`
+	RoleId      string                 `json:"roleId,omitempty"`
+	Args        map[string]interface{} `json:"args"`
+}
+
+// HistoryFilter selects permission events. Empty fields match all events.
+type HistoryFilter struct {
+	OrgId     string          `json:"orgId"`
+	Node      string          `json:"node"` // enode id or url
+	Account   *common.Address `json:"account"`
+	RoleId    string          `json:"roleId"`
+	FromBlock *hexutil.Uint64 `json:"fromBlock"`
+	ToBlock   *hexutil.Uint64 `json:"toBlock"`
+}
+
+func (f *HistoryFilter) matches(e *PermissionEvent, enodeId string) bool {
+	if f.FromBlock != nil && e.BlockNumber < uint64(*f.FromBlock) {
+		return false
+	}
+	if f.ToBlock != nil && e.BlockNumber > uint64(*f.ToBlock) {
+		return false
+	}
+	if f.OrgId != "" && e.OrgId != f.OrgId {
+		return false
+	}
+	if enodeId != "" && e.EnodeId != enodeId {
+		return false
+	}
+	if f.Account != nil && (e.Account == nil || *e.Account != *f.Account) {
+		return false
+	}
+	if f.RoleId != "" && e.RoleId != f.RoleId {
+		return false
+	}
+	return true
+}
+
+var (
+	historyEventPrefix      = []byte("permission-history-e") // historyEventPrefix + num (uint64 big endian) + log index (uint32 big endian) -> event
+	historyCheckpointPrefix = []byte("permission-history-c") // historyCheckpointPrefix + contracts hash -> last indexed block
+)
+
+// historyIndexer records the events of the permission contracts in the
+// database. On start only the blocks after the last indexed one are replayed.
+type historyIndexer struct {
+	contracts map[common.Address]abi.ABI
+	sender    func(common.Hash) (common.Address, error)
+	db        ethdb.KeyValueStore
+
+	mux        sync.RWMutex
+	checkpoint uint64
+	ready      bool
+}
+
+func newHistoryIndexer(db ethdb.KeyValueStore, contracts map[common.Address]string, sender func(common.Hash) (common.Address, error)) (*historyIndexer, error) {
+	h := &historyIndexer{
+		contracts: make(map[common.Address]abi.ABI),
+		sender:    sender,
+		db:        db,
+	}
+	for addr, def := range contracts {
+		parsed, err := abi.JSON(strings.NewReader(def))
+		if err != nil {
+			return nil, err
+		}
+		h.contracts[addr] = parsed
+	}
+	if blob, _ := db.Get(h.checkpointKey()); len(blob) == 8 {
+		h.checkpoint = binary.BigEndian.Uint64(blob)
+	}
+	return h, nil
+}
+
+// checkpointKey returns the key of the last indexed block. It depends on the
+// contracts so that switching to new contracts indexes them from the start.
+func (h *historyIndexer) checkpointKey() []byte {
+	addrs := make([]common.Address, 0, len(h.contracts))
+	for addr := range h.contracts {
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i][:], addrs[j][:]) < 0 })
+	var blob []byte
+	for _, addr := range addrs {
+		blob = append(blob, addr[:]...)
+	}
+	return append(append([]byte{}, historyCheckpointPrefix...), crypto.Keccak256(blob)...)
+}
+
+// setCheckpoint records that all the events up to the block are indexed
+func (h *historyIndexer) setCheckpoint(number uint64) {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	if number <= h.checkpoint {
+		return
+	}
+	var blob [8]byte
+	binary.BigEndian.PutUint64(blob[:], number)
+	if err := h.db.Put(h.checkpointKey(), blob[:]); err != nil {
+		log.Warn("Failed to store permission history checkpoint", "number", number, "err", err)
+		return
+	}
+	h.checkpoint = number
+}
+
+func historyEventKey(number uint64, index uint) []byte {
+	key := make([]byte, len(historyEventPrefix)+12)
+	copy(key, historyEventPrefix)
+	binary.BigEndian.PutUint64(key[len(historyEventPrefix):], number)
+	binary.BigEndian.PutUint32(key[len(historyEventPrefix)+8:], uint32(index))
+	return key
+}
+
+// start indexes the events after the checkpoint up to the given head and
+// keeps recording new ones until the permission service stops
+func (h *historyIndexer) start(filterer bind.ContractFilterer, head uint64) error {
+	query := goethereum.FilterQuery{}
+	for addr := range h.contracts {
+		query.Addresses = append(query.Addresses, addr)
+	}
+	logs := make(chan types.Log, 128)
+	sub, err := filterer.SubscribeFilterLogs(context.Background(), query, logs)
+	if err != nil {
+		return fmt.Errorf("failed to watch permission events: %v", err)
+	}
+	h.mux.RLock()
+	checkpoint := h.checkpoint
+	h.mux.RUnlock()
+	if head > checkpoint {
+		query.FromBlock = new(big.Int).SetUint64(checkpoint + 1)
+		query.ToBlock = new(big.Int).SetUint64(head)
+		past, err := filterer.FilterLogs(context.Background(), query)
+		if err != nil {
+			sub.Unsubscribe()
+			return fmt.Errorf("failed to read permission events: %v", err)
+		}
+		for _, l := range past {
+			if err := h.add(l); err != nil {
+				log.Warn("Failed to index permission event", "tx", l.TxHash, "err", err)
+			}
+		}
+		h.setCheckpoint(head)
+	}
+	h.mux.Lock()
+	h.ready = true
+	h.mux.Unlock()
+
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
+	go func() {
+		defer stopSubscription.Unsubscribe()
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case l := <-logs:
+				if err := h.add(l); err != nil {
+					log.Warn("Failed to index permission event", "tx", l.TxHash, "err", err)
+				}
+				// logs are delivered per block, so the earlier blocks are done
+				if !l.Removed && l.BlockNumber > 0 {
+					h.setCheckpoint(l.BlockNumber - 1)
+				}
+			case err := <-sub.Err():
+				log.Error("permission history watch failed", "err", err)
+				return
+			case <-stopChan:
+				log.Info("quit permission history watch")
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// add decodes and stores the log, or drops it if it was removed by a reorg
+func (h *historyIndexer) add(l types.Log) error {
+	key := historyEventKey(l.BlockNumber, l.Index)
+	if l.Removed {
+		return h.remove(key, l.TxHash)
+	}
+	if has, _ := h.db.Has(key); has {
+		if e, err := h.read(key); err == nil && e.TxHash == l.TxHash {
+			return nil
+		}
+	}
+
+	contract, ok := h.contracts[l.Address]
+	if !ok || len(l.Topics) == 0 {
+		return nil
+	}
+	event, err := contract.EventByID(l.Topics[0])
+	if err != nil {
+		return err
+	}
+	values := make(map[string]interface{})
+	if err := event.Inputs.UnpackIntoMap(values, l.Data); err != nil {
+		return err
+	}
+	e := PermissionEvent{
+		BlockNumber: l.BlockNumber,
+		TxHash:      l.TxHash,
+		LogIndex:    l.Index,
+		Contract:    l.Address,
+		Event:       event.Name,
+		Args:        make(map[string]interface{}),
+	}
+	for name, value := range values {
+		e.Args[strings.TrimPrefix(name, "_")] = value
+	}
+	if actor, err := h.sender(l.TxHash); err == nil {
+		e.Actor = actor
+	} else {
+		log.Debug("Failed to get permission event actor", "tx", l.TxHash, "err", err)
+	}
+	e.OrgId, _ = e.Args["orgId"].(string)
+	// org events carry the id relative to the parent org
+	if porgId, _ := e.Args["porgId"].(string); porgId != "" {
+		e.OrgId = porgId + "." + e.OrgId
+	}
+	e.EnodeId, _ = e.Args["enodeId"].(string)
+	e.RoleId, _ = e.Args["roleId"].(string)
+	for _, name := range []string{"account", "vAccount"} {
+		if acct, ok := e.Args[name].(common.Address); ok {
+			e.Account = &acct
+		}
+	}
+
+	blob, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	return h.db.Put(key, blob)
+}
+
+// remove deletes the event of a log removed by a reorg, unless the key was
+// already reused by the new chain
+func (h *historyIndexer) remove(key []byte, txHash common.Hash) error {
+	h.mux.Lock()
+	defer h.mux.Unlock()
+	e, err := h.read(key)
+	if err != nil || e.TxHash != txHash {
+		return nil
+	}
+	return h.db.Delete(key)
+}
+
+// read decodes a stored event. Numbers in the event arguments are kept as
+// json.Number to not lose precision.
+func (h *historyIndexer) read(key []byte) (*PermissionEvent, error) {
+	blob, err := h.db.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	return decodeHistoryEvent(blob)
+}
+
+func decodeHistoryEvent(blob []byte) (*PermissionEvent, error) {
+	dec := json.NewDecoder(bytes.NewReader(blob))
+	dec.UseNumber()
+	e := new(PermissionEvent)
+	if err := dec.Decode(e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// query returns the recorded events matching the filter in chain order
+func (h *historyIndexer) query(filter HistoryFilter) ([]PermissionEvent, error) {
+	if filter.FromBlock != nil && filter.ToBlock != nil && *filter.FromBlock > *filter.ToBlock {
+		return nil, ErrInvalidHistoryBlockRange
+	}
+	enodeId := filter.Node
+	if strings.HasPrefix(enodeId, "enode://") {
+		node, err := enode.ParseV4(enodeId)
+		if err != nil {
+			return nil, ptype.ErrInvalidNode
+		}
+		enodeId = node.EnodeID()
+	}
+
+	h.mux.RLock()
+	defer h.mux.RUnlock()
+	if !h.ready {
+		return nil, ErrHistoryNotReady
+	}
+	var start uint64
+	if filter.FromBlock != nil {
+		start = uint64(*filter.FromBlock)
+	}
+	it := h.db.NewIteratorWithStart(historyEventKey(start, 0))
+	defer it.Release()
+
+	events := []PermissionEvent{}
+	for it.Next() {
+		if !bytes.HasPrefix(it.Key(), historyEventPrefix) {
+			break
+		}
+		e, err := decodeHistoryEvent(it.Value())
+		if err != nil {
+			log.Warn("Failed to decode permission event", "key", hexutil.Encode(it.Key()), "err", err)
+			continue
+		}
+		if filter.ToBlock != nil && e.BlockNumber > uint64(*filter.ToBlock) {
+			break
+		}
+		if filter.matches(e, enodeId) {
+			events = append(events, *e)
+		}
+	}
+	return events, it.Error()
+}
+
+// exportHistory encodes the events in the given format
+func exportHistory(events []PermissionEvent, format string) (string, error) {
+	switch strings.ToLower(format) {
+	case HistoryFormatJSON:
+		blob, err := json.MarshalIndent(events, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(blob), nil
+
+	case HistoryFormatCSV:
+		var buf bytes.Buffer
+		w := csv.NewWriter(&buf)
+		w.Write([]string{"blockNumber", "txHash", "logIndex", "actor", "contract", "event", "orgId", "enodeId", "account", "roleId", "args"})
+		for _, e := range events {
+			var account string
+			if e.Account != nil {
+				account = e.Account.Hex()
+			}
+			args, err := json.Marshal(e.Args)
+			if err != nil {
+				return "", err
+			}
+			w.Write([]string{strconv.FormatUint(e.BlockNumber, 10), e.TxHash.Hex(), strconv.FormatUint(uint64(e.LogIndex), 10), e.Actor.Hex(),
+				e.Contract.Hex(), e.Event, e.OrgId, e.EnodeId, account, e.RoleId, string(args)})
+		}
+		w.Flush()
+		return buf.String(), w.Error()
+
+	default:
+		return "", ErrInvalidHistoryFormat
+	}
+}
+
+// returns the permission contracts emitting events with their abi definition
+func (p *PermissionCtrl) historyContracts() map[common.Address]string {
+	if p.IsV2Permission() {
+		return map[common.Address]string{
+			p.permConfig.OrgAddress:     v2bind.OrgManagerABI,
+			p.permConfig.NodeAddress:    v2bind.NodeManagerABI,
+			p.permConfig.AccountAddress: v2bind.AcctManagerABI,
+			p.permConfig.RoleAddress:    v2bind.RoleManagerABI,
+			p.permConfig.VoterAddress:   v2bind.VoterManagerABI,
+		}
+	}
+	return map[common.Address]string{
+		p.permConfig.OrgAddress:     v1bind.OrgManagerABI,
+		p.permConfig.NodeAddress:    v1bind.NodeManagerABI,
+		p.permConfig.AccountAddress: v1bind.AcctManagerABI,
+		p.permConfig.RoleAddress:    v1bind.RoleManagerABI,
+		p.permConfig.VoterAddress:   v1bind.VoterManagerABI,
+	}
+}
+
+// returns the sender of a transaction in the local chain
+func (p *PermissionCtrl) txSender(hash common.Hash) (common.Address, error) {
+	tx, _, _, _ := rawdb.ReadTransaction(p.eth.ChainDb(), hash)
+	if tx == nil {
+		return common.Address{}, fmt.Errorf("transaction %x not found", hash)
+	}
+	return tx.From(), nil
+}
+
+// indexes the permission contract events for the history api. The history
+// is not needed to run the node, so indexing failures are only logged.
+func (p *PermissionCtrl) indexHistory() error {
+	h, err := newHistoryIndexer(p.eth.ChainDb(), p.historyContracts(), p.txSender)
+	if err != nil {
+		log.Error("Failed to create permission history index", "err", err)
+		return nil
+	}
+	p.history = h
+	if err := h.start(p.ethClnt, p.eth.BlockChain().CurrentBlock().NumberU64()); err != nil {
+		log.Error("Failed to index permission history", "err", err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package permission
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	v2bind "github.com/ethereum/go-ethereum/permission/v2/bind"
+	"github.com/stretchr/testify/assert"
+)
+
+func makeEventLog(t *testing.T, def string, contract common.Address, name string, block uint64, tx common.Hash, args ...interface{}) types.Log {
+	parsed, err := abi.JSON(strings.NewReader(def))
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := parsed.Events[name]
+	data, err := event.Inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{Address: contract, Topics: []common.Hash{event.ID()}, Data: data, BlockNumber: block, TxHash: tx}
+}
+
+func TestHistoryIndexer(t *testing.T) {
+	orgContract := common.BytesToAddress([]byte("org"))
+	nodeContract := common.BytesToAddress([]byte("node"))
+	acctContract := common.BytesToAddress([]byte("acct"))
+	admin := common.BytesToAddress([]byte("admin"))
+	acct := common.BytesToAddress([]byte("account"))
+	enodeId := "ac6b1096ca56b9f6d004b779ae3728bf83f8e22453404cc3cef16a3d9b96608bc67c4b30db88e0a5a6c6390213f7acbe1153ff6d23ce57380104288ae19373ef"
+
+	db := rawdb.NewMemoryDatabase()
+	h, err := newHistoryIndexer(db, map[common.Address]string{
+		orgContract:  v2bind.OrgManagerABI,
+		nodeContract: v2bind.NodeManagerABI,
+		acctContract: v2bind.AcctManagerABI,
+	}, func(common.Hash) (common.Address, error) { return admin, nil })
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.query(HistoryFilter{})
+	assert.Equal(t, ErrHistoryNotReady, err)
+	h.ready = true
+
+	logs := []types.Log{
+		makeEventLog(t, v2bind.OrgManagerABI, orgContract, "OrgApproved", 1, common.HexToHash("0x01"), arbitraryOrgToAdd, "", arbitraryOrgToAdd, big.NewInt(1), big.NewInt(2)),
+		makeEventLog(t, v2bind.OrgManagerABI, orgContract, "OrgApproved", 2, common.HexToHash("0x02"), arbitrarySubOrg, arbitraryOrgToAdd, arbitraryOrgToAdd, big.NewInt(2), big.NewInt(2)),
+		makeEventLog(t, v2bind.NodeManagerABI, nodeContract, "NodeApproved", 3, common.HexToHash("0x03"), enodeId, "127.0.0.1", uint16(21000), uint16(50401), arbitraryOrgToAdd),
+		makeEventLog(t, v2bind.AcctManagerABI, acctContract, "AccountStatusChanged", 4, common.HexToHash("0x04"), acct, arbitraryOrgToAdd, big.NewInt(3)),
+	}
+	for _, l := range logs {
+		assert.NoError(t, h.add(l))
+	}
+	// replayed logs are recorded once
+	assert.NoError(t, h.add(logs[0]))
+
+	events, err := h.query(HistoryFilter{})
+	assert.NoError(t, err)
+	assert.Len(t, events, 4)
+	assert.Equal(t, "OrgApproved", events[0].Event)
+	assert.Equal(t, admin, events[0].Actor)
+	assert.Equal(t, arbitraryOrgToAdd+"."+arbitrarySubOrg, events[1].OrgId)
+
+	events, _ = h.query(HistoryFilter{OrgId: arbitraryOrgToAdd})
+	assert.Len(t, events, 3)
+	events, _ = h.query(HistoryFilter{Node: arbitraryNode1})
+	assert.Len(t, events, 1)
+	assert.Equal(t, "NodeApproved", events[0].Event)
+	events, _ = h.query(HistoryFilter{Account: &acct})
+	assert.Len(t, events, 1)
+	assert.Equal(t, json.Number("3"), events[0].Args["status"])
+
+	from, to := hexutil.Uint64(2), hexutil.Uint64(3)
+	events, _ = h.query(HistoryFilter{FromBlock: &from, ToBlock: &to})
+	assert.Len(t, events, 2)
+	_, err = h.query(HistoryFilter{FromBlock: &to, ToBlock: &from})
+	assert.Equal(t, ErrInvalidHistoryBlockRange, err)
+
+	// the index and checkpoint survive a restart
+	h.setCheckpoint(4)
+	restarted, err := newHistoryIndexer(db, map[common.Address]string{
+		orgContract:  v2bind.OrgManagerABI,
+		nodeContract: v2bind.NodeManagerABI,
+		acctContract: v2bind.AcctManagerABI,
+	}, func(common.Hash) (common.Address, error) { return admin, nil })
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4), restarted.checkpoint)
+	restarted.ready = true
+	events, _ = restarted.query(HistoryFilter{})
+	assert.Len(t, events, 4)
+
+	// logs removed by a reorg are dropped
+	removed := logs[3]
+	removed.Removed = true
+	assert.NoError(t, h.add(removed))
+	events, _ = h.query(HistoryFilter{Account: &acct})
+	assert.Len(t, events, 0)
+
+	// export
+	events, _ = h.query(HistoryFilter{})
+	out, err := exportHistory(events, "JSON")
+	assert.NoError(t, err)
+	var decoded []PermissionEvent
+	assert.NoError(t, json.Unmarshal([]byte(out), &decoded))
+	assert.Len(t, decoded, 3)
+
+	out, err = exportHistory(events, HistoryFormatCSV)
+	assert.NoError(t, err)
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, 4)
+	assert.Equal(t, "NodeApproved", records[3][5])
+
+	_, err = exportHistory(events, "xml")
+	assert.Equal(t, ErrInvalidHistoryFormat, err)
+}
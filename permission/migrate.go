@@ -0,0 +1,1165 @@
+package permission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	"github.com/ethereum/go-ethereum/permission/v2"
+	v2bind "github.com/ethereum/go-ethereum/permission/v2/bind"
+)
+
+// The migration moves the permissions of a running network to a fresh
+// deployment of the latest permissions model. The new contracts are deployed
+// and populated from the migrating network admin account while the old ones
+// stay in force. Once the new contracts hold the same orgs, roles, nodes and
+// accounts as the permission cache, every node is scheduled to switch
+// permission-config.json to them after the same block.
+//
+// The node stops sending permission changes to the old contracts once the
+// migration started, and no longer accepts transactions calling them after
+// the switch. Changes made on other nodes in the meantime show up as
+// differences and block the switch.
+
+// further actions of migration steps, named after the api calls they submit
+const (
+	ActionRemoveRole          = "removeRole"
+	ActionUpdateOrgStatus     = "updateOrgStatus"
+	ActionUpdateNodeStatus    = "updateNodeStatus"
+	ActionUpdateAccountStatus = "updateAccountStatus"
+)
+
+// StepPendingSigner marks a migration step whose sender is not an account of
+// this node; the migration resumes when run on the sender's node
+const StepPendingSigner StepStatus = "pendingSigner"
+
+var (
+	ErrMigrationPending    = errors.New("permissions have changes pending approval, complete them before migrating")
+	ErrMigrationScheduled  = errors.New("permission migration is already scheduled")
+	ErrMigrationNotFound   = errors.New("no permission migration in progress")
+	ErrSwitchBlockPassed   = errors.New("switch block must be after the current block")
+	ErrInvalidMigration    = errors.New("migration config must give the contracts of the latest permissions model")
+	ErrNoDeployBackend     = errors.New("permission client cannot wait for transactions")
+	ErrMigrationTxFailed   = errors.New("migration transaction failed")
+	ErrMigrationTxTimedOut = errors.New("timed out waiting for the migration transaction")
+	ErrMigrationInProgress = errors.New("permission contracts are frozen while a migration is in progress")
+	ErrMigrationSigner     = errors.New("migration must be run from an unlocked account of this node")
+)
+
+// time to wait for a migration transaction to be mined
+var migrationTxTimeout = 60 * time.Second
+
+// PermissionSnapshot lists the orgs, roles, nodes and accounts of a set of
+// permission contracts, as held in the permission cache
+type PermissionSnapshot struct {
+	Orgs     []pcore.OrgInfo     `json:"orgs"`
+	Roles    []pcore.RoleInfo    `json:"roles"`
+	Nodes    []pcore.NodeInfo    `json:"nodes"`
+	Accounts []pcore.AccountInfo `json:"accounts"`
+}
+
+// MigrationSchedule is the state of a migration, stored in
+// permission-migration.json of the data directory
+type MigrationSchedule struct {
+	Config      ptype.PermissionConfig `json:"config"`      // of the new contracts
+	SwitchBlock uint64                 `json:"switchBlock"` // 0 while the new contracts are populated
+}
+
+// due checks if the node should have switched at the given block
+func (s *MigrationSchedule) due(number uint64) bool {
+	return s.SwitchBlock != 0 && number >= s.SwitchBlock
+}
+
+// MigrationStep is a single api call populating the new contracts. Steps
+// without a sender are submitted by the migrating network admin; the others
+// need the org admin of the org.
+type MigrationStep struct {
+	ReconcileStep
+	Op     uint8           `json:"op,omitempty"` // status update action
+	Sender *common.Address `json:"sender,omitempty"`
+}
+
+func (s MigrationStep) String() string {
+	switch s.Action {
+	case ActionRemoveRole:
+		return fmt.Sprintf("%s %s in %s", s.Action, s.RoleId, s.OrgId)
+	case ActionUpdateOrgStatus, ActionApproveOrgStatus:
+		return fmt.Sprintf("%s %s with action %d", s.Action, s.OrgId, s.Op)
+	case ActionUpdateNodeStatus:
+		return fmt.Sprintf("%s %s of %s with action %d", s.Action, s.Url, s.OrgId, s.Op)
+	case ActionUpdateAccountStatus:
+		return fmt.Sprintf("%s %s of %s with action %d", s.Action, s.Account.Hex(), s.OrgId, s.Op)
+	}
+	return s.ReconcileStep.String()
+}
+
+type MigrationStepResult struct {
+	Step   MigrationStep `json:"step"`
+	Status StepStatus    `json:"status"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// MigrationResult reports the steps submitted and the differences left
+// between the old and new contracts. The switch is scheduled only when no
+// differences are left.
+type MigrationResult struct {
+	Schedule    MigrationSchedule     `json:"schedule"`
+	Steps       []MigrationStepResult `json:"steps"`
+	Differences []string              `json:"differences"`
+	Staged      bool                  `json:"staged"`
+}
+
+// LoadMigrationSchedule reads a migration file. A missing file returns no
+// schedule.
+func LoadMigrationSchedule(path string) (*MigrationSchedule, error) {
+	blob, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var s MigrationSchedule
+	if err := json.Unmarshal(blob, &s); err != nil {
+		return nil, fmt.Errorf("invalid migration %s: %v", path, err)
+	}
+	return &s, nil
+}
+
+// Save stores the migration in the given file
+func (s *MigrationSchedule) Save(path string) error {
+	blob, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, blob, 0644)
+}
+
+// newSnapshot lists the content of the given caches in a stable order
+func newSnapshot(orgs *pcore.OrgCache, roles *pcore.RoleCache, nodes *pcore.NodeCache, accounts *pcore.AcctCache) *PermissionSnapshot {
+	s := &PermissionSnapshot{
+		Orgs:     orgs.GetOrgList(),
+		Roles:    roles.GetRoleList(),
+		Nodes:    nodes.GetNodeList(),
+		Accounts: accounts.GetAcctList(),
+	}
+	sort.Slice(s.Orgs, func(i, j int) bool {
+		if c := s.Orgs[i].Level.Cmp(s.Orgs[j].Level); c != 0 {
+			return c < 0
+		}
+		return s.Orgs[i].FullOrgId < s.Orgs[j].FullOrgId
+	})
+	sort.Slice(s.Roles, func(i, j int) bool {
+		if s.Roles[i].OrgId != s.Roles[j].OrgId {
+			return s.Roles[i].OrgId < s.Roles[j].OrgId
+		}
+		return s.Roles[i].RoleId < s.Roles[j].RoleId
+	})
+	sort.Slice(s.Nodes, func(i, j int) bool { return s.Nodes[i].Url < s.Nodes[j].Url })
+	sort.Slice(s.Accounts, func(i, j int) bool { return bytes.Compare(s.Accounts[i].AcctId[:], s.Accounts[j].AcctId[:]) < 0 })
+	return s
+}
+
+// cacheSnapshot lists the permissions the node enforces
+func cacheSnapshot() *PermissionSnapshot {
+	return newSnapshot(pcore.OrgInfoMap, pcore.RoleInfoMap, pcore.NodeInfoMap, pcore.AcctInfoMap)
+}
+
+// contractSnapshot loads the permissions of the given contracts into fresh
+// caches, the same way the node populates its cache on start
+func contractSnapshot(contract ptype.InitService) (*PermissionSnapshot, error) {
+	orgs := pcore.NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	roles := pcore.NewRoleCache(params.DEFAULT_ROLECACHE_SIZE)
+	nodes := pcore.NewNodeCache(params.DEFAULT_NODECACHE_SIZE)
+	accounts := pcore.NewAcctCache(params.DEFAULT_ACCOUNTCACHE_SIZE)
+
+	numberOfOrgs, err := contract.GetNumberOfOrgs()
+	if err != nil {
+		return nil, err
+	}
+	for k := int64(0); k < numberOfOrgs.Int64(); k++ {
+		orgId, porgId, ultParent, level, status, err := contract.GetOrgInfo(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		orgs.UpsertOrg(orgId, porgId, ultParent, level, pcore.OrgStatus(int(status.Int64())))
+	}
+	numberOfRoles, err := contract.GetNumberOfRoles()
+	if err != nil {
+		return nil, err
+	}
+	for k := int64(0); k < numberOfRoles.Int64(); k++ {
+		r, err := contract.GetRoleDetailsFromIndex(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		roles.UpsertRole(r.OrgId, r.RoleId, r.Voter, r.Admin, pcore.AccessType(int(r.AccessType.Int64())), r.Active)
+	}
+	numberOfNodes, err := contract.GetNumberOfNodes()
+	if err != nil {
+		return nil, err
+	}
+	for k := int64(0); k < numberOfNodes.Int64(); k++ {
+		orgId, url, status, err := contract.GetNodeDetailsFromIndex(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		nodes.UpsertNode(orgId, url, pcore.NodeStatus(int(status.Int64())))
+	}
+	numberOfAccounts, err := contract.GetNumberOfAccounts()
+	if err != nil {
+		return nil, err
+	}
+	for k := int64(0); k < numberOfAccounts.Int64(); k++ {
+		addr, org, role, status, orgAdmin, err := contract.GetAccountDetailsFromIndex(big.NewInt(k))
+		if err != nil {
+			return nil, err
+		}
+		accounts.UpsertAccount(org, role, addr, orgAdmin, pcore.AcctStatus(int(status.Int64())))
+	}
+	return newSnapshot(orgs, roles, nodes, accounts), nil
+}
+
+// migratedAccount checks if the account holds a role worth migrating;
+// accounts whose role was revoked or replaced are left behind
+func migratedAccount(a pcore.AccountInfo) bool {
+	return a.Status != pcore.AcctInactive && a.Status != pcore.AdminRevoked
+}
+
+// accountStatus returns the status the account has in the new contracts,
+// where a completed recovery leaves the account simply active
+func accountStatus(a pcore.AccountInfo) pcore.AcctStatus {
+	if a.Status == pcore.AcctRecoveryCompleted {
+		return pcore.AcctActive
+	}
+	return a.Status
+}
+
+// nodeKey identifies a node by its enode id, ignoring how the url is written
+// by the permissions model
+func nodeKey(url string) string {
+	if n, err := enode.ParseV4(url); err == nil {
+		return n.ID().String()
+	}
+	return url
+}
+
+// nodeEndpoint returns the address the node is permissioned for
+func nodeEndpoint(url string) string {
+	n, err := enode.ParseV4(url)
+	if err != nil {
+		return url
+	}
+	return fmt.Sprintf("%v:%d raftport %d", n.IP(), n.TCP(), n.RaftPort())
+}
+
+// diffSnapshots lists what differs between the old and the new contracts
+func diffSnapshots(old, cur *PermissionSnapshot) []string {
+	diff := []string{}
+
+	orgs := make(map[string]pcore.OrgInfo)
+	for _, o := range cur.Orgs {
+		orgs[o.FullOrgId] = o
+	}
+	for _, o := range old.Orgs {
+		c, ok := orgs[o.FullOrgId]
+		delete(orgs, o.FullOrgId)
+		switch {
+		case !ok:
+			diff = append(diff, fmt.Sprintf("org %s is missing", o.FullOrgId))
+		case c.ParentOrgId != o.ParentOrgId || c.UltimateParent != o.UltimateParent || c.Status != o.Status || c.Level.Cmp(o.Level) != 0:
+			diff = append(diff, fmt.Sprintf("org %s differs", o.FullOrgId))
+		}
+	}
+	for _, c := range cur.Orgs {
+		if _, ok := orgs[c.FullOrgId]; ok {
+			diff = append(diff, fmt.Sprintf("org %s is not in the old contracts", c.FullOrgId))
+		}
+	}
+
+	roles := make(map[pcore.RoleKey]pcore.RoleInfo)
+	for _, r := range cur.Roles {
+		roles[pcore.RoleKey{OrgId: r.OrgId, RoleId: r.RoleId}] = r
+	}
+	for _, r := range old.Roles {
+		key := pcore.RoleKey{OrgId: r.OrgId, RoleId: r.RoleId}
+		c, ok := roles[key]
+		delete(roles, key)
+		switch {
+		case !ok:
+			diff = append(diff, fmt.Sprintf("role %s of %s is missing", r.RoleId, r.OrgId))
+		case c != r:
+			diff = append(diff, fmt.Sprintf("role %s of %s differs", r.RoleId, r.OrgId))
+		}
+	}
+	for _, c := range cur.Roles {
+		if _, ok := roles[pcore.RoleKey{OrgId: c.OrgId, RoleId: c.RoleId}]; ok {
+			diff = append(diff, fmt.Sprintf("role %s of %s is not in the old contracts", c.RoleId, c.OrgId))
+		}
+	}
+
+	nodes := make(map[string]pcore.NodeInfo)
+	for _, n := range cur.Nodes {
+		nodes[nodeKey(n.Url)] = n
+	}
+	for _, o := range old.Nodes {
+		key := nodeKey(o.Url)
+		c, ok := nodes[key]
+		delete(nodes, key)
+		switch {
+		case !ok:
+			diff = append(diff, fmt.Sprintf("node %s of %s is missing", o.Url, o.OrgId))
+		case c.OrgId != o.OrgId || c.Status != o.Status || nodeEndpoint(c.Url) != nodeEndpoint(o.Url):
+			diff = append(diff, fmt.Sprintf("node %s of %s differs", o.Url, o.OrgId))
+		}
+	}
+	for _, c := range cur.Nodes {
+		if _, ok := nodes[nodeKey(c.Url)]; ok {
+			diff = append(diff, fmt.Sprintf("node %s of %s is not in the old contracts", c.Url, c.OrgId))
+		}
+	}
+
+	accounts := make(map[common.Address]pcore.AccountInfo)
+	for _, a := range cur.Accounts {
+		if migratedAccount(a) {
+			accounts[a.AcctId] = a
+		}
+	}
+	for _, o := range old.Accounts {
+		if !migratedAccount(o) {
+			continue
+		}
+		c, ok := accounts[o.AcctId]
+		delete(accounts, o.AcctId)
+		switch {
+		case !ok:
+			diff = append(diff, fmt.Sprintf("account %s of %s is missing", o.AcctId.Hex(), o.OrgId))
+		case c.OrgId != o.OrgId || c.RoleId != o.RoleId || c.IsOrgAdmin != o.IsOrgAdmin || accountStatus(c) != accountStatus(o):
+			diff = append(diff, fmt.Sprintf("account %s of %s differs", o.AcctId.Hex(), o.OrgId))
+		}
+	}
+	for _, c := range cur.Accounts {
+		if _, ok := accounts[c.AcctId]; ok {
+			diff = append(diff, fmt.Sprintf("account %s of %s is not in the old contracts", c.AcctId.Hex(), c.OrgId))
+		}
+	}
+	return diff
+}
+
+// checkSettled makes sure nothing in the old contracts is waiting for a vote
+// or a recovery, as those cannot be carried over
+func checkSettled(old *PermissionSnapshot) error {
+	for _, o := range old.Orgs {
+		if o.Status == pcore.OrgPendingApproval || o.Status == pcore.OrgPendingSuspension {
+			return ErrMigrationPending
+		}
+	}
+	for _, n := range old.Nodes {
+		if n.Status == pcore.NodePendingApproval || n.Status == pcore.NodeRecoveryInitiated {
+			return ErrMigrationPending
+		}
+	}
+	for _, a := range old.Accounts {
+		if a.Status == pcore.AcctPendingApproval || a.Status == pcore.AcctRecoveryInitiated {
+			return ErrMigrationPending
+		}
+	}
+	return nil
+}
+
+// planMigration returns the steps bringing the new contracts, booted with
+// the network admin org, its nodes and the migrating account, to the state
+// of the old ones. Steps already reflected in the new contracts are skipped,
+// so that an interrupted migration can be planned again.
+//
+// The other network admins are added last: each of them needs the votes of
+// the admins already added, so only the first one is approved right away.
+func planMigration(old, cur *PermissionSnapshot, permConfig *ptype.PermissionConfig) ([]MigrationStep, error) {
+	if err := checkSettled(old); err != nil {
+		return nil, err
+	}
+	curOrgs := make(map[string]pcore.OrgInfo)
+	for _, o := range cur.Orgs {
+		curOrgs[o.FullOrgId] = o
+	}
+	curRoles := make(map[pcore.RoleKey]pcore.RoleInfo)
+	for _, r := range cur.Roles {
+		curRoles[pcore.RoleKey{OrgId: r.OrgId, RoleId: r.RoleId}] = r
+	}
+	curNodes := make(map[string]pcore.NodeInfo)
+	for _, n := range cur.Nodes {
+		curNodes[nodeKey(n.Url)] = n
+	}
+	curAccounts := make(map[common.Address]pcore.AccountInfo)
+	for _, a := range cur.Accounts {
+		curAccounts[a.AcctId] = a
+	}
+
+	// org admins of the master orgs submit the changes of their org tree
+	ultimateParent := make(map[string]string)
+	for _, o := range old.Orgs {
+		ultimateParent[o.FullOrgId] = o.UltimateParent
+	}
+	masterOrgs := make(map[string]bool)
+	for _, o := range old.Orgs {
+		masterOrgs[o.FullOrgId] = o.ParentOrgId == ""
+	}
+	admins := make(map[string]common.Address)
+	for _, a := range old.Accounts {
+		if _, ok := admins[a.OrgId]; !ok && a.RoleId == permConfig.OrgAdminRole && a.IsOrgAdmin && migratedAccount(a) {
+			admins[a.OrgId] = a.AcctId
+		}
+	}
+	senderFor := func(orgId string) *common.Address {
+		if ultimateParent[orgId] == permConfig.NwAdminOrg {
+			return nil
+		}
+		admin := admins[ultimateParent[orgId]]
+		return &admin
+	}
+	orgNodes := make(map[string][]pcore.NodeInfo)
+	for _, n := range old.Nodes {
+		orgNodes[n.OrgId] = append(orgNodes[n.OrgId], n)
+	}
+	firstNode := func(orgId string) string {
+		if nodes := orgNodes[orgId]; len(nodes) > 0 {
+			return nodes[0].Url
+		}
+		return ""
+	}
+
+	var steps []MigrationStep
+	add := func(sender *common.Address, step ReconcileStep, op uint8) {
+		steps = append(steps, MigrationStep{ReconcileStep: step, Op: op, Sender: sender})
+	}
+
+	// 1. orgs, parents first, each with its first node
+	// nodes and accounts the org steps create
+	createdNodes := make(map[string]bool)
+	createdAccounts := make(map[common.Address]bool)
+	for _, o := range old.Orgs {
+		if o.FullOrgId == permConfig.NwAdminOrg {
+			continue
+		}
+		c, exists := curOrgs[o.FullOrgId]
+		url := firstNode(o.FullOrgId)
+		if o.ParentOrgId == "" {
+			admin, ok := admins[o.FullOrgId]
+			if !ok || url == "" {
+				return nil, ErrMissingAdmin
+			}
+			createdNodes[nodeKey(url)], createdAccounts[admin] = true, true
+			if !exists {
+				add(nil, ReconcileStep{Action: ActionAddOrg, OrgId: o.OrgId, Url: url, Account: &admin}, 0)
+			}
+			if !exists || c.Status == pcore.OrgPendingApproval {
+				add(nil, ReconcileStep{Action: ActionApproveOrg, OrgId: o.OrgId, Url: url, Account: &admin}, 0)
+			}
+			continue
+		}
+		if url != "" {
+			createdNodes[nodeKey(url)] = true
+		}
+		if !exists {
+			add(senderFor(o.ParentOrgId), ReconcileStep{Action: ActionAddSubOrg, OrgId: o.OrgId, ParentOrgId: o.ParentOrgId, Url: url}, 0)
+		}
+	}
+
+	// 2. roles, except the admin roles the org steps create
+	for _, r := range old.Roles {
+		if r.RoleId == permConfig.NwAdminRole && r.OrgId == permConfig.NwAdminOrg {
+			continue
+		}
+		if r.RoleId == permConfig.OrgAdminRole && masterOrgs[r.OrgId] && r.OrgId != permConfig.NwAdminOrg {
+			continue
+		}
+		if _, ok := curRoles[pcore.RoleKey{OrgId: r.OrgId, RoleId: r.RoleId}]; !ok {
+			add(senderFor(r.OrgId), ReconcileStep{Action: ActionAddNewRole, OrgId: r.OrgId, RoleId: r.RoleId, Access: r.Access, IsVoter: r.IsVoter, IsAdmin: r.IsAdmin}, 0)
+		}
+	}
+
+	// 3. the remaining nodes
+	for _, n := range old.Nodes {
+		if _, ok := curNodes[nodeKey(n.Url)]; !ok && !createdNodes[nodeKey(n.Url)] {
+			add(senderFor(n.OrgId), ReconcileStep{Action: ActionAddNode, OrgId: n.OrgId, Url: n.Url}, 0)
+		}
+	}
+
+	// 4. accounts, with the network admins set aside
+	var nwAdmins []pcore.AccountInfo
+	addAccount := func(a pcore.AccountInfo) {
+		acct := a.AcctId
+		c, exists := curAccounts[acct]
+		switch {
+		case exists && c.Status != pcore.AcctPendingApproval:
+		case exists:
+			add(nil, ReconcileStep{Action: ActionApproveAdminRole, OrgId: a.OrgId, Account: &acct, RoleId: a.RoleId}, 0)
+		case a.RoleId == permConfig.OrgAdminRole || (a.OrgId == permConfig.NwAdminOrg && a.RoleId == permConfig.NwAdminRole):
+			add(nil, ReconcileStep{Action: ActionAssignAdminRole, OrgId: a.OrgId, Account: &acct, RoleId: a.RoleId}, 0)
+			add(nil, ReconcileStep{Action: ActionApproveAdminRole, OrgId: a.OrgId, Account: &acct, RoleId: a.RoleId}, 0)
+		default:
+			add(senderFor(a.OrgId), ReconcileStep{Action: ActionAddAccountToOrg, OrgId: a.OrgId, Account: &acct, RoleId: a.RoleId}, 0)
+		}
+	}
+	for _, a := range old.Accounts {
+		if !migratedAccount(a) || createdAccounts[a.AcctId] {
+			continue
+		}
+		if a.OrgId == permConfig.NwAdminOrg && a.RoleId == permConfig.NwAdminRole {
+			nwAdmins = append(nwAdmins, a)
+			continue
+		}
+		addAccount(a)
+	}
+
+	// 5. statuses, orgs last as suspended orgs take no changes
+	for _, r := range old.Roles {
+		if c, ok := curRoles[pcore.RoleKey{OrgId: r.OrgId, RoleId: r.RoleId}]; !r.Active && (!ok || c.Active) {
+			add(senderFor(r.OrgId), ReconcileStep{Action: ActionRemoveRole, OrgId: r.OrgId, RoleId: r.RoleId}, 0)
+		}
+	}
+	for _, n := range old.Nodes {
+		status := pcore.NodeApproved
+		if c, ok := curNodes[nodeKey(n.Url)]; ok {
+			status = c.Status
+		}
+		switch {
+		case n.Status == status:
+		case n.Status == pcore.NodeDeactivated:
+			add(senderFor(n.OrgId), ReconcileStep{Action: ActionUpdateNodeStatus, OrgId: n.OrgId, Url: n.Url}, uint8(SuspendNode))
+		case n.Status == pcore.NodeBlackListed:
+			add(senderFor(n.OrgId), ReconcileStep{Action: ActionUpdateNodeStatus, OrgId: n.OrgId, Url: n.Url}, uint8(BlacklistNode))
+		}
+	}
+	addAccountStatus := func(a pcore.AccountInfo) {
+		acct := a.AcctId
+		status := pcore.AcctActive
+		if c, ok := curAccounts[acct]; ok {
+			status = accountStatus(c)
+		}
+		switch {
+		case accountStatus(a) == status:
+		case a.Status == pcore.AcctSuspended:
+			add(senderFor(a.OrgId), ReconcileStep{Action: ActionUpdateAccountStatus, OrgId: a.OrgId, Account: &acct}, uint8(SuspendAccount))
+		case a.Status == pcore.AcctBlacklisted:
+			add(senderFor(a.OrgId), ReconcileStep{Action: ActionUpdateAccountStatus, OrgId: a.OrgId, Account: &acct}, uint8(BlacklistAccount))
+		}
+	}
+	for _, a := range old.Accounts {
+		if migratedAccount(a) && !(a.OrgId == permConfig.NwAdminOrg && a.RoleId == permConfig.NwAdminRole) {
+			addAccountStatus(a)
+		}
+	}
+	for _, o := range old.Orgs {
+		c, ok := curOrgs[o.FullOrgId]
+		switch {
+		case o.Status != pcore.OrgSuspended || (ok && c.Status == pcore.OrgSuspended):
+		case ok && c.Status == pcore.OrgPendingSuspension:
+			add(nil, ReconcileStep{Action: ActionApproveOrgStatus, OrgId: o.FullOrgId}, uint8(SuspendOrg))
+		default:
+			add(nil, ReconcileStep{Action: ActionUpdateOrgStatus, OrgId: o.FullOrgId}, uint8(SuspendOrg))
+			add(nil, ReconcileStep{Action: ActionApproveOrgStatus, OrgId: o.FullOrgId}, uint8(SuspendOrg))
+		}
+	}
+
+	// 6. the other network admins
+	for _, a := range nwAdmins {
+		addAccount(a)
+	}
+	for _, a := range nwAdmins {
+		addAccountStatus(a)
+	}
+	return steps, nil
+}
+
+// migration populates the new contracts for a migration
+type migration struct {
+	p        *PermissionCtrl
+	config   *ptype.PermissionConfig // of the new contracts
+	contract ptype.InitService       // reads the new contracts
+	waiter   bind.DeployBackend
+	txa      ethapi.SendTxArgs
+}
+
+func (p *PermissionCtrl) newMigration(config *ptype.PermissionConfig, txa ethapi.SendTxArgs) (*migration, error) {
+	waiter, ok := p.ethClnt.(bind.DeployBackend)
+	if !ok {
+		return nil, ErrNoDeployBackend
+	}
+	return &migration{p: p, config: config, waiter: waiter, txa: txa}, nil
+}
+
+// bind binds the reader of the new contracts
+func (m *migration) bind() error {
+	m.contract = NewPermissionContractService(m.p.ethClnt, true, m.p.key, m.config, m.p.isRaft, m.p.useDns)
+	return m.contract.BindContracts()
+}
+
+// transactOpts returns the options sending transactions from the given
+// account, or the migrating account. Gas is estimated unless given.
+func (m *migration) transactOpts(sender *common.Address) (*bind.TransactOpts, error) {
+	txa := m.txa
+	if sender != nil {
+		txa.From = *sender
+	}
+	opts, err := m.p.getTxParams(txa)
+	if err != nil {
+		return nil, err
+	}
+	if txa.Gas == nil {
+		opts.GasLimit = 0
+	}
+	return opts, nil
+}
+
+// wait waits for the transaction to be mined successfully
+func (m *migration) wait(tx *types.Transaction, err error) error {
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), migrationTxTimeout)
+	defer cancel()
+	receipt, err := bind.WaitMined(ctx, m.waiter, tx)
+	if err == context.DeadlineExceeded {
+		return ErrMigrationTxTimedOut
+	} else if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("%v: %s", ErrMigrationTxFailed, tx.Hash().Hex())
+	}
+	return nil
+}
+
+// deployed waits for a contract deployment and returns its address
+func (m *migration) deployed(addr common.Address, tx *types.Transaction, _ interface{}, err error) (common.Address, error) {
+	return addr, m.wait(tx, err)
+}
+
+// deploy deploys the contracts of the latest permissions model with the
+// migrating account as the guardian and returns their config
+func (m *migration) deploy(old *ptype.PermissionConfig) error {
+	opts, err := m.transactOpts(nil)
+	if err != nil {
+		return err
+	}
+	backend := m.p.ethClnt
+	config := *old
+	config.PermissionsModel = ptype.PERMISSION_V2
+
+	if config.UpgrdAddress, err = m.deployed(v2bind.DeployPermUpgr(opts, backend, opts.From)); err != nil {
+		return fmt.Errorf("failed to deploy the upgradable contract: %v", err)
+	}
+	upgrAddress := config.UpgrdAddress
+	if config.OrgAddress, err = m.deployed(v2bind.DeployOrgManager(opts, backend, upgrAddress)); err != nil {
+		return fmt.Errorf("failed to deploy the org manager: %v", err)
+	}
+	if config.RoleAddress, err = m.deployed(v2bind.DeployRoleManager(opts, backend, upgrAddress)); err != nil {
+		return fmt.Errorf("failed to deploy the role manager: %v", err)
+	}
+	if config.AccountAddress, err = m.deployed(v2bind.DeployAcctManager(opts, backend, upgrAddress)); err != nil {
+		return fmt.Errorf("failed to deploy the account manager: %v", err)
+	}
+	if config.VoterAddress, err = m.deployed(v2bind.DeployVoterManager(opts, backend, upgrAddress)); err != nil {
+		return fmt.Errorf("failed to deploy the voter manager: %v", err)
+	}
+	if config.NodeAddress, err = m.deployed(v2bind.DeployNodeManager(opts, backend, upgrAddress)); err != nil {
+		return fmt.Errorf("failed to deploy the node manager: %v", err)
+	}
+	if config.ImplAddress, err = m.deployed(v2bind.DeployPermImpl(opts, backend, upgrAddress, config.OrgAddress, config.RoleAddress, config.AccountAddress, config.VoterAddress, config.NodeAddress)); err != nil {
+		return fmt.Errorf("failed to deploy the implementation contract: %v", err)
+	}
+	if config.InterfAddress, err = m.deployed(v2bind.DeployPermInterface(opts, backend, upgrAddress)); err != nil {
+		return fmt.Errorf("failed to deploy the interface contract: %v", err)
+	}
+	upgr, err := v2bind.NewPermUpgr(config.UpgrdAddress, backend)
+	if err != nil {
+		return err
+	}
+	if err := m.wait(upgr.Init(opts, config.InterfAddress, config.ImplAddress)); err != nil {
+		return fmt.Errorf("failed to link the permission contracts: %v", err)
+	}
+	m.config = &config
+	log.Info("Deployed permission contracts for migration", "interface", config.InterfAddress, "upgradable", config.UpgrdAddress)
+	return nil
+}
+
+// boot boots the new contracts with the network admin org, its nodes and
+// the migrating account
+func (m *migration) boot(old *PermissionSnapshot) error {
+	if booted, err := m.contract.GetNetworkBootStatus(); err != nil || booted {
+		return err
+	}
+	opts, err := m.transactOpts(nil)
+	if err != nil {
+		return err
+	}
+	interf, err := v2bind.NewPermInterface(m.config.InterfAddress, m.p.ethClnt)
+	if err != nil {
+		return err
+	}
+	session := &v2bind.PermInterfaceSession{Contract: interf, TransactOpts: *opts}
+	if err := m.wait(session.SetPolicy(m.config.NwAdminOrg, m.config.NwAdminRole, m.config.OrgAdminRole)); err != nil {
+		return err
+	}
+	if err := m.wait(session.Init(m.config.SubOrgBreadth, m.config.SubOrgDepth)); err != nil {
+		return err
+	}
+	for _, n := range old.Nodes {
+		if n.OrgId != m.config.NwAdminOrg {
+			continue
+		}
+		enodeId, ip, port, raftPort, err := ptype.GetNodeDetails(n.Url, m.p.isRaft, m.p.useDns)
+		if err != nil {
+			return err
+		}
+		if err := m.wait(session.AddAdminNode(enodeId, ip, port, raftPort)); err != nil {
+			return err
+		}
+	}
+	if err := m.wait(session.AddAdminAccount(opts.From)); err != nil {
+		return err
+	}
+	return m.wait(session.UpdateNetworkBootStatus())
+}
+
+// submit sends the transaction of the step to the new contracts
+func (m *migration) submit(step MigrationStep) (*types.Transaction, error) {
+	opts, err := m.transactOpts(step.Sender)
+	if err != nil {
+		return nil, err
+	}
+	backend := &v2.Backend{}
+	contractBackend := ptype.ContractBackend{EthClnt: m.p.ethClnt, Key: m.p.key, PermConfig: m.config, IsRaft: m.p.isRaft, UseDns: m.p.useDns}
+	args := ptype.TxArgs{OrgId: step.OrgId, POrgId: step.ParentOrgId, Url: step.Url, RoleId: step.RoleId, IsVoter: step.IsVoter,
+		IsAdmin: step.IsAdmin, AccessType: uint8(step.Access), Action: step.Op}
+	if step.Account != nil {
+		args.AcctId = *step.Account
+	}
+	switch step.Action {
+	case ActionAddOrg, ActionApproveOrg, ActionAddSubOrg, ActionUpdateOrgStatus, ActionApproveOrgStatus:
+		s, err := backend.GetOrgService(opts, contractBackend)
+		if err != nil {
+			return nil, err
+		}
+		switch step.Action {
+		case ActionAddOrg:
+			return s.AddOrg(args)
+		case ActionApproveOrg:
+			return s.ApproveOrg(args)
+		case ActionAddSubOrg:
+			return s.AddSubOrg(args)
+		case ActionUpdateOrgStatus:
+			return s.UpdateOrgStatus(args)
+		default:
+			return s.ApproveOrgStatus(args)
+		}
+	case ActionAddNewRole, ActionRemoveRole:
+		s, err := backend.GetRoleService(opts, contractBackend)
+		if err != nil {
+			return nil, err
+		}
+		if step.Action == ActionAddNewRole {
+			return s.AddNewRole(args)
+		}
+		return s.RemoveRole(args)
+	case ActionAddNode, ActionUpdateNodeStatus:
+		s, err := backend.GetNodeService(opts, contractBackend)
+		if err != nil {
+			return nil, err
+		}
+		if step.Action == ActionAddNode {
+			return s.AddNode(args)
+		}
+		return s.UpdateNodeStatus(args)
+	case ActionAssignAdminRole, ActionApproveAdminRole, ActionAddAccountToOrg, ActionUpdateAccountStatus:
+		s, err := backend.GetAccountService(opts, contractBackend)
+		if err != nil {
+			return nil, err
+		}
+		switch step.Action {
+		case ActionAssignAdminRole:
+			return s.AssignAdminRole(args)
+		case ActionApproveAdminRole:
+			return s.ApproveAdminRole(args)
+		case ActionAddAccountToOrg:
+			return s.AssignAccountRole(args)
+		default:
+			return s.UpdateAccountStatus(args)
+		}
+	}
+	return nil, fmt.Errorf("unknown action %s", step.Action)
+}
+
+// approved checks if the vote of an approval step completed it
+func (m *migration) approved(step MigrationStep) bool {
+	switch step.Action {
+	case ActionApproveOrg:
+		_, _, _, _, status, err := m.contract.GetOrgDetails(step.OrgId)
+		return err == nil && pcore.OrgStatus(status.Int64()) == pcore.OrgApproved
+	case ActionApproveOrgStatus:
+		_, _, _, _, status, err := m.contract.GetOrgDetails(step.OrgId)
+		return err == nil && pcore.OrgStatus(status.Int64()) == pcore.OrgSuspended
+	case ActionApproveAdminRole:
+		_, _, _, status, _, err := m.contract.GetAccountDetails(*step.Account)
+		return err == nil && pcore.AcctStatus(status.Int64()) == pcore.AcctActive
+	}
+	return true
+}
+
+// apply submits the steps in order, waiting for each to be mined. It stops
+// at the first step failing, waiting for other voters or needing an account
+// of another node.
+func (m *migration) apply(steps []MigrationStep) []MigrationStepResult {
+	results := make([]MigrationStepResult, 0, len(steps))
+	for _, step := range steps {
+		result := MigrationStepResult{Step: step, Status: StepDone}
+		if step.Sender != nil {
+			if _, err := m.p.validateAccount(*step.Sender); err != nil {
+				result.Status, result.Error = StepPendingSigner, fmt.Sprintf("account %s is not an account of this node", step.Sender.Hex())
+				return append(results, result)
+			}
+		}
+		if err := m.wait(m.submit(step)); err != nil {
+			result.Status, result.Error = StepFailed, err.Error()
+			return append(results, result)
+		}
+		if !m.approved(step) {
+			result.Status = StepPendingApproval
+			return append(results, result)
+		}
+		log.Info("Migrated permission step", "step", step)
+		results = append(results, result)
+	}
+	return results
+}
+
+// returns the file the migration is stored in
+func (p *PermissionCtrl) migrationPath() string {
+	return filepath.Join(p.dataDir, params.PERMISSION_MIGRATION_CONFIG)
+}
+
+// loadMigration loads the stored migration of the node
+func (p *PermissionCtrl) loadMigration() error {
+	s, err := LoadMigrationSchedule(p.migrationPath())
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %v", params.PERMISSION_MIGRATION_CONFIG, err)
+	}
+	p.migrationMux.Lock()
+	p.migration = s
+	p.migrationMux.Unlock()
+	return nil
+}
+
+// setMigration stores the migration of the node, or removes it if nil
+func (p *PermissionCtrl) setMigration(s *MigrationSchedule) error {
+	p.migrationMux.Lock()
+	defer p.migrationMux.Unlock()
+	if s == nil {
+		if err := os.Remove(p.migrationPath()); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	} else if err := s.Save(p.migrationPath()); err != nil {
+		return err
+	}
+	p.migration = s
+	return nil
+}
+
+func (p *PermissionCtrl) getMigration() *MigrationSchedule {
+	p.migrationMux.Lock()
+	defer p.migrationMux.Unlock()
+	return p.migration
+}
+
+// migrate runs or resumes the migration of the node, scheduling the switch
+// once the new contracts match the permission cache
+func (p *PermissionCtrl) migrate(switchBlock uint64, txa ethapi.SendTxArgs) (*MigrationResult, error) {
+	s := p.getMigration()
+	if s != nil && s.SwitchBlock != 0 {
+		return nil, ErrMigrationScheduled
+	}
+	old := cacheSnapshot()
+	if err := checkSettled(old); err != nil {
+		return nil, err
+	}
+	if auditService, err := p.NewPermissionAuditService(); err != nil {
+		return nil, err
+	} else if _, _, _, opType, err := auditService.GetPendingOp(p.permConfig.NwAdminOrg); err != nil {
+		return nil, err
+	} else if opType != 0 {
+		return nil, ErrMigrationPending
+	}
+
+	var config *ptype.PermissionConfig
+	if s != nil {
+		config = &s.Config
+	}
+	m, err := p.newMigration(config, txa)
+	if err != nil {
+		return nil, err
+	}
+	if s == nil {
+		if err := m.deploy(p.permConfig); err != nil {
+			return nil, err
+		}
+		// remember the contracts so that an interrupted migration resumes
+		// with them
+		s = &MigrationSchedule{Config: *m.config}
+		if err := p.setMigration(s); err != nil {
+			return nil, err
+		}
+	}
+	if err := m.bind(); err != nil {
+		return nil, err
+	}
+	if err := m.boot(old); err != nil {
+		return nil, fmt.Errorf("failed to boot the permission contracts: %v", err)
+	}
+
+	cur, err := contractSnapshot(m.contract)
+	if err != nil {
+		return nil, err
+	}
+	steps, err := planMigration(old, cur, p.permConfig)
+	if err != nil {
+		return nil, err
+	}
+	result := &MigrationResult{Schedule: *s, Steps: m.apply(steps)}
+	if cur, err = contractSnapshot(m.contract); err != nil {
+		return nil, err
+	}
+	if result.Differences = diffSnapshots(cacheSnapshot(), cur); len(result.Differences) > 0 {
+		return result, nil
+	}
+	return result, p.stageMigration(result, switchBlock)
+}
+
+// verifyMigration compares the new contracts of the schedule with the
+// permission cache
+func (p *PermissionCtrl) verifyMigration(s *MigrationSchedule) ([]string, error) {
+	if s.Config.PermissionsModel != ptype.PERMISSION_V2 || s.Config.IsEmpty() {
+		return nil, ErrInvalidMigration
+	}
+	contract := NewPermissionContractService(p.ethClnt, true, p.key, &s.Config, p.isRaft, p.useDns)
+	if err := contract.BindContracts(); err != nil {
+		return nil, err
+	}
+	cur, err := contractSnapshot(contract)
+	if err != nil {
+		return nil, err
+	}
+	return diffSnapshots(cacheSnapshot(), cur), nil
+}
+
+// stageMigration schedules the switch of the verified migration
+func (p *PermissionCtrl) stageMigration(result *MigrationResult, switchBlock uint64) error {
+	if switchBlock <= p.eth.BlockChain().CurrentBlock().NumberU64() {
+		return ErrSwitchBlockPassed
+	}
+	result.Schedule.SwitchBlock = switchBlock
+	if err := p.setMigration(&result.Schedule); err != nil {
+		return err
+	}
+	result.Staged = true
+	log.Info("Scheduled permission contract switch", "block", switchBlock, "interface", result.Schedule.Config.InterfAddress)
+	return nil
+}
+
+// useMigratedConfig replaces permission-config.json with the config of the
+// new contracts, keeping the old one as a backup, and sets up the backend
+// for them
+func (p *PermissionCtrl) useMigratedConfig(s *MigrationSchedule) error {
+	path := filepath.Join(p.dataDir, params.PERMISSION_MODEL_CONFIG)
+	if blob, err := ioutil.ReadFile(path); err == nil {
+		if err := ioutil.WriteFile(path+".bak", blob, 0644); err != nil {
+			return err
+		}
+	}
+	config := s.Config
+	// the replaced contracts stay frozen across restarts
+	config.FrozenContracts = append(append([]common.Address{}, p.permConfig.FrozenContracts...), p.permConfig.Contracts()...)
+	blob, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(path, blob, 0644); err != nil {
+		return err
+	}
+	pcore.FreezeContracts(config.FrozenContracts...)
+	p.permConfig = &config
+	if err := p.populateBackEnd(); err != nil {
+		return err
+	}
+	p.updateBackEnd()
+	if err := p.setMigration(nil); err != nil {
+		return err
+	}
+	log.Info("Switched to migrated permission contracts", "block", s.SwitchBlock, "interface", config.InterfAddress)
+	return nil
+}
+
+// switchContracts moves a running node to the new contracts. The watchers
+// of the old contracts are stopped before the new ones start. The caches
+// are kept, as the new contracts were verified to hold the same
+// permissions, and the new watchers replay their events on top of them.
+func (p *PermissionCtrl) switchContracts(s *MigrationSchedule) error {
+	p.migrateMux.Lock()
+	defer p.migrateMux.Unlock()
+
+	ptype.ContractStopFeed.Send(ptype.StopEvent{})
+	if err := p.useMigratedConfig(s); err != nil {
+		return err
+	}
+	if err := p.contract.BindContracts(); err != nil {
+		return err
+	}
+	pcore.SetDefaults(p.permConfig.NwAdminRole, p.permConfig.OrgAdminRole, p.IsV2Permission())
+	for _, f := range []func() error{
+		p.backend.ManageOrgPermissions,
+		p.backend.ManageNodePermissions,
+		p.backend.ManageRolePermissions,
+		p.backend.ManageAccountPermissions,
+		p.indexHistory,
+		p.trackApprovals,
+	} {
+		if err := f(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNotMigrating checks that the contracts in use are not frozen by a
+// migration in progress
+func (p *PermissionCtrl) checkNotMigrating() error {
+	if p.getMigration() != nil {
+		return ErrMigrationInProgress
+	}
+	return nil
+}
+
+// checks that the migration is run by a network admin whose account is
+// unlocked on this node, rather than trusting the sender given by the caller
+func (q *QuorumControlsAPI) valMigrationAdmin(txa ethapi.SendTxArgs) error {
+	w, err := q.permCtrl.validateAccount(txa.From)
+	if err != nil {
+		return ptype.ErrInvalidAccount
+	}
+	message := []byte("permission migration")
+	sig, err := w.SignText(accounts.Account{Address: txa.From}, message)
+	if err != nil {
+		return ErrMigrationSigner
+	}
+	if len(sig) == crypto.SignatureLength && sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	if signer, err := crypto.SigToPub(accounts.TextHash(message), sig); err != nil || crypto.PubkeyToAddress(*signer) != txa.From {
+		return ErrMigrationSigner
+	}
+	if !q.isNetworkAdmin(txa.From) {
+		return ptype.ErrNotNetworkAdmin
+	}
+	return nil
+}
+
+// monitorMigration switches to the migrated contracts after the switch block
+func (p *PermissionCtrl) monitorMigration() error {
+	go func() {
+		chainHeadCh := make(chan core.ChainHeadEvent, 1)
+		headSub := p.eth.BlockChain().SubscribeChainHeadEvent(chainHeadCh)
+		defer headSub.Unsubscribe()
+		stopChan, stopSubscription := ptype.SubscribeStopEvent()
+		defer stopSubscription.Unsubscribe()
+		for {
+			select {
+			case head := <-chainHeadCh:
+				if s := p.getMigration(); s != nil && s.due(head.Block.NumberU64()) {
+					if err := p.switchContracts(s); err != nil {
+						log.Error("Failed to switch to migrated permission contracts", "err", err)
+					}
+				}
+			case <-stopChan:
+				return
+			}
+		}
+	}()
+	return nil
+}
+
+// Migrate deploys the contracts of the latest permissions model, populates
+// them with the permissions of the network and schedules the node to switch
+// to them after the given block. An interrupted migration, or one waiting
+// for the accounts of other nodes, is resumed by calling it again.
+func (q *QuorumControlsAPI) Migrate(switchBlock uint64, txa ethapi.SendTxArgs) (*MigrationResult, error) {
+	if err := q.valMigrationAdmin(txa); err != nil {
+		return nil, err
+	}
+	q.permCtrl.migrateMux.Lock()
+	defer q.permCtrl.migrateMux.Unlock()
+	return q.permCtrl.migrate(switchBlock, txa)
+}
+
+// ScheduleMigration verifies the contracts populated by a migration on
+// another node against the permissions of this node and schedules the
+// switch to them
+func (q *QuorumControlsAPI) ScheduleMigration(schedule MigrationSchedule, txa ethapi.SendTxArgs) (*MigrationResult, error) {
+	if err := q.valMigrationAdmin(txa); err != nil {
+		return nil, err
+	}
+	q.permCtrl.migrateMux.Lock()
+	defer q.permCtrl.migrateMux.Unlock()
+	if s := q.permCtrl.getMigration(); s != nil && s.SwitchBlock != 0 {
+		return nil, ErrMigrationScheduled
+	}
+	diff, err := q.permCtrl.verifyMigration(&schedule)
+	if err != nil {
+		return nil, err
+	}
+	result := &MigrationResult{Schedule: MigrationSchedule{Config: schedule.Config}, Steps: []MigrationStepResult{}, Differences: diff}
+	if len(diff) > 0 {
+		return result, nil
+	}
+	return result, q.permCtrl.stageMigration(result, schedule.SwitchBlock)
+}
+
+// MigrationStatus returns the migration of the node, if any
+func (q *QuorumControlsAPI) MigrationStatus() *MigrationSchedule {
+	return q.permCtrl.getMigration()
+}
+
+// CancelMigration drops the migration of the node before its switch block.
+// Contracts deployed for it are left unused.
+func (q *QuorumControlsAPI) CancelMigration(txa ethapi.SendTxArgs) (string, error) {
+	if err := q.valMigrationAdmin(txa); err != nil {
+		return "", err
+	}
+	q.permCtrl.migrateMux.Lock()
+	defer q.permCtrl.migrateMux.Unlock()
+	if q.permCtrl.getMigration() == nil {
+		return "", ErrMigrationNotFound
+	}
+	if err := q.permCtrl.setMigration(nil); err != nil {
+		return "", err
+	}
+	return actionSuccess, nil
+}
@@ -0,0 +1,156 @@
+package permission
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// bootedSnapshot is what the new contracts hold right after the boot
+func bootedSnapshot(from common.Address) *PermissionSnapshot {
+	return &PermissionSnapshot{
+		Orgs:     []pcore.OrgInfo{{OrgId: arbitraryNetworkAdminOrg, FullOrgId: arbitraryNetworkAdminOrg, UltimateParent: arbitraryNetworkAdminOrg, Level: big.NewInt(1), Status: pcore.OrgApproved}},
+		Roles:    []pcore.RoleInfo{{OrgId: arbitraryNetworkAdminOrg, RoleId: arbitraryNetworkAdminRole, IsVoter: true, IsAdmin: true, Access: pcore.FullAccess, Active: true}},
+		Nodes:    []pcore.NodeInfo{{OrgId: arbitraryNetworkAdminOrg, Url: arbitraryNode1, Status: pcore.NodeApproved}},
+		Accounts: []pcore.AccountInfo{{OrgId: arbitraryNetworkAdminOrg, RoleId: arbitraryNetworkAdminRole, AcctId: from, IsOrgAdmin: true, Status: pcore.AcctActive}},
+	}
+}
+
+func TestPlanMigration(t *testing.T) {
+	permConfig := &ptype.PermissionConfig{NwAdminOrg: arbitraryNetworkAdminOrg, NwAdminRole: arbitraryNetworkAdminRole, OrgAdminRole: arbitraryOrgAdminRole}
+	from := common.BytesToAddress([]byte("from"))
+	admin2 := common.BytesToAddress([]byte("admin2"))
+	orgAdmin := common.BytesToAddress([]byte("orgAdmin"))
+	acct1 := common.BytesToAddress([]byte("acct1"))
+	retired := common.BytesToAddress([]byte("retired"))
+	subOrg := arbitraryOrgToAdd + "." + arbitrarySubOrg
+
+	old := bootedSnapshot(from)
+	old.Orgs = append(old.Orgs,
+		pcore.OrgInfo{OrgId: arbitraryOrgToAdd, FullOrgId: arbitraryOrgToAdd, UltimateParent: arbitraryOrgToAdd, Level: big.NewInt(1), Status: pcore.OrgApproved},
+		pcore.OrgInfo{OrgId: arbitrarySubOrg, FullOrgId: subOrg, ParentOrgId: arbitraryOrgToAdd, UltimateParent: arbitraryOrgToAdd, Level: big.NewInt(2), Status: pcore.OrgApproved})
+	old.Roles = append(old.Roles,
+		pcore.RoleInfo{OrgId: arbitraryOrgToAdd, RoleId: arbitraryOrgAdminRole, IsVoter: true, IsAdmin: true, Access: pcore.FullAccess, Active: true},
+		pcore.RoleInfo{OrgId: arbitraryOrgToAdd, RoleId: arbitrartNewRole1, Access: pcore.Transact, Active: true},
+		pcore.RoleInfo{OrgId: arbitraryOrgToAdd, RoleId: arbitrartNewRole2, Access: pcore.ReadOnly})
+	old.Nodes = append(old.Nodes,
+		pcore.NodeInfo{OrgId: arbitraryOrgToAdd, Url: arbitraryNode2, Status: pcore.NodeApproved},
+		pcore.NodeInfo{OrgId: arbitraryOrgToAdd, Url: arbitraryNode3, Status: pcore.NodeDeactivated},
+		pcore.NodeInfo{OrgId: subOrg, Url: arbitraryNode4, Status: pcore.NodeApproved})
+	old.Accounts = append(old.Accounts,
+		pcore.AccountInfo{OrgId: arbitraryNetworkAdminOrg, RoleId: arbitraryNetworkAdminRole, AcctId: admin2, IsOrgAdmin: true, Status: pcore.AcctActive},
+		pcore.AccountInfo{OrgId: arbitraryOrgToAdd, RoleId: arbitraryOrgAdminRole, AcctId: orgAdmin, IsOrgAdmin: true, Status: pcore.AcctActive},
+		pcore.AccountInfo{OrgId: subOrg, RoleId: arbitrartNewRole1, AcctId: acct1, Status: pcore.AcctSuspended},
+		pcore.AccountInfo{OrgId: arbitraryOrgToAdd, RoleId: arbitraryOrgAdminRole, AcctId: retired, IsOrgAdmin: true, Status: pcore.AdminRevoked})
+
+	// 1. freshly booted contracts get everything but the boot content, with
+	// org changes sent by the org admin and network admins added last
+	steps, err := planMigration(old, bootedSnapshot(from), permConfig)
+	assert.NoError(t, err)
+	var actions []string
+	for _, step := range steps {
+		actions = append(actions, step.Action)
+	}
+	assert.Equal(t, []string{ActionAddOrg, ActionApproveOrg, ActionAddSubOrg, ActionAddNewRole, ActionAddNewRole, ActionAddNode,
+		ActionAddAccountToOrg, ActionRemoveRole, ActionUpdateNodeStatus, ActionUpdateAccountStatus,
+		ActionAssignAdminRole, ActionApproveAdminRole}, actions)
+	assert.Equal(t, orgAdmin, *steps[0].Account)
+	assert.Equal(t, arbitraryNode2, steps[0].Url)
+	assert.Nil(t, steps[0].Sender)
+	assert.Equal(t, arbitraryNode4, steps[2].Url)
+	assert.Equal(t, orgAdmin, *steps[2].Sender)
+	assert.Equal(t, arbitraryNode3, steps[5].Url)
+	assert.Equal(t, uint8(SuspendNode), steps[8].Op)
+	assert.Equal(t, uint8(SuspendAccount), steps[9].Op)
+	assert.Equal(t, admin2, *steps[11].Account)
+	assert.Nil(t, steps[11].Sender)
+
+	// 2. a resumed migration skips what is in place and completes pending
+	// votes
+	cur := bootedSnapshot(from)
+	cur.Orgs = append(cur.Orgs, pcore.OrgInfo{OrgId: arbitraryOrgToAdd, FullOrgId: arbitraryOrgToAdd, UltimateParent: arbitraryOrgToAdd, Level: big.NewInt(1), Status: pcore.OrgApproved},
+		pcore.OrgInfo{OrgId: arbitrarySubOrg, FullOrgId: subOrg, ParentOrgId: arbitraryOrgToAdd, UltimateParent: arbitraryOrgToAdd, Level: big.NewInt(2), Status: pcore.OrgApproved})
+	cur.Roles = old.Roles
+	cur.Nodes = old.Nodes
+	cur.Accounts = append(cur.Accounts, old.Accounts[2:4]...)
+	cur.Accounts = append(cur.Accounts, pcore.AccountInfo{OrgId: arbitraryNetworkAdminOrg, RoleId: arbitraryNetworkAdminRole, AcctId: admin2, Status: pcore.AcctPendingApproval})
+	steps, err = planMigration(old, cur, permConfig)
+	assert.NoError(t, err)
+	if assert.Len(t, steps, 1) {
+		assert.Equal(t, ActionApproveAdminRole, steps[0].Action)
+		assert.Equal(t, admin2, *steps[0].Account)
+	}
+
+	// 3. pending votes and master orgs without admin cannot be migrated
+	old.Nodes[1].Status = pcore.NodePendingApproval
+	_, err = planMigration(old, bootedSnapshot(from), permConfig)
+	assert.Equal(t, ErrMigrationPending, err)
+	old.Nodes[1].Status = pcore.NodeApproved
+	old.Accounts[2].RoleId = arbitrartNewRole1
+	_, err = planMigration(old, bootedSnapshot(from), permConfig)
+	assert.Equal(t, ErrMissingAdmin, err)
+}
+
+func TestDiffSnapshots(t *testing.T) {
+	from := common.BytesToAddress([]byte("from"))
+	acct1 := common.BytesToAddress([]byte("acct1"))
+
+	// the url of a node may be written differently by the old model
+	old := bootedSnapshot(from)
+	cur := bootedSnapshot(from)
+	cur.Nodes[0].Url = strings.Replace(arbitraryNode1, "?discport=0&", "?", 1)
+	assert.Empty(t, diffSnapshots(old, cur))
+
+	// accounts with a revoked role are not migrated
+	old.Accounts = append(old.Accounts, pcore.AccountInfo{OrgId: arbitraryNetworkAdminOrg, RoleId: arbitraryOrgAdminRole, AcctId: acct1, Status: pcore.AdminRevoked})
+	assert.Empty(t, diffSnapshots(old, cur))
+
+	old.Nodes[0].Status = pcore.NodeDeactivated
+	old.Roles = append(old.Roles, pcore.RoleInfo{OrgId: arbitraryNetworkAdminOrg, RoleId: arbitrartNewRole1})
+	cur.Accounts = append(cur.Accounts, pcore.AccountInfo{OrgId: arbitraryNetworkAdminOrg, RoleId: arbitrartNewRole1, AcctId: acct1, Status: pcore.AcctActive})
+	assert.Equal(t, []string{
+		"role " + arbitrartNewRole1 + " of " + arbitraryNetworkAdminOrg + " is missing",
+		"node " + arbitraryNode1 + " of " + arbitraryNetworkAdminOrg + " differs",
+		"account " + acct1.Hex() + " of " + arbitraryNetworkAdminOrg + " is not in the old contracts",
+	}, diffSnapshots(old, cur))
+}
+
+func TestMigrationSchedule(t *testing.T) {
+	dir, err := ioutil.TempDir("", "migration")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "permission-migration.json")
+
+	s, err := LoadMigrationSchedule(path)
+	assert.NoError(t, err)
+	assert.Nil(t, s)
+
+	schedule := &MigrationSchedule{
+		Config: ptype.PermissionConfig{
+			PermissionsModel: ptype.PERMISSION_V2,
+			InterfAddress:    common.BytesToAddress([]byte("interface")),
+			NwAdminOrg:       arbitraryNetworkAdminOrg,
+			SubOrgDepth:      big.NewInt(4),
+			SubOrgBreadth:    big.NewInt(4),
+		},
+		SwitchBlock: 100,
+	}
+	assert.NoError(t, schedule.Save(path))
+	s, err = LoadMigrationSchedule(path)
+	assert.NoError(t, err)
+	assert.Equal(t, schedule, s)
+	assert.False(t, s.due(99))
+	assert.True(t, s.due(100))
+
+	// a migration still being populated is never due
+	s.SwitchBlock = 0
+	assert.False(t, s.due(100))
+}
@@ -3,6 +3,7 @@ package permission
 import (
 	"fmt"
 	"math/big"
+	"path/filepath"
 	"sync"
 	"time"
 
@@ -26,6 +27,17 @@ func (p *PermissionCtrl) AfterStart() error {
 	if err != nil {
 		return err
 	}
+	// switch to migrated contracts if the switch block passed while the node
+	// was down
+	if err := p.loadMigration(); err != nil {
+		return err
+	}
+	if s := p.getMigration(); s != nil && s.due(p.eth.BlockChain().CurrentBlock().NumberU64()) {
+		if err := p.useMigratedConfig(s); err != nil {
+			return fmt.Errorf("failed to switch to migrated permission contracts: %v", err)
+		}
+	}
+	pcore.FreezeContracts(p.permConfig.FrozenContracts...)
 	if err = p.contract.BindContracts(); err != nil {
 		return fmt.Errorf("populateInitPermissions failed to bind contracts: %v", err)
 	}
@@ -38,6 +50,7 @@ func (p *PermissionCtrl) AfterStart() error {
 
 	// set the function point for transaction allowed check
 	pcore.PermissionTransactionAllowedFunc = p.IsTransactionAllowed
+	pcore.SetChainHeadFunc(p.chainHead)
 	setPermissionService(p)
 
 	// set the default access to ReadOnly
@@ -48,6 +61,9 @@ func (p *PermissionCtrl) AfterStart() error {
 		p.backend.ManageNodePermissions,    // monitor org  level Node management events
 		p.backend.ManageRolePermissions,    // monitor org level role management events
 		p.backend.ManageAccountPermissions, // monitor org level account management events
+		p.indexHistory,                     // record permission events for audits
+		p.trackApprovals,                   // follow network admin votes for the approval policies
+		p.monitorMigration,                 // switch to migrated contracts at the scheduled block
 	} {
 		if err := f(); err != nil {
 			return err
@@ -109,6 +125,13 @@ func (p *PermissionCtrl) asyncStart() {
 	p.updateBackEnd()
 }
 
+// returns the number and time of the chain head, which the validity windows
+// of peers and rpc callers are checked against
+func (p *PermissionCtrl) chainHead() (uint64, uint64) {
+	head := p.eth.BlockChain().CurrentHeader()
+	return head.Number.Uint64(), head.Time
+}
+
 // monitors QIP714Block and set default access
 func (p *PermissionCtrl) monitorQIP714Block() error {
 	// if QIP714block is not given, set the default access
@@ -153,12 +176,19 @@ func (p *PermissionCtrl) instantiateCache(orgCacheSize, roleCacheSize, nodeCache
 
 	pcore.AcctInfoMap = pcore.NewAcctCache(accountCacheSize)
 	pcore.AcctInfoMap.PopulateCacheFunc(p.populateAccountToCache)
+
+	pcore.ContractAccessMap = pcore.NewContractCache()
+	pcore.ValidityMap = pcore.NewValidityCache()
+	pcore.NodeCertMap = pcore.NewNodeCertCache()
 }
 
 // Thus function checks if the initial network boot up status and if no
 // populates permissions model with details from permission-config.json
 func (p *PermissionCtrl) populateInitPermissions(orgCacheSize, roleCacheSize, nodeCacheSize, accountCacheSize int) error {
 	p.instantiateCache(orgCacheSize, roleCacheSize, nodeCacheSize, accountCacheSize)
+	if err := p.loadNodeCertificates(); err != nil {
+		return err
+	}
 	networkInitialized, err := p.contract.GetNetworkBootStatus()
 	if err != nil {
 		// handle the scenario of no contract code.
@@ -188,6 +218,18 @@ func (p *PermissionCtrl) populateInitPermissions(orgCacheSize, roleCacheSize, no
 	return nil
 }
 
+// loads the certificate of this node, which transactions submitted to the
+// node are checked against. The certificate authorities of orgs are filled
+// from the org contract events.
+func (p *PermissionCtrl) loadNodeCertificates() error {
+	certs, err := pcore.ReadNodeCertificate(filepath.Join(p.dataDir, params.NODE_CERT_FILE))
+	if err != nil {
+		return fmt.Errorf("failed to load %s: %v", params.NODE_CERT_FILE, err)
+	}
+	pcore.NodeCertMap.SetNodeCertificate(enode.NewV4(&p.key.PublicKey, nil, 0, 0).EnodeID(), certs)
+	return nil
+}
+
 // initialize the permissions model and populate initial values
 func (p *PermissionCtrl) bootupNetwork() error {
 	if _, err := p.contract.SetPolicy(p.permConfig.NwAdminOrg, p.permConfig.NwAdminRole, p.permConfig.OrgAdminRole); err != nil {
@@ -400,11 +442,12 @@ func (p *PermissionCtrl) populateNodeCacheAndValidate(hexNodeId, ultimateParentI
 		numNodes := numberOfNodes.Uint64()
 		for k := uint64(0); k < numNodes; k++ {
 			if orgId, url, status, err := p.contract.GetNodeDetailsFromIndex(big.NewInt(int64(k))); err == nil {
-				if orgRec, err := pcore.OrgInfoMap.GetOrg(orgId); err != nil {
+				if orgRec, err := pcore.OrgInfoMap.GetOrg(orgId); err == nil {
 					if orgRec.UltimateParent == ultimateParentId {
 						recEnode, _ := enode.ParseV4(url)
 						if recEnode.ID() == passedEnode.ID() {
-							txnAllowed = true
+							// the certificate of the node must be for the org owning it
+							txnAllowed = pcore.IsNodeCertifiedFor(recEnode.EnodeID(), orgId)
 							pcore.NodeInfoMap.UpsertNode(orgId, url, pcore.NodeStatus(int(status.Int64())))
 						}
 					}
@@ -0,0 +1,401 @@
+package permission
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+)
+
+// actions of a reconciliation step, named after the api calls they submit
+const (
+	ActionAddOrg            = "addOrg"
+	ActionApproveOrg        = "approveOrg"
+	ActionAddSubOrg         = "addSubOrg"
+	ActionAddNewRole        = "addNewRole"
+	ActionAssignAdminRole   = "assignAdminRole"
+	ActionApproveAdminRole  = "approveAdminRole"
+	ActionAddNode           = "addNode"
+	ActionAddAccountToOrg   = "addAccountToOrg"
+	ActionChangeAccountRole = "changeAccountRole"
+)
+
+// StepStatus is the outcome of a reconciliation step
+type StepStatus string
+
+const (
+	StepDone            StepStatus = "done"
+	StepPendingApproval StepStatus = "pendingApproval"
+	StepFailed          StepStatus = "failed"
+)
+
+var (
+	ErrSubOrgAdmin  = errors.New("org admin can be declared for master orgs only")
+	ErrMissingAdmin = errors.New("new master org needs an admin account and a node")
+	ErrDuplicateOrg = errors.New("org declared more than once")
+)
+
+// time to wait for a step to show up in the permission cache, and the
+// interval it is checked at
+var (
+	reconcileStepTimeout = 30 * time.Second
+	reconcilePollPeriod  = 100 * time.Millisecond
+)
+
+// DesiredState describes the orgs, roles, nodes and accounts the network
+// should have. Sub orgs are nested in their parent org.
+type DesiredState struct {
+	Orgs []DesiredOrg `json:"orgs"`
+}
+
+type DesiredOrg struct {
+	OrgId    string           `json:"orgId"`
+	Admin    *common.Address  `json:"admin,omitempty"` // org admin of master orgs
+	Nodes    []string         `json:"nodes,omitempty"`
+	Roles    []DesiredRole    `json:"roles,omitempty"`
+	Accounts []DesiredAccount `json:"accounts,omitempty"`
+	SubOrgs  []DesiredOrg     `json:"subOrgs,omitempty"`
+}
+
+type DesiredRole struct {
+	RoleId  string          `json:"roleId"`
+	Access  core.AccessType `json:"access"`
+	IsVoter bool            `json:"isVoter"`
+	IsAdmin bool            `json:"isAdmin"`
+}
+
+type DesiredAccount struct {
+	Account common.Address `json:"account"`
+	RoleId  string         `json:"roleId"`
+}
+
+// ReconcileStep is a single api call bringing the network closer to the
+// desired state
+type ReconcileStep struct {
+	Action      string          `json:"action"`
+	OrgId       string          `json:"orgId"`
+	ParentOrgId string          `json:"parentOrgId,omitempty"`
+	Url         string          `json:"url,omitempty"`
+	Account     *common.Address `json:"account,omitempty"`
+	RoleId      string          `json:"roleId,omitempty"`
+	Access      core.AccessType `json:"access,omitempty"`
+	IsVoter     bool            `json:"isVoter,omitempty"`
+	IsAdmin     bool            `json:"isAdmin,omitempty"`
+}
+
+func (s ReconcileStep) String() string {
+	switch s.Action {
+	case ActionAddOrg, ActionApproveOrg:
+		return fmt.Sprintf("%s %s with node %s and admin %s", s.Action, s.OrgId, s.Url, s.Account.Hex())
+	case ActionAddSubOrg:
+		return fmt.Sprintf("%s %s under %s with node %q", s.Action, s.OrgId, s.ParentOrgId, s.Url)
+	case ActionAddNewRole:
+		return fmt.Sprintf("%s %s in %s with access %d voter %v admin %v", s.Action, s.RoleId, s.OrgId, s.Access, s.IsVoter, s.IsAdmin)
+	case ActionAddNode:
+		return fmt.Sprintf("%s %s to %s", s.Action, s.Url, s.OrgId)
+	default:
+		return fmt.Sprintf("%s %s as %s in %s", s.Action, s.Account.Hex(), s.RoleId, s.OrgId)
+	}
+}
+
+// ReconcilePlan lists the steps to reach the desired state in dependency
+// order. Drift lists what exists in the network but not in the desired
+// state; it is reported only, never removed.
+type ReconcilePlan struct {
+	Steps []ReconcileStep `json:"steps"`
+	Drift []string        `json:"drift"`
+}
+
+type ReconcileStepResult struct {
+	Step   ReconcileStep `json:"step"`
+	Status StepStatus    `json:"status"`
+	Error  string        `json:"error,omitempty"`
+}
+
+// LoadDesiredState reads a desired state file
+func LoadDesiredState(path string) (*DesiredState, error) {
+	blob, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var state DesiredState
+	if err := json.Unmarshal(blob, &state); err != nil {
+		return nil, fmt.Errorf("invalid desired state %s: %v", path, err)
+	}
+	return &state, nil
+}
+
+// planReconcile diffs the desired state against the permission cache
+func planReconcile(state *DesiredState, permConfig *ptype.PermissionConfig) (*ReconcilePlan, error) {
+	plan := &ReconcilePlan{Steps: []ReconcileStep{}, Drift: []string{}}
+	declared := make(map[string]bool)
+	for i := range state.Orgs {
+		if err := plan.addOrg(&state.Orgs[i], "", permConfig.OrgAdminRole, declared); err != nil {
+			return nil, err
+		}
+	}
+
+	// report the orgs, nodes and accounts the desired state doesn't know of
+	for _, o := range core.OrgInfoMap.GetOrgList() {
+		if !declared[o.FullOrgId] && o.UltimateParent != permConfig.NwAdminOrg {
+			plan.Drift = append(plan.Drift, fmt.Sprintf("org %s is not declared", o.FullOrgId))
+		}
+	}
+	nodes := make(map[string]bool)
+	accounts := make(map[common.Address]bool)
+	state.walk(func(org *DesiredOrg, fullOrgId string) {
+		for _, url := range org.Nodes {
+			nodes[url] = true
+		}
+		for _, a := range org.Accounts {
+			accounts[a.Account] = true
+		}
+		if org.Admin != nil {
+			accounts[*org.Admin] = true
+		}
+	})
+	for _, n := range core.NodeInfoMap.GetNodeList() {
+		if declared[n.OrgId] && !nodes[n.Url] {
+			plan.Drift = append(plan.Drift, fmt.Sprintf("node %s of %s is not declared", n.Url, n.OrgId))
+		}
+	}
+	for _, a := range core.AcctInfoMap.GetAcctList() {
+		if declared[a.OrgId] && !accounts[a.AcctId] {
+			plan.Drift = append(plan.Drift, fmt.Sprintf("account %s of %s is not declared", a.AcctId.Hex(), a.OrgId))
+		}
+	}
+	return plan, nil
+}
+
+// walk calls fn on every declared org with its full org id
+func (s *DesiredState) walk(fn func(org *DesiredOrg, fullOrgId string)) {
+	var visit func(org *DesiredOrg, parent string)
+	visit = func(org *DesiredOrg, parent string) {
+		fullOrgId := org.OrgId
+		if parent != "" {
+			fullOrgId = parent + "." + org.OrgId
+		}
+		fn(org, fullOrgId)
+		for i := range org.SubOrgs {
+			visit(&org.SubOrgs[i], fullOrgId)
+		}
+	}
+	for i := range s.Orgs {
+		visit(&s.Orgs[i], "")
+	}
+}
+
+// addOrg plans the org itself first, then its roles, admin, nodes and
+// accounts, and finally its sub orgs
+func (p *ReconcilePlan) addOrg(org *DesiredOrg, parent, orgAdminRole string, declared map[string]bool) error {
+	fullOrgId := org.OrgId
+	if parent != "" {
+		fullOrgId = parent + "." + org.OrgId
+		if org.Admin != nil {
+			return ErrSubOrgAdmin
+		}
+	}
+	if declared[fullOrgId] {
+		return ErrDuplicateOrg
+	}
+	declared[fullOrgId] = true
+
+	nodes := org.Nodes
+	existing, _ := core.OrgInfoMap.GetOrg(fullOrgId)
+	switch {
+	case existing == nil && parent == "":
+		if org.Admin == nil || len(nodes) == 0 {
+			return ErrMissingAdmin
+		}
+		p.Steps = append(p.Steps,
+			ReconcileStep{Action: ActionAddOrg, OrgId: org.OrgId, Url: nodes[0], Account: org.Admin},
+			ReconcileStep{Action: ActionApproveOrg, OrgId: org.OrgId, Url: nodes[0], Account: org.Admin})
+		nodes = nodes[1:]
+
+	case existing == nil:
+		var url string
+		if len(nodes) > 0 {
+			url, nodes = nodes[0], nodes[1:]
+		}
+		p.Steps = append(p.Steps, ReconcileStep{Action: ActionAddSubOrg, OrgId: org.OrgId, ParentOrgId: parent, Url: url})
+
+	case existing.Status == core.OrgPendingApproval:
+		if org.Admin == nil || len(nodes) == 0 {
+			return ErrMissingAdmin
+		}
+		p.Steps = append(p.Steps, ReconcileStep{Action: ActionApproveOrg, OrgId: org.OrgId, Url: nodes[0], Account: org.Admin})
+		nodes = nodes[1:]
+
+	default:
+		if org.Admin != nil {
+			if a, _ := core.AcctInfoMap.GetAccount(*org.Admin); a == nil || a.OrgId != fullOrgId || a.RoleId != orgAdminRole {
+				p.Steps = append(p.Steps, ReconcileStep{Action: ActionAssignAdminRole, OrgId: fullOrgId, Account: org.Admin, RoleId: orgAdminRole})
+				p.Steps = append(p.Steps, ReconcileStep{Action: ActionApproveAdminRole, OrgId: fullOrgId, Account: org.Admin, RoleId: orgAdminRole})
+			} else if a.Status == core.AcctPendingApproval {
+				p.Steps = append(p.Steps, ReconcileStep{Action: ActionApproveAdminRole, OrgId: fullOrgId, Account: org.Admin, RoleId: orgAdminRole})
+			}
+		}
+	}
+
+	for _, r := range org.Roles {
+		if existing != nil {
+			if role, _ := core.RoleInfoMap.GetRole(fullOrgId, r.RoleId); role != nil && role.Active {
+				if role.Access != r.Access || role.IsVoter != r.IsVoter || role.IsAdmin != r.IsAdmin {
+					p.Drift = append(p.Drift, fmt.Sprintf("role %s of %s differs from the declared one", r.RoleId, fullOrgId))
+				}
+				continue
+			}
+		}
+		p.Steps = append(p.Steps, ReconcileStep{Action: ActionAddNewRole, OrgId: fullOrgId, RoleId: r.RoleId, Access: r.Access, IsVoter: r.IsVoter, IsAdmin: r.IsAdmin})
+	}
+	for _, url := range nodes {
+		if n, _ := core.NodeInfoMap.GetNodeByUrl(url); n != nil {
+			if n.OrgId != fullOrgId {
+				p.Drift = append(p.Drift, fmt.Sprintf("node %s belongs to %s", url, n.OrgId))
+			}
+			continue
+		}
+		p.Steps = append(p.Steps, ReconcileStep{Action: ActionAddNode, OrgId: fullOrgId, Url: url})
+	}
+	for _, acct := range org.Accounts {
+		acct := acct
+		a, _ := core.AcctInfoMap.GetAccount(acct.Account)
+		switch {
+		case a == nil:
+			p.Steps = append(p.Steps, ReconcileStep{Action: ActionAddAccountToOrg, OrgId: fullOrgId, Account: &acct.Account, RoleId: acct.RoleId})
+		case a.OrgId != fullOrgId:
+			p.Drift = append(p.Drift, fmt.Sprintf("account %s belongs to %s", acct.Account.Hex(), a.OrgId))
+		case a.RoleId != acct.RoleId:
+			p.Steps = append(p.Steps, ReconcileStep{Action: ActionChangeAccountRole, OrgId: fullOrgId, Account: &acct.Account, RoleId: acct.RoleId})
+		}
+	}
+	for i := range org.SubOrgs {
+		if err := p.addOrg(&org.SubOrgs[i], fullOrgId, orgAdminRole, declared); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// submits the api call of the step
+func (q *QuorumControlsAPI) submitStep(step ReconcileStep, txa ethapi.SendTxArgs) error {
+	var err error
+	switch step.Action {
+	case ActionAddOrg:
+		_, err = q.AddOrg(step.OrgId, step.Url, *step.Account, txa)
+	case ActionApproveOrg:
+		_, err = q.ApproveOrg(step.OrgId, step.Url, *step.Account, txa)
+	case ActionAddSubOrg:
+		_, err = q.AddSubOrg(step.ParentOrgId, step.OrgId, step.Url, txa)
+	case ActionAddNewRole:
+		_, err = q.AddNewRole(step.OrgId, step.RoleId, uint8(step.Access), step.IsVoter, step.IsAdmin, txa)
+	case ActionAssignAdminRole:
+		_, err = q.AssignAdminRole(step.OrgId, *step.Account, step.RoleId, txa)
+	case ActionApproveAdminRole:
+		_, err = q.ApproveAdminRole(step.OrgId, *step.Account, txa)
+	case ActionAddNode:
+		_, err = q.AddNode(step.OrgId, step.Url, txa)
+	case ActionAddAccountToOrg:
+		_, err = q.AddAccountToOrg(*step.Account, step.OrgId, step.RoleId, txa)
+	case ActionChangeAccountRole:
+		_, err = q.ChangeAccountRole(*step.Account, step.OrgId, step.RoleId, txa)
+	default:
+		err = fmt.Errorf("unknown action %s", step.Action)
+	}
+	return err
+}
+
+// stepApplied checks if the permission cache reflects the step
+func stepApplied(step ReconcileStep) bool {
+	fullOrgId := step.OrgId
+	if step.ParentOrgId != "" {
+		fullOrgId = step.ParentOrgId + "." + step.OrgId
+	}
+	switch step.Action {
+	case ActionAddOrg, ActionAddSubOrg:
+		org, _ := core.OrgInfoMap.GetOrg(fullOrgId)
+		return org != nil
+	case ActionApproveOrg:
+		org, _ := core.OrgInfoMap.GetOrg(fullOrgId)
+		return org != nil && org.Status == core.OrgApproved
+	case ActionAddNewRole:
+		role, _ := core.RoleInfoMap.GetRole(fullOrgId, step.RoleId)
+		return role != nil && role.Active
+	case ActionAddNode:
+		node, _ := core.NodeInfoMap.GetNodeByUrl(step.Url)
+		return node != nil
+	case ActionAssignAdminRole:
+		a, _ := core.AcctInfoMap.GetAccount(*step.Account)
+		return a != nil && a.OrgId == fullOrgId && a.RoleId == step.RoleId
+	default:
+		a, _ := core.AcctInfoMap.GetAccount(*step.Account)
+		return a != nil && a.OrgId == fullOrgId && a.RoleId == step.RoleId && a.Status == core.AcctActive
+	}
+}
+
+// applyPlan submits the steps in order, waiting for each to be mined before
+// the next one. It stops at the first step failing or still waiting for the
+// approval of other voters, as the later steps may depend on it, and when
+// the context is cancelled or the permission service stops.
+func (q *QuorumControlsAPI) applyPlan(ctx context.Context, plan *ReconcilePlan, txa ethapi.SendTxArgs) []ReconcileStepResult {
+	stopChan, stopSubscription := ptype.SubscribeStopEvent()
+	defer stopSubscription.Unsubscribe()
+	ticker := time.NewTicker(reconcilePollPeriod)
+	defer ticker.Stop()
+
+	results := make([]ReconcileStepResult, 0, len(plan.Steps))
+	for _, step := range plan.Steps {
+		result := ReconcileStepResult{Step: step, Status: StepDone}
+		if err := q.submitStep(step, txa); err != nil {
+			result.Status, result.Error = StepFailed, err.Error()
+			return append(results, result)
+		}
+		timeout := time.NewTimer(reconcileStepTimeout)
+		for !stepApplied(step) {
+			select {
+			case <-ticker.C:
+				continue
+			case <-timeout.C:
+				if step.Action == ActionApproveOrg || step.Action == ActionApproveAdminRole {
+					result.Status = StepPendingApproval
+				} else {
+					result.Status, result.Error = StepFailed, "timed out waiting for the step to be mined"
+				}
+			case <-ctx.Done():
+				result.Status, result.Error = StepFailed, "reconciliation cancelled: "+ctx.Err().Error()
+			case <-stopChan:
+				result.Status, result.Error = StepFailed, "permission service stopped"
+			}
+			timeout.Stop()
+			return append(results, result)
+		}
+		timeout.Stop()
+		log.Info("Reconciled permission step", "step", step)
+		results = append(results, result)
+	}
+	return results
+}
+
+// PlanReconcile returns the api calls needed to reach the desired state
+func (q *QuorumControlsAPI) PlanReconcile(state DesiredState) (*ReconcilePlan, error) {
+	return planReconcile(&state, q.permCtrl.permConfig)
+}
+
+// Reconcile submits the api calls needed to reach the desired state from
+// the given account, in dependency order. Waiting for the steps stops when
+// the rpc request is cancelled.
+func (q *QuorumControlsAPI) Reconcile(ctx context.Context, state DesiredState, txa ethapi.SendTxArgs) ([]ReconcileStepResult, error) {
+	plan, err := q.PlanReconcile(state)
+	if err != nil {
+		return nil, err
+	}
+	return q.applyPlan(ctx, plan, txa), nil
+}
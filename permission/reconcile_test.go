@@ -0,0 +1,77 @@
+package permission
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlanReconcile(t *testing.T) {
+	orgCache, nodeCache, roleCache, acctCache := pcore.OrgInfoMap, pcore.NodeInfoMap, pcore.RoleInfoMap, pcore.AcctInfoMap
+	defer func() {
+		pcore.OrgInfoMap, pcore.NodeInfoMap, pcore.RoleInfoMap, pcore.AcctInfoMap = orgCache, nodeCache, roleCache, acctCache
+	}()
+	pcore.OrgInfoMap = pcore.NewOrgCache(orgCacheSize)
+	pcore.NodeInfoMap = pcore.NewNodeCache(nodeCacheSize)
+	pcore.RoleInfoMap = pcore.NewRoleCache(roleCacheSize)
+	pcore.AcctInfoMap = pcore.NewAcctCache(accountCacheSize)
+	permConfig := &ptype.PermissionConfig{NwAdminOrg: arbitraryNetworkAdminOrg, NwAdminRole: arbitraryNetworkAdminRole, OrgAdminRole: arbitraryOrgAdminRole}
+
+	admin := common.BytesToAddress([]byte("admin"))
+	acct1 := common.BytesToAddress([]byte("acct1"))
+	acct2 := common.BytesToAddress([]byte("acct2"))
+	pcore.OrgInfoMap.UpsertOrg(arbitraryNetworkAdminOrg, "", arbitraryNetworkAdminOrg, big.NewInt(1), pcore.OrgApproved)
+
+	state := &DesiredState{Orgs: []DesiredOrg{{
+		OrgId: arbitraryOrgToAdd,
+		Admin: &admin,
+		Nodes: []string{arbitraryNode1, arbitraryNode2},
+		Roles: []DesiredRole{{RoleId: arbitrartNewRole1, Access: pcore.Transact}},
+		SubOrgs: []DesiredOrg{{
+			OrgId:    arbitrarySubOrg,
+			Nodes:    []string{arbitraryNode3},
+			Accounts: []DesiredAccount{{Account: acct1, RoleId: arbitrartNewRole1}},
+		}},
+	}}}
+
+	// 1. a new network gets everything created in dependency order
+	plan, err := planReconcile(state, permConfig)
+	assert.NoError(t, err)
+	var actions []string
+	for _, step := range plan.Steps {
+		actions = append(actions, step.Action)
+	}
+	assert.Equal(t, []string{ActionAddOrg, ActionApproveOrg, ActionAddNewRole, ActionAddNode, ActionAddSubOrg, ActionAddAccountToOrg}, actions)
+	assert.Equal(t, arbitraryNode1, plan.Steps[0].Url)
+	assert.Equal(t, arbitraryNode3, plan.Steps[4].Url)
+	assert.Equal(t, arbitraryOrgToAdd+"."+arbitrarySubOrg, plan.Steps[5].OrgId)
+	assert.Empty(t, plan.Drift)
+
+	// 2. a pending org needs approval only, existing parts are skipped and
+	// undeclared ones reported
+	pcore.OrgInfoMap.UpsertOrg(arbitraryOrgToAdd, "", arbitraryOrgToAdd, big.NewInt(1), pcore.OrgPendingApproval)
+	pcore.OrgInfoMap.UpsertOrg(arbitrarySubOrg, arbitraryOrgToAdd, arbitraryOrgToAdd, big.NewInt(2), pcore.OrgApproved)
+	pcore.NodeInfoMap.UpsertNode(arbitraryOrgToAdd, arbitraryNode2, pcore.NodeApproved)
+	pcore.NodeInfoMap.UpsertNode(arbitraryOrgToAdd+"."+arbitrarySubOrg, arbitraryNode4, pcore.NodeApproved)
+	pcore.AcctInfoMap.UpsertAccount(arbitraryOrgToAdd+"."+arbitrarySubOrg, arbitrartNewRole2, acct1, false, pcore.AcctActive)
+	pcore.AcctInfoMap.UpsertAccount(arbitraryOrgToAdd, arbitrartNewRole1, acct2, false, pcore.AcctActive)
+
+	plan, err = planReconcile(state, permConfig)
+	assert.NoError(t, err)
+	actions = nil
+	for _, step := range plan.Steps {
+		actions = append(actions, step.Action)
+	}
+	assert.Equal(t, []string{ActionApproveOrg, ActionAddNewRole, ActionAddNode, ActionChangeAccountRole}, actions)
+	assert.Len(t, plan.Drift, 2)
+
+	// 3. invalid states are rejected
+	_, err = planReconcile(&DesiredState{Orgs: []DesiredOrg{{OrgId: "ORG2"}}}, permConfig)
+	assert.Equal(t, ErrMissingAdmin, err)
+	_, err = planReconcile(&DesiredState{Orgs: []DesiredOrg{{OrgId: arbitraryNetworkAdminOrg, SubOrgs: []DesiredOrg{{OrgId: "SUB2", Admin: &admin}}}}}, permConfig)
+	assert.Equal(t, ErrSubOrgAdmin, err)
+}
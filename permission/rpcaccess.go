@@ -0,0 +1,53 @@
+package permission
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
+)
+
+// returns the file the rpc access rules are stored in
+func (p *PermissionCtrl) rpcAccessPath() string {
+	return filepath.Join(p.dataDir, params.RPC_ACCESS_CONFIG)
+}
+
+// authorizeRpcCall checks a call granted by the security plugin against the
+// on-chain permissions of the account the token's identity maps to
+func (p *PermissionCtrl) authorizeRpcCall(token *proto.PreAuthenticatedAuthenticationToken, service, method string) error {
+	return pcore.RpcAccessMap.Authorize(rpcIdentity(token.GetRawToken()), service, method)
+}
+
+// rpcIdentity returns the client an access token was issued to: the subject
+// of a JWT, or its client id if it has no subject. Other tokens have no
+// identity. The token is verified by the security plugin already.
+func rpcIdentity(rawToken []byte) string {
+	token := strings.TrimSpace(string(rawToken))
+	// drop the authorization scheme, e.g. Bearer
+	if i := strings.LastIndexByte(token, ' '); i >= 0 {
+		token = token[i+1:]
+	}
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		Subject  string `json:"sub"`
+		ClientId string `json:"client_id"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	if claims.Subject != "" {
+		return claims.Subject
+	}
+	return claims.ClientId
+}
@@ -0,0 +1,20 @@
+package permission
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRpcIdentity(t *testing.T) {
+	jwt := func(claims string) string {
+		return "eyJhbGciOiJSUzI1NiJ9." + base64.RawURLEncoding.EncodeToString([]byte(claims)) + ".c2lnbmF0dXJl"
+	}
+	assert.Equal(t, "ops", rpcIdentity([]byte(jwt(`{"sub":"ops","client_id":"cli"}`))))
+	assert.Equal(t, "ops", rpcIdentity([]byte("Bearer "+jwt(`{"sub":"ops"}`))))
+	assert.Equal(t, "cli", rpcIdentity([]byte(jwt(`{"client_id":"cli"}`))))
+	assert.Equal(t, "", rpcIdentity([]byte("opaque-token")))
+	assert.Equal(t, "", rpcIdentity([]byte("a.!!.c")))
+	assert.Equal(t, "", rpcIdentity(nil))
+}
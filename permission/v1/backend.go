@@ -25,21 +25,26 @@ func (b *Backend) ManageAccountPermissions() error {
 	var blockNumber uint64 = 1
 	opts.Start = &blockNumber
 
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessModified(opts, chAccessModified); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessModified(opts, chAccessModified)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed AccountAccessModified: %v", err)
 	}
 
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessRevoked(opts, chAccessRevoked); err != nil {
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessRevoked(opts, chAccessRevoked)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed AccountAccessRevoked: %v", err)
 	}
 
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountStatusChanged(opts, chStatusChanged); err != nil {
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchAccountStatusChanged(opts, chStatusChanged)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed AccountStatusChanged: %v", err)
 	}
 
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtAccessModified := <-chAccessModified:
@@ -72,17 +77,21 @@ func (b *Backend) ManageRolePermissions() error {
 	opts.Start = &blockNumber
 	contract := b.Contr
 
-	if _, err := contract.PermRole.RoleManagerFilterer.WatchRoleCreated(opts, chRoleCreated); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(contract.PermRole.RoleManagerFilterer.WatchRoleCreated(opts, chRoleCreated)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchRoleCreated: %v", err)
 	}
 
-	if _, err := contract.PermRole.RoleManagerFilterer.WatchRoleRevoked(opts, chRoleRevoked); err != nil {
+	if err := watch.Add(contract.PermRole.RoleManagerFilterer.WatchRoleRevoked(opts, chRoleRevoked)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchRoleRevoked: %v", err)
 	}
 
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtRoleCreated := <-chRoleCreated:
@@ -114,25 +123,31 @@ func (b *Backend) ManageOrgPermissions() error {
 	opts.Start = &blockNumber
 	contract := b.Contr
 
-	if _, err := contract.PermOrg.OrgManagerFilterer.WatchOrgPendingApproval(opts, chPendingApproval); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(contract.PermOrg.OrgManagerFilterer.WatchOrgPendingApproval(opts, chPendingApproval)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchOrgPendingApproval: %v", err)
 	}
 
-	if _, err := contract.PermOrg.OrgManagerFilterer.WatchOrgApproved(opts, chOrgApproved); err != nil {
+	if err := watch.Add(contract.PermOrg.OrgManagerFilterer.WatchOrgApproved(opts, chOrgApproved)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchOrgApproved: %v", err)
 	}
 
-	if _, err := contract.PermOrg.OrgManagerFilterer.WatchOrgSuspended(opts, chOrgSuspended); err != nil {
+	if err := watch.Add(contract.PermOrg.OrgManagerFilterer.WatchOrgSuspended(opts, chOrgSuspended)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchOrgSuspended: %v", err)
 	}
 
-	if _, err := contract.PermOrg.OrgManagerFilterer.WatchOrgSuspensionRevoked(opts, chOrgReactivated); err != nil {
+	if err := watch.Add(contract.PermOrg.OrgManagerFilterer.WatchOrgSuspensionRevoked(opts, chOrgReactivated)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchOrgSuspensionRevoked: %v", err)
 	}
 
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtPendingApproval := <-chPendingApproval:
@@ -169,36 +184,45 @@ func (b *Backend) ManageNodePermissions() error {
 	opts.Start = &blockNumber
 	contract := b.Contr
 
-	if _, err := contract.PermNode.NodeManagerFilterer.WatchNodeApproved(opts, chNodeApproved); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(contract.PermNode.NodeManagerFilterer.WatchNodeApproved(opts, chNodeApproved)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchNodeApproved: %v", err)
 	}
 
-	if _, err := contract.PermNode.NodeManagerFilterer.WatchNodeProposed(opts, chNodeProposed); err != nil {
+	if err := watch.Add(contract.PermNode.NodeManagerFilterer.WatchNodeProposed(opts, chNodeProposed)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchNodeProposed: %v", err)
 	}
 
-	if _, err := contract.PermNode.NodeManagerFilterer.WatchNodeDeactivated(opts, chNodeDeactivated); err != nil {
+	if err := watch.Add(contract.PermNode.NodeManagerFilterer.WatchNodeDeactivated(opts, chNodeDeactivated)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed NodeDeactivated: %v", err)
 	}
-	if _, err := contract.PermNode.NodeManagerFilterer.WatchNodeActivated(opts, chNodeActivated); err != nil {
+	if err := watch.Add(contract.PermNode.NodeManagerFilterer.WatchNodeActivated(opts, chNodeActivated)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchNodeActivated: %v", err)
 	}
 
-	if _, err := contract.PermNode.NodeManagerFilterer.WatchNodeBlacklisted(opts, chNodeBlacklisted); err != nil {
+	if err := watch.Add(contract.PermNode.NodeManagerFilterer.WatchNodeBlacklisted(opts, chNodeBlacklisted)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed NodeBlacklisting: %v", err)
 	}
 
-	if _, err := contract.PermNode.NodeManagerFilterer.WatchNodeRecoveryInitiated(opts, chNodeRecoveryInit); err != nil {
+	if err := watch.Add(contract.PermNode.NodeManagerFilterer.WatchNodeRecoveryInitiated(opts, chNodeRecoveryInit)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed NodeRecoveryInitiated: %v", err)
 	}
 
-	if _, err := contract.PermNode.NodeManagerFilterer.WatchNodeRecoveryCompleted(opts, chNodeRecoveryDone); err != nil {
+	if err := watch.Add(contract.PermNode.NodeManagerFilterer.WatchNodeRecoveryCompleted(opts, chNodeRecoveryDone)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed NodeRecoveryCompleted: %v", err)
 	}
 
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtNodeApproved := <-chNodeApproved:
@@ -262,13 +286,16 @@ func (b *Backend) MonitorNetworkBootUp() error {
 	var blockNumber uint64 = 1
 	opts.Start = &blockNumber
 
-	if _, err := b.Contr.PermImpl.PermImplFilterer.WatchPermissionsInitialized(opts, netWorkBootCh); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(b.Contr.PermImpl.PermImplFilterer.WatchPermissionsInitialized(opts, netWorkBootCh)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchPermissionsInitialized: %v", err)
 	}
 
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtMetworkBootUpCompleted := <-netWorkBootCh:
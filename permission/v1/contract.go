@@ -165,6 +165,14 @@ func (a *Audit) CheckPendingOp(_orgId string) bool {
 	return err == nil && op.Int64() != 0
 }
 
+func (a *Audit) GetPendingOp(_authOrg string) (string, string, common.Address, int64, error) {
+	pOrg, pEnodeId, pAcct, op, err := a.Backend.PermInterfSession.GetPendingOp(_authOrg)
+	if err != nil {
+		return "", "", common.Address{}, 0, err
+	}
+	return pOrg, pEnodeId, pAcct, op.Int64(), nil
+}
+
 func (c *Control) ConnectionAllowed(_enodeId, _ip string, _port, _raftPort uint16) (bool, error) {
 	passedEnodeId, err := enode.ParseV4(_enodeId)
 	if err != nil {
@@ -272,6 +280,43 @@ func (a *Account) AssignAdminRole(_args ptype.TxArgs) (*types.Transaction, error
 	return a.Backend.PermInterfSession.AssignAdminRole(_args.OrgId, _args.AcctId, _args.RoleId)
 }
 
+// contract level allow lists are part of the V2 model only
+func (a *Account) AddContractAccess(_args ptype.TxArgs) (*types.Transaction, error) {
+	return nil, ptype.ErrOpNotAllowed
+}
+
+func (a *Account) RemoveContractAccess(_args ptype.TxArgs) (*types.Transaction, error) {
+	return nil, ptype.ErrOpNotAllowed
+}
+
+// validity windows are part of the V2 model only
+func (a *Account) SetAccountValidity(_args ptype.TxArgs) (*types.Transaction, error) {
+	return nil, ptype.ErrOpNotAllowed
+}
+
+func (n *Node) SetNodeValidity(_args ptype.TxArgs) (*types.Transaction, error) {
+	return nil, ptype.ErrOpNotAllowed
+}
+
+// approval policies are part of the V2 model only, the V1 voter contract
+// always completes on a majority
+func (o *Org) SetApprovalPolicy(_args ptype.TxArgs) (*types.Transaction, error) {
+	return nil, ptype.ErrOpNotAllowed
+}
+
+// certificate authorities of orgs are part of the V2 model only
+func (o *Org) AddOrgCA(_args ptype.TxArgs) (*types.Transaction, error) {
+	return nil, ptype.ErrOpNotAllowed
+}
+
+func (o *Org) RemoveOrgCA(_args ptype.TxArgs) (*types.Transaction, error) {
+	return nil, ptype.ErrOpNotAllowed
+}
+
+func (a *Audit) GetApprovalPolicy(_pendingOp int64) (*big.Int, error) {
+	return nil, ptype.ErrOpNotAllowed
+}
+
 // This is to make sure all Contr instances are ready and initialized
 //
 // Required to be call after standard service start lifecycle
@@ -1,7 +1,9 @@
 package v2
 
 import (
+	"encoding/hex"
 	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -20,26 +22,49 @@ func (b *Backend) ManageAccountPermissions() error {
 	chAccessModified := make(chan *eb.AcctManagerAccountAccessModified)
 	chAccessRevoked := make(chan *eb.AcctManagerAccountAccessRevoked)
 	chStatusChanged := make(chan *eb.AcctManagerAccountStatusChanged)
+	chContractAccessModified := make(chan *eb.AcctManagerContractAccessModified)
+	chContractAccessRevoked := make(chan *eb.AcctManagerContractAccessRevoked)
+	chValidityChanged := make(chan *eb.AcctManagerAccountValidityChanged)
 
 	opts := &bind.WatchOpts{}
 	var blockNumber uint64 = 1
 	opts.Start = &blockNumber
 
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessModified(opts, chAccessModified); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessModified(opts, chAccessModified)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed AccountAccessModified: %v", err)
 	}
 
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessRevoked(opts, chAccessRevoked); err != nil {
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchAccountAccessRevoked(opts, chAccessRevoked)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed AccountAccessRevoked: %v", err)
 	}
 
-	if _, err := b.Contr.PermAcct.AcctManagerFilterer.WatchAccountStatusChanged(opts, chStatusChanged); err != nil {
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchAccountStatusChanged(opts, chStatusChanged)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed AccountStatusChanged: %v", err)
 	}
 
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchContractAccessModified(opts, chContractAccessModified)); err != nil {
+		watch.Stop()
+		return fmt.Errorf("failed ContractAccessModified: %v", err)
+	}
+
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchContractAccessRevoked(opts, chContractAccessRevoked)); err != nil {
+		watch.Stop()
+		return fmt.Errorf("failed ContractAccessRevoked: %v", err)
+	}
+
+	if err := watch.Add(b.Contr.PermAcct.AcctManagerFilterer.WatchAccountValidityChanged(opts, chValidityChanged)); err != nil {
+		watch.Stop()
+		return fmt.Errorf("failed AccountValidityChanged: %v", err)
+	}
+
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtAccessModified := <-chAccessModified:
@@ -54,6 +79,24 @@ func (b *Backend) ManageAccountPermissions() error {
 				} else {
 					log.Info("error fetching account information", "err", err)
 				}
+
+			case evtContractAccessModified := <-chContractAccessModified:
+				access := contractAccessInfo(evtContractAccessModified.Contract, evtContractAccessModified.Account, evtContractAccessModified.OrgId, evtContractAccessModified.RoleId)
+				for _, s := range evtContractAccessModified.Selectors {
+					access.Selectors = append(access.Selectors, common.CopyBytes(s[:]))
+				}
+				if err := core.ContractAccessMap.UpsertContractAccess(access); err != nil {
+					log.Error("error updating contract access", "contract", access.Contract, "err", err)
+				}
+
+			case evtContractAccessRevoked := <-chContractAccessRevoked:
+				access := contractAccessInfo(evtContractAccessRevoked.Contract, evtContractAccessRevoked.Account, evtContractAccessRevoked.OrgId, evtContractAccessRevoked.RoleId)
+				if err := core.ContractAccessMap.RemoveContractAccess(access); err != nil {
+					log.Error("error removing contract access", "contract", access.Contract, "err", err)
+				}
+
+			case evtValidityChanged := <-chValidityChanged:
+				updateValidity(core.ValidityInfo{Account: &evtValidityChanged.Account}, evtValidityChanged.FromBlock, evtValidityChanged.UntilBlock, evtValidityChanged.FromTime, evtValidityChanged.UntilTime)
 			case <-stopChan:
 				log.Info("quit account contract watch")
 				return
@@ -63,6 +106,32 @@ func (b *Backend) ManageAccountPermissions() error {
 	return nil
 }
 
+// builds the cache entry of a contract access event, the contracts use a
+// zero account for accesses given to a role
+func contractAccessInfo(contract, account common.Address, orgId, roleId string) core.ContractAccessInfo {
+	access := core.ContractAccessInfo{Contract: contract}
+	if account != (common.Address{}) {
+		access.AcctId = &account
+	} else {
+		access.OrgId, access.RoleId = orgId, roleId
+	}
+	return access
+}
+
+// applies a validity window event to the cache, an empty window removes the
+// existing one
+func updateValidity(vi core.ValidityInfo, fromBlock, untilBlock, fromTime, untilTime *big.Int) {
+	vi.FromBlock, vi.UntilBlock = fromBlock.Uint64(), untilBlock.Uint64()
+	vi.FromTime, vi.UntilTime = fromTime.Uint64(), untilTime.Uint64()
+	if vi.FromBlock == 0 && vi.UntilBlock == 0 && vi.FromTime == 0 && vi.UntilTime == 0 {
+		core.ValidityMap.RemoveValidity(vi.Account, vi.EnodeId)
+		return
+	}
+	if err := core.ValidityMap.UpsertValidity(vi); err != nil {
+		log.Error("error updating validity window", "account", vi.Account, "enodeId", vi.EnodeId, "err", err)
+	}
+}
+
 func (b *Backend) ManageRolePermissions() error {
 	chRoleCreated := make(chan *eb.RoleManagerRoleCreated, 1)
 	chRoleRevoked := make(chan *eb.RoleManagerRoleRevoked, 1)
@@ -71,17 +140,21 @@ func (b *Backend) ManageRolePermissions() error {
 	var blockNumber uint64 = 1
 	opts.Start = &blockNumber
 
-	if _, err := b.Contr.PermRole.RoleManagerFilterer.WatchRoleCreated(opts, chRoleCreated); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(b.Contr.PermRole.RoleManagerFilterer.WatchRoleCreated(opts, chRoleCreated)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchRoleCreated: %v", err)
 	}
 
-	if _, err := b.Contr.PermRole.RoleManagerFilterer.WatchRoleRevoked(opts, chRoleRevoked); err != nil {
+	if err := watch.Add(b.Contr.PermRole.RoleManagerFilterer.WatchRoleRevoked(opts, chRoleRevoked)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchRoleRevoked: %v", err)
 	}
 
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtRoleCreated := <-chRoleCreated:
@@ -107,30 +180,48 @@ func (b *Backend) ManageOrgPermissions() error {
 	chOrgApproved := make(chan *eb.OrgManagerOrgApproved, 1)
 	chOrgSuspended := make(chan *eb.OrgManagerOrgSuspended, 1)
 	chOrgReactivated := make(chan *eb.OrgManagerOrgSuspensionRevoked, 1)
+	chOrgCAAdded := make(chan *eb.OrgManagerOrgCAAdded, 1)
+	chOrgCARemoved := make(chan *eb.OrgManagerOrgCARemoved, 1)
 
 	opts := &bind.WatchOpts{}
 	var blockNumber uint64 = 1
 	opts.Start = &blockNumber
 
-	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgPendingApproval(opts, chPendingApproval); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(b.Contr.PermOrg.OrgManagerFilterer.WatchOrgPendingApproval(opts, chPendingApproval)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchOrgPendingApproval: %v", err)
 	}
 
-	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgApproved(opts, chOrgApproved); err != nil {
+	if err := watch.Add(b.Contr.PermOrg.OrgManagerFilterer.WatchOrgApproved(opts, chOrgApproved)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchOrgApproved: %v", err)
 	}
 
-	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgSuspended(opts, chOrgSuspended); err != nil {
+	if err := watch.Add(b.Contr.PermOrg.OrgManagerFilterer.WatchOrgSuspended(opts, chOrgSuspended)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchOrgSuspended: %v", err)
 	}
 
-	if _, err := b.Contr.PermOrg.OrgManagerFilterer.WatchOrgSuspensionRevoked(opts, chOrgReactivated); err != nil {
+	if err := watch.Add(b.Contr.PermOrg.OrgManagerFilterer.WatchOrgSuspensionRevoked(opts, chOrgReactivated)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchOrgSuspensionRevoked: %v", err)
 	}
 
+	if err := watch.Add(b.Contr.PermOrg.OrgManagerFilterer.WatchOrgCAAdded(opts, chOrgCAAdded)); err != nil {
+		watch.Stop()
+		return fmt.Errorf("failed WatchOrgCAAdded: %v", err)
+	}
+
+	if err := watch.Add(b.Contr.PermOrg.OrgManagerFilterer.WatchOrgCARemoved(opts, chOrgCARemoved)); err != nil {
+		watch.Stop()
+		return fmt.Errorf("failed WatchOrgCARemoved: %v", err)
+	}
+
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtPendingApproval := <-chPendingApproval:
@@ -144,6 +235,20 @@ func (b *Backend) ManageOrgPermissions() error {
 
 			case evtOrgReactivated := <-chOrgReactivated:
 				core.OrgInfoMap.UpsertOrg(evtOrgReactivated.OrgId, evtOrgReactivated.PorgId, evtOrgReactivated.UltParent, evtOrgReactivated.Level, core.OrgApproved)
+
+			case evtOrgCAAdded := <-chOrgCAAdded:
+				if err := core.NodeCertMap.AddOrgCA(core.OrgCAFromDER(evtOrgCAAdded.OrgId, evtOrgCAAdded.Certificate)); err != nil && err != core.ErrOrgCAExists {
+					log.Error("error adding org certificate authority", "orgId", evtOrgCAAdded.OrgId, "err", err)
+				}
+				b.dropUncertifiedPeers()
+
+			case evtOrgCARemoved := <-chOrgCARemoved:
+				fingerprint := hex.EncodeToString(evtOrgCARemoved.Fingerprint[:])
+				if err := core.NodeCertMap.RemoveOrgCA(evtOrgCARemoved.OrgId, fingerprint); err != nil {
+					log.Error("error removing org certificate authority", "orgId", evtOrgCARemoved.OrgId, "fingerprint", fingerprint, "err", err)
+				}
+				b.dropUncertifiedPeers()
+
 			case <-stopChan:
 				log.Info("quit org contract watch")
 				return
@@ -153,6 +258,16 @@ func (b *Backend) ManageOrgPermissions() error {
 	return nil
 }
 
+// disconnects the peers which no longer meet the certificate requirements of
+// their org
+func (b *Backend) dropUncertifiedPeers() {
+	if n := b.Ib.Node(); n != nil {
+		if srv := n.Server(); srv != nil {
+			srv.DropUnpermissionedPeers()
+		}
+	}
+}
+
 func (b *Backend) ManageNodePermissions() error {
 	chNodeApproved := make(chan *eb.NodeManagerNodeApproved, 1)
 	chNodeProposed := make(chan *eb.NodeManagerNodeProposed, 1)
@@ -161,41 +276,56 @@ func (b *Backend) ManageNodePermissions() error {
 	chNodeBlacklisted := make(chan *eb.NodeManagerNodeBlacklisted)
 	chNodeRecoveryInit := make(chan *eb.NodeManagerNodeRecoveryInitiated, 1)
 	chNodeRecoveryDone := make(chan *eb.NodeManagerNodeRecoveryCompleted, 1)
+	chNodeValidityChanged := make(chan *eb.NodeManagerNodeValidityChanged, 1)
 
 	opts := &bind.WatchOpts{}
 	var blockNumber uint64 = 1
 	opts.Start = &blockNumber
 
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeApproved(opts, chNodeApproved); err != nil {
+	var watch ptype.ContractWatch
+	if err := watch.Add(b.Contr.PermNode.NodeManagerFilterer.WatchNodeApproved(opts, chNodeApproved)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchNodeApproved: %v", err)
 	}
 
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeProposed(opts, chNodeProposed); err != nil {
+	if err := watch.Add(b.Contr.PermNode.NodeManagerFilterer.WatchNodeProposed(opts, chNodeProposed)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchNodeProposed: %v", err)
 	}
 
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeDeactivated(opts, chNodeDeactivated); err != nil {
+	if err := watch.Add(b.Contr.PermNode.NodeManagerFilterer.WatchNodeDeactivated(opts, chNodeDeactivated)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed NodeDeactivated: %v", err)
 	}
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeActivated(opts, chNodeActivated); err != nil {
+	if err := watch.Add(b.Contr.PermNode.NodeManagerFilterer.WatchNodeActivated(opts, chNodeActivated)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed WatchNodeActivated: %v", err)
 	}
 
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeBlacklisted(opts, chNodeBlacklisted); err != nil {
+	if err := watch.Add(b.Contr.PermNode.NodeManagerFilterer.WatchNodeBlacklisted(opts, chNodeBlacklisted)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed NodeBlacklisting: %v", err)
 	}
 
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeRecoveryInitiated(opts, chNodeRecoveryInit); err != nil {
+	if err := watch.Add(b.Contr.PermNode.NodeManagerFilterer.WatchNodeRecoveryInitiated(opts, chNodeRecoveryInit)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed NodeRecoveryInitiated: %v", err)
 	}
 
-	if _, err := b.Contr.PermNode.NodeManagerFilterer.WatchNodeRecoveryCompleted(opts, chNodeRecoveryDone); err != nil {
+	if err := watch.Add(b.Contr.PermNode.NodeManagerFilterer.WatchNodeRecoveryCompleted(opts, chNodeRecoveryDone)); err != nil {
+		watch.Stop()
 		return fmt.Errorf("failed NodeRecoveryCompleted: %v", err)
 	}
 
+	if err := watch.Add(b.Contr.PermNode.NodeManagerFilterer.WatchNodeValidityChanged(opts, chNodeValidityChanged)); err != nil {
+		watch.Stop()
+		return fmt.Errorf("failed NodeValidityChanged: %v", err)
+	}
+
+	stopChan, stopSubscription := ptype.SubscribeContractStopEvent()
 	go func() {
-		stopChan, stopSubscription := ptype.SubscribeStopEvent()
 		defer stopSubscription.Unsubscribe()
+		defer watch.Stop()
 		for {
 			select {
 			case evtNodeApproved := <-chNodeApproved:
@@ -250,6 +380,9 @@ func (b *Backend) ManageNodePermissions() error {
 					log.Error("error updating permissioned-nodes.json", "err", err)
 				}
 
+			case evtNodeValidityChanged := <-chNodeValidityChanged:
+				updateValidity(core.ValidityInfo{EnodeId: evtNodeValidityChanged.EnodeId}, evtNodeValidityChanged.FromBlock, evtNodeValidityChanged.UntilBlock, evtNodeValidityChanged.FromTime, evtNodeValidityChanged.UntilTime)
+
 			case <-stopChan:
 				log.Info("quit Node contract watch")
 				return
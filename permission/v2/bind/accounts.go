@@ -28,12 +28,12 @@ var (
 )
 
 // AcctManagerABI is the input ABI used to generate the binding from.
-const AcctManagerABI = "[{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_adminRole\",\"type\":\"bool\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeExistingAdmin\",\"outputs\":[{\"name\":\"voterUpdate\",\"type\":\"bool\"},{\"name\":\"account\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountDetails\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNumberOfAccounts\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountOrgRole\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountRole\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"orgAdminExists\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_aIndex\",\"type\":\"uint256\"}],\"name\":\"getAccountDetailsFromIndex\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addNewAdmin\",\"outputs\":[{\"name\":\"voterUpdate\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setDefaults\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"checkOrgAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_orgAdmin\",\"type\":\"bool\"},{\"indexed\":false,\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"AccountAccessModified\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_orgAdmin\",\"type\":\"bool\"}],\"name\":\"AccountAccessRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"AccountStatusChanged\",\"type\":\"event\"}]"
+const AcctManagerABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_orgAdmin\",\"type\":\"bool\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"AccountAccessModified\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_orgAdmin\",\"type\":\"bool\"}],\"name\":\"AccountAccessRevoked\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"AccountStatusChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromBlock\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_untilBlock\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTime\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_untilTime\",\"type\":\"uint256\"}],\"name\":\"AccountValidityChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes4[]\",\"name\":\"_selectors\",\"type\":\"bytes4[]\"}],\"name\":\"ContractAccessModified\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"ContractAccessRevoked\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addNewAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"voterUpdate\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"_adminRole\",\"type\":\"bool\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"}],\"name\":\"checkOrgAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountDetails\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_aIndex\",\"type\":\"uint256\"}],\"name\":\"getAccountDetailsFromIndex\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountOrgRole\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountRole\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountStatus\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"getAccountValidity\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_cIndex\",\"type\":\"uint256\"}],\"name\":\"getContractAccessDetailsFromIndex\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"bytes4[]\",\"name\":\"\",\"type\":\"bytes4[]\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNumberOfAccounts\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNumberOfContractAccesses\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"orgAdminExists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeExistingAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"voterUpdate\",\"type\":\"bool\"},{\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"revokeContractAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_fromBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_fromTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilTime\",\"type\":\"uint256\"}],\"name\":\"setAccountValidity\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"bytes4[]\",\"name\":\"_selectors\",\"type\":\"bytes4[]\"}],\"name\":\"setContractAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setDefaults\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 var AcctManagerParsedABI, _ = abi.JSON(strings.NewReader(AcctManagerABI))
 
 // AcctManagerBin is the compiled bytecode used for deploying new contracts.
-var AcctManagerBin = "0x608060405234801561001057600080fd5b50604051602080613d2d8339810180604052602081101561003057600080fd5b5051600080546001600160a01b039092166001600160a01b0319909216919091179055613ccb806100626000396000f3fe608060405234801561001057600080fd5b50600436106100f55760003560e01c806384b7a84a11610097578063cef7f6af11610066578063cef7f6af1461078c578063e3483a9d1461084a578063e8b42bf414610918578063fd4fa05a14610a51576100f5565b806384b7a84a146105d7578063950145cf14610654578063b2018568146106f8578063c214e5e514610715576100f5565b8063309e36ef116100d3578063309e36ef1461038c5780636acee5fd146103a65780636b568d76146104aa57806381d66b231461053c576100f5565b8063143a5604146100fa5780631d09dc93146101cc5780632aceb5341461025d575b600080fd5b6101ca6004803603608081101561011057600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561013a57600080fd5b82018360208201111561014c57600080fd5b803590602001918460018302840111600160201b8311171561016d57600080fd5b919390929091602081019035600160201b81111561018a57600080fd5b82018360208201111561019c57600080fd5b803590602001918460018302840111600160201b831117156101bd57600080fd5b9193509150351515610a77565b005b61023a600480360360208110156101e257600080fd5b810190602081018135600160201b8111156101fc57600080fd5b82018360208201111561020e57600080fd5b803590602001918460018302840111600160201b8311171561022f57600080fd5b509092509050610e75565b6040805192151583526001600160a01b0390911660208301528051918290030190f35b6102836004803603602081101561027357600080fd5b50356001600160a01b0316611401565b60405180866001600160a01b03166001600160a01b03168152602001806020018060200185815260200184151515158152602001838103835287818151815260200191508051906020019080838360005b838110156102ec5781810151838201526020016102d4565b50505050905090810190601f1680156103195780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b8381101561034c578181015183820152602001610334565b50505050905090810190601f1680156103795780820380516001836020036101000a031916815260200191505b5097505050505050505060405180910390f35b610394611659565b60408051918252519081900360200190f35b6103cc600480360360208110156103bc57600080fd5b50356001600160a01b0316611660565b604051808060200180602001838103835285818151815260200191508051906020019080838360005b8381101561040d5781810151838201526020016103f5565b50505050905090810190601f16801561043a5780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b8381101561046d578181015183820152602001610455565b50505050905090810190601f16801561049a5780820380516001836020036101000a031916815260200191505b5094505050505060405180910390f35b610528600480360360408110156104c057600080fd5b6001600160a01b038235169190810190604081016020820135600160201b8111156104ea57600080fd5b8201836020820111156104fc57600080fd5b803590602001918460018302840111600160201b8311171561051d57600080fd5b509092509050611831565b604080519115158252519081900360200190f35b6105626004803603602081101561055257600080fd5b50356001600160a01b031661198c565b6040805160208082528351818301528351919283929083019185019080838360005b8381101561059c578181015183820152602001610584565b50505050905090810190601f1680156105c95780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b6101ca600480360360608110156105ed57600080fd5b810190602081018135600160201b81111561060757600080fd5b82018360208201111561061957600080fd5b803590602001918460018302840111600160201b8311171561063a57600080fd5b91935091506001600160a01b038135169060200135611ae2565b6105286004803603602081101561066a57600080fd5b810190602081018135600160201b81111561068457600080fd5b82018360208201111561069657600080fd5b803590602001918460018302840111600160201b831117156106b757600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550612215945050505050565b6102836004803603602081101561070e57600080fd5b5035612390565b6105286004803603604081101561072b57600080fd5b810190602081018135600160201b81111561074557600080fd5b82018360208201111561075757600080fd5b803590602001918460018302840111600160201b8311171561077857600080fd5b9193509150356001600160a01b031661257a565b6101ca600480360360408110156107a257600080fd5b810190602081018135600160201b8111156107bc57600080fd5b8201836020820111156107ce57600080fd5b803590602001918460018302840111600160201b831117156107ef57600080fd5b919390929091602081019035600160201b81111561080c57600080fd5b82018360208201111561081e57600080fd5b803590602001918460018302840111600160201b8311171561083f57600080fd5b509092509050612bcc565b6101ca6004803603608081101561086057600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561088a57600080fd5b82018360208201111561089c57600080fd5b803590602001918460018302840111600160201b831117156108bd57600080fd5b919390929091602081019035600160201b8111156108da57600080fd5b8201836020820111156108ec57600080fd5b803590602001918460018302840111600160201b8311171561090d57600080fd5b919350915035612cb8565b6105286004803603606081101561092e57600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561095857600080fd5b82018360208201111561096a57600080fd5b803590602001918460018302840111600160201b8311171561098b57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156109dd57600080fd5b8201836020820111156109ef57600080fd5b803590602001918460018302840111600160201b83111715610a1057600080fd5b91908080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525092955061302a945050505050565b61039460048036036020811015610a6757600080fd5b50356001600160a01b0316613591565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015610ac457600080fd5b505afa158015610ad8573d6000803e3d6000fd5b505050506040513d6020811015610aee57600080fd5b50516001600160a01b03163314610b435760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b6040805160208082019081526004805460026000196101006001841615020190911604938301849052929091829160609091019084908015610bc65780601f10610b9b57610100808354040283529160200191610bc6565b820191906000526020600020905b815481529060010190602001808311610ba957829003601f168201915b50509250505060405160208183030381529060405280519060200120838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f82011690508083019250505093505050506040516020818303038152906040528051906020012014158015610db457506040805160208082019081526005805460026000196101006001841615020190911604938301849052929091829160609091019084908015610cc15780601f10610c9657610100808354040283529160200191610cc1565b820191906000526020600020905b815481529060010190602001808311610ca457829003601f168201915b50509250505060405160208183030381529060405280519060200120838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f82011690508083019250505093505050506040516020818303038152906040526040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015610d69578181015183820152602001610d51565b50505050905090810190601f168015610d965780820380516001836020036101000a031916815260200191505b50925050506040516020818303038152906040528051906020012014155b1515610df457604051600160e51b62461bcd028152600401808060200182810382526040815260200180613bfb6040913960400191505060405180910390fd5b610e6d8686868080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8a018190048102820181019092528881529250889150879081908401838280828437600092019190915250600292508791506135ee9050565b505050505050565b6000805460408051600160e41b62e32cf9028152905183926001600160a01b031691630e32cf90916004808301926020929190829003018186803b158015610ebc57600080fd5b505afa158015610ed0573d6000803e3d6000fd5b505050506040513d6020811015610ee657600080fd5b50516001600160a01b03163314610f3b5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b610f7a84848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061221592505050565b156113f357600061100260066000878760405160200180806020018281038252848482818152602001925080828437600081840152601f19601f820116905080830192505050935050505060405160208183030381529060405280519060200120815260200190815260200160002060009054906101000a90046001600160a01b03166139c8565b9050600660018281548110151561101557fe5b906000526020600020906005020160030181905550600060018281548110151561103b57fe5b906000526020600020906005020160040160006101000a81548160ff0219169083151502179055507f68e62a03aeb0a125c2fc869eed72f2fca473680987bdd680c093a534e17cc77660018281548110151561109357fe5b6000918252602090912060059091020154600180546001600160a01b0390921691849081106110be57fe5b90600052602060002090600502016001016001848154811015156110de57fe5b90600052602060002090600502016002016001858154811015156110fe57fe5b60009182526020909120600460059092020101546001805460ff909216918790811061112657fe5b600091825260209182902060036005909202010154604080516001600160a01b038816815284151560608201526080810183905260a0938101848152875460026000196101006001841615020190911604948201859052929390929183019060c0840190889080156111d95780601f106111ae576101008083540402835291602001916111d9565b820191906000526020600020905b8154815290600101906020018083116111bc57829003601f168201915b505083810382528654600260001961010060018416150201909116048082526020909101908790801561124d5780601f106112225761010080835404028352916020019161124d565b820191906000526020600020905b81548152906001019060200180831161123057829003601f168201915b505097505050505050505060405180910390a160408051602080820190815260048054600260001961010060018416150201909116049383018490529290918291606090910190849080156112e35780601f106112b8576101008083540402835291602001916112e3565b820191906000526020600020905b8154815290600101906020018083116112c657829003601f168201915b5050925050506040516020818303038152906040528051906020012060018281548110151561130e57fe5b6000918252602091829020604080518085019485526002600590940290920183018054600019610100600183161502011693909304908201819052919291829160600190849080156113a15780601f10611376576101008083540402835291602001916113a1565b820191906000526020600020905b81548152906001019060200180831161138457829003601f168201915b50509250505060405160208183030381529060405280519060200120146001828154811015156113cd57fe5b60009182526020909120600590910201549093506001600160a01b031691506113fa9050565b5060009050805b9250929050565b6001600160a01b038116600090815260026020526040812054606090819083908190151561146857505060408051808201825260048152600160e01b634e4f4e45026020808301919091528251908101909252600080835286955090935090915080611650565b6000611473876139c8565b905060018181548110151561148457fe5b6000918252602090912060059091020154600180546001600160a01b0390921691839081106114af57fe5b90600052602060002090600502016001016001838154811015156114cf57fe5b90600052602060002090600502016002016001848154811015156114ef57fe5b90600052602060002090600502016003015460018581548110151561151057fe5b600091825260209182902060046005909202010154845460408051601f6002600019600186161561010002019094169390930492830185900485028101850190915281815260ff909216928691908301828280156115af5780601f10611584576101008083540402835291602001916115af565b820191906000526020600020905b81548152906001019060200180831161159257829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f81018490048402820184019092528181529599508894509250840190508282801561163d5780601f106116125761010080835404028352916020019161163d565b820191906000526020600020905b81548152906001019060200180831161162057829003601f168201915b5050505050925095509550955095509550505b91939590929450565b6001545b90565b6001600160a01b038116600090815260026020526040902054606090819015156116bd57604051806040016040528060048152602001600160e01b634e4f4e4502815250604051806020016040528060008152509150915061182c565b60006116c8846139c8565b90506001818154811015156116d957fe5b90600052602060002090600502016001016001828154811015156116f957fe5b6000918252602091829020835460408051601f600260001961010060018716150201909416849004908101879004870282018701909252818152600590940290920101928491908301828280156117915780601f1061176657610100808354040283529160200191611791565b820191906000526020600020905b81548152906001019060200180831161177457829003601f168201915b5050845460408051602060026001851615610100026000190190941693909304601f81018490048402820184019092528181529597508694509250840190508282801561181f5780601f106117f45761010080835404028352916020019161181f565b820191906000526020600020905b81548152906001019060200180831161180257829003601f168201915b5050505050905092509250505b915091565b6001600160a01b038316600090815260026020526040812054151561185857506001611985565b6000611863856139c8565b9050838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f8201169050808301925050509350505050604051602081830303815290604052805190602001206001828154811015156118c757fe5b906000526020600020906005020160010160405160200180806020018281038252838181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156119645780601f1061193957610100808354040283529160200191611964565b820191906000526020600020905b81548152906001019060200180831161194757829003601f168201915b50509250505060405160208183030381529060405280519060200120149150505b9392505050565b6001600160a01b03811660009081526002602052604090205460609015156119d257506040805180820190915260048152600160e01b634e4f4e45026020820152611add565b60006119dd836139c8565b90506001818154811015156119ee57fe5b9060005260206000209060050201600301546000141515611abc576001805482908110611a1757fe5b600091825260209182902060026005909202018101805460408051601f600019610100600186161502019093169490940491820185900485028401850190528083529192909190830182828015611aaf5780601f10611a8457610100808354040283529160200191611aaf565b820191906000526020600020905b815481529060010190602001808311611a9257829003601f168201915b5050505050915050611add565b50506040805180820190915260048152600160e01b634e4f4e450260208201525b919050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611b2f57600080fd5b505afa158015611b43573d6000803e3d6000fd5b505050506040513d6020811015611b5957600080fd5b50516001600160a01b03163314611bae5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b83838080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201829052506001600160a01b03871681526002602052604090205486935015159150611c5390505760408051600160e51b62461bcd02815260206004820152601760248201527f6163636f756e7420646f6573206e6f7420657869737473000000000000000000604482015290519081900360640190fd5b816040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015611c94578181015183820152602001611c7c565b50505050905090810190601f168015611cc15780820380516001836020036101000a031916815260200191505b5092505050604051602081830303815290604052805190602001206001611ce7836139c8565b81548110611cf157fe5b90600052602060002090600502016001016040516020018080602001828103825283818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015611d8e5780601f10611d6357610100808354040283529160200191611d8e565b820191906000526020600020905b815481529060010190602001808311611d7157829003601f168201915b50509250505060405160208183030381529060405280519060200120141515611e015760408051600160e51b62461bcd02815260206004820152601860248201527f6163636f756e7420696e20646966666572656e74206f72670000000000000000604482015290519081900360640190fd5b600083118015611e115750600683105b1515611e675760408051600160e51b62461bcd02815260206004820152601d60248201527f696e76616c696420737461747573206368616e67652072657175657374000000604482015290519081900360640190fd5b611eb58487878080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201829052506040805160208101909152908152925061302a915050565b151560011415611ef957604051600160e51b62461bcd028152600401808060200182810382526031815260200180613c6f6031913960400191505060405180910390fd5b60008360011415611f76576001611f0f866139c8565b81548110611f1957fe5b9060005260206000209060050201600301546002141515611f6e57604051600160e51b62461bcd028152600401808060200182810382526039815260200180613b9a6039913960400191505060405180910390fd5b50600461215f565b8360021415611ff1576001611f8a866139c8565b81548110611f9457fe5b9060005260206000209060050201600301546004141515611fe957604051600160e51b62461bcd02815260040180806020018281038252603c815260200180613b5e603c913960400191505060405180910390fd5b50600261215f565b836003141561206d576001612005866139c8565b8154811061200f57fe5b90600052602060002090600502016003015460051415151561206557604051600160e51b62461bcd028152600401808060200182810382526038815260200180613b266038913960400191505060405180910390fd5b50600561215f565b83600414156120e8576001612081866139c8565b8154811061208b57fe5b90600052602060002090600502016003015460051415156120e057604051600160e51b62461bcd028152600401808060200182810382526034815260200180613c3b6034913960400191505060405180910390fd5b50600761215f565b836005141561215f5760016120fc866139c8565b8154811061210657fe5b906000526020600020906005020160030154600714151561215b57604051600160e51b62461bcd028152600401808060200182810382526038815260200180613aee6038913960400191505060405180910390fd5b5060025b80600161216b876139c8565b8154811061217557fe5b9060005260206000209060050201600301819055507f36b0ea38154dec5e98b6bf928b971a9db5e8cd4b6946350e9e43fb9848c70b258588888460405180856001600160a01b03166001600160a01b03168152602001806020018381526020018281038252858582818152602001925080828437600083820152604051601f909101601f191690920182900397509095505050505050a150505050505050565b6000806001600160a01b031660066000846040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561226657818101518382015260200161224e565b50505050905090810190601f1680156122935780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020546001600160a01b03161461238857600060066000846040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156123125781810151838201526020016122fa565b50505050905090810190601f16801561233f5780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020546001600160a01b0316905061237d81613591565b600214915050611add565b506000919050565b60006060806000806001868154811015156123a757fe5b6000918252602090912060059091020154600180546001600160a01b0390921691889081106123d257fe5b90600052602060002090600502016001016001888154811015156123f257fe5b906000526020600020906005020160020160018981548110151561241257fe5b90600052602060002090600502016003015460018a81548110151561243357fe5b600091825260209182902060046005909202010154845460408051601f6002600019600186161561010002019094169390930492830185900485028101850190915281815260ff909216928691908301828280156124d25780601f106124a7576101008083540402835291602001916124d2565b820191906000526020600020905b8154815290600101906020018083116124b557829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959950889450925084019050828280156125605780601f1061253557610100808354040283529160200191612560565b820191906000526020600020905b81548152906001019060200180831161254357829003601f168201915b505050505092509450945094509450945091939590929450565b60008060009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156125c957600080fd5b505afa1580156125dd573d6000803e3d6000fd5b505050506040513d60208110156125f357600080fd5b50516001600160a01b031633146126485760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60606126538361198c565b9050600061266084613591565b9050600061266d856139c8565b604080516020808201908152600580546002600019610100600184161502019091160493830184905293945091829160600190849080156126ef5780601f106126c4576101008083540402835291602001916126ef565b820191906000526020600020905b8154815290600101906020018083116126d257829003601f168201915b50509250505060405160208183030381529060405280519060200120836040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561274c578181015183820152602001612734565b50505050905090810190601f1680156127795780820380516001836020036101000a031916815260200191505b5092505050604051602081830303815290604052805190602001201480156127a15750816001145b15612831578460066000898960405160200180806020018281038252848482818152602001925080828437600081840152601f19601f820116905080830192505050935050505060405160208183030381529060405280519060200120815260200190815260200160002060006101000a8154816001600160a01b0302191690836001600160a01b031602179055505b600260018281548110151561284257fe5b9060005260206000209060050201600301819055506001808281548110151561286757fe5b906000526020600020906005020160040160006101000a81548160ff0219169083151502179055507f68e62a03aeb0a125c2fc869eed72f2fca473680987bdd680c093a534e17cc776856001838154811015156128c057fe5b90600052602060002090600502016001016001848154811015156128e057fe5b906000526020600020906005020160020160018581548110151561290057fe5b60009182526020909120600460059092020101546001805460ff909216918790811061292857fe5b600091825260209182902060036005909202010154604080516001600160a01b038816815284151560608201526080810183905260a0938101848152875460026000196101006001841615020190911604948201859052929390929183019060c0840190889080156129db5780601f106129b0576101008083540402835291602001916129db565b820191906000526020600020905b8154815290600101906020018083116129be57829003601f168201915b5050838103825286546002600019610100600184161502019091160480825260209091019087908015612a4f5780601f10612a2457610100808354040283529160200191612a4f565b820191906000526020600020905b815481529060010190602001808311612a3257829003601f168201915b505097505050505050505060405180910390a16040805160208082019081526004805460026000196101006001841615020190911604938301849052929091829160609091019084908015612ae55780601f10612aba57610100808354040283529160200191612ae5565b820191906000526020600020905b815481529060010190602001808311612ac857829003601f168201915b50509250505060405160208183030381529060405280519060200120600182815481101515612b1057fe5b600091825260209182902060408051808501948552600260059094029092018301805460001961010060018316150201169390930490820181905291929182916060019084908015612ba35780601f10612b7857610100808354040283529160200191612ba3565b820191906000526020600020905b815481529060010190602001808311612b8657829003601f168201915b505092505050604051602081830303815290604052805190602001201493505050509392505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015612c1957600080fd5b505afa158015612c2d573d6000803e3d6000fd5b505050506040513d6020811015612c4357600080fd5b50516001600160a01b03163314612c985760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b612ca4600485856139e7565b50612cb1600583836139e7565b5050505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015612d0557600080fd5b505afa158015612d19573d6000803e3d6000fd5b505050506040513d6020811015612d2f57600080fd5b50516001600160a01b03163314612d845760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b6040805160208082019081526005805460026000196101006001841615020190911604938301849052929091829160609091019084908015612e075780601f10612ddc57610100808354040283529160200191612e07565b820191906000526020600020905b815481529060010190602001808311612dea57829003601f168201915b50509250505060405160208183030381529060405280519060200120838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f8201169050808301925050509350505050604051602081830303815290604052805190602001201480612f7157506040805160208082019081526004805460026000196101006001841615020190911604938301849052929091829160609091019084908015612f005780601f10612ed557610100808354040283529160200191612f00565b820191906000526020600020905b815481529060010190602001808311612ee357829003601f168201915b50509250505060405160208183030381529060405280519060200120838360405160200180806020018281038252848482818152602001925080828437600081840152601f19601f820116905080830192505050935050505060405160208183030381529060405280519060200120145b1515612fb157604051600160e51b62461bcd028152600401808060200182810382526028815260200180613bd36028913960400191505060405180910390fd5b610e6d8686868080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8a018190048102820181019092528881529250889150879081908401838280828437600092019190915250879250600191506135ee9050565b604080516020808201908152600480546002600019610100600184161502019091160493830184905260009390928291606090910190849080156130af5780601f10613084576101008083540402835291602001916130af565b820191906000526020600020905b81548152906001019060200180831161309257829003601f168201915b505092505050604051602081830303815290604052805190602001206130d48561198c565b6040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156131145781810151838201526020016130fc565b50505050905090810190601f1680156131415780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120141561342257600061316d856139c8565b9050836040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156131b0578181015183820152602001613198565b50505050905090810190601f1680156131dd5780820380516001836020036101000a031916815260200191505b50925050506040516020818303038152906040528051906020012060018281548110151561320757fe5b906000526020600020906005020160010160405160200180806020018281038252838181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156132a45780601f10613279576101008083540402835291602001916132a4565b820191906000526020600020905b81548152906001019060200180831161328757829003601f168201915b50509250505060405160208183030381529060405280519060200120148061341a5750826040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156133085781810151838201526020016132f0565b50505050905090810190601f1680156133355780820380516001836020036101000a031916815260200191505b50925050506040516020818303038152906040528051906020012060018281548110151561335f57fe5b906000526020600020906005020160010160405160200180806020018281038252838181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156133fc5780601f106133d1576101008083540402835291602001916133fc565b820191906000526020600020905b8154815290600101906020018083116133df57829003601f168201915b50509250505060405160208183030381529060405280519060200120145b915050611985565b836001600160a01b031660066000856040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015613471578181015183820152602001613459565b50505050905090810190601f16801561349e5780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020546001600160a01b031614806135895750836001600160a01b031660066000846040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561352757818101518382015260200161350f565b50505050905090810190601f1680156135545780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020546001600160a01b0316145b949350505050565b6001600160a01b03811660009081526002602052604081205415156135b857506000611add565b60006135c3836139c8565b90506001818154811015156135d457fe5b906000526020600020906005020160030154915050919050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561363b57600080fd5b505afa15801561364f573d6000803e3d6000fd5b505050506040513d602081101561366557600080fd5b50516001600160a01b031633146136ba5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60006136c5866139c8565b6001600160a01b0387166000908152600260205260409020549091501561377757836001828154811015156136f657fe5b9060005260206000209060050201600201908051906020019061371a929190613a65565b508260018281548110151561372b57fe5b9060005260206000209060050201600301819055508160018281548110151561375057fe5b60009182526020909120600590910201600401805460ff1916911515919091179055613892565b600380546001908101918290556001600160a01b03888116600081815260026020908152604080832096909655855160a0810187529283528281018b81529583018a905260608301899052871515608084015284548086018087559590925282517fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf6600590930292830180546001600160a01b03191691909516178455945180519495929461384f937fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf7909301929190910190613a65565b506040820151805161386b916002840191602090910190613a65565b50606082015160038201556080909101516004909101805460ff1916911515919091179055505b7f68e62a03aeb0a125c2fc869eed72f2fca473680987bdd680c093a534e17cc776868686858760405180866001600160a01b03166001600160a01b03168152602001806020018060200185151515158152602001848152602001838103835287818151815260200191508051906020019080838360005b83811015613921578181015183820152602001613909565b50505050905090810190601f16801561394e5780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b83811015613981578181015183820152602001613969565b50505050905090810190601f1680156139ae5780820380516001836020036101000a031916815260200191505b5097505050505050505060405180910390a1505050505050565b6001600160a01b03166000908152600260205260409020546000190190565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10613a285782800160ff19823516178555613a55565b82800160010185558215613a55579182015b82811115613a55578235825591602001919060010190613a3a565b50613a61929150613ad3565b5090565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10613aa657805160ff1916838001178555613a55565b82800160010185558215613a55579182015b82811115613a55578251825591602001919060010190613ab8565b61165d91905b80821115613a615760008155600101613ad956fe6163636f756e74207265636f76657279206e6f7420696e697469617465642e206f7065726174696f6e2063616e6e6f7420626520646f6e656163636f756e7420697320616c726561647920626c61636b6c69737465642e206f7065726174696f6e2063616e6e6f7420626520646f6e656163636f756e74206973206e6f7420696e2073757370656e646564207374617475732e206f7065726174696f6e2063616e6e6f7420626520646f6e656163636f756e74206973206e6f7420696e20616374697665207374617475732e206f7065726174696f6e2063616e6e6f7420626520646f6e6563616e2062652063616c6c656420746f2061737369676e2061646d696e20726f6c6573206f6e6c7963616e6e6f742062652063616c6c65642066726f2061737369676e696e67206f72672061646d696e20616e64206e6574776f726b2061646d696e20726f6c65736163636f756e74206973206e6f7420626c61636b6c69737465642e206f7065726174696f6e2063616e6e6f7420626520646f6e65737461747573206368616e6765206e6f7420706f737369626c6520666f72206f72672061646d696e206163636f756e7473a165627a7a72305820f5cf4f43c37e9dae763b0a301bd310a91d3b733b1642aabb6208a69fcc6a272d0029"
+var AcctManagerBin = "0x6080346200007a57601f62003bce38819003918201601f19168301916001600160401b038311848410176200007f578084926020946040528339810103126200007a57516001600160a01b038116908190036200007a57600080546001600160a01b031916919091179055604051613b1f9081620000af8239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe6080604052600436101561001257600080fd5b60003560e01c8063143a5604146126445780631d09dc93146125965780632aceb53414612562578063309e36ef146125445780634aac8df814611d625780636acee5fd14611d165780636b568d7614611cd257806381d66b2314611c9a57806384b7a84a14611672578063950145cf14611638578063b201856814611590578063c214e5e51461132e578063c46e124e14611310578063cef7f6af14611010578063cefa420014610d4e578063d3e9d06414610b5f578063de811523146108c9578063e3483a9d146101f7578063e8b42bf41461018f578063f54df919146101335763fd4fa05a1461010357600080fd5b3461012e57602036600319011261012e576020610126610121612ccb565b6137fd565b604051908152f35b600080fd5b3461012e57602036600319011261012e576001600160a01b03610154612ccb565b166000526009602052608060406000208054906001810154906003600282015491015491604051938452602084015260408301526060820152f35b3461012e57606036600319011261012e576101a8612ccb565b6001600160401b0360243581811161012e576101c8903690600401612e46565b9160443591821161012e576020926101e76101ed933690600401612e46565b9161384f565b6040519015158152f35b3461012e57608036600319011261012e57610210612ccb565b6024356001600160401b03811161012e5761022f903690600401612cf7565b90916044356001600160401b03811161012e57610250903690600401612cf7565b60005460405162e32cf960e41b80825292966001600160a01b0396939492871693929091602081600481885afa9081156108015761029b9189916000916108aa575b5016331461327d565b6040516020810190602082526102c7816102b9604082018d87613318565b03601f198101835282612ddf565b5190206040516020810190602082526102e6816102b960408201612fcd565b519020148015610863575b1561080d5761030761030f926020943691612e00565b973691612e00565b9260046040518094819382525afa9081156108015761033a9185916000916107d2575016331461327d565b6001600160a01b0382166000908152600260205260408082205485851683529120546000199091019490156104f257600261037486612e94565b5001948251956001600160401b0387116104dc5761039c876103968354612ee5565b836132d1565b602096601f81116001146104595761040461042c9361043a9695936103ea84600495600080516020613aca8339815191529d60009161044e575b508160011b916000199060031b1c19161790565b90555b6103f681612e94565b506003606435910155612e94565b5001805460ff191660011790555b60405195869516855260a0602086015260a0850190612d24565b908382036040850152612d24565b6001606083015260643560808301520390a1005b90508901518e6103d6565b601f198116978260005260206000209860005b8181106104c457509361043a969593600184600080516020613aca8339815191529c6104049560049761042c9a106104ab575b5050811b0190556103ed565b8a015160001960f88460031b161c191690558d8061049f565b878301518b556001909a01996020928301920161046c565b634e487b7160e01b600052604160045260246000fd5b935060035460001981146107bc576001018060035583831660005260026020526040600020556040519361052585612da9565b838316855260208501818152826040870152606435606087015260016080870152600154600160401b8110156104dc578060016105659201600155612e94565b9190916107a657865182546001600160a01b031916908716178255518051906001600160401b0382116104dc576105ac826105a36001860154612ee5565b600186016132d1565b602090601f8311600114610734576105dd929160009183610729575b50508160011b916000199060031b1c19161790565b60018201555b60408601519586516001600160401b0381116104dc576106138161060a6002860154612ee5565b600286016132d1565b6020601f821160011461069a57926004608061043a9796946106638561068a9661042c99600080516020613aca8339815191529f60009261068f5750508160011b916000199060031b1c19161790565b60028501555b606081015160038501550151151591019060ff801983541691151516179055565b610412565b015190508f806105c8565b6002840160005260206000209860005b601f19841681106107115750608061043a979694600185600080516020613aca8339815191529d61042c999660049661068a99601f198116106106f8575b505050811b016002850155610669565b015160001960f88460031b161c191690558e80806106e8565b828201518b556001909a0199602092830192016106aa565b0151905089806105c8565b9190600184016000526020600020906000935b601f198416851061078b576001945083601f19811610610772575b505050811b0160018201556105e3565b015160001960f88460031b161c19169055888080610762565b81810151835560209485019460019093019290910190610747565b634e487b7160e01b600052600060045260246000fd5b634e487b7160e01b600052601160045260246000fd5b6107f4915060203d6020116107fa575b6107ec8183612ddf565b81019061325e565b87610292565b503d6107e2565b6040513d6000823e3d90fd5b60405162461bcd60e51b815260206004820152602860248201527f63616e2062652063616c6c656420746f2061737369676e2061646d696e20726f6044820152676c6573206f6e6c7960c01b6064820152608490fd5b50604051602081019060208252610882816102b9604082018d87613318565b5190206040516020810190602082526108a1816102b960408201612f1f565b519020146102f1565b6108c3915060203d6020116107fa576107ec8183612ddf565b8b610292565b3461012e5760c036600319011261012e576108e2612ccb565b6001600160401b0360243581811161012e57610902903690600401612cf7565b929091604435906064356084359060a4359260018060a01b0396876000541660405198899162e32cf960e41b835282600460209c8d935afa91821561080157610a01610a0d6109b66109b18f958f958f9761096f83610a169b61097794600091610b42575016331461327d565b36908b612e00565b96169d8e6000526002865261099260406000205415156134e4565b6001600160a01b03166000908152600260205260409020546000190190565b612e94565b5060405190816109d386820192878452600160408401910161305d565b03916109e7601f1993848101835282612ddf565b519020946040519384918683019687526040830190612d24565b03908101835282612ddf565b51902014613530565b82158015610b38575b80610b26575b15610ad3576040519860808a019283118a8410176104dc577f6ea63efb3bdf2807af92d2125e5d915edc94daef16c2bdf2ff75a575af1a467499610ab9936040528781526003898b808401888152600960408601928b845260608701948d8652600052526040600020945185555160018501555160028401555191015560c0604051998a998a5289015260c0880191613318565b9360408601526060850152608084015260a08301520390a1005b60405162461bcd60e51b815260048101899052602560248201527f76616c69646974792077696e646f7720656e6473206265666f72652069742073604482015264746172747360d81b6064820152608490fd5b50841580610a25575083851015610a25565b5085831015610a1f565b610b5991508b3d8d116107fa576107ec8183612ddf565b38610292565b3461012e57608036600319011261012e57610b78612ccb565b610b80612ce1565b6001600160401b039160443583811161012e57610ba1903690600401612cf7565b909360643590811161012e57610bbb903690600401612cf7565b60005460405162e32cf960e41b81526020976001600160a01b0397929091908990829060049082908c165afa90811561080157610c04918991600091610d37575016331461327d565b610c25610c12368784612e00565b610c1d368688612e00565b908489613a1c565b80600052600889526040600020548015159081610d13575b5015610cce57600052600888526040600020549460001986019586116107bc577f7865f34e49f7eb87b88f816744712a37bb89b621934e0ae433ad1dafb1996a989888610cbb946005610c92610cc99a613591565b500160ff1981541690556040519a8b9a168a521690880152608060408801526080870191613318565b918483036060860152613318565b0390a1005b60405162461bcd60e51b8152600481018a9052601e60248201527f636f6e74726163742061636365737320646f6573206e6f7420657869737400006044820152606490fd5b6000198101915081116107bc576005610d2d60ff92613591565b500154168a610c3d565b6108c391508b3d8d116107fa576107ec8183612ddf565b3461012e57602036600319011261012e57610d6a600435613591565b5060018060a01b038082541690600183015416916004810190600360ff60058301541691610dc460405191610dad83610da6816002850161305d565b0384612ddf565b610dbd604051809581930161305d565b0383612ddf565b604051958680976020875491828152019081976000526020600020906000915b816007840110610f9b5793610e7296936020989693610e4993610e64975491818110610f7e575b818110610f61575b818110610f44575b818110610f27575b818110610f0a575b818110610eed575b818110610ed2575b10610ebe575b50038b612ddf565b6040519889528589015260c0604089015260c0880190612d24565b908682036060880152612d24565b948486036080860152519485815201916000945b808610610e9d575050829350151560a08301520390f35b909260208060019263ffffffff60e01b875116815201940195019490610e86565b6001600160e01b031916815288018d610e41565b828c1b6001600160e01b0319168452928b0192600101610e3b565b604083901b6001600160e01b0319168452928b0192600101610e33565b606083901b6001600160e01b0319168452928b0192600101610e2b565b608083901b6001600160e01b0319168452928b0192600101610e23565b60a083901b6001600160e01b0319168452928b0192600101610e1b565b60c083901b6001600160e01b0319168452928b0192600101610e13565b60e083901b6001600160e01b0319168452928b0192600101610e0b565b935090916001610100600892865463ffffffff60e01b90818160e01b16835260c08282821b16602085015260a08383821b166040860152606084846080928282851b16818a01521b1690860152838360401b1690850152828260201b16908401521660e08201520194019201908a9392610de4565b3461012e57604036600319011261012e576001600160401b0360043581811161012e57611041903690600401612cf7565b909160243581811161012e5761105b903690600401612cf7565b92909160018060a01b0391826000541660405193849162e32cf960e41b835282600460209788935afa80156108015761109e926000916112f3575016331461327d565b8181116104dc576110b0600454612ee5565b95601f968781116112a7575b50600090878311600114611221576110ec9291600091836112165750508160011b916000199060031b1c19161790565b6004555b83116104dc57611101600554612ee5565b8481116111c5575b5060009383116001146111485750611138926000918361113d5750508160011b916000199060031b1c19161790565b600555005b0135905083806105c8565b601f198316937f036b6384b5eca791c62761152d0c79bb0604c104a5fb6f4eb0703f3154bb3db092916000905b8682106111ad5750508360019510611193575b505050811b01600555005b0135600019600384901b60f8161c19169055828080611188565b80600184968294958701358155019501920190611175565b611207907f036b6384b5eca791c62761152d0c79bb0604c104a5fb6f4eb0703f3154bb3db08680870160051c82019285881061120d575b0160051c01906132ba565b84611109565b925081926111fc565b0135905088806105c8565b601f1983169160046000527f8a35acfbc15ff81a39ae7d344fd709f28e8600b4aa8c65c6b64bfe7fe36bd19b9260005b87828210611291575050908460019594939210611277575b505050811b016004556110f0565b0135600019600384901b60f8161c19169055878080611269565b6001849682939587013581550195019201611251565b6112ed9060046000527f8a35acfbc15ff81a39ae7d344fd709f28e8600b4aa8c65c6b64bfe7fe36bd19b8980860160051c82019288871061120d570160051c01906132ba565b876110bc565b61130a9150863d88116107fa576107ec8183612ddf565b89610292565b3461012e57600036600319011261012e576020600754604051908152f35b3461012e57604036600319011261012e576004356001600160401b03811161012e5761135e903690600401612cf7565b90611367612ce1565b60005460405162e32cf960e41b81526020946001600160a01b0393909290919086908290600490829088165afa91821561080157846113c66114d696600080516020613aca83398151915295600295600091610d37575016331461327d565b876113d083613788565b976113da846137fd565b6001600160a01b03851660009081526002602052604090205490979060001901976040519a8b611414868201928784526040830190612d24565b039b611428601f199d8e8101835282612ddf565b5190208b6040516114468782019288845282610a0160408201612fcd565b519020149081611585575b5061152b575b5050505081600361146786612e94565b500155600461147585612e94565b5001805460ff191660011790556114ce61148e85612e94565b509161149986612e94565b509260ff60046114a889612e94565b500154169060036114b889612e94565b5001549260016040519788970192019086613339565b0390a1612e94565b50604051611501816114f586820194878652600260408401910161305d565b03848101835282612ddf565b5190209060405161151f8482019285845282610a0160408201612f1f565b51902014604051908152f35b611554906115486040519384928684019687526040840191613318565b038a8101835282612ddf565b5190206000526006885260406000209082166bffffffffffffffffffffffff60a01b82541617905587878180611457565b60019150148c611451565b3461012e57602036600319011261012e5760016004356116346115b282612e94565b50838060a01b03905416916115c681612e94565b509060026116286115d683612e94565b5061161160ff60046115f560036115ec89612e94565b50015497612e94565b500154169561160a604051809b81930161305d565b0389612ddf565b611621604051809481930161305d565b0382612ddf565b60405195869586612d64565b0390f35b3461012e57602036600319011261012e576004356001600160401b03811161012e576101ed61166d6020923690600401612e46565b6136f6565b3461012e57606036600319011261012e576004356001600160401b03811161012e576116a2903690600401612cf7565b6116aa612ce1565b60005460405162e32cf960e41b81526044946020946001600160a01b0394919390928735918790829060049082908a165afa908115610801576116f9918791600091611c7d575016331461327d565b61174e611707368587612e00565b95831695866000526002885261172360406000205415156134e4565b87610a01610a0d6109b66109b18860018060a01b031660005260026020526000196040600020540190565b80151580611c73575b15611c2f57600161178361176c368688612e00565b6040519061177982612dc4565b600082528561384f565b151514611bd15760009060018103611899575050600260036117bf6109b18460018060a01b031660005260026020526000196040600020540190565b5001540361182f57600080516020613aaa833981519152955091611824916109b193600361180a60049687936001600160a01b03166000908152600260205260409020546000190190565b500155606060405196879687528601526060850191613318565b9060408301520390a1005b60405162461bcd60e51b815260048101869052603960248201527f6163636f756e74206973206e6f7420696e20616374697665207374617475732e818801527f206f7065726174696f6e2063616e6e6f7420626520646f6e65000000000000006064820152608490fd5b6002810361196a5750506001600160a01b0381166000908152600260205260409020546004906003906118cf9060001901612e94565b5001540361190057600080516020613aaa833981519152955091611824916109b193600361180a6002968793610992565b60405162461bcd60e51b815260048101869052603c60248201527f6163636f756e74206973206e6f7420696e2073757370656e6465642073746174818801527f75732e206f7065726174696f6e2063616e6e6f7420626520646f6e65000000006064820152608490fd5b60038103611a365750506001600160a01b0381166000908152600260205260409020546005906003906119a09060001901612e94565b500154146119d157600080516020613aaa833981519152955091611824916109b193600361180a6005968793610992565b60405162461bcd60e51b815260048101869052603860248201527f6163636f756e7420697320616c726561647920626c61636b6c69737465642e2081880152776f7065726174696f6e2063616e6e6f7420626520646f6e6560401b6064820152608490fd5b60048103611afe5750506001600160a01b038116600090815260026020526040902054600590600390611a6c9060001901612e94565b50015403611a9d57600080516020613aaa833981519152955091611824916109b193600361180a6007968793610992565b60405162461bcd60e51b815260048101869052603460248201527f6163636f756e74206973206e6f7420626c61636b6c69737465642e206f70657281880152736174696f6e2063616e6e6f7420626520646f6e6560601b6064820152608490fd5b909690600514611b2e575b50916118249186600361180a6109b1600080516020613aaa8339815191529a97610992565b955060076003611b586109b18460018060a01b031660005260026020526000196040600020540190565b50015403611b6c5760029550611824611b09565b60405162461bcd60e51b815260048101869052603860248201527f6163636f756e74207265636f76657279206e6f7420696e697469617465642e2081880152776f7065726174696f6e2063616e6e6f7420626520646f6e6560401b6064820152608490fd5b60405162461bcd60e51b815260048101879052603160248201527f737461747573206368616e6765206e6f7420706f737369626c6520666f72206f818901527072672061646d696e206163636f756e747360781b6064820152608490fd5b60405162461bcd60e51b815260048101879052601d60248201527f696e76616c696420737461747573206368616e6765207265717565737400000081890152606490fd5b5060068110611757565b611c949150893d8b116107fa576107ec8183612ddf565b8a610292565b3461012e57602036600319011261012e57611634611cbe611cb9612ccb565b613788565b604051918291602083526020830190612d24565b3461012e57604036600319011261012e57611ceb612ccb565b6024356001600160401b03811161012e57602091611d106101ed923690600401612cf7565b91613649565b3461012e57602036600319011261012e57611d54611634611d3d611d38612ccb565b6131c6565b604092919251938493604085526040850190612d24565b908382036020850152612d24565b3461012e5760a036600319011261012e57611d7b612ccb565b611d83612ce1565b906044356001600160401b03811161012e57611da3903690600401612cf7565b906064356001600160401b03811161012e57611dc3903690600401612cf7565b919092608435956001600160401b03871161012e573660238801121561012e576001600160401b0387600401351161012e57366024886004013560051b8901011161012e5760005460405162e32cf960e41b815290602090829060049082906001600160a01b03165afa801561080157611e5091600091612525575b506001600160a01b0316331461327d565b8115801561251d575b15156001600160a01b03821615146124a857611e8c611e79368486612e00565b611e84368789612e00565b908389613a1c565b600081815260086020526040902054156120a2576000526008602052604060002054806000198101116107bc57611ec66000198201613591565b50611ed88960040135600483016135cc565b600460248a019101600052602060002060005b8a6004013560031c811061204f575060048a0135600719811690819003611fe6575b50505092611f729492611f64926005611f2c6020999760001901613591565b5001805460ff191660011790555b604080516001600160a01b039a8b168152919099168882015260a098810189905297880191613318565b918583036060870152613318565b8281036080840152836004013581520191602481019060005b81600401358110611fbe577f8c504313a5f470ad50ffcfffabc7633869f34797c77d95b8ff6518e0b2041b2784860385a1005b90919360208060019263ffffffff60e01b611fd88961357c565b168152019501929101611f8b565b6000928b91845b81846004013503811061200f575050506004013560031c015582826005611f0d565b9194600191935061204360209161202588613634565b60e01c908560021b60031b9163ffffffff809116831b921b19161790565b95019101918c92611fed565b6000805b60088110612068575082820155600101611eeb565b9390602061209960019261207b85613634565b60e01c908860021b60031b9163ffffffff809116831b921b19161790565b92019401612053565b6040518060c08101106001600160401b0360c0830111176104dc5760c081016040526001600160a01b038881168252831660208201526120e3368587612e00565b60408201526120f3368789612e00565b606082015260405161210f60208b6004013560051b0182612ddf565b60048a0135815260248a01602082015b60248c6004013560051b8d010182106124905750506080820152600160a0820152600754600160401b8110156104dc5780600161215f9201600755613591565b9190916107a657805182546001600160a01b039182166001600160a01b0319918216178455602083015160018501805491909316911617905560408101518051906001600160401b0382116104dc576121c8826121bf6002870154612ee5565b600287016132d1565b602090601f831160011461241e576121f89291600091836123a15750508160011b916000199060031b1c19161790565b60028301555b60608101518051906001600160401b0382116104dc5761222e826122256003870154612ee5565b600387016132d1565b602090601f83116001146123ac5761225e9291600091836123a15750508160011b916000199060031b1c19161790565b60038301555b60808101518051906001600160401b0382116104dc5760209061228a83600487016135cc565b01906004840160005260206000209060005b8160031c8110612355575060071981168082036122fc575b505050509260209795926122e6611f72989693600560a0611f64980151151591019060ff801983541691151516179055565b6007549060005260088952604060002055611f3a565b9260009360005b81840381106123205750505060031c0155826122e68360056122b4565b909194602061234b600192885160e01c908560021b60031b9163ffffffff809116831b921b19161790565b9601929101612303565b6000805b6008811061236e57508382015560010161229c565b94906020612398600192845160e01c908960021b60031b9163ffffffff809116831b921b19161790565b92019501612359565b015190508d806105c8565b9190600385016000526020600020906000935b601f1984168510612403576001945083601f198116106123ea575b505050811b016003830155612264565b015160001960f88460031b161c191690558c80806123da565b818101518355602094850194600190930192909101906123bf565b9190600285016000526020600020906000935b601f1984168510612475576001945083601f1981161061245c575b505050811b0160028301556121fe565b015160001960f88460031b161c191690558c808061244c565b81810151835560209485019460019093019290910190612431565b6020809161249d8461357c565b81520191019061211f565b60405162461bcd60e51b815260206004820152604160248201527f636f6e747261637420616363657373206d75737420626520676976656e20746f60448201527f2065697468657220616e206163636f756e74206f7220616e206f726720726f6c6064820152606560f81b608482015260a490fd5b508315611e59565b61253e915060203d6020116107fa576107ec8183612ddf565b89611e3f565b3461012e57600036600319011261012e576020600154604051908152f35b3461012e57602036600319011261012e57611634612586612581612ccb565b6130f2565b9160409593955195869586612d64565b3461012e57602036600319011261012e576004356001600160401b03811161012e576125c6903690600401612cf7565b60005460405162e32cf960e41b81526001600160a01b039290916020908390600490829087165afa938415610801576126108460409661261595600091612626575016331461327d565b61337e565b835191151582529091166020820152f35b61263e915060203d81116107fa576107ec8183612ddf565b88610292565b3461012e57608036600319011261012e5761265d612ccb565b6024356001600160401b03811161012e5761267c903690600401612cf7565b916044356001600160401b03811161012e5761269c903690600401612cf7565b92909360643515156064350361012e5760018060a01b03600054169162e32cf960e41b95866080526020608060046080875afa801561080157600090612c95575b6126f1906001600160a01b0316331461327d565b60405160208101906020825261270f816102b9604082018b87613318565b51902060405160208101906020825261272e816102b960408201612f1f565b519020141580612c33575b15612bc95761274f612757926020943691612e00565b953691612e00565b9460046040518094819382525afa80156108015761278791600091612baa57506001600160a01b0316331461327d565b6001600160a01b038116600090815260026020526040902054600019810193901561292b576127b584612e94565b50938151946001600160401b0386116104dc576127e2866127d96002840154612ee5565b600284016132d1565b602095601f81116001146128a357612882939261285e92600261282e8461284795600080516020613aca8339815191529c60009161289857508160011b916000199060031b1c19161790565b9101555b6002600361283f83612e94565b500155612e94565b50600401805460ff191660ff606435151516179055565b61042c60405194859460018060a01b0316855260a0602086015260a0850190612d24565b60643515156060830152600260808301520390a1005b90508801518d6103d6565b6002820160005260206000209660005b601f198316811061291357509261285e926002600184600080516020613aca8339815191529b612882999861284797601f198116106128fa575b5050811b01910155612832565b89015160001960f88460031b161c191690558c806128ed565b858201518955600190980197602091820191016128b3565b925060035460001981146107bc576001018060035560018060a01b03821660005260026020526040600020556040519261296484612da9565b6001600160a01b038216845260208401838152604085018290526002606086015260643515156080860152600154600160401b8110156104dc578060016129ae9201600155612e94565b9190916107a657855182546001600160a01b0319166001600160a01b0391909116178255518051906001600160401b0382116104dc576129f5826105a36001860154612ee5565b602090601f8311600114612b3857612a25929160009183612b2d5750508160011b916000199060031b1c19161790565b60018201555b60408501519485516001600160401b0381116104dc57612a528161060a6002860154612ee5565b6020601f8211600114612aa3576080612a9e9361066384612882989795600495600080516020613aca8339815191529d6000926123a15750508160011b916000199060031b1c19161790565b61285e565b6002840160005260206000209760005b601f1984168110612b155750612a9e93600184600080516020613aca8339815191529b6004956080956128829b9a98601f19811610612afc57505050811b016002850155610669565b015160001960f88460031b161c191690558c80806106e8565b828201518a5560019099019860209283019201612ab3565b0151905088806105c8565b9190600184016000526020600020906000935b601f1984168510612b8f576001945083601f19811610612b76575b505050811b016001820155612a2b565b015160001960f88460031b161c19169055878080612b66565b81810151835560209485019460019093019290910190612b4b565b612bc3915060203d6020116107fa576107ec8183612ddf565b85611e3f565b608460405162461bcd60e51b815260206004820152604060248201527f63616e6e6f742062652063616c6c65642066726f2061737369676e696e67206f60448201527f72672061646d696e20616e64206e6574776f726b2061646d696e20726f6c65736064820152fd5b50604051602080820152612c4f816102b9604082018a86613318565b604051612c6c816102b96020820194602086526040830190612d24565b519020604051602081019060208252612c8b816102b960408201612fcd565b5190201415612739565b5060203d602011612cc4575b612cbd81612cb36126f1936080612ddf565b608001608061325e565b90506126dd565b503d612ca1565b600435906001600160a01b038216820361012e57565b602435906001600160a01b038216820361012e57565b9181601f8401121561012e578235916001600160401b03831161012e576020838186019501011161012e57565b919082519283825260005b848110612d50575050826000602080949584010152601f8019910116010190565b602081830181015184830182015201612d2f565b9395949192612d8e608094612d9c9360018060a01b0316875260a0602088015260a0870190612d24565b908582036040870152612d24565b9460608401521515910152565b60a081019081106001600160401b038211176104dc57604052565b602081019081106001600160401b038211176104dc57604052565b90601f801991011681019081106001600160401b038211176104dc57604052565b9291926001600160401b0382116104dc5760405191612e29601f8201601f191660200184612ddf565b82948184528183011161012e578281602093846000960137010152565b9080601f8301121561012e57816020612e6193359101612e00565b90565b60405190604082018281106001600160401b038211176104dc5760405260048252634e4f4e4560e01b6020830152565b600154811015612ecf576005906001600052027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf60190600090565b634e487b7160e01b600052603260045260246000fd5b90600182811c92168015612f15575b6020831014612eff57565b634e487b7160e01b600052602260045260246000fd5b91607f1691612ef4565b60045460009291612f2f82612ee5565b908181526020926001908181169081600014612fb05750600114612f54575b50505050565b929394509060046000527f8a35acfbc15ff81a39ae7d344fd709f28e8600b4aa8c65c6b64bfe7fe36bd19b92846000945b838610612f9c575050505001019038808080612f4e565b805485870183015294019385908201612f85565b60ff191685840152505090151560051b0101915038808080612f4e565b60055460009291612fdd82612ee5565b908181526020926001908181169081600014612fb057506001146130015750505050565b929394509060056000527f036b6384b5eca791c62761152d0c79bb0604c104a5fb6f4eb0703f3154bb3db092846000945b838610613049575050505001019038808080612f4e565b805485870183015294019385908201613032565b80546000939261306c82612ee5565b9182825260209360019182811690816000146130d35750600114613092575b5050505050565b90939495506000929192528360002092846000945b8386106130bf5750505050010190388080808061308b565b8054858701830152940193859082016130a7565b60ff19168685015250505090151560051b01019150388080808061308b565b9060018060a01b03918281166000526002602052604060002054156131a2576001600160a01b0316600090815260026020526040902054600019019161313783612e94565b5054169161314481612e94565b509261314f82612e94565b5092600261319d600161318c60ff6004613176600361316d8b612e94565b50015499612e94565b500154169598611621604051809481930161305d565b95611621604051809481930161305d565b929190565b91506131ac612e64565b906040516131b981612dc4565b6000815290600090600090565b6001600160a01b03811660009081526002602052604090205415613241576001600160a01b031660009081526002602052604090205460001901906002612e61600161323061321e61321787612e94565b5096612e94565b5095611621604051809481930161305d565b93611621604051809481930161305d565b5061324a612e64565b9060405161325781612dc4565b6000815290565b9081602091031261012e57516001600160a01b038116810361012e5790565b1561328457565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b8181106132c5575050565b600081556001016132ba565b9190601f81116132e057505050565b61330c926000526020600020906020601f840160051c8301931061330e575b601f0160051c01906132ba565b565b90915081906132ff565b908060209392818452848401376000828201840152601f01601f1916010190565b9192613364608094613372939897969860018060a01b0316855260a0602086015260a085019061305d565b90838203604085015261305d565b94151560608201520152565b91909161338f61166d368584612e00565b61339c5750600091508190565b6134de6040805194856133bb6020958683019387855285840191613318565b03956133cf601f1997888101835282612ddf565b519020600090815260068452818120546001600160a01b0390811682526002602052604090912054909390610a01906134d4906000190197600660036134148b612e94565b50015560046134228a612e94565b5001805460ff19169055600080516020613aca8339815191526002886134478c612e94565b5054166134956134568d612e94565b50918d61346281612e94565b5093600361348060ff600461347686612e94565b5001541693612e94565b5001549260018d519788970192019086613339565b0390a16134a189612e94565b5085516134be816114f58882019489865260028b8401910161305d565b5190209480519384918683019687528201612f1f565b5190201493612e94565b50541690565b156134eb57565b60405162461bcd60e51b815260206004820152601760248201527f6163636f756e7420646f6573206e6f74206578697374730000000000000000006044820152606490fd5b1561353757565b60405162461bcd60e51b815260206004820152601860248201527f6163636f756e7420696e20646966666572656e74206f726700000000000000006044820152606490fd5b35906001600160e01b03198216820361012e57565b600754811015612ecf576006906007600052027fa66cc928b5edb82af9bd49922954155ab7b0942694bea4ce44661d9a8736c6880190600090565b90600160401b81116104dc5781548183558082106135e957505050565b61330c926000526007602060002091601c82850160031c84019460021b1680613619575b500160031c01906132ba565b600019908186019182549160200360031b1c1690553861360d565b356001600160e01b03198116810361012e5790565b6001600160a01b038116600090815260026020526040902054919291156136ee576001600160a01b0316600090815260026020526040902054610a01906136e7906136979060001901612e94565b5060405190816136b6602082019260208452600160408401910161305d565b03916136ca601f1993848101835282612ddf565b519020946040519384916020830196602088526040840191613318565b5190201490565b505050600190565b60409081516020928382018481528261371183820186612d24565b0392613725601f1994858101835282612ddf565b519020600090815260068552819020546001600160a01b039290831661374f575050505050600090565b6002946137849460069261377385519182610a018682019587875289830190612d24565b5190206000525260002054166137fd565b1490565b6001600160a01b038116600090815260026020526040902054156137f4576001600160a01b03166000908152600260205260409020546000190160036137cd82612e94565b500154156137f457612e616137e3600292612e94565b50611621604051809481930161305d565b50612e61612e64565b6001600160a01b03811660009081526002602052604090205415613849576001600160a01b03166000908152600260205260409020546003906138439060001901612e94565b50015490565b50600090565b909161385a82613788565b604090815194602091866138778482019285845286830190612d24565b039661388b601f1998898101835282612ddf565b5190208351838101908482526138a681611548888201612f1f565b5190201461393c5782516138d3816138c78582019486865287830190612d24565b03888101835282612ddf565b5190206000526006815260018060a01b039283808460002054169516809514958615613903575b50505050505090565b8394959650613924600694519182610a018682019587875289830190612d24565b519020600052526000205416143880808080806138fa565b94909291936139629060018060a01b031660005260026020526000196040600020540190565b9461396c86612e94565b508551613995816139898882019489865260018b8401910161305d565b03858101835282612ddf565b5190209085516139b281613989888201948986528a830190612d24565b519020149485156139c6575b505050505090565b613a0e92939495506139d790612e94565b5085516139f4816114f58882019489865260018b8401910161305d565b51902094610a018151948592878401978852830190612d24565b5190201438808080806139be565b926001600160a01b039291831680613a725750613a6c9192610a01613a596040519586936020850198168852606060408501526080840190612d24565b601f199384848303016060850152612d24565b51902090565b915050604051916020830193168352604082015260408152606081018181106001600160401b038211176104dc576040525190209056fe36b0ea38154dec5e98b6bf928b971a9db5e8cd4b6946350e9e43fb9848c70b2568e62a03aeb0a125c2fc869eed72f2fca473680987bdd680c093a534e17cc776a2646970667358221220aeaa9da89fa5037b1d6e7f6ba62f11c466e42ccaa865f916054791ad2143fc8b64736f6c63430008150033"
 
 // DeployAcctManager deploys a new Ethereum contract, binding an instance of AcctManager to it.
 func DeployAcctManager(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address) (common.Address, *types.Transaction, *AcctManager, error) {
@@ -371,6 +371,78 @@ func (_AcctManager *AcctManagerCallerSession) GetAccountStatus(_account common.A
 	return _AcctManager.Contract.GetAccountStatus(&_AcctManager.CallOpts, _account)
 }
 
+// GetAccountValidity is a free data retrieval call binding the contract method 0xf54df919.
+//
+// Solidity: function getAccountValidity(address _account) constant returns(uint256, uint256, uint256, uint256)
+func (_AcctManager *AcctManagerCaller) GetAccountValidity(opts *bind.CallOpts, _account common.Address) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+		ret2 = new(*big.Int)
+		ret3 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+		ret2,
+		ret3,
+	}
+	err := _AcctManager.contract.Call(opts, out, "getAccountValidity", _account)
+	return *ret0, *ret1, *ret2, *ret3, err
+}
+
+// GetAccountValidity is a free data retrieval call binding the contract method 0xf54df919.
+//
+// Solidity: function getAccountValidity(address _account) constant returns(uint256, uint256, uint256, uint256)
+func (_AcctManager *AcctManagerSession) GetAccountValidity(_account common.Address) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _AcctManager.Contract.GetAccountValidity(&_AcctManager.CallOpts, _account)
+}
+
+// GetAccountValidity is a free data retrieval call binding the contract method 0xf54df919.
+//
+// Solidity: function getAccountValidity(address _account) constant returns(uint256, uint256, uint256, uint256)
+func (_AcctManager *AcctManagerCallerSession) GetAccountValidity(_account common.Address) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _AcctManager.Contract.GetAccountValidity(&_AcctManager.CallOpts, _account)
+}
+
+// GetContractAccessDetailsFromIndex is a free data retrieval call binding the contract method 0xcefa4200.
+//
+// Solidity: function getContractAccessDetailsFromIndex(uint256 _cIndex) constant returns(address, address, string, string, bytes4[], bool)
+func (_AcctManager *AcctManagerCaller) GetContractAccessDetailsFromIndex(opts *bind.CallOpts, _cIndex *big.Int) (common.Address, common.Address, string, string, [][4]byte, bool, error) {
+	var (
+		ret0 = new(common.Address)
+		ret1 = new(common.Address)
+		ret2 = new(string)
+		ret3 = new(string)
+		ret4 = new([][4]byte)
+		ret5 = new(bool)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+		ret2,
+		ret3,
+		ret4,
+		ret5,
+	}
+	err := _AcctManager.contract.Call(opts, out, "getContractAccessDetailsFromIndex", _cIndex)
+	return *ret0, *ret1, *ret2, *ret3, *ret4, *ret5, err
+}
+
+// GetContractAccessDetailsFromIndex is a free data retrieval call binding the contract method 0xcefa4200.
+//
+// Solidity: function getContractAccessDetailsFromIndex(uint256 _cIndex) constant returns(address, address, string, string, bytes4[], bool)
+func (_AcctManager *AcctManagerSession) GetContractAccessDetailsFromIndex(_cIndex *big.Int) (common.Address, common.Address, string, string, [][4]byte, bool, error) {
+	return _AcctManager.Contract.GetContractAccessDetailsFromIndex(&_AcctManager.CallOpts, _cIndex)
+}
+
+// GetContractAccessDetailsFromIndex is a free data retrieval call binding the contract method 0xcefa4200.
+//
+// Solidity: function getContractAccessDetailsFromIndex(uint256 _cIndex) constant returns(address, address, string, string, bytes4[], bool)
+func (_AcctManager *AcctManagerCallerSession) GetContractAccessDetailsFromIndex(_cIndex *big.Int) (common.Address, common.Address, string, string, [][4]byte, bool, error) {
+	return _AcctManager.Contract.GetContractAccessDetailsFromIndex(&_AcctManager.CallOpts, _cIndex)
+}
+
 // GetNumberOfAccounts is a free data retrieval call binding the contract method 0x309e36ef.
 //
 // Solidity: function getNumberOfAccounts() constant returns(uint256)
@@ -397,6 +469,32 @@ func (_AcctManager *AcctManagerCallerSession) GetNumberOfAccounts() (*big.Int, e
 	return _AcctManager.Contract.GetNumberOfAccounts(&_AcctManager.CallOpts)
 }
 
+// GetNumberOfContractAccesses is a free data retrieval call binding the contract method 0xc46e124e.
+//
+// Solidity: function getNumberOfContractAccesses() constant returns(uint256)
+func (_AcctManager *AcctManagerCaller) GetNumberOfContractAccesses(opts *bind.CallOpts) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _AcctManager.contract.Call(opts, out, "getNumberOfContractAccesses")
+	return *ret0, err
+}
+
+// GetNumberOfContractAccesses is a free data retrieval call binding the contract method 0xc46e124e.
+//
+// Solidity: function getNumberOfContractAccesses() constant returns(uint256)
+func (_AcctManager *AcctManagerSession) GetNumberOfContractAccesses() (*big.Int, error) {
+	return _AcctManager.Contract.GetNumberOfContractAccesses(&_AcctManager.CallOpts)
+}
+
+// GetNumberOfContractAccesses is a free data retrieval call binding the contract method 0xc46e124e.
+//
+// Solidity: function getNumberOfContractAccesses() constant returns(uint256)
+func (_AcctManager *AcctManagerCallerSession) GetNumberOfContractAccesses() (*big.Int, error) {
+	return _AcctManager.Contract.GetNumberOfContractAccesses(&_AcctManager.CallOpts)
+}
+
 // OrgAdminExists is a free data retrieval call binding the contract method 0x950145cf.
 //
 // Solidity: function orgAdminExists(string _orgId) constant returns(bool)
@@ -533,6 +631,69 @@ func (_AcctManager *AcctManagerTransactorSession) RemoveExistingAdmin(_orgId str
 	return _AcctManager.Contract.RemoveExistingAdmin(&_AcctManager.TransactOpts, _orgId)
 }
 
+// RevokeContractAccess is a paid mutator transaction binding the contract method 0xd3e9d064.
+//
+// Solidity: function revokeContractAccess(address _contract, address _account, string _orgId, string _roleId) returns()
+func (_AcctManager *AcctManagerTransactor) RevokeContractAccess(opts *bind.TransactOpts, _contract common.Address, _account common.Address, _orgId string, _roleId string) (*types.Transaction, error) {
+	return _AcctManager.contract.Transact(opts, "revokeContractAccess", _contract, _account, _orgId, _roleId)
+}
+
+// RevokeContractAccess is a paid mutator transaction binding the contract method 0xd3e9d064.
+//
+// Solidity: function revokeContractAccess(address _contract, address _account, string _orgId, string _roleId) returns()
+func (_AcctManager *AcctManagerSession) RevokeContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string) (*types.Transaction, error) {
+	return _AcctManager.Contract.RevokeContractAccess(&_AcctManager.TransactOpts, _contract, _account, _orgId, _roleId)
+}
+
+// RevokeContractAccess is a paid mutator transaction binding the contract method 0xd3e9d064.
+//
+// Solidity: function revokeContractAccess(address _contract, address _account, string _orgId, string _roleId) returns()
+func (_AcctManager *AcctManagerTransactorSession) RevokeContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string) (*types.Transaction, error) {
+	return _AcctManager.Contract.RevokeContractAccess(&_AcctManager.TransactOpts, _contract, _account, _orgId, _roleId)
+}
+
+// SetAccountValidity is a paid mutator transaction binding the contract method 0xde811523.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_AcctManager *AcctManagerTransactor) SetAccountValidity(opts *bind.TransactOpts, _account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _AcctManager.contract.Transact(opts, "setAccountValidity", _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetAccountValidity is a paid mutator transaction binding the contract method 0xde811523.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_AcctManager *AcctManagerSession) SetAccountValidity(_account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _AcctManager.Contract.SetAccountValidity(&_AcctManager.TransactOpts, _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetAccountValidity is a paid mutator transaction binding the contract method 0xde811523.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_AcctManager *AcctManagerTransactorSession) SetAccountValidity(_account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _AcctManager.Contract.SetAccountValidity(&_AcctManager.TransactOpts, _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetContractAccess is a paid mutator transaction binding the contract method 0x4aac8df8.
+//
+// Solidity: function setContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors) returns()
+func (_AcctManager *AcctManagerTransactor) SetContractAccess(opts *bind.TransactOpts, _contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte) (*types.Transaction, error) {
+	return _AcctManager.contract.Transact(opts, "setContractAccess", _contract, _account, _orgId, _roleId, _selectors)
+}
+
+// SetContractAccess is a paid mutator transaction binding the contract method 0x4aac8df8.
+//
+// Solidity: function setContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors) returns()
+func (_AcctManager *AcctManagerSession) SetContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte) (*types.Transaction, error) {
+	return _AcctManager.Contract.SetContractAccess(&_AcctManager.TransactOpts, _contract, _account, _orgId, _roleId, _selectors)
+}
+
+// SetContractAccess is a paid mutator transaction binding the contract method 0x4aac8df8.
+//
+// Solidity: function setContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors) returns()
+func (_AcctManager *AcctManagerTransactorSession) SetContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte) (*types.Transaction, error) {
+	return _AcctManager.Contract.SetContractAccess(&_AcctManager.TransactOpts, _contract, _account, _orgId, _roleId, _selectors)
+}
+
 // SetDefaults is a paid mutator transaction binding the contract method 0xcef7f6af.
 //
 // Solidity: function setDefaults(string _nwAdminRole, string _oAdminRole) returns()
@@ -988,3 +1149,420 @@ func (_AcctManager *AcctManagerFilterer) ParseAccountStatusChanged(log types.Log
 	}
 	return event, nil
 }
+
+// AcctManagerAccountValidityChangedIterator is returned from FilterAccountValidityChanged and is used to iterate over the raw logs and unpacked data for AccountValidityChanged events raised by the AcctManager contract.
+type AcctManagerAccountValidityChangedIterator struct {
+	Event *AcctManagerAccountValidityChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AcctManagerAccountValidityChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AcctManagerAccountValidityChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AcctManagerAccountValidityChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AcctManagerAccountValidityChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AcctManagerAccountValidityChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AcctManagerAccountValidityChanged represents a AccountValidityChanged event raised by the AcctManager contract.
+type AcctManagerAccountValidityChanged struct {
+	Account    common.Address
+	OrgId      string
+	FromBlock  *big.Int
+	UntilBlock *big.Int
+	FromTime   *big.Int
+	UntilTime  *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterAccountValidityChanged is a free log retrieval operation binding the contract event 0x6ea63efb3bdf2807af92d2125e5d915edc94daef16c2bdf2ff75a575af1a4674.
+//
+// Solidity: event AccountValidityChanged(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime)
+func (_AcctManager *AcctManagerFilterer) FilterAccountValidityChanged(opts *bind.FilterOpts) (*AcctManagerAccountValidityChangedIterator, error) {
+
+	logs, sub, err := _AcctManager.contract.FilterLogs(opts, "AccountValidityChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &AcctManagerAccountValidityChangedIterator{contract: _AcctManager.contract, event: "AccountValidityChanged", logs: logs, sub: sub}, nil
+}
+
+var AccountValidityChangedTopicHash = "0x6ea63efb3bdf2807af92d2125e5d915edc94daef16c2bdf2ff75a575af1a4674"
+
+// WatchAccountValidityChanged is a free log subscription operation binding the contract event 0x6ea63efb3bdf2807af92d2125e5d915edc94daef16c2bdf2ff75a575af1a4674.
+//
+// Solidity: event AccountValidityChanged(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime)
+func (_AcctManager *AcctManagerFilterer) WatchAccountValidityChanged(opts *bind.WatchOpts, sink chan<- *AcctManagerAccountValidityChanged) (event.Subscription, error) {
+
+	logs, sub, err := _AcctManager.contract.WatchLogs(opts, "AccountValidityChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AcctManagerAccountValidityChanged)
+				if err := _AcctManager.contract.UnpackLog(event, "AccountValidityChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAccountValidityChanged is a log parse operation binding the contract event 0x6ea63efb3bdf2807af92d2125e5d915edc94daef16c2bdf2ff75a575af1a4674.
+//
+// Solidity: event AccountValidityChanged(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime)
+func (_AcctManager *AcctManagerFilterer) ParseAccountValidityChanged(log types.Log) (*AcctManagerAccountValidityChanged, error) {
+	event := new(AcctManagerAccountValidityChanged)
+	if err := _AcctManager.contract.UnpackLog(event, "AccountValidityChanged", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// AcctManagerContractAccessModifiedIterator is returned from FilterContractAccessModified and is used to iterate over the raw logs and unpacked data for ContractAccessModified events raised by the AcctManager contract.
+type AcctManagerContractAccessModifiedIterator struct {
+	Event *AcctManagerContractAccessModified // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AcctManagerContractAccessModifiedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AcctManagerContractAccessModified)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AcctManagerContractAccessModified)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AcctManagerContractAccessModifiedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AcctManagerContractAccessModifiedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AcctManagerContractAccessModified represents a ContractAccessModified event raised by the AcctManager contract.
+type AcctManagerContractAccessModified struct {
+	Contract  common.Address
+	Account   common.Address
+	OrgId     string
+	RoleId    string
+	Selectors [][4]byte
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractAccessModified is a free log retrieval operation binding the contract event 0x8c504313a5f470ad50ffcfffabc7633869f34797c77d95b8ff6518e0b2041b27.
+//
+// Solidity: event ContractAccessModified(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors)
+func (_AcctManager *AcctManagerFilterer) FilterContractAccessModified(opts *bind.FilterOpts) (*AcctManagerContractAccessModifiedIterator, error) {
+
+	logs, sub, err := _AcctManager.contract.FilterLogs(opts, "ContractAccessModified")
+	if err != nil {
+		return nil, err
+	}
+	return &AcctManagerContractAccessModifiedIterator{contract: _AcctManager.contract, event: "ContractAccessModified", logs: logs, sub: sub}, nil
+}
+
+var ContractAccessModifiedTopicHash = "0x8c504313a5f470ad50ffcfffabc7633869f34797c77d95b8ff6518e0b2041b27"
+
+// WatchContractAccessModified is a free log subscription operation binding the contract event 0x8c504313a5f470ad50ffcfffabc7633869f34797c77d95b8ff6518e0b2041b27.
+//
+// Solidity: event ContractAccessModified(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors)
+func (_AcctManager *AcctManagerFilterer) WatchContractAccessModified(opts *bind.WatchOpts, sink chan<- *AcctManagerContractAccessModified) (event.Subscription, error) {
+
+	logs, sub, err := _AcctManager.contract.WatchLogs(opts, "ContractAccessModified")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AcctManagerContractAccessModified)
+				if err := _AcctManager.contract.UnpackLog(event, "ContractAccessModified", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractAccessModified is a log parse operation binding the contract event 0x8c504313a5f470ad50ffcfffabc7633869f34797c77d95b8ff6518e0b2041b27.
+//
+// Solidity: event ContractAccessModified(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors)
+func (_AcctManager *AcctManagerFilterer) ParseContractAccessModified(log types.Log) (*AcctManagerContractAccessModified, error) {
+	event := new(AcctManagerContractAccessModified)
+	if err := _AcctManager.contract.UnpackLog(event, "ContractAccessModified", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// AcctManagerContractAccessRevokedIterator is returned from FilterContractAccessRevoked and is used to iterate over the raw logs and unpacked data for ContractAccessRevoked events raised by the AcctManager contract.
+type AcctManagerContractAccessRevokedIterator struct {
+	Event *AcctManagerContractAccessRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *AcctManagerContractAccessRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(AcctManagerContractAccessRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(AcctManagerContractAccessRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *AcctManagerContractAccessRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *AcctManagerContractAccessRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// AcctManagerContractAccessRevoked represents a ContractAccessRevoked event raised by the AcctManager contract.
+type AcctManagerContractAccessRevoked struct {
+	Contract common.Address
+	Account  common.Address
+	OrgId    string
+	RoleId   string
+	Raw      types.Log // Blockchain specific contextual infos
+}
+
+// FilterContractAccessRevoked is a free log retrieval operation binding the contract event 0x7865f34e49f7eb87b88f816744712a37bb89b621934e0ae433ad1dafb1996a98.
+//
+// Solidity: event ContractAccessRevoked(address _contract, address _account, string _orgId, string _roleId)
+func (_AcctManager *AcctManagerFilterer) FilterContractAccessRevoked(opts *bind.FilterOpts) (*AcctManagerContractAccessRevokedIterator, error) {
+
+	logs, sub, err := _AcctManager.contract.FilterLogs(opts, "ContractAccessRevoked")
+	if err != nil {
+		return nil, err
+	}
+	return &AcctManagerContractAccessRevokedIterator{contract: _AcctManager.contract, event: "ContractAccessRevoked", logs: logs, sub: sub}, nil
+}
+
+var ContractAccessRevokedTopicHash = "0x7865f34e49f7eb87b88f816744712a37bb89b621934e0ae433ad1dafb1996a98"
+
+// WatchContractAccessRevoked is a free log subscription operation binding the contract event 0x7865f34e49f7eb87b88f816744712a37bb89b621934e0ae433ad1dafb1996a98.
+//
+// Solidity: event ContractAccessRevoked(address _contract, address _account, string _orgId, string _roleId)
+func (_AcctManager *AcctManagerFilterer) WatchContractAccessRevoked(opts *bind.WatchOpts, sink chan<- *AcctManagerContractAccessRevoked) (event.Subscription, error) {
+
+	logs, sub, err := _AcctManager.contract.WatchLogs(opts, "ContractAccessRevoked")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(AcctManagerContractAccessRevoked)
+				if err := _AcctManager.contract.UnpackLog(event, "ContractAccessRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseContractAccessRevoked is a log parse operation binding the contract event 0x7865f34e49f7eb87b88f816744712a37bb89b621934e0ae433ad1dafb1996a98.
+//
+// Solidity: event ContractAccessRevoked(address _contract, address _account, string _orgId, string _roleId)
+func (_AcctManager *AcctManagerFilterer) ParseContractAccessRevoked(log types.Log) (*AcctManagerContractAccessRevoked, error) {
+	event := new(AcctManagerContractAccessRevoked)
+	if err := _AcctManager.contract.UnpackLog(event, "ContractAccessRevoked", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -28,12 +28,12 @@ var (
 )
 
 // NodeManagerABI is the input ABI used to generate the binding from.
-const NodeManagerABI = "[{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"enodeId\",\"type\":\"string\"}],\"name\":\"getNodeDetails\",\"outputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_nodeStatus\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addOrgNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_nodeIndex\",\"type\":\"uint256\"}],\"name\":\"getNodeDetailsFromIndex\",\"outputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_nodeStatus\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNumberOfNodes\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"approveNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeProposed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeDeactivated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeActivated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeBlacklisted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeRecoveryInitiated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeRecoveryCompleted\",\"type\":\"event\"}]"
+const NodeManagerABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeActivated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeBlacklisted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeDeactivated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeProposed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeRecoveryCompleted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"NodeRecoveryInitiated\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromBlock\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_untilBlock\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_fromTime\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_untilTime\",\"type\":\"uint256\"}],\"name\":\"NodeValidityChanged\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addOrgNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"approveNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"enodeId\",\"type\":\"string\"}],\"name\":\"getNodeDetails\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"uint256\",\"name\":\"_nodeStatus\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_nodeIndex\",\"type\":\"uint256\"}],\"name\":\"getNodeDetailsFromIndex\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"uint256\",\"name\":\"_nodeStatus\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"}],\"name\":\"getNodeValidity\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNumberOfNodes\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_fromBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_fromTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilTime\",\"type\":\"uint256\"}],\"name\":\"setNodeValidity\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 var NodeManagerParsedABI, _ = abi.JSON(strings.NewReader(NodeManagerABI))
 
 // NodeManagerBin is the compiled bytecode used for deploying new contracts.
-var NodeManagerBin = "0x608060405234801561001057600080fd5b5060405160208061338f8339810180604052602081101561003057600080fd5b5051600080546001600160a01b039092166001600160a01b031990921691909117905561332d806100626000396000f3fe608060405234801561001057600080fd5b50600436106100935760003560e01c80634c573311116100665780634c5733111461042b578063549583df1461073057806397c07a9b146108f2578063b81c806a1461090f578063f82e08ac1461092957610093565b806337d50b27146100985780633f0e0e471461025e5780634530abe11461042b57806345a59e5b146105ed575b600080fd5b61025c600480360360c08110156100ae57600080fd5b810190602081018135600160201b8111156100c857600080fd5b8201836020820111156100da57600080fd5b803590602001918460018302840111600160201b831117156100fb57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561014d57600080fd5b82018360208201111561015f57600080fd5b803590602001918460018302840111600160201b8311171561018057600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929561ffff8535811696602087013590911695919450925060608101915060400135600160201b8111156101e657600080fd5b8201836020820111156101f857600080fd5b803590602001918460018302840111600160201b8311171561021957600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505091359250610aeb915050565b005b6102cc6004803603602081101561027457600080fd5b810190602081018135600160201b81111561028e57600080fd5b8201836020820111156102a057600080fd5b803590602001918460018302840111600160201b831117156102c157600080fd5b50909250905061160a565b6040805161ffff80861660608301528416608082015260a0810183905260c080825288519082015287519091829160208084019284019160e08501918c019080838360005b83811015610329578181015183820152602001610311565b50505050905090810190601f1680156103565780820380516001836020036101000a031916815260200191505b5084810383528951815289516020918201918b019080838360005b83811015610389578181015183820152602001610371565b50505050905090810190601f1680156103b65780820380516001836020036101000a031916815260200191505b5084810382528851815288516020918201918a019080838360005b838110156103e95781810151838201526020016103d1565b50505050905090810190601f1680156104165780820380516001836020036101000a031916815260200191505b50995050505050505050505060405180910390f35b61025c600480360360a081101561044157600080fd5b810190602081018135600160201b81111561045b57600080fd5b82018360208201111561046d57600080fd5b803590602001918460018302840111600160201b8311171561048e57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156104e057600080fd5b8201836020820111156104f257600080fd5b803590602001918460018302840111600160201b8311171561051357600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929561ffff8535811696602087013590911695919450925060608101915060400135600160201b81111561057957600080fd5b82018360208201111561058b57600080fd5b803590602001918460018302840111600160201b831117156105ac57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295506119d1945050505050565b61071c6004803603606081101561060357600080fd5b810190602081018135600160201b81111561061d57600080fd5b82018360208201111561062f57600080fd5b803590602001918460018302840111600160201b8311171561065057600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156106a257600080fd5b8201836020820111156106b457600080fd5b803590602001918460018302840111600160201b831117156106d557600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050903561ffff169150611ec79050565b604080519115158252519081900360200190f35b61025c600480360360a081101561074657600080fd5b810190602081018135600160201b81111561076057600080fd5b82018360208201111561077257600080fd5b803590602001918460018302840111600160201b8311171561079357600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156107e557600080fd5b8201836020820111156107f757600080fd5b803590602001918460018302840111600160201b8311171561081857600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929561ffff8535811696602087013590911695919450925060608101915060400135600160201b81111561087e57600080fd5b82018360208201111561089057600080fd5b803590602001918460018302840111600160201b831117156108b157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295506121e8945050505050565b6102cc6004803603602081101561090857600080fd5b50356125d5565b610917612879565b60408051918252519081900360200190f35b61025c600480360360a081101561093f57600080fd5b810190602081018135600160201b81111561095957600080fd5b82018360208201111561096b57600080fd5b803590602001918460018302840111600160201b8311171561098c57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156109de57600080fd5b8201836020820111156109f057600080fd5b803590602001918460018302840111600160201b83111715610a1157600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929561ffff8535811696602087013590911695919450925060608101915060400135600160201b811115610a7757600080fd5b820183602082011115610a8957600080fd5b803590602001918460018302840111600160201b83111715610aaa57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550612880945050505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015610b3857600080fd5b505afa158015610b4c573d6000803e3d6000fd5b505050506040513d6020811015610b6257600080fd5b50516001600160a01b03163314610bb75760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b8560036000826040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015610bfd578181015183820152602001610be5565b50505050905090810190601f168015610c2a5780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020541515610caa5760408051600160e51b62461bcd02815260206004820152601e60248201527f70617373656420656e6f646520696420646f6573206e6f742065786973740000604482015290519081900360640190fd5b610cb48784612eed565b1515610cf457604051600160e51b62461bcd02815260040180806020018281038252602a815260200180613265602a913960400191505060405180910390fd5b8160011480610d035750816002145b80610d0e5750816003145b80610d195750816004145b80610d245750816005145b1515610d6457604051600160e51b62461bcd0281526004018080602001828103825260268152602001806132af6026913960400191505060405180910390fd5b6000610d6f88613047565b9050866040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015610db2578181015183820152602001610d9a565b50505050905090810190601f168015610ddf5780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120600182815481101515610e0957fe5b90600052602060002090600502016001016040516020018080602001828103825283818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015610ea65780601f10610e7b57610100808354040283529160200191610ea6565b820191906000526020600020905b815481529060010190602001808311610e8957829003601f168201915b50509250505060405160208183030381529060405280519060200120141580610ef957508561ffff16600182815481101515610ede57fe5b600091825260209091206002600590920201015461ffff1614155b80610f3457508461ffff16600182815481101515610f1357fe5b600091825260209091206005909102016002015462010000900461ffff1614155b15610f3f5750611601565b826001141561114857610f51886130f0565b600214610f965760408051600160e51b62461bcd02815260206004820152601d602482015260008051602061328f833981519152604482015290519081900360640190fd5b6003600182815481101515610fa757fe5b9060005260206000209060050201600401819055507ff631019be71bc682c59150635d714061185232e98e60de8bdd87bbee239cc5c888888888886040518080602001806020018661ffff1661ffff1681526020018561ffff1661ffff16815260200180602001848103845289818151815260200191508051906020019080838360005b8381101561104357818101518382015260200161102b565b50505050905090810190601f1680156110705780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b838110156110a357818101518382015260200161108b565b50505050905090810190601f1680156110d05780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b838110156111035781810151838201526020016110eb565b50505050905090810190601f1680156111305780820380516001836020036101000a031916815260200191505b509850505050505050505060405180910390a16115ff565b826002141561124b5761115a886130f0565b60031461119f5760408051600160e51b62461bcd02815260206004820152601d602482015260008051602061328f833981519152604482015290519081900360640190fd5b60026001828154811015156111b057fe5b9060005260206000209060050201600401819055507ffb98f62dea866f0c373574c8523f611d0db1d8f19cc1b95d07a221d36a6a45de88888888886040518080602001806020018661ffff1661ffff1681526020018561ffff1661ffff16815260200180602001848103845289818151815260200191508051906020019080838360008381101561104357818101518382015260200161102b565b826003141561130057600460018281548110151561126557fe5b9060005260206000209060050201600401819055507f25300d4d785e654bc9b7979700cfa0fdc9ace890a46841fecfce661fd2c41a3388888888886040518080602001806020018661ffff1661ffff1681526020018561ffff1661ffff16815260200180602001848103845289818151815260200191508051906020019080838360008381101561104357818101518382015260200161102b565b826004141561140357611312886130f0565b6004146113575760408051600160e51b62461bcd02815260206004820152601d602482015260008051602061328f833981519152604482015290519081900360640190fd5b600560018281548110151561136857fe5b9060005260206000209060050201600401819055507f72779f66ea90e28bae76fbfe03eaef5ae01699976c7493f93186ab9560ccfaa488888888886040518080602001806020018661ffff1661ffff1681526020018561ffff1661ffff16815260200180602001848103845289818151815260200191508051906020019080838360008381101561104357818101518382015260200161102b565b61140c886130f0565b6005146114515760408051600160e51b62461bcd02815260206004820152601d602482015260008051602061328f833981519152604482015290519081900360640190fd5b600260018281548110151561146257fe5b9060005260206000209060050201600401819055507f60aac8c36efdaabf125dc9ec2124bde8b3ceafe5c8b4fc8063fc4ac9017eb0be88888888886040518080602001806020018661ffff1661ffff1681526020018561ffff1661ffff16815260200180602001848103845289818151815260200191508051906020019080838360005b838110156114fe5781810151838201526020016114e6565b50505050905090810190601f16801561152b5780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b8381101561155e578181015183820152602001611546565b50505050905090810190601f16801561158b5780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b838110156115be5781810151838201526020016115a6565b50505050905090810190601f1680156115eb5780820380516001836020036101000a031916815260200191505b509850505050505050505060405180910390a15b505b50505050505050565b6060806060600080600060026000866040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015611659578181015183820152602001611641565b50505050905090810190601f1680156116865780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120815260200190815260200160002054600014156116f157505060408051602080820183526000808352835180830185528181528451928301909452808252919650919450909250905080806119c7565b600061173289898080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061304792505050565b905060018181548110151561174357fe5b906000526020600020906005020160030160018281548110151561176357fe5b906000526020600020906005020160000160018381548110151561178357fe5b90600052602060002090600502016001016001848154811015156117a357fe5b60009182526020909120600260059092020101546001805461ffff90921691869081106117cc57fe5b906000526020600020906005020160020160029054906101000a900461ffff166001868154811015156117fb57fe5b600091825260209182902060046005909202010154865460408051601f6002600019610100600187161502019094169390930492830185900485028101850190915281815291928891908301828280156118965780601f1061186b57610100808354040283529160200191611896565b820191906000526020600020905b81548152906001019060200180831161187957829003601f168201915b5050885460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959b508a9450925084019050828280156119245780601f106118f957610100808354040283529160200191611924565b820191906000526020600020905b81548152906001019060200180831161190757829003601f168201915b5050875460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959a50899450925084019050828280156119b25780601f10611987576101008083540402835291602001916119b2565b820191906000526020600020905b81548152906001019060200180831161199557829003601f168201915b50505050509350965096509650965096509650505b9295509295509295565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611a1e57600080fd5b505afa158015611a32573d6000803e3d6000fd5b505050506040513d6020811015611a4857600080fd5b50516001600160a01b03163314611a9d5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b8460036000826040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015611ae3578181015183820152602001611acb565b50505050905090810190601f168015611b105780820380516001836020036101000a031916815260200191505b5060408051601f198184030181529181528151602092830120865290850195909552505050016000205415611b8f5760408051600160e51b62461bcd02815260206004820152601660248201527f70617373656420656e6f64652069642065786973747300000000000000000000604482015290519081900360640190fd5b60048054600101908190556040805160208082018181528a519383019390935289516003936000938c9391928392606090920191850190808383895b83811015611be3578181015183820152602001611bcb565b50505050905090810190601f168015611c105780820380516001836020036101000a031916815260200191505b5060408051601f1981840301815291815281516020928301208652858201969096529385016000908120969096555050825160c0810184528a81528083018a905261ffff808a169482019490945292871660608401525060808201859052600260a083015260018054808201808355919094528251805191946005027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf60192611cbe928492909101906131cc565b506020828101518051611cd792600185019201906131cc565b506040820151600282018054606085015161ffff908116620100000263ffff0000199190941661ffff19909216919091171691909117905560808201518051611d2a9160038401916020909101906131cc565b5060a082015181600401555050507f9394c836a3325586270659f6aa3b9f835abca9afe7fec5abfc69760bb12bce0d86868686866040518080602001806020018661ffff1661ffff1681526020018561ffff1661ffff16815260200180602001848103845289818151815260200191508051906020019080838360005b83811015611dbf578181015183820152602001611da7565b50505050905090810190601f168015611dec5780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b83811015611e1f578181015183820152602001611e07565b50505050905090810190601f168015611e4c5780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b83811015611e7f578181015183820152602001611e67565b50505050905090810190601f168015611eac5780820380516001836020036101000a031916815260200191505b509850505050505050505060405180910390a1505050505050565b60008060009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611f1657600080fd5b505afa158015611f2a573d6000803e3d6000fd5b505050506040513d6020811015611f4057600080fd5b50516001600160a01b03163314611f955760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60036000856040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015611fda578181015183820152602001611fc2565b50505050905090810190601f1680156120075780820380516001836020036101000a031916815260200191505b50925050506040516020818303038152906040528051906020012081526020019081526020016000205460001415612041575060006121e1565b600061204c85613047565b905060018181548110151561205d57fe5b90600052602060002090600502016004015460021480156121cc5750836040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156120ba5781810151838201526020016120a2565b50505050905090810190601f1680156120e75780820380516001836020036101000a031916815260200191505b50925050506040516020818303038152906040528051906020012060018281548110151561211157fe5b906000526020600020906005020160010160405160200180806020018281038252838181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156121ae5780601f10612183576101008083540402835291602001916121ae565b820191906000526020600020905b81548152906001019060200180831161219157829003601f168201915b50509250505060405160208183030381529060405280519060200120145b156121db5760019150506121e1565b60009150505b9392505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561223557600080fd5b505afa158015612249573d6000803e3d6000fd5b505050506040513d602081101561225f57600080fd5b50516001600160a01b031633146122b45760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b8460036000826040516020018080602001828103825283818151815260200191508051906020019080838360005b838110156122fa5781810151838201526020016122e2565b50505050905090810190601f1680156123275780820380516001836020036101000a031916815260200191505b5060408051601f1981840301815291815281516020928301208652908501959095525050500160002054156123a65760408051600160e51b62461bcd02815260206004820152601660248201527f70617373656420656e6f64652069642065786973747300000000000000000000604482015290519081900360640190fd5b60048054600101908190556040805160208082018181528a519383019390935289516003936000938c9391928392606090920191850190808383895b838110156123fa5781810151838201526020016123e2565b50505050905090810190601f1680156124275780820380516001836020036101000a031916815260200191505b5060408051601f1981840301815291815281516020928301208652858201969096529385016000908120969096555050825160c0810184528a81528083018a905261ffff808a169482019490945292871660608401525060808201859052600160a083018190528054808201808355919094528251805191946005027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf601926124d5928492909101906131cc565b5060208281015180516124ee92600185019201906131cc565b506040820151600282018054606085015161ffff908116620100000263ffff0000199190941661ffff199092169190911716919091179055608082015180516125419160038401916020909101906131cc565b5060a082015181600401555050507ff9bad9f8a2dccc52fad61273a7fd673335b420319506c19b87df9ce7a19732da86868686866040518080602001806020018661ffff1661ffff1681526020018561ffff1661ffff168152602001806020018481038452898181518152602001915080519060200190808383600083811015611dbf578181015183820152602001611da7565b606080606060008060006001878154811015156125ee57fe5b906000526020600020906005020160030160018881548110151561260e57fe5b906000526020600020906005020160000160018981548110151561262e57fe5b906000526020600020906005020160010160018a81548110151561264e57fe5b60009182526020909120600260059092020101546001805461ffff909216918c90811061267757fe5b906000526020600020906005020160020160029054906101000a900461ffff1660018c8154811015156126a657fe5b600091825260209182902060046005909202010154865460408051601f6002600019610100600187161502019094169390930492830185900485028101850190915281815291928891908301828280156127415780601f1061271657610100808354040283529160200191612741565b820191906000526020600020905b81548152906001019060200180831161272457829003601f168201915b5050885460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959b508a9450925084019050828280156127cf5780601f106127a4576101008083540402835291602001916127cf565b820191906000526020600020905b8154815290600101906020018083116127b257829003601f168201915b5050875460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959a508994509250840190508282801561285d5780601f106128325761010080835404028352916020019161285d565b820191906000526020600020905b81548152906001019060200180831161284057829003601f168201915b5050505050935095509550955095509550955091939550919395565b6004545b90565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156128cd57600080fd5b505afa1580156128e1573d6000803e3d6000fd5b505050506040513d60208110156128f757600080fd5b50516001600160a01b0316331461294c5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b8460036000826040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561299257818101518382015260200161297a565b50505050905090810190601f1680156129bf5780820380516001836020036101000a031916815260200191505b5060408051601f19818403018152918152815160209283012086529085019590955250505001600020541515612a3f5760408051600160e51b62461bcd02815260206004820152601e60248201527f70617373656420656e6f646520696420646f6573206e6f742065786973740000604482015290519081900360640190fd5b612a498683612eed565b1515612a8957604051600160e51b62461bcd02815260040180806020018281038252602d8152602001806132d5602d913960400191505060405180910390fd5b612a92866130f0565b600114612ae95760408051600160e51b62461bcd02815260206004820152601c60248201527f6e6f7468696e672070656e64696e6720666f7220617070726f76616c00000000604482015290519081900360640190fd5b6000612af487613047565b9050856040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015612b37578181015183820152602001612b1f565b50505050905090810190601f168015612b645780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120600182815481101515612b8e57fe5b90600052602060002090600502016001016040516020018080602001828103825283818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015612c2b5780601f10612c0057610100808354040283529160200191612c2b565b820191906000526020600020905b815481529060010190602001808311612c0e57829003601f168201915b50509250505060405160208183030381529060405280519060200120141580612c7e57508461ffff16600182815481101515612c6357fe5b600091825260209091206002600590920201015461ffff1614155b80612cb957508361ffff16600182815481101515612c9857fe5b600091825260209091206005909102016002015462010000900461ffff1614155b15612cc45750612ee5565b6002600182815481101515612cd557fe5b9060005260206000209060050201600401819055507f9394c836a3325586270659f6aa3b9f835abca9afe7fec5abfc69760bb12bce0d600182815481101515612d1a57fe5b9060005260206000209060050201600001878787600186815481101515612d3d57fe5b90600052602060002090600502016003016040518080602001806020018661ffff1661ffff1681526020018561ffff1661ffff16815260200180602001848103845289818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015612dfb5780601f10612dd057610100808354040283529160200191612dfb565b820191906000526020600020905b815481529060010190602001808311612dde57829003601f168201915b505084810383528851815288516020918201918a019080838360005b83811015612e2f578181015183820152602001612e17565b50505050905090810190601f168015612e5c5780820380516001836020036101000a031916815260200191505b50848103825285546002600019610100600184161502019091160480825260209091019086908015612ecf5780601f10612ea457610100808354040283529160200191612ecf565b820191906000526020600020905b815481529060010190602001808311612eb257829003601f168201915b50509850505050505050505060405180910390a1505b505050505050565b6000816040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015612f30578181015183820152602001612f18565b50505050905090810190601f168015612f5d5780820380516001836020036101000a031916815260200191505b5092505050604051602081830303815290604052805190602001206001612f8385613047565b81548110612f8d57fe5b60009182526020918290206040805180850194855260036005909402909201929092018054600260001961010060018416150201909116049282018390529291829160600190849080156130225780601f10612ff757610100808354040283529160200191613022565b820191906000526020600020905b81548152906001019060200180831161300557829003601f168201915b5050925050506040516020818303038152906040528051906020012014905092915050565b6000600160036000846040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015613090578181015183820152602001613078565b50505050905090810190601f1680156130bd5780820380516001836020036101000a031916815260200191505b5092505050604051602081830303815290604052805190602001208152602001908152602001600020540390505b919050565b600060036000836040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561313757818101518382015260200161311f565b50505050905090810190601f1680156131645780820380516001836020036101000a031916815260200191505b5092505050604051602081830303815290604052805190602001208152602001908152602001600020546000141561319e575060006130eb565b60016131a983613047565b815481106131b357fe5b9060005260206000209060050201600401549050919050565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061320d57805160ff191683800117855561323a565b8280016001018555821561323a579182015b8281111561323a57825182559160200191906001019061321f565b5061324692915061324a565b5090565b61287d91905b80821115613246576000815560010161325056fe656e6f646520696420646f6573206e6f742062656c6f6e6720746f2074686520706173736564206f72676f7065726174696f6e2063616e6e6f7420626520706572666f726d6564000000696e76616c6964206f7065726174696f6e2e2077726f6e6720616374696f6e20706173736564656e6f646520696420646f6573206e6f742062656c6f6e6720746f2074686520706173736564206f7267206964a165627a7a7230582033fd8af5439a9af79764088da6847651cc33d3b41698fff5d54b3e47419c5c6a0029"
+var NodeManagerBin = "0x60803461007457601f61206f38819003918201601f19168301916001600160401b038311848410176100795780849260209460405283398101031261007457516001600160a01b0381169081900361007457600080546001600160a01b031916919091179055604051611fc690816100a98239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe6080604052600436101561001257600080fd5b60003560e01c80633021183f14610ae557806337d50b27146109c55780633f0e0e47146109985780634530abe11461085257806345a59e5b146108cf5780634c57331114610852578063549583df146102ef57806397c07a9b14610220578063b6dface314610198578063b81c806a1461017a5763f82e08ac1461009557600080fd5b34610175576100a336610ee0565b60005460405162e32cf960e41b81526001600160a01b0396929593949392916020908190839060049082908c165afa9182156101695761013a986003936100f59260009161013c575b5016331461120b565b6040518181019082825261011e816101106040820188610e4d565b03601f198101835282610d6e565b5190206000525261013560406000205415156117d2565b61181e565b005b61015c9150843d8611610162575b6101548183610d6e565b8101906111ec565b386100ec565b503d61014a565b6040513d6000823e3d90fd5b600080fd5b34610175576000366003190112610175576020600454604051908152f35b34610175576101a636610e04565b6101e36060604051838194602083019660208852816040850152848401376000838284010152601f80199101168101036040810184520182610d6e565b5190206000526005602052608060406000208054906001810154906003600282015491015491604051938452602084015260408301526060820152f35b34610175576020366003190112610175576102c160036102eb60043561024581610f59565b5090600260016102df61025784610f59565b506102c861026486610f59565b50916102b5600461029a6102778a610f59565b5061ffff998a9101541698600261028d8c610f59565b50015460101c1699610f59565b500154986102ae604051809d819301610fe4565b038b610d6e565b6040519a8b8092610fe4565b038a610d6e565b6102d86040518094819301610fe4565b0382610d6e565b60405196879687610e8d565b0390f35b34610175576102fd36610ee0565b90600460018060a09795971b03602081600054166040519384809262e32cf960e41b82525afa80156101695761033d92600091610833575016331461120b565b60405160208101906020825261035a816101106040820188610e4d565b519020600052600360205261037460406000205415611248565b61037f600454611764565b806004556040516020810190602082526103a0816101106040820189610e4d565b5190206000526003602052604060002055604051946103be86610d38565b83865284602087015261ffff8116604087015261ffff82166060870152826080870152600160a0870152600154600160401b811015610675578060016104079201600155610f59565b61081d5786518051906001600160401b03821161067557819061042a8454610faa565b601f81116107cd575b50602090601f831160011461076157600092610756575b50508160011b916000199060031b1c19161781555b60208701518051906001600160401b0382116106755781906104846001850154610faa565b601f8111610703575b50602090601f83116001146106965760009261068b575b50508160011b916000199060031b1c19161760018201555b6002810161ffff60408901511681549063ffff000060608b015160101b169163ffffffff19161717905560808701519687516001600160401b038111610675576105096003840154610faa565b601f811161062e575b506020601f82116001146105925761058296959493928260049360a0937ff9bad9f8a2dccc52fad61273a7fd673335b420319506c19b87df9ce7a19732da9d600092610587575b50508160011b916000199060031b1c19161760038501555b015191015560405195869586611789565b0390a1005b015190508d80610559565b6003840160005260206000209960005b601f19841681106106165750926001837ff9bad9f8a2dccc52fad61273a7fd673335b420319506c19b87df9ce7a19732da9c60a0946105829b9a999897600497601f198116106105fd575b505050811b016003850155610571565b015160001960f88460031b161c191690558d80806105ed565b828201518c556001909b019a602092830192016105a2565b600384016000526020600020601f830160051c81016020841061066e575b601f830160051c82018110610662575050610512565b6000815560010161064c565b508061064c565b634e487b7160e01b600052604160045260246000fd5b0151905089806104a4565b600185016000908152602081209350601f198516905b8181106106eb57509084600195949392106106d2575b505050811b0160018201556104bc565b015160001960f88460031b161c191690558980806106c2565b929360206001819287860151815501950193016106ac565b909150600184016000526020600020601f840160051c81016020851061074f575b90849392915b601f830160051c8201811061074057505061048d565b6000815585945060010161072a565b5080610724565b01519050898061044a565b9250836000526020600020906000935b601f19841685106107b2576001945083601f19811610610799575b505050811b01815561045f565b015160001960f88460031b161c1916905589808061078c565b81810151835560209485019460019093019290910190610771565b909150836000526020600020601f840160051c810160208510610816575b90849392915b601f830160051c82018110610807575050610433565b600081558594506001016107f1565b50806107eb565b634e487b7160e01b600052600060045260246000fd5b61084c915060203d602011610162576101548183610d6e565b886100ec565b346101755761086036610ee0565b60005460405162e32cf960e41b81526001600160a01b039692959394939291602090829060049082908b165afa9687156101695761013a976108ac926000916108b1575016331461120b565b61128d565b6108c9915060203d8111610162576101548183610d6e565b896100ec565b34610175576060366003190112610175576001600160401b0360043581811161017557610900903690600401610dd5565b906024359081116101755761091a60049136908301610dd5565b610922610df3565b5060005460405162e32cf960e41b81526001600160a01b0394909390916020918591829088165afa928315610169576020946109719461096c9260009161097b575016331461120b565b611eab565b6040519015158152f35b6109929150873d8111610162576101548183610d6e565b876100ec565b34610175576102eb6109b26109ac36610e04565b90611079565b9260409694969291925196879687610e8d565b346101755760c0366003190112610175576001600160401b03600435818111610175576109f6903690600401610dd5565b60243582811161017557610a0e903690600401610dd5565b91610a17610df3565b906064359061ffff821682036101755760843590811161017557610a3f903690600401610dd5565b60005460405162e32cf960e41b81526001600160a01b0396929492916020908190839060049082908c165afa9182156101695761013a98600393610a8d92600091610ac8575016331461120b565b60405181810190828252610aa881610110604082018c610e4d565b51902060005252610abf60406000205415156117d2565b60a43594611add565b610adf9150843d8611610162576101548183610d6e565b8b6100ec565b346101755760c0366003190112610175576001600160401b0360043581811161017557610b16903690600401610dd5565b9060243581811161017557610b2f903690600401610dd5565b9160443560643560843560a4359160018060a01b03806000541660405191829162e32cf960e41b835282600460209586935afa801561016957610b7c92600091610ac8575016331461120b565b6040519080820181815282610b94604082018a610e4d565b0392610ba8601f1994858101835282610d6e565b51902060005260038152610bc260406000205415156117d2565b610bd4610bcf8a89611df5565b611a32565b82158015610d2e575b80610d1c575b15610cca576040519860808a019889118a8a1017610675577fb1698011a54e01883d2c39c6bae3ab590c786972a15ded059f893580e41bec4e996003610cb094610ca39b60405289835284830187815260408401908982528c60608601938c8552610c6c6040519182610c608c8201958d87526040830190610e4d565b03908101835282610d6e565b519020600052600587526040600020945185555160018501555160028401555191015560405198899860c08a5260c08a0190610e4d565b9188830390890152610e4d565b9360408601526060850152608084015260a08301520390a1005b6084906040519062461bcd60e51b82526004820152602560248201527f76616c69646974792077696e646f7720656e6473206265666f72652069742073604482015264746172747360d81b6064820152fd5b50841580610be3575083851015610be3565b5085831015610bdd565b60c081019081106001600160401b0382111761067557604052565b602081019081106001600160401b0382111761067557604052565b90601f801991011681019081106001600160401b0382111761067557604052565b9291926001600160401b0382116106755760405191610db8601f8201601f191660200184610d6e565b829481845281830111610175578281602093846000960137010152565b9080601f8301121561017557816020610df093359101610d8f565b90565b6044359061ffff8216820361017557565b906020600319830112610175576004356001600160401b039283821161017557806023830112156101755781600401359384116101755760248483010111610175576024019190565b919082519283825260005b848110610e79575050826000602080949584010152601f8019910116010190565b602081830181015184830182015201610e58565b92610ebc60a09593610eae610eca949a99989a60c0885260c0880190610e4d565b908682036020880152610e4d565b908482036040860152610e4d565b9561ffff80921660608401521660808201520152565b9060a0600319830112610175576001600160401b036004358181116101755783610f0c91600401610dd5565b926024358281116101755781610f2491600401610dd5565b9261ffff92604435848116810361017557936064359081168103610175579260843591821161017557610df091600401610dd5565b600154811015610f94576005906001600052027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf60190600090565b634e487b7160e01b600052603260045260246000fd5b90600182811c92168015610fda575b6020831014610fc457565b634e487b7160e01b600052602260045260246000fd5b91607f1691610fb9565b805460009392610ff382610faa565b91828252602093600191828116908160001461105a5750600114611019575b5050505050565b90939495506000929192528360002092846000945b83861061104657505050500101903880808080611012565b80548587018301529401938590820161102e565b60ff19168685015250505090151560051b010191503880808080611012565b60409182516020908181019082825260608051808884015260005b8181106111da57506000818401830152601f01601f1916820182900387810183526002949392916110c6910182610d6e565b5190206000525282600020541561119e5736906110e292610d8f565b6110eb90611dc0565b916110f583610f59565b509261110081610f59565b509361110b82610f59565b509261111683610f59565b509361ffff600281960154169461112c85610f59565b506002015460101c169361113f90610f59565b50600401549286518060038193019061115791610fe4565b036111629082610d6e565b968651611170818093610fe4565b0361117b9082610d6e565b95518060018193019061118d91610fe4565b036111989082610d6e565b93929190565b505080516111ab81610d53565b600081529181516111bb81610d53565b6000815291516111ca81610d53565b6000815290600090600090600090565b60808101518482018401528501611094565b9081602091031261017557516001600160a01b03811681036101755790565b1561121257565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b1561124f57565b60405162461bcd60e51b815260206004820152601660248201527570617373656420656e6f64652069642065786973747360501b6044820152606490fd5b91949390926040516020810190602082526112af816101106040820188610e4d565b51902060005260036020526112c960406000205415611248565b6112d4600454611764565b806004556040516020810190602082526112f5816101106040820189610e4d565b51902060005260036020526040600020556040519561131387610d38565b83875284602088015261ffff8116604088015261ffff82166060880152826080880152600260a0880152600154600160401b8110156106755780600161135c9201600155610f59565b61081d5787518051906001600160401b03821161067557819061137f8454610faa565b601f8111611714575b50602090601f83116001146116a85760009261169d575b50508160011b916000199060031b1c19161781555b60208801518051906001600160401b0382116106755781906113d96001850154610faa565b601f811161164a575b50602090601f83116001146115d8576000926115cd575b50508160011b916000199060031b1c19161760018201555b6002810161ffff60408a01511681549063ffff000060608c015160101b169163ffffffff19161717905560808801519788516001600160401b0381116106755761145e6003840154610faa565b601f8111611582575b506020601f82116001146114e6576114d696959493928260049360a0937f9394c836a3325586270659f6aa3b9f835abca9afe7fec5abfc69760bb12bce0d9d9e6000926114db5750508160011b916000199060031b1c1916176003850155015191015560405195869586611789565b0390a1565b015190503880610559565b6003840160005260206000209a60005b601f198416811061156a5750926001837f9394c836a3325586270659f6aa3b9f835abca9afe7fec5abfc69760bb12bce0d9c9d60a0946114d69b9a999897600497601f1981161061155157505050811b016003850155610571565b015160001960f88460031b161c191690553880806105ed565b828201518d556001909c019b602092830192016114f6565b600384016000526020600020601f830160051c810191602084106115c3575b601f0160051c01905b8181106115b75750611467565b600081556001016115aa565b90915081906115a1565b0151905038806113f9565b9250600184016000526020600020906000935b601f198416851061162f576001945083601f19811610611616575b505050811b016001820155611411565b015160001960f88460031b161c19169055388080611606565b818101518355602094850194600190930192909101906115eb565b909150600184016000526020600020601f840160051c810160208510611696575b90849392915b601f830160051c820181106116875750506113e2565b60008155859450600101611671565b508061166b565b01519050388061139f565b9250836000526020600020906000935b601f19841685106116f9576001945083601f198116106116e0575b505050811b0181556113b4565b015160001960f88460031b161c191690553880806116d3565b818101518355602094850194600190930192909101906116b8565b909150836000526020600020601f840160051c81016020851061175d575b90849392915b601f830160051c8201811061174e575050611388565b60008155859450600101611738565b5080611732565b60001981146117735760010190565b634e487b7160e01b600052601160045260246000fd5b916117b3906117a5610df097959360a0865260a0860190610e4d565b908482036020860152610e4d565b9361ffff80921660408401521660608201526080818403910152610e4d565b156117d957565b60405162461bcd60e51b815260206004820152601e60248201527f70617373656420656e6f646520696420646f6573206e6f7420657869737400006044820152606490fd5b90929361182b9082611df5565b156119d757600161183b82611e5e565b036119925761184990611dc0565b9061185382610f59565b506040908151908161187360208201926020845260018684019101610fe4565b0391611887601f1993848101835282610d6e565b5190209082516118a660208201926020845282610c608782018b610e4d565b51902014801590611974575b8015611951575b611012576003611924946114d6937f9394c836a3325586270659f6aa3b9f835abca9afe7fec5abfc69760bb12bce0d97600260046118f689610f59565b50015561193261190f61190889610f59565b5098610f59565b50938651998a9960a08b5260a08b0190610fe4565b9089820360208b0152610e4d565b9461ffff80931690880152166060860152848303608086015201610fe4565b50600261195d84610f59565b5061ffff918291015460101c1690861614156118b9565b50600261198084610f59565b50015461ffff838116911614156118b2565b60405162461bcd60e51b815260206004820152601c60248201527f6e6f7468696e672070656e64696e6720666f7220617070726f76616c000000006044820152606490fd5b60405162461bcd60e51b815260206004820152602d60248201527f656e6f646520696420646f6573206e6f742062656c6f6e6720746f207468652060448201526c1c185cdcd959081bdc99c81a59609a1b6064820152608490fd5b15611a3957565b60405162461bcd60e51b815260206004820152602a60248201527f656e6f646520696420646f6573206e6f742062656c6f6e6720746f2074686520604482015269706173736564206f726760b01b6064820152608490fd5b15611a9857565b60405162461bcd60e51b815260206004820152601d60248201527f6f7065726174696f6e2063616e6e6f7420626520706572666f726d65640000006044820152606490fd5b9291909394611aef610bcf8786611df5565b6001811490818015611db6575b8015611dac575b8015611da2575b8015611d98575b15611d4457611b1f85611dc0565b90611b2982610f59565b50926040938885519182611b4b60208201926020845260018a84019101610fe4565b0392611b5f601f1994858101835282610d6e565b51902091611b7f87519182610c606020820195602087528b830190610e4d565b51902014801590611d26575b8015611d03575b611cf85715611bf25750916114d6939160036004611be57ff631019be71bc682c59150635d714061185232e98e60de8bdd87bbee239cc5c89a96611be06002611bda8c611e5e565b14611a91565b610f59565b5001555195869586611789565b60028103611c395750916114d6939160026004611be57ffb98f62dea866f0c373574c8523f611d0db1d8f19cc1b95d07a221d36a6a45de9a96611be06003611bda8c611e5e565b60038103611c765750916114d69391600480611be57f25300d4d785e654bc9b7979700cfa0fdc9ace890a46841fecfce661fd2c41a339a96610f59565b600403611cba57916114d6939160056004611be57f72779f66ea90e28bae76fbfe03eaef5ae01699976c7493f93186ab9560ccfaa49a96611be083611bda8c611e5e565b916114d6939160026004611be57f60aac8c36efdaabf125dc9ec2124bde8b3ceafe5c8b4fc8063fc4ac9017eb0be9a96611be06005611bda8c611e5e565b505050505050505050565b506002611d0f84610f59565b5061ffff918291015460101c169087161415611b92565b506002611d3284610f59565b50015461ffff86811691161415611b8b565b60405162461bcd60e51b815260206004820152602660248201527f696e76616c6964206f7065726174696f6e2e2077726f6e6720616374696f6e206044820152651c185cdcd95960d21b6064820152608490fd5b5060058114611b11565b5060048114611b0a565b5060038114611b03565b5060028114611afc565b604051611ddd816101106020820194602086526040830190610e4d565b51902060005260036020526000196040600020540190565b611be0611e0191611dc0565b50906040519182611e216020820192602084526003604084019101610fe4565b0392611e35601f1994858101835282610d6e565b51902091611e576040519182610c606020820195602087526040830190610e4d565b5190201490565b604051602081019060208252611e7b816101106040820186610e4d565b519020600052600360205260406000205415611ea557611e9f611be0600492611dc0565b50015490565b50600090565b60409081519260209182850183815285611ec786820184610e4d565b0395611edb601f1997888101835282610d6e565b51902060005260038352836000205415611f8657611ef890611dc0565b9360026004611f0687610f59565b500154149485611f24575b5050505050611f1f57600090565b600190565b611f789293949550611f3590610f59565b508551611f5e81611f528882019489865260018b84019101610fe4565b03848101835282610d6e565b51902094610c608151948592878401978852830190610e4d565b519020143880808080611f11565b505050505060009056fea264697066735822122096280428e249a01b362eae60cfe7dabe3f3039f805765951c099c56f3455b3d064736f6c63430008150033"
 
 // DeployNodeManager deploys a new Ethereum contract, binding an instance of NodeManager to it.
 func DeployNodeManager(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address) (common.Address, *types.Transaction, *NodeManager, error) {
@@ -321,6 +321,40 @@ func (_NodeManager *NodeManagerCallerSession) GetNodeDetailsFromIndex(_nodeIndex
 	return _NodeManager.Contract.GetNodeDetailsFromIndex(&_NodeManager.CallOpts, _nodeIndex)
 }
 
+// GetNodeValidity is a free data retrieval call binding the contract method 0xb6dface3.
+//
+// Solidity: function getNodeValidity(string _enodeId) constant returns(uint256, uint256, uint256, uint256)
+func (_NodeManager *NodeManagerCaller) GetNodeValidity(opts *bind.CallOpts, _enodeId string) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+		ret1 = new(*big.Int)
+		ret2 = new(*big.Int)
+		ret3 = new(*big.Int)
+	)
+	out := &[]interface{}{
+		ret0,
+		ret1,
+		ret2,
+		ret3,
+	}
+	err := _NodeManager.contract.Call(opts, out, "getNodeValidity", _enodeId)
+	return *ret0, *ret1, *ret2, *ret3, err
+}
+
+// GetNodeValidity is a free data retrieval call binding the contract method 0xb6dface3.
+//
+// Solidity: function getNodeValidity(string _enodeId) constant returns(uint256, uint256, uint256, uint256)
+func (_NodeManager *NodeManagerSession) GetNodeValidity(_enodeId string) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _NodeManager.Contract.GetNodeValidity(&_NodeManager.CallOpts, _enodeId)
+}
+
+// GetNodeValidity is a free data retrieval call binding the contract method 0xb6dface3.
+//
+// Solidity: function getNodeValidity(string _enodeId) constant returns(uint256, uint256, uint256, uint256)
+func (_NodeManager *NodeManagerCallerSession) GetNodeValidity(_enodeId string) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	return _NodeManager.Contract.GetNodeValidity(&_NodeManager.CallOpts, _enodeId)
+}
+
 // GetNumberOfNodes is a free data retrieval call binding the contract method 0xb81c806a.
 //
 // Solidity: function getNumberOfNodes() constant returns(uint256)
@@ -431,6 +465,27 @@ func (_NodeManager *NodeManagerTransactorSession) ApproveNode(_enodeId string, _
 	return _NodeManager.Contract.ApproveNode(&_NodeManager.TransactOpts, _enodeId, _ip, _port, _raftport, _orgId)
 }
 
+// SetNodeValidity is a paid mutator transaction binding the contract method 0x3021183f.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_NodeManager *NodeManagerTransactor) SetNodeValidity(opts *bind.TransactOpts, _enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _NodeManager.contract.Transact(opts, "setNodeValidity", _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetNodeValidity is a paid mutator transaction binding the contract method 0x3021183f.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_NodeManager *NodeManagerSession) SetNodeValidity(_enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetNodeValidity(&_NodeManager.TransactOpts, _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetNodeValidity is a paid mutator transaction binding the contract method 0x3021183f.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_NodeManager *NodeManagerTransactorSession) SetNodeValidity(_enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _NodeManager.Contract.SetNodeValidity(&_NodeManager.TransactOpts, _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
 // UpdateNodeStatus is a paid mutator transaction binding the contract method 0x37d50b27.
 //
 // Solidity: function updateNodeStatus(string _enodeId, string _ip, uint16 _port, uint16 _raftport, string _orgId, uint256 _action) returns()
@@ -1424,3 +1479,143 @@ func (_NodeManager *NodeManagerFilterer) ParseNodeRecoveryInitiated(log types.Lo
 	}
 	return event, nil
 }
+
+// NodeManagerNodeValidityChangedIterator is returned from FilterNodeValidityChanged and is used to iterate over the raw logs and unpacked data for NodeValidityChanged events raised by the NodeManager contract.
+type NodeManagerNodeValidityChangedIterator struct {
+	Event *NodeManagerNodeValidityChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *NodeManagerNodeValidityChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(NodeManagerNodeValidityChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(NodeManagerNodeValidityChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *NodeManagerNodeValidityChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *NodeManagerNodeValidityChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// NodeManagerNodeValidityChanged represents a NodeValidityChanged event raised by the NodeManager contract.
+type NodeManagerNodeValidityChanged struct {
+	EnodeId    string
+	OrgId      string
+	FromBlock  *big.Int
+	UntilBlock *big.Int
+	FromTime   *big.Int
+	UntilTime  *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterNodeValidityChanged is a free log retrieval operation binding the contract event 0xb1698011a54e01883d2c39c6bae3ab590c786972a15ded059f893580e41bec4e.
+//
+// Solidity: event NodeValidityChanged(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime)
+func (_NodeManager *NodeManagerFilterer) FilterNodeValidityChanged(opts *bind.FilterOpts) (*NodeManagerNodeValidityChangedIterator, error) {
+
+	logs, sub, err := _NodeManager.contract.FilterLogs(opts, "NodeValidityChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &NodeManagerNodeValidityChangedIterator{contract: _NodeManager.contract, event: "NodeValidityChanged", logs: logs, sub: sub}, nil
+}
+
+var NodeValidityChangedTopicHash = "0xb1698011a54e01883d2c39c6bae3ab590c786972a15ded059f893580e41bec4e"
+
+// WatchNodeValidityChanged is a free log subscription operation binding the contract event 0xb1698011a54e01883d2c39c6bae3ab590c786972a15ded059f893580e41bec4e.
+//
+// Solidity: event NodeValidityChanged(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime)
+func (_NodeManager *NodeManagerFilterer) WatchNodeValidityChanged(opts *bind.WatchOpts, sink chan<- *NodeManagerNodeValidityChanged) (event.Subscription, error) {
+
+	logs, sub, err := _NodeManager.contract.WatchLogs(opts, "NodeValidityChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(NodeManagerNodeValidityChanged)
+				if err := _NodeManager.contract.UnpackLog(event, "NodeValidityChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseNodeValidityChanged is a log parse operation binding the contract event 0xb1698011a54e01883d2c39c6bae3ab590c786972a15ded059f893580e41bec4e.
+//
+// Solidity: event NodeValidityChanged(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime)
+func (_NodeManager *NodeManagerFilterer) ParseNodeValidityChanged(log types.Log) (*NodeManagerNodeValidityChanged, error) {
+	event := new(NodeManagerNodeValidityChanged)
+	if err := _NodeManager.contract.UnpackLog(event, "NodeValidityChanged", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -28,12 +28,12 @@ var (
 )
 
 // OrgManagerABI is the input ABI used to generate the binding from.
-const OrgManagerABI = "[{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateOrg\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"approveOrgStatusUpdate\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getUltimateParent\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_pOrgId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"checkOrgActive\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgIndex\",\"type\":\"uint256\"}],\"name\":\"getOrgInfo\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getSubOrgIndexes\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256[]\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNumberOfOrgs\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_orgStatus\",\"type\":\"uint256\"}],\"name\":\"checkOrgStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_breadth\",\"type\":\"uint256\"},{\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"setUpOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"approveOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getOrgDetails\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"checkOrgExists\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_level\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"OrgApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_level\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"OrgPendingApproval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_level\",\"type\":\"uint256\"}],\"name\":\"OrgSuspended\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_level\",\"type\":\"uint256\"}],\"name\":\"OrgSuspensionRevoked\",\"type\":\"event\"}]"
+const OrgManagerABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_level\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"OrgApproved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_certificate\",\"type\":\"bytes\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"_fingerprint\",\"type\":\"bytes32\"}],\"name\":\"OrgCAAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"bytes32\",\"name\":\"_fingerprint\",\"type\":\"bytes32\"}],\"name\":\"OrgCARemoved\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_level\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_status\",\"type\":\"uint256\"}],\"name\":\"OrgPendingApproval\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_level\",\"type\":\"uint256\"}],\"name\":\"OrgSuspended\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_porgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_ultParent\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_level\",\"type\":\"uint256\"}],\"name\":\"OrgSuspensionRevoked\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"_certificate\",\"type\":\"bytes\"}],\"name\":\"addOrgCA\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_pOrgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"approveOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"approveOrgStatusUpdate\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"checkOrgActive\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"checkOrgExists\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_orgStatus\",\"type\":\"uint256\"}],\"name\":\"checkOrgStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNumberOfOrgs\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"bytes32\",\"name\":\"_fingerprint\",\"type\":\"bytes32\"}],\"name\":\"getOrgCA\",\"outputs\":[{\"internalType\":\"bytes\",\"name\":\"\",\"type\":\"bytes\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getOrgCAList\",\"outputs\":[{\"internalType\":\"bytes32[]\",\"name\":\"\",\"type\":\"bytes32[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getOrgDetails\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_orgIndex\",\"type\":\"uint256\"}],\"name\":\"getOrgInfo\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getSubOrgIndexes\",\"outputs\":[{\"internalType\":\"uint256[]\",\"name\":\"\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getUltimateParent\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"bytes32\",\"name\":\"_fingerprint\",\"type\":\"bytes32\"}],\"name\":\"removeOrgCA\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_breadth\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"setUpOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateOrg\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 var OrgManagerParsedABI, _ = abi.JSON(strings.NewReader(OrgManagerABI))
 
 // OrgManagerBin is the compiled bytecode used for deploying new contracts.
-var OrgManagerBin = "0x608060405260018054600160a01b60ff021916905560046002819055600355600060065534801561002f57600080fd5b50604051602080613bb58339810180604052602081101561004f57600080fd5b5051600180546001600160a01b0319166001600160a01b03909216919091179055613b368061007f6000396000f3fe608060405234801561001057600080fd5b50600436106100ea5760003560e01c80637755ebdd1161008c578063e302831611610066578063e302831614610787578063f4d6d9f5146107f5578063f9953de514610863578063ffe40d1d146108d1576100ea565b80637755ebdd146106655780638c8642df1461066d5780639e58eb9f14610713576100ea565b80631f953480116100c85780631f953480146102c25780633fd62ae7146103805780635c4f32ee146104385780635e99f6e5146105a7576100ea565b80630cc27493146100ef57806314f775f91461016f578063177c8d8a146101df575b600080fd5b61015d6004803603604081101561010557600080fd5b810190602081018135600160201b81111561011f57600080fd5b82018360208201111561013157600080fd5b803590602001918460018302840111600160201b8311171561015257600080fd5b919350915035610975565b60408051918252519081900360200190f35b6101dd6004803603604081101561018557600080fd5b810190602081018135600160201b81111561019f57600080fd5b8201836020820111156101b157600080fd5b803590602001918460018302840111600160201b831117156101d257600080fd5b919350915035610d07565b005b61024d600480360360208110156101f557600080fd5b810190602081018135600160201b81111561020f57600080fd5b82018360208201111561022157600080fd5b803590602001918460018302840111600160201b8311171561024257600080fd5b509092509050610ef9565b6040805160208082528351818301528351919283929083019185019080838360005b8381101561028757818101518382015260200161026f565b50505050905090810190601f1680156102b45780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b6101dd600480360360408110156102d857600080fd5b810190602081018135600160201b8111156102f257600080fd5b82018360208201111561030457600080fd5b803590602001918460018302840111600160201b8311171561032557600080fd5b919390929091602081019035600160201b81111561034257600080fd5b82018360208201111561035457600080fd5b803590602001918460018302840111600160201b8311171561037557600080fd5b5090925090506110ae565b6104246004803603602081101561039657600080fd5b810190602081018135600160201b8111156103b057600080fd5b8201836020820111156103c257600080fd5b803590602001918460018302840111600160201b831117156103e357600080fd5b91908080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525092955061128c945050505050565b604080519115158252519081900360200190f35b6104556004803603602081101561044e57600080fd5b5035611499565b60405180806020018060200180602001868152602001858152602001848103845289818151815260200191508051906020019080838360005b838110156104a657818101518382015260200161048e565b50505050905090810190601f1680156104d35780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b838110156105065781810151838201526020016104ee565b50505050905090810190601f1680156105335780820380516001836020036101000a031916815260200191505b50848103825287518152875160209182019189019080838360005b8381101561056657818101518382015260200161054e565b50505050905090810190601f1680156105935780820380516001836020036101000a031916815260200191505b509850505050505050505060405180910390f35b610615600480360360208110156105bd57600080fd5b810190602081018135600160201b8111156105d757600080fd5b8201836020820111156105e957600080fd5b803590602001918460018302840111600160201b8311171561060a57600080fd5b50909250905061170e565b60408051602080825283518183015283519192839290830191858101910280838360005b83811015610651578181015183820152602001610639565b505050509050019250505060405180910390f35b61015d61185b565b6104246004803603604081101561068357600080fd5b810190602081018135600160201b81111561069d57600080fd5b8201836020820111156106af57600080fd5b803590602001918460018302840111600160201b831117156106d057600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505091359250611862915050565b6101dd6004803603606081101561072957600080fd5b810190602081018135600160201b81111561074357600080fd5b82018360208201111561075557600080fd5b803590602001918460018302840111600160201b8311171561077657600080fd5b9193509150803590602001356119ba565b6101dd6004803603602081101561079d57600080fd5b810190602081018135600160201b8111156107b757600080fd5b8201836020820111156107c957600080fd5b803590602001918460018302840111600160201b831117156107ea57600080fd5b509092509050611ae6565b6104556004803603602081101561080b57600080fd5b810190602081018135600160201b81111561082557600080fd5b82018360208201111561083757600080fd5b803590602001918460018302840111600160201b8311171561085857600080fd5b509092509050611ef1565b6101dd6004803603602081101561087957600080fd5b810190602081018135600160201b81111561089357600080fd5b8201836020820111156108a557600080fd5b803590602001918460018302840111600160201b831117156108c657600080fd5b50909250905061225a565b610424600480360360208110156108e757600080fd5b810190602081018135600160201b81111561090157600080fd5b82018360208201111561091357600080fd5b803590602001918460018302840111600160201b8311171561093457600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550612407945050505050565b60015460408051600160e41b62e32cf902815290516000926001600160a01b031691630e32cf90916004808301926020929190829003018186803b1580156109bc57600080fd5b505afa1580156109d0573d6000803e3d6000fd5b505050506040513d60208110156109e657600080fd5b50516001600160a01b03163314610a3b5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b83838080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250610a7d92508391506124079050565b1515600114610ace5760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b8260011480610add5750826002145b1515610b1d57604051600160e51b62461bcd028152600401808060200182810382526025815260200180613a646025913960400191505060405180910390fd5b6000610b5e86868080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b9050600481815481101515610b6f57fe5b9060005260206000209060080201600601546001141515610bc457604051600160e51b62461bcd028152600401808060200182810382526027815260200180613a896027913960400191505060405180910390fd5b6000808560011415610bdb57506002905080610bec565b8560021415610bec57506004905060035b610c2d88888080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250869250611862915050565b1515600114610c7057604051600160e51b62461bcd028152600401808060200182810382526027815260200180613ab06027913960400191505060405180910390fd5b8560011415610cbd57610cb888888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061252292505050565b610cfc565b610cfc88888080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506127e492505050565b979650505050505050565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015610d5557600080fd5b505afa158015610d69573d6000803e3d6000fd5b505050506040513d6020811015610d7f57600080fd5b50516001600160a01b03163314610dd45760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b82828080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250610e1692508391506124079050565b1515600114610e675760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b8160011415610eb457610eaf84848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061299192505050565b610ef3565b610ef384848080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250612c4f92505050565b50505050565b60015460408051600160e41b62e32cf902815290516060926001600160a01b031691630e32cf90916004808301926020929190829003018186803b158015610f4057600080fd5b505afa158015610f54573d6000803e3d6000fd5b505050506040513d6020811015610f6a57600080fd5b50516001600160a01b03163314610fbf5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b600461100084848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b8154811061100a57fe5b6000918252602091829020600460089092020101805460408051601f60026000196101006001871615020190941693909304928301859004850281018501909152818152928301828280156110a05780601f10611075576101008083540402835291602001916110a0565b820191906000526020600020905b81548152906001019060200180831161108357829003601f168201915b505050505090505b92915050565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156110fc57600080fd5b505afa158015611110573d6000803e3d6000fd5b505050506040513d602081101561112657600080fd5b50516001600160a01b0316331461117b5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b8383838360405160200180858580828437600160f91b601702920191825250600101838380828437808301925050509450505050506040516020818303038152906040526111c881612407565b1561120d5760408051600160e51b62461bcd02815260206004820152600a6024820152600160b01b696f72672065786973747302604482015290519081900360640190fd5b61128585858080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525050604080516020601f8901819004810282018101909252878152925087915086908190840183828082843760009201919091525060029250829150612d0e9050565b5050505050565b600060056000836040516020018082805190602001908083835b602083106112c55780518252601f1990920191602091820191016112a6565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120815260200190815260200160002054600014151561149057600061132383612495565b905060048181548110151561133457fe5b906000526020600020906008020160010154600214806113735750600480548290811061135d57fe5b9060005260206000209060080201600101546003145b1561148e57600061142c60048381548110151561138c57fe5b6000918252602091829020600460089092020101805460408051601f60026000196101006001871615020190941693909304928301859004850281018501909152818152928301828280156114225780601f106113f757610100808354040283529160200191611422565b820191906000526020600020905b81548152906001019060200180831161140557829003601f168201915b5050505050612495565b905060048181548110151561143d57fe5b9060005260206000209060080201600101546002148061147c5750600480548290811061146657fe5b9060005260206000209060080201600101546003145b1561148c57600192505050611494565b505b505b5060005b919050565b60608060606000806004868154811015156114b057fe5b90600052602060002090600802016000016004878154811015156114d057fe5b90600052602060002090600802016002016004888154811015156114f057fe5b906000526020600020906008020160040160048981548110151561151057fe5b90600052602060002090600802016006015460048a81548110151561153157fe5b906000526020600020906008020160010154848054600181600116156101000203166002900480601f0160208091040260200160405190810160405280929190818152602001828054600181600116156101000203166002900480156115d85780601f106115ad576101008083540402835291602001916115d8565b820191906000526020600020905b8154815290600101906020018083116115bb57829003601f168201915b5050875460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959a50899450925084019050828280156116665780601f1061163b57610100808354040283529160200191611666565b820191906000526020600020905b81548152906001019060200180831161164957829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959950889450925084019050828280156116f45780601f106116c9576101008083540402835291602001916116f4565b820191906000526020600020905b8154815290600101906020018083116116d757829003601f168201915b505050505092509450945094509450945091939590929450565b606061174f83838080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061240792505050565b15156001146117a05760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b60006117e184848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b90506004818154811015156117f257fe5b906000526020600020906008020160070180548060200260200160405190810160405280929190818152602001828054801561184d57602002820191906000526020600020905b815481526020019060010190808311611839575b505050505091505092915050565b6004545b90565b600060056000846040516020018082805190602001908083835b6020831061189b5780518252601f19909201916020918201910161187c565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120815260200190815260200160002054600014156118f5575060006110a8565b600061190084612495565b905060056000856040516020018082805190602001908083835b602083106119395780518252601f19909201916020918201910161191a565b6001836020036101000a038019825116818451168082178552505050505050905001915050604051602081830303815290604052805190602001208152602001908152602001600020546000141580156119b257508260048281548110151561199e57fe5b906000526020600020906008020160010154145b949350505050565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611a0857600080fd5b505afa158015611a1c573d6000803e3d6000fd5b505050506040513d6020811015611a3257600080fd5b50516001600160a01b03163314611a875760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b611adc6040518060200160405280600081525085858080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506001925060029150612d0e9050565b6002556003555050565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015611b3457600080fd5b505afa158015611b48573d6000803e3d6000fd5b505050506040513d6020811015611b5e57600080fd5b50516001600160a01b03163314611bb35760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b611bf582828080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525060019250611862915050565b1515600114611c465760408051600160e51b62461bcd0281526020600482015260126024820152600160701b716e6f7468696e6720746f20617070726f766502604482015290519081900360640190fd5b6000611c8783838080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b90506002600482815481101515611c9a57fe5b9060005260206000209060080201600101819055507fd705723a50859c9cc1d3953e10b8b9478820e7a62927ad3215897ed87b20591c600482815481101515611cdf57fe5b9060005260206000209060080201600001600483815481101515611cff57fe5b9060005260206000209060080201600201600484815481101515611d1f57fe5b9060005260206000209060080201600401600485815481101515611d3f57fe5b906000526020600020906008020160060154600260405180806020018060200180602001868152602001858152602001848103845289818154600181600116156101000203166002900481526020019150805460018160011615610100020316600290048015611df05780601f10611dc557610100808354040283529160200191611df0565b820191906000526020600020905b815481529060010190602001808311611dd357829003601f168201915b5050848103835288546002600019610100600184161502019091160480825260209091019089908015611e645780601f10611e3957610100808354040283529160200191611e64565b820191906000526020600020905b815481529060010190602001808311611e4757829003601f168201915b5050848103825287546002600019610100600184161502019091160480825260209091019088908015611ed85780601f10611ead57610100808354040283529160200191611ed8565b820191906000526020600020905b815481529060010190602001808311611ebb57829003601f168201915b50509850505050505050505060405180910390a1505050565b6060806060600080611f3887878080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061240792505050565b1515611fa757868660008083838080601f016020809104026020016040519081016040528093929190818152602001838380828437600092018290525060408051602080820183528382528251908101909252918152949d509b50929950939750919550612250945050505050565b6000611fe888888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061249592505050565b9050600481815481101515611ff957fe5b906000526020600020906008020160000160048281548110151561201957fe5b906000526020600020906008020160020160048381548110151561203957fe5b906000526020600020906008020160040160048481548110151561205957fe5b90600052602060002090600802016006015460048581548110151561207a57fe5b906000526020600020906008020160010154848054600181600116156101000203166002900480601f0160208091040260200160405190810160405280929190818152602001828054600181600116156101000203166002900480156121215780601f106120f657610100808354040283529160200191612121565b820191906000526020600020905b81548152906001019060200180831161210457829003601f168201915b5050875460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959a50899450925084019050828280156121af5780601f10612184576101008083540402835291602001916121af565b820191906000526020600020905b81548152906001019060200180831161219257829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f81018490048402820184019092528181529599508894509250840190508282801561223d5780601f106122125761010080835404028352916020019161223d565b820191906000526020600020905b81548152906001019060200180831161222057829003601f168201915b5050505050925095509550955095509550505b9295509295909350565b600160009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156122a857600080fd5b505afa1580156122bc573d6000803e3d6000fd5b505050506040513d60208110156122d257600080fd5b50516001600160a01b031633146123275760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b81818080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061236992508391506124079050565b156123ae5760408051600160e51b62461bcd02815260206004820152600a6024820152600160b01b696f72672065786973747302604482015290519081900360640190fd5b6124026040518060200160405280600081525084848080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525060019250829150612d0e9050565b505050565b600060056000836040516020018082805190602001908083835b602083106124405780518252601f199092019160209182019101612421565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120815260200190815260200160002054600014159050919050565b6000600160056000846040516020018082805190602001908083835b602083106124d05780518252601f1990920191602091820191016124b1565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120815260200190815260200160002054039050919050565b61252d816002611862565b151560011461257057604051600160e51b62461bcd028152600401808060200182810382526034815260200180613ad76034913960400191505060405180910390fd5b600061257b82612495565b9050600360048281548110151561258e57fe5b9060005260206000209060080201600101819055507f0e8b7be64e0c730234ba2cd252b227fb481d7a247ba806d1941144c535bf054b6004828154811015156125d357fe5b90600052602060002090600802016000016004838154811015156125f357fe5b906000526020600020906008020160020160048481548110151561261357fe5b906000526020600020906008020160040160048581548110151561263357fe5b9060005260206000209060080201600601546003604051808060200180602001806020018681526020018581526020018481038452898181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156126e45780601f106126b9576101008083540402835291602001916126e4565b820191906000526020600020905b8154815290600101906020018083116126c757829003601f168201915b50508481038352885460026000196101006001841615020190911604808252602090910190899080156127585780601f1061272d57610100808354040283529160200191612758565b820191906000526020600020905b81548152906001019060200180831161273b57829003601f168201915b50508481038252875460026000196101006001841615020190911604808252602090910190889080156127cc5780601f106127a1576101008083540402835291602001916127cc565b820191906000526020600020905b8154815290600101906020018083116127af57829003601f168201915b50509850505050505050505060405180910390a15050565b6127ef816004611862565b15156001146128485760408051600160e51b62461bcd02815260206004820152601a60248201527f6f7267206e6f7420696e2073757370656e646564207374617465000000000000604482015290519081900360640190fd5b600061285382612495565b9050600560048281548110151561286657fe5b9060005260206000209060080201600101819055507f0e8b7be64e0c730234ba2cd252b227fb481d7a247ba806d1941144c535bf054b6004828154811015156128ab57fe5b90600052602060002090600802016000016004838154811015156128cb57fe5b90600052602060002090600802016002016004848154811015156128eb57fe5b906000526020600020906008020160040160048581548110151561290b57fe5b9060005260206000209060080201600601546005604051808060200180602001806020018681526020018581526020018481038452898181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156126e45780601f106126b9576101008083540402835291602001916126e4565b61299c816003611862565b15156001146129ed5760408051600160e51b62461bcd0281526020600482015260126024820152600160701b716e6f7468696e6720746f20617070726f766502604482015290519081900360640190fd5b60006129f882612495565b905060048082815481101515612a0a57fe5b9060005260206000209060080201600101819055507f73ccf8d6c8385bf5347269bd59712da33183c1a5e1702494bcdb87d0f4674d96600482815481101515612a4f57fe5b9060005260206000209060080201600001600483815481101515612a6f57fe5b9060005260206000209060080201600201600484815481101515612a8f57fe5b9060005260206000209060080201600401600485815481101515612aaf57fe5b600091825260209182902060066008909202010154604080516060810183905260808082528754600260001961010060018416150201909116049082018190529293909283929183019183019060a084019089908015612b505780601f10612b2557610100808354040283529160200191612b50565b820191906000526020600020905b815481529060010190602001808311612b3357829003601f168201915b5050848103835287546002600019610100600184161502019091160480825260209091019088908015612bc45780601f10612b9957610100808354040283529160200191612bc4565b820191906000526020600020905b815481529060010190602001808311612ba757829003601f168201915b5050848103825286546002600019610100600184161502019091160480825260209091019087908015612c385780601f10612c0d57610100808354040283529160200191612c38565b820191906000526020600020905b815481529060010190602001808311612c1b57829003601f168201915b505097505050505050505060405180910390a15050565b612c5a816005611862565b1515600114612cab5760408051600160e51b62461bcd0281526020600482015260126024820152600160701b716e6f7468696e6720746f20617070726f766502604482015290519081900360640190fd5b6000612cb682612495565b90506002600482815481101515612cc957fe5b9060005260206000209060080201600101819055507f882f030c609566cd82918a97d457fd48f9cfcefd11282e2654cde3f94579c15f600482815481101515612a4f57fe5b600080806001851415612d9057856040516020018082805190602001908083835b60208310612d4e5780518252601f199092019160209182019101612d2f565b6001836020036101000a038019825116818451168082178552505050505050905001915050604051602081830303815290604052805190602001209150612ecf565b866040516020018082805190602001908083835b60208310612dc35780518252601f199092019160209182019101612da4565b6001836020036101000a03801982511681845116808217855250505050505090500191505060405160208183030381529060405280519060200120925086866040516020018083805190602001908083835b60208310612e345780518252601f199092019160209182019101612e15565b6001836020036101000a03801982511681845116808217855250505050505090500180600160f91b60170281525060010182805190602001908083835b60208310612e905780518252601f199092019160209182019101612e71565b6001836020036101000a038019825116818451168082178552505050505050905001925050506040516020818303038152906040528051906020012091505b600680546001908101918290556000848152600560205260408120929092556004805491612eff9190830161382a565b90508560011415612fc45785600482815481101515612f1a57fe5b9060005260206000209060080201600601819055506000600482815481101515612f4057fe5b90600052602060002090600802016005018190555086600482815481101515612f6557fe5b90600052602060002090600802016003019080519060200190612f89929190613856565b5086600482815481101515612f9a57fe5b90600052602060002090600802016004019080519060200190612fbe929190613856565b50613308565b600084815260056020526040902054600354600480546000199093019450909184908110612fee57fe5b6000918252602090912060076008909202010154106130575760408051600160e51b62461bcd02815260206004820152601660248201527f62726561647468206c6576656c20657863656564656400000000000000000000604482015290519081900360640190fd5b600254600480548490811061306857fe5b9060005260206000209060080201600601541015156130d15760408051600160e51b62461bcd02815260206004820152601460248201527f6465707468206c6576656c206578636565646564000000000000000000000000604482015290519081900360640190fd5b60048054839081106130df57fe5b90600052602060002090600802016006015460010160048281548110151561310357fe5b9060005260206000209060080201600601819055508160048281548110151561312857fe5b6000918252602090912060056008909202010155600480548390811061314a57fe5b906000526020600020906008020160040160048281548110151561316a57fe5b9060005260206000209060080201600401908054600181600116156101000203166002900461319a9291906138d4565b5060006004838154811015156131ac57fe5b906000526020600020906008020160070180548091906001016131cf9190613949565b9050816004848154811015156131e157fe5b9060005260206000209060080201600701828154811015156131ff57fe5b906000526020600020018190555088886040516020018083805190602001908083835b602083106132415780518252601f199092019160209182019101613222565b6001836020036101000a03801982511681845116808217855250505050505090500180600160f91b60170281525060010182805190602001908083835b6020831061329d5780518252601f19909201916020918201910161327e565b6001836020036101000a038019825116818451168082178552505050505050905001925050506040516020818303038152906040526004838154811015156132e157fe5b90600052602060002090600802016003019080519060200190613305929190613856565b50505b8660048281548110151561331857fe5b9060005260206000209060080201600001908051906020019061333c929190613856565b508760048281548110151561334d57fe5b90600052602060002090600802016002019080519060200190613371929190613856565b508460048281548110151561338257fe5b90600052602060002090600802016001018190555084600114156135e2577f0e8b7be64e0c730234ba2cd252b227fb481d7a247ba806d1941144c535bf054b6004828154811015156133d057fe5b90600052602060002090600802016000016004838154811015156133f057fe5b906000526020600020906008020160020160048481548110151561341057fe5b906000526020600020906008020160040160048581548110151561343057fe5b9060005260206000209060080201600601546001604051808060200180602001806020018681526020018581526020018481038452898181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156134e15780601f106134b6576101008083540402835291602001916134e1565b820191906000526020600020905b8154815290600101906020018083116134c457829003601f168201915b50508481038352885460026000196101006001841615020190911604808252602090910190899080156135555780601f1061352a57610100808354040283529160200191613555565b820191906000526020600020905b81548152906001019060200180831161353857829003601f168201915b50508481038252875460026000196101006001841615020190911604808252602090910190889080156135c95780601f1061359e576101008083540402835291602001916135c9565b820191906000526020600020905b8154815290600101906020018083116135ac57829003601f168201915b50509850505050505050505060405180910390a1613820565b7fd705723a50859c9cc1d3953e10b8b9478820e7a62927ad3215897ed87b20591c60048281548110151561361257fe5b906000526020600020906008020160000160048381548110151561363257fe5b906000526020600020906008020160020160048481548110151561365257fe5b906000526020600020906008020160040160048581548110151561367257fe5b9060005260206000209060080201600601546002604051808060200180602001806020018681526020018581526020018481038452898181546001816001161561010002031660029004815260200191508054600181600116156101000203166002900480156137235780601f106136f857610100808354040283529160200191613723565b820191906000526020600020905b81548152906001019060200180831161370657829003601f168201915b50508481038352885460026000196101006001841615020190911604808252602090910190899080156137975780601f1061376c57610100808354040283529160200191613797565b820191906000526020600020905b81548152906001019060200180831161377a57829003601f168201915b505084810382528754600260001961010060018416150201909116048082526020909101908890801561380b5780601f106137e05761010080835404028352916020019161380b565b820191906000526020600020905b8154815290600101906020018083116137ee57829003601f168201915b50509850505050505050505060405180910390a15b5050505050505050565b81548183558181111561240257600802816008028360005260206000209182019101612402919061396d565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061389757805160ff19168380011785556138c4565b828001600101855582156138c4579182015b828111156138c45782518255916020019190600101906138a9565b506138d09291506139e4565b5090565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061390d57805485556138c4565b828001600101855582156138c457600052602060002091601f016020900482015b828111156138c457825482559160010191906001019061392e565b815481835581811115612402576000838152602090206124029181019083016139e4565b61185f91905b808211156138d057600061398782826139fe565b600182016000905560028201600061399f91906139fe565b6139ad6003830160006139fe565b6139bb6004830160006139fe565b600582016000905560068201600090556007820160006139db9190613a45565b50600801613973565b61185f91905b808211156138d057600081556001016139ea565b50805460018160011615610100020316600290046000825580601f10613a245750613a42565b601f016020900490600052602060002090810190613a4291906139e4565b50565b5080546000825590600052602060002090810190613a4291906139e456fe696e76616c696420616374696f6e2e206f7065726174696f6e206e6f7420616c6c6f7765646e6f742061206d6173746572206f72672e206f7065726174696f6e206e6f7420616c6c6f7765646f72672073746174757320646f6573206e6f7420616c6c6f7720746865206f7065726174696f6e6f7267206e6f7420696e20617070726f766564207374617475732e206f7065726174696f6e2063616e6e6f7420626520646f6e65a165627a7a72305820e20472358f6cc444b5476d1b471677de46e77b7421a1bcae4549f346a29e10870029"
+var OrgManagerBin = "0x60803461008157601f62002db238819003918201601f19168301916001600160401b038311848410176100865780849260209460405283398101031261008157516001600160a01b038116908190036100815760015460046002556004600355600060065560018060a81b03191617600155604051612cfc9081620000b68239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe608080604052600436101561001357600080fd5b60003560e01c9081630cc2749314611d495750806314f775f914611bfe57806315f547f81461192a578063177c8d8a146118935780631f953480146112ec5780633798b6a41461126d5780633fd62ae7146112335780633ff280d71461114b5780635c4f32ee1461109a5780635e99f6e514610fc25780637755ebdd14610fa45780638c8642df14610f665780639e58eb9f14610ac1578063bcbdd7e6146107d9578063e3028316146106af578063f4d6d9f51461065f578063f9953de51461012e5763ffe40d1d146100e557600080fd5b34610129576020366003190112610129576004356001600160401b0381116101295761011f61011a602092369060040161229f565b612b02565b6040519015158152f35b600080fd5b3461012957602080600319360112610129576001600160401b036004358181116101295761016090369060040161212c565b60018060a01b03600192600486838654166040519283809262e32cf960e41b82525afa928315610653576101d2936101a392600091610626575b50163314612323565b6101ba6101b461011a368685612259565b15612360565b604051926101c78461221d565b600084523691612259565b92604051858101906101fb878288516101ee8187858d016121d5565b8101038084520182612238565b51902061020960065461260f565b90816006556000526005865260406000205560045493600160401b8510156104e15783850160045561023a856123da565b5050836006610248876123da565b50015560006005610258876123da565b5001556003610266866123da565b500181518381116104e1576102858161027f845461246b565b8461258f565b87601f82116001146105c55790806102b392600091610559575b508160011b916000199060031b1c19161790565b90555b60046102c1866123da565b500181518381116104e1576102da8161027f845461246b565b87601f82116001146105645790806103079260009161055957508160011b916000199060031b1c19161790565b90555b610313856123da565b50908051908382116104e1576103338261032d855461246b565b8561258f565b8790601f83116001146104f75761036392916000918361045c575b50508160011b916000199060031b1c19161790565b90555b6002610371856123da565b50019180519182116104e15761038b8261032d855461246b565b8590601f8311600114610467578261042f969593600080516020612c878339815191529895936103d19360009261045c5750508160011b916000199060031b1c19161790565b90555b80806103df856123da565b50015561044c6103ee846123da565b5093600461043d6103fe836123da565b509560026006610417610410876123da565b50966123da565b500154976040519a8b9a60a08c5260a08c01906124a5565b928a8403908b0152016124a5565b918683036040880152016124a5565b91606084015260808301520390a1005b01519050898061034e565b90601f1983169184600052876000209260005b8181106104cc5750928694919261042f98979383600080516020612c878339815191529b9896106104b3575b505050811b0190556103d4565b015160001960f88460031b161c191690558880806104a6565b8284015185559387019392890192890161047a565b634e487b7160e01b600052604160045260246000fd5b869291601f19831691856000528a6000209260005b8c828210610543575050841161052a575b505050811b019055610366565b015160001960f88460031b161c1916905588808061051d565b8385015186558b9790950194938401930161050c565b90508401518a61029f565b908691601f19821690846000528a6000209160005b8c8282106105af5750508311610596575b5050811b01905561030a565b85015160001960f88460031b161c19169055898061058a565b8389015185558b96909401939283019201610579565b908691601f19821690846000528a6000209160005b8c82821061061057505083116105f7575b5050811b0190556102b6565b85015160001960f88460031b161c1916905589806105eb565b8389015185558b969094019392830192016105da565b6106469150893d8b1161064c575b61063e8183612238565b810190612304565b8961019a565b503d610634565b6040513d6000823e3d90fd5b34610129576020366003190112610129576004356001600160401b0381116101295761069b6106956106ab92369060040161212c565b9061261e565b91604095939551958695866122bd565b0390f35b34610129576020366003190112610129576004356001600160401b038111610129576106e06004913690830161212c565b60015460405162e32cf960e41b8152919391926001600160a01b039290916020918591829086165afa90811561065357600080516020612ca78339815191529461073e61076a94610765946004976000916107c15750163314612323565b61075e6001610756610751368587612259565b612917565b15151461242a565b3691612259565b612c51565b60026001610777836123da565b5001556107bc610786826123da565b50610790836123da565b5060066107a661079f866123da565b50956123da565b500154916002604051968796019201908561253a565b0390a1005b610646915060203d811161064c5761063e8183612238565b34610129576107e736612159565b6001805460405162e32cf960e41b81529194939260209290916001600160a01b039084908390600490829085165afa80156106535761083092600091610aa45750163314612323565b61084a8561084261011a368789612259565b151514612399565b604051828101908486833761086e8482878101600083820152038084520182612238565b5190208060005260088352604060002082600052835261089260406000205461246b565b15610a53578060005260088352604060002082600052835260406000206108b9815461246b565b9081610a17575b505060005260078252604060002094806000905b61091e575b50507f430f4f6ffc6412b9e640d470ceee4c72c008edb5c9d42205305122025b0e251494506109156040519485946040865260408601916125ee565b918301520390a1005b865480821015610a115783610933838a6125d6565b919054600392831b1c1461095257505061094c9061260f565b816108d4565b92509690600019978881019081116109fb5761098161097461099892856125d6565b905490861b1c92846125d6565b819391549060031b91821b91600019901b19161790565b905580549687156109e557807f430f4f6ffc6412b9e640d470ceee4c72c008edb5c9d42205305122025b0e25149801926109d284846125d6565b81939154921b1b191690555585806108d9565b634e487b7160e01b600052603160045260246000fd5b634e487b7160e01b600052601160045260246000fd5b506108d9565b81601f60009311600114610a2f5750555b86806108c0565b908083918252610a4c601f888420940160051c84018b8501612578565b5555610a28565b60405162461bcd60e51b8152600481018490526024808201527f636572746966696361746520617574686f7269747920646f6573206e6f7420656044820152631e1a5cdd60e21b6064820152608490fd5b610abb9150853d871161064c5761063e8183612238565b8861019a565b34610129576060366003190112610129576001600160401b0360043581811161012957610af290369060040161212c565b6001805460405162e32cf960e41b8152602494602094926001600160a01b03929086908290600490829087165afa92831561065357610b3f936101ba92600091610f495750163314612323565b9460405184810190610b5d8682816101ee8c878151938492016121d5565b519020610b6b60065461260f565b90816006556000526005855260406000205560045493600160401b851015610f3457838501600455610b9c856123da565b5050836006610baa876123da565b50015560006005610bba876123da565b500155610bc6856123da565b509660038098018151848111610e5457610be48161027f845461246b565b83601f8211600114610ed4579080610c1192600091610e6957508160011b916000199060031b1c19161790565b90555b6004610c1f876123da565b50018151848111610e5457610c388161027f845461246b565b83601f8211600114610e74579080610c6592600091610e6957508160011b916000199060031b1c19161790565b90555b610c71866123da565b5090805190848211610e5457610c8b8261032d855461246b565b8390601f8311600114610df357610cba929160009183610d575750508160011b916000199060031b1c19161790565b90555b6002610cc8866123da565b5001928051928311610dde57610ce883610ce2865461246b565b8661258f565b81601f8411600114610d62575092610d2e8360029460049794600080516020612ca78339815191529997600092610d575750508160011b916000199060031b1c19161790565b90555b610d3a836123da565b500155610d49610786826123da565b0390a1604435600255359055005b015190508b8061034e565b9190601f1984168560005283600020936000905b828210610dc7575050938693600080516020612ca7833981519152989693600498938360029810610daf575b505050811b019055610d31565b0151600019838d1b60f8161c191690558a8080610da2565b808986978294978701518155019601940190610d76565b86634e487b7160e01b60005260416004526000fd5b879291601f1983169185600052866000209260005b88828210610e3e5750508411610e26575b505050811b019055610cbd565b0151600019838d1b60f8161c191690558a8080610e19565b8385015186558c97909501949384019301610e08565b88634e487b7160e01b60005260416004526000fd5b90508401518c61029f565b908791601f1982169084600052866000209160005b88828210610ebe5750508311610ea6575b5050811b019055610c68565b850151600019838e1b60f8161c191690558b80610e9a565b8389015185558c96909401939283019201610e89565b908791601f1982169084600052866000209160005b88828210610f1e5750508311610f06575b5050811b019055610c14565b850151600019838e1b60f8161c191690558b80610efa565b8389015185558c96909401939283019201610ee9565b85634e487b7160e01b60005260416004526000fd5b610f609150883d8a1161064c5761063e8183612238565b8a61019a565b34610129576040366003190112610129576004356001600160401b0381116101295761011f610f9b602092369060040161229f565b6024359061299a565b34610129576000366003190112610129576020600454604051908152f35b346101295760208060031936011261012957600435906001600160401b03821161012957600761101b611016610fff61076595369060040161212c565b959060019661075e8861084261011a368587612259565b6123da565b50019160405191828185549182815201908195600052826000209060005b818110611087575050508361104f910384612238565b60405192818401908285525180915260408401949160005b8281106110745785870386f35b8351875295810195928101928401611067565b8254845292840192918501918501611039565b3461012957602036600319011261012957600261110a6106ab6004356110bf816123da565b5090600461113f6110cf836123da565b506111286110dc856123da565b509161111160016110fa60066110f18a6123da565b500154986123da565b500154976040519a8b80926124a5565b038a612238565b611121604051809b8193016124a5565b0389612238565b61113860405180948193016124a5565b0382612238565b604051958695866122bd565b3461012957602080600319360112610129576004356001600160401b0381116101295761117c90369060040161212c565b61119f836040518381948383019687378101600083820152038084520182612238565b51902060005260078152604060002060405190818382549182815201908192600052846000209060005b8682821061121f5786866111df82880383612238565b604051928392818401908285525180915260408401929160005b82811061120857505050500390f35b8351855286955093810193928101926001016111f9565b8354855290930192600192830192016111c9565b34610129576020366003190112610129576004356001600160401b0381116101295761011f611268602092369060040161229f565b612a28565b34610129576112d16112a861128136612159565b91906040519384916020958187928383019687378101600083820152038084520182612238565b5190206000526008825260406000209060005281526112d86040600020604051938480926124a5565b0383612238565b6106ab6040519282849384528301906121f8565b346101295760046112fc3661218b565b60015460405162e32cf960e41b815291959193926001600160a01b039290916020918691829086165afa80156106535760209561134c6113979461138f938a986000916118765750163314612323565b6040519480828988013761075e6101b48288019761011a601760f91b998a8d8201528260219e8f92818c8583013701600083820152036001810184520182612238565b933691612259565b93604051908482018451926113b68782818901966101ee81878a6121d5565b51902091604051868101906113fe84828b8a516113d481888a6121d5565b82018a8d8201526113ef82519d8e93019d8e868401906121d5565b01036001810184520182612238565b51902061140c60065461260f565b9081600655600052600560205260406000205560045495600160401b93848810156104e15760018801600455611441886123da565b5050600052600560205260406000205460001981019081116109fb576007611468826123da565b500154600354111561183857600661147f826123da565b50015460025411156117fc576006611496826123da565b500154600181018091116109fb576007916114ec9160066114b68c6123da565b5001558060056114c58c6123da565b5001556110168a6004806114e26114db866123da565b50936123da565b5092019101612b74565b50018054948510156104e15761150f610981868a936001611540990181556125d6565b90556040519485926115288851809260208701906121d5565b83019060208201526113ef89518093868401906121d5565b611549836123da565b50908051906001600160401b0382116104e1576115768261156d600386015461246b565b6003860161258f565b602090601f831160011461178f5791806115a9926003946000926117845750508160011b916000199060031b1c19161790565b9101555b6115b6826123da565b5083516001600160401b0381116104e1576115d58161027f845461246b565b6020601f821160011461171b578190611607939495966000926117105750508160011b916000199060031b1c19161790565b90555b6002611615836123da565b5001908051906001600160401b0382116104e1576116378261032d855461246b565b602090601f83116001146116955782600080516020612ca783398151915295936004959361167b9360009261168a5750508160011b916000199060031b1c19161790565b905560026001610777836123da565b01519050878061034e565b90601f198316918460005260206000209260005b8181106116f85750926001928592600080516020612ca7833981519152989660049896106116df575b505050811b01905561076a565b015160001960f88460031b161c191690558680806116d2565b929360206001819287860151815501950193016116a9565b01519050868061034e565b8260005260206000209060005b601f198416811061176c575060019394959683601f19811610611753575b505050811b01905561160a565b015160001960f88460031b161c19169055858080611746565b9091602060018192858b015181550193019101611728565b01519050888061034e565b90601f198316916003850160005260206000209260005b8181106117e4575091600193918560039694106117cc575b505050811b019101556115ad565b015160001983861b60f8161c191690558780806117be565b929360206001819287860151815501950193016117a6565b60405162461bcd60e51b815260206004820152601460248201527319195c1d1a081b195d995b08195e18d95959195960621b6044820152606490fd5b60405162461bcd60e51b8152602060048201526016602482015275189c9958591d1a081b195d995b08195e18d95959195960521b6044820152606490fd5b61188d91508a3d8c1161064c5761063e8183612238565b8b61019a565b3461012957602080600319360112610129576004356001600160401b038111610129576118c490369060040161212c565b60015460405162e32cf960e41b8152929392906001600160a01b039084908390600490829085165afa8015610653576004956112d89461075e6110169461076594611919976000916118765750163314612323565b506112d160405180958193016124a5565b34610129576119383661218b565b6001805460405162e32cf960e41b8152939594936020916001600160a01b039083908390600490829085165afa80156106535761197f92600091611be75750163314612323565b6119918261084261011a36888a612259565b8215611bac5760405181810190858783376119bb8382888101600083820152038084520182612238565b51902092816000604051838a823780848101838152039060025afa15610653576000519380600052600883526040600020856000528352611a0060406000205461246b565b611b67578060005260088352604060002085600052835260406000206001600160401b0383116104e157611a3e83611a38835461246b565b8361258f565b600083601f8111600114611afd5780611a6c92600091611af257508160011b916000199060031b1c19161790565b90555b600052600782526040600020968754600160401b8110156104e157611ac1610981827ffa7aa7e2af6ff2834c0327546dcb6aa1952aef24ce4168d5b24eaf265c9499049b611ae7988a950181556125d6565b9055611ada6040519788976060895260608901916125ee565b92868403908701526125ee565b9060408301520390a1005b90508b01358c61029f565b50601f19841690826000528486600020928c888a6000925b848410611b4e575050505010611b34575b50508483811b019055611a6f565b8a0135600019600386901b60f8161c191690558980611b26565b8601358755909501949384019388935001888a8f611b15565b60405162461bcd60e51b815260048101849052601c60248201527f636572746966696361746520617574686f7269747920657869737473000000006044820152606490fd5b6064906040519062461bcd60e51b825260048201526014602482015273636572746966696361746520697320656d70747960601b6044820152fd5b6106469150843d861161064c5761063e8183612238565b3461012957611c0c36612159565b919060018060a01b0360019360046020838754166040519283809262e32cf960e41b82525afa928315610653578693611c4f92600091611d315750163314612323565b611c618261084261011a368789612259565b03611cef57916004611ca7611c9a82947f73ccf8d6c8385bf5347269bd59712da33183c1a5e1702494bcdb87d0f4674d96963691612259565b6107658461075683612893565b91611cb1836123da565b5001556107bc611cc0826123da565b50611cca836123da565b506006611cd961079f866123da565b5001549160026040519687960192019085612b35565b916002611ca7611d246004947f882f030c609566cd82918a97d457fd48f9cfcefd11282e2654cde3f94579c15f963691612259565b610765846107568361280f565b610abb915060203d811161064c5761063e8183612238565b3461012957611d5736612159565b919060018060a01b0391846001938085541662e32cf960e41b83528260046020998a935afa801561065357611d96926000916121155750163314612323565b611da88361084261011a368686612259565b8284149384801561210b575b156120b857836006611dcd611016610765368888612259565b500154036120635760008091866000146120425750505060029383611dfd865b611df8368787612259565b61299a565b151503611fed5715611f3557611e14913691612259565b81611e1e8261278b565b151503611ed357600080516020612c87833981519152916003611e43611e9d93612c51565b91611e4d836123da565b500155611e59816123da565b5090611eba611e67826123da565b50916004611eab6006611e83611e7c856123da565b50946123da565b50015494600260405198899860a08a5260a08a01906124a5565b918883038d8a0152016124a5565b918583036040870152016124a5565b906060830152600360808301520390a15b604051908152f35b60405162461bcd60e51b815260048101859052603460248201527f6f7267206e6f7420696e20617070726f766564207374617475732e206f7065726044820152736174696f6e2063616e6e6f7420626520646f6e6560601b6064820152608490fd5b611f40913691612259565b81611f4a826126f8565b151503611fa857600080516020612c87833981519152916005611f6f611e9d93612c51565b91611f79836123da565b500155611f85816123da565b5090611f93611e67826123da565b906060830152600560808301520390a1611ecb565b60405162461bcd60e51b815260048101859052601a60248201527f6f7267206e6f7420696e2073757370656e6465642073746174650000000000006044820152606490fd5b60405162461bcd60e51b815260048101879052602760248201527f6f72672073746174757320646f6573206e6f7420616c6c6f7720746865206f7060448201526632b930ba34b7b760c91b6064820152608490fd5b919591600214612057575b611dfd8591611ded565b5060039450600461204d565b60405162461bcd60e51b815260048101879052602760248201527f6e6f742061206d6173746572206f72672e206f7065726174696f6e206e6f7420604482015266185b1b1bddd95960ca1b6064820152608490fd5b60405162461bcd60e51b815260048101879052602560248201527f696e76616c696420616374696f6e2e206f7065726174696f6e206e6f7420616c6044820152641b1bddd95960da1b6064820152608490fd5b5060028114611db4565b610abb9150883d8a1161064c5761063e8183612238565b9181601f84011215610129578235916001600160401b038311610129576020838186019501011161012957565b604060031982011261012957600435906001600160401b038211610129576121839160040161212c565b909160243590565b6040600319820112610129576001600160401b039160043583811161012957826121b79160040161212c565b93909392602435918211610129576121d19160040161212c565b9091565b60005b8381106121e85750506000910152565b81810151838201526020016121d8565b90602091612211815180928185528580860191016121d5565b601f01601f1916010190565b602081019081106001600160401b038211176104e157604052565b90601f801991011681019081106001600160401b038211176104e157604052565b9291926001600160401b0382116104e15760405191612282601f8201601f191660200184612238565b829481845281830111610129578281602093846000960137010152565b9080601f83011215610129578160206122ba93359101612259565b90565b91926122ec6080946122de6122fa949998979960a0875260a08701906121f8565b9085820360208701526121f8565b9083820360408501526121f8565b9460608201520152565b9081602091031261012957516001600160a01b03811681036101295790565b1561232a57565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b1561236757565b60405162461bcd60e51b815260206004820152600a6024820152696f72672065786973747360b01b6044820152606490fd5b156123a057565b60405162461bcd60e51b81526020600482015260126024820152711bdc99c8191bd95cc81b9bdd08195e1a5cdd60721b6044820152606490fd5b60045481101561241457600460005260031b7f8a35acfbc15ff81a39ae7d344fd709f28e8600b4aa8c65c6b64bfe7fe36bd19b0190600090565b634e487b7160e01b600052603260045260246000fd5b1561243157565b60405162461bcd60e51b81526020600482015260126024820152716e6f7468696e6720746f20617070726f766560701b6044820152606490fd5b90600182811c9216801561249b575b602083101461248557565b634e487b7160e01b600052602260045260246000fd5b91607f169161247a565b8054600093926124b48261246b565b91828252602093600191828116908160001461251b57506001146124da575b5050505050565b90939495506000929192528360002092846000945b838610612507575050505001019038808080806124d3565b8054858701830152940193859082016124ef565b60ff19168685015250505090151560051b0101915038808080806124d3565b9060029361256a60809461255c6122fa9499989960a0875260a08701906124a5565b9085820360208701526124a5565b9083820360408501526124a5565b818110612583575050565b60008155600101612578565b9190601f811161259e57505050565b6125ca926000526020600020906020601f840160051c830193106125cc575b601f0160051c0190612578565b565b90915081906125bd565b80548210156124145760005260206000200190600090565b908060209392818452848401376000828201840152601f01601f1916010190565b60001981146109fb5760010190565b61262c61011a368484612259565b156126c25761076590612640923691612259565b9061264a826123da565b5091612655816123da565b5092612660826123da565b509260046126bd60026126ac61113861269b600161268b60066126828c6123da565b5001549a6123da565b50015497604051928380926124a5565b9861113860405180948193016124a5565b9561113860405180948193016124a5565b929190565b6126cd913691612259565b906040516126da8161221d565b60008152906040516126eb8161221d565b6000815290600090600090565b60405160208082018351926127178382818801966101ee81878a6121d5565b51902060005260058152604060002054156127835760059161273884612c51565b936127548360405180936101ee838301968792519283916121d5565b519020600052526040600020541515908161276d575090565b6004915061277c6001916123da565b5001541490565b505050600090565b60405160208082018351926127aa8382818801966101ee81878a6121d5565b5190206000526005815260406000205415612783576005916127cb84612c51565b936127e78360405180936101ee838301968792519283916121d5565b5190206000525260406000205415159081612800575090565b6002915061277c6001916123da565b604051602080820183519261282e8382818801966101ee81878a6121d5565b51902060005260058152604060002054156127835760059161284f84612c51565b9361286b8360405180936101ee838301968792519283916121d5565b5190206000525260406000205415159081612884575090565b6005915061277c6001916123da565b60405160208082018351926128b28382818801966101ee81878a6121d5565b5190206000526005815260406000205415612783576005916128d384612c51565b936128ef8360405180936101ee838301968792519283916121d5565b5190206000525260406000205415159081612908575090565b6003915061277c6001916123da565b60405160208082018351926129368382818801966101ee81878a6121d5565b51902060005260058152604060002054156127835760059161295784612c51565b936129738360405180936101ee838301968792519283916121d5565b519020600052526040600020541515908161298c575090565b6001915061277c82916123da565b9060405160208082018451926129ba8382818901966101ee81878a6121d5565b5190206000526005815260406000205415612a1f576005916129db85612c51565b946129f78360405180936101ee838301968792519283916121d5565b5190206000525260406000205415159182612a1157505090565b600191925061277c906123da565b50505050600090565b6040516020810190612a4560208285516101ee8187858a016121d5565b5190206000526005602052604060002054612a61575b50600090565b612a6a90612c51565b60026001612a77836123da565b500154148015612aeb575b15612a5b576004610765612a98612aa9936123da565b5061113860405180948193016124a5565b60026001612ab6836123da565b50015414908115612ad2575b50612acd5738612a5b565b600190565b60039150612ae16001916123da565b5001541438612ac2565b5060036001612af9836123da565b50015414612a82565b604051612b1f602082816101ee81830196878151938492016121d5565b5190206000526005602052604060002054151590565b949392612b61606093612b53612b6f9460808a5260808a01906124a5565b9088820360208a01526124a5565b9086820360408801526124a5565b930152565b90808214612c4d57612b86815461246b565b906001600160401b0382116104e157612ba38261032d855461246b565b600090601f8311600114612be257612bd3929160009183612bd75750508160011b916000199060031b1c19161790565b9055565b01549050388061034e565b815260208082208483528183209291601f1985169083905b828210612c34575050908460019594939210612c1b575b505050811b019055565b015460001960f88460031b161c19169055388080612c11565b8495819295850154815560018091019601940190612bfa565b5050565b604051612c6e602082816101ee81830196878151938492016121d5565b5190206000526005602052600019604060002054019056fe0e8b7be64e0c730234ba2cd252b227fb481d7a247ba806d1941144c535bf054bd705723a50859c9cc1d3953e10b8b9478820e7a62927ad3215897ed87b20591ca264697066735822122036b70d917460e20c9f37630b73c0d65defa774e112e929f862d7c767b83169e964736f6c63430008150033"
 
 // DeployOrgManager deploys a new Ethereum contract, binding an instance of OrgManager to it.
 func DeployOrgManager(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address) (common.Address, *types.Transaction, *OrgManager, error) {
@@ -295,6 +295,58 @@ func (_OrgManager *OrgManagerCallerSession) GetNumberOfOrgs() (*big.Int, error)
 	return _OrgManager.Contract.GetNumberOfOrgs(&_OrgManager.CallOpts)
 }
 
+// GetOrgCA is a free data retrieval call binding the contract method 0x3798b6a4.
+//
+// Solidity: function getOrgCA(string _orgId, bytes32 _fingerprint) constant returns(bytes)
+func (_OrgManager *OrgManagerCaller) GetOrgCA(opts *bind.CallOpts, _orgId string, _fingerprint [32]byte) ([]byte, error) {
+	var (
+		ret0 = new([]byte)
+	)
+	out := ret0
+	err := _OrgManager.contract.Call(opts, out, "getOrgCA", _orgId, _fingerprint)
+	return *ret0, err
+}
+
+// GetOrgCA is a free data retrieval call binding the contract method 0x3798b6a4.
+//
+// Solidity: function getOrgCA(string _orgId, bytes32 _fingerprint) constant returns(bytes)
+func (_OrgManager *OrgManagerSession) GetOrgCA(_orgId string, _fingerprint [32]byte) ([]byte, error) {
+	return _OrgManager.Contract.GetOrgCA(&_OrgManager.CallOpts, _orgId, _fingerprint)
+}
+
+// GetOrgCA is a free data retrieval call binding the contract method 0x3798b6a4.
+//
+// Solidity: function getOrgCA(string _orgId, bytes32 _fingerprint) constant returns(bytes)
+func (_OrgManager *OrgManagerCallerSession) GetOrgCA(_orgId string, _fingerprint [32]byte) ([]byte, error) {
+	return _OrgManager.Contract.GetOrgCA(&_OrgManager.CallOpts, _orgId, _fingerprint)
+}
+
+// GetOrgCAList is a free data retrieval call binding the contract method 0x3ff280d7.
+//
+// Solidity: function getOrgCAList(string _orgId) constant returns(bytes32[])
+func (_OrgManager *OrgManagerCaller) GetOrgCAList(opts *bind.CallOpts, _orgId string) ([][32]byte, error) {
+	var (
+		ret0 = new([][32]byte)
+	)
+	out := ret0
+	err := _OrgManager.contract.Call(opts, out, "getOrgCAList", _orgId)
+	return *ret0, err
+}
+
+// GetOrgCAList is a free data retrieval call binding the contract method 0x3ff280d7.
+//
+// Solidity: function getOrgCAList(string _orgId) constant returns(bytes32[])
+func (_OrgManager *OrgManagerSession) GetOrgCAList(_orgId string) ([][32]byte, error) {
+	return _OrgManager.Contract.GetOrgCAList(&_OrgManager.CallOpts, _orgId)
+}
+
+// GetOrgCAList is a free data retrieval call binding the contract method 0x3ff280d7.
+//
+// Solidity: function getOrgCAList(string _orgId) constant returns(bytes32[])
+func (_OrgManager *OrgManagerCallerSession) GetOrgCAList(_orgId string) ([][32]byte, error) {
+	return _OrgManager.Contract.GetOrgCAList(&_OrgManager.CallOpts, _orgId)
+}
+
 // GetOrgDetails is a free data retrieval call binding the contract method 0xf4d6d9f5.
 //
 // Solidity: function getOrgDetails(string _orgId) constant returns(string, string, string, uint256, uint256)
@@ -440,6 +492,27 @@ func (_OrgManager *OrgManagerTransactorSession) AddOrg(_orgId string) (*types.Tr
 	return _OrgManager.Contract.AddOrg(&_OrgManager.TransactOpts, _orgId)
 }
 
+// AddOrgCA is a paid mutator transaction binding the contract method 0x15f547f8.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate) returns()
+func (_OrgManager *OrgManagerTransactor) AddOrgCA(opts *bind.TransactOpts, _orgId string, _certificate []byte) (*types.Transaction, error) {
+	return _OrgManager.contract.Transact(opts, "addOrgCA", _orgId, _certificate)
+}
+
+// AddOrgCA is a paid mutator transaction binding the contract method 0x15f547f8.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate) returns()
+func (_OrgManager *OrgManagerSession) AddOrgCA(_orgId string, _certificate []byte) (*types.Transaction, error) {
+	return _OrgManager.Contract.AddOrgCA(&_OrgManager.TransactOpts, _orgId, _certificate)
+}
+
+// AddOrgCA is a paid mutator transaction binding the contract method 0x15f547f8.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate) returns()
+func (_OrgManager *OrgManagerTransactorSession) AddOrgCA(_orgId string, _certificate []byte) (*types.Transaction, error) {
+	return _OrgManager.Contract.AddOrgCA(&_OrgManager.TransactOpts, _orgId, _certificate)
+}
+
 // AddSubOrg is a paid mutator transaction binding the contract method 0x1f953480.
 //
 // Solidity: function addSubOrg(string _pOrgId, string _orgId) returns()
@@ -503,6 +576,27 @@ func (_OrgManager *OrgManagerTransactorSession) ApproveOrgStatusUpdate(_orgId st
 	return _OrgManager.Contract.ApproveOrgStatusUpdate(&_OrgManager.TransactOpts, _orgId, _action)
 }
 
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0xbcbdd7e6.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint) returns()
+func (_OrgManager *OrgManagerTransactor) RemoveOrgCA(opts *bind.TransactOpts, _orgId string, _fingerprint [32]byte) (*types.Transaction, error) {
+	return _OrgManager.contract.Transact(opts, "removeOrgCA", _orgId, _fingerprint)
+}
+
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0xbcbdd7e6.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint) returns()
+func (_OrgManager *OrgManagerSession) RemoveOrgCA(_orgId string, _fingerprint [32]byte) (*types.Transaction, error) {
+	return _OrgManager.Contract.RemoveOrgCA(&_OrgManager.TransactOpts, _orgId, _fingerprint)
+}
+
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0xbcbdd7e6.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint) returns()
+func (_OrgManager *OrgManagerTransactorSession) RemoveOrgCA(_orgId string, _fingerprint [32]byte) (*types.Transaction, error) {
+	return _OrgManager.Contract.RemoveOrgCA(&_OrgManager.TransactOpts, _orgId, _fingerprint)
+}
+
 // SetUpOrg is a paid mutator transaction binding the contract method 0x9e58eb9f.
 //
 // Solidity: function setUpOrg(string _orgId, uint256 _breadth, uint256 _depth) returns()
@@ -684,6 +778,279 @@ func (_OrgManager *OrgManagerFilterer) ParseOrgApproved(log types.Log) (*OrgMana
 	return event, nil
 }
 
+// OrgManagerOrgCAAddedIterator is returned from FilterOrgCAAdded and is used to iterate over the raw logs and unpacked data for OrgCAAdded events raised by the OrgManager contract.
+type OrgManagerOrgCAAddedIterator struct {
+	Event *OrgManagerOrgCAAdded // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OrgManagerOrgCAAddedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OrgManagerOrgCAAdded)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OrgManagerOrgCAAdded)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OrgManagerOrgCAAddedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OrgManagerOrgCAAddedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OrgManagerOrgCAAdded represents a OrgCAAdded event raised by the OrgManager contract.
+type OrgManagerOrgCAAdded struct {
+	OrgId       string
+	Certificate []byte
+	Fingerprint [32]byte
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterOrgCAAdded is a free log retrieval operation binding the contract event 0xfa7aa7e2af6ff2834c0327546dcb6aa1952aef24ce4168d5b24eaf265c949904.
+//
+// Solidity: event OrgCAAdded(string _orgId, bytes _certificate, bytes32 _fingerprint)
+func (_OrgManager *OrgManagerFilterer) FilterOrgCAAdded(opts *bind.FilterOpts) (*OrgManagerOrgCAAddedIterator, error) {
+
+	logs, sub, err := _OrgManager.contract.FilterLogs(opts, "OrgCAAdded")
+	if err != nil {
+		return nil, err
+	}
+	return &OrgManagerOrgCAAddedIterator{contract: _OrgManager.contract, event: "OrgCAAdded", logs: logs, sub: sub}, nil
+}
+
+var OrgCAAddedTopicHash = "0xfa7aa7e2af6ff2834c0327546dcb6aa1952aef24ce4168d5b24eaf265c949904"
+
+// WatchOrgCAAdded is a free log subscription operation binding the contract event 0xfa7aa7e2af6ff2834c0327546dcb6aa1952aef24ce4168d5b24eaf265c949904.
+//
+// Solidity: event OrgCAAdded(string _orgId, bytes _certificate, bytes32 _fingerprint)
+func (_OrgManager *OrgManagerFilterer) WatchOrgCAAdded(opts *bind.WatchOpts, sink chan<- *OrgManagerOrgCAAdded) (event.Subscription, error) {
+
+	logs, sub, err := _OrgManager.contract.WatchLogs(opts, "OrgCAAdded")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OrgManagerOrgCAAdded)
+				if err := _OrgManager.contract.UnpackLog(event, "OrgCAAdded", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOrgCAAdded is a log parse operation binding the contract event 0xfa7aa7e2af6ff2834c0327546dcb6aa1952aef24ce4168d5b24eaf265c949904.
+//
+// Solidity: event OrgCAAdded(string _orgId, bytes _certificate, bytes32 _fingerprint)
+func (_OrgManager *OrgManagerFilterer) ParseOrgCAAdded(log types.Log) (*OrgManagerOrgCAAdded, error) {
+	event := new(OrgManagerOrgCAAdded)
+	if err := _OrgManager.contract.UnpackLog(event, "OrgCAAdded", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+// OrgManagerOrgCARemovedIterator is returned from FilterOrgCARemoved and is used to iterate over the raw logs and unpacked data for OrgCARemoved events raised by the OrgManager contract.
+type OrgManagerOrgCARemovedIterator struct {
+	Event *OrgManagerOrgCARemoved // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *OrgManagerOrgCARemovedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(OrgManagerOrgCARemoved)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(OrgManagerOrgCARemoved)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *OrgManagerOrgCARemovedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *OrgManagerOrgCARemovedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// OrgManagerOrgCARemoved represents a OrgCARemoved event raised by the OrgManager contract.
+type OrgManagerOrgCARemoved struct {
+	OrgId       string
+	Fingerprint [32]byte
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterOrgCARemoved is a free log retrieval operation binding the contract event 0x430f4f6ffc6412b9e640d470ceee4c72c008edb5c9d42205305122025b0e2514.
+//
+// Solidity: event OrgCARemoved(string _orgId, bytes32 _fingerprint)
+func (_OrgManager *OrgManagerFilterer) FilterOrgCARemoved(opts *bind.FilterOpts) (*OrgManagerOrgCARemovedIterator, error) {
+
+	logs, sub, err := _OrgManager.contract.FilterLogs(opts, "OrgCARemoved")
+	if err != nil {
+		return nil, err
+	}
+	return &OrgManagerOrgCARemovedIterator{contract: _OrgManager.contract, event: "OrgCARemoved", logs: logs, sub: sub}, nil
+}
+
+var OrgCARemovedTopicHash = "0x430f4f6ffc6412b9e640d470ceee4c72c008edb5c9d42205305122025b0e2514"
+
+// WatchOrgCARemoved is a free log subscription operation binding the contract event 0x430f4f6ffc6412b9e640d470ceee4c72c008edb5c9d42205305122025b0e2514.
+//
+// Solidity: event OrgCARemoved(string _orgId, bytes32 _fingerprint)
+func (_OrgManager *OrgManagerFilterer) WatchOrgCARemoved(opts *bind.WatchOpts, sink chan<- *OrgManagerOrgCARemoved) (event.Subscription, error) {
+
+	logs, sub, err := _OrgManager.contract.WatchLogs(opts, "OrgCARemoved")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(OrgManagerOrgCARemoved)
+				if err := _OrgManager.contract.UnpackLog(event, "OrgCARemoved", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOrgCARemoved is a log parse operation binding the contract event 0x430f4f6ffc6412b9e640d470ceee4c72c008edb5c9d42205305122025b0e2514.
+//
+// Solidity: event OrgCARemoved(string _orgId, bytes32 _fingerprint)
+func (_OrgManager *OrgManagerFilterer) ParseOrgCARemoved(log types.Log) (*OrgManagerOrgCARemoved, error) {
+	event := new(OrgManagerOrgCARemoved)
+	if err := _OrgManager.contract.UnpackLog(event, "OrgCARemoved", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 // OrgManagerOrgPendingApprovalIterator is returned from FilterOrgPendingApproval and is used to iterate over the raw logs and unpacked data for OrgPendingApproval events raised by the OrgManager contract.
 type OrgManagerOrgPendingApprovalIterator struct {
 	Event *OrgManagerOrgPendingApproval // Event containing the contract specifics and raw log
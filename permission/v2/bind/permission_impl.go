@@ -28,12 +28,12 @@ var (
 )
 
 // PermImplABI is the input ABI used to generate the binding from.
-const PermImplABI = "[{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_action\",\"type\":\"uint256\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_access\",\"type\":\"uint256\"},{\"name\":\"_voter\",\"type\":\"bool\"},{\"name\":\"_admin\",\"type\":\"bool\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addNewRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setPolicy\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"startBlacklistedAccountRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateOrgStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"updateNetworkBootStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveBlacklistedAccountRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNetworkBootStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addAdminAccount\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"removeRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_pOrgId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_sender\",\"type\":\"address\"},{\"name\":\"_target\",\"type\":\"address\"},{\"name\":\"_value\",\"type\":\"uint256\"},{\"name\":\"_gasPrice\",\"type\":\"uint256\"},{\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"name\":\"_payload\",\"type\":\"bytes\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"isOrgAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveBlacklistedNodeRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_breadth\",\"type\":\"uint256\"},{\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveOrgStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_action\",\"type\":\"uint256\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getPolicyDetails\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isNetworkAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"startBlacklistedNodeRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOp\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"name\":\"_oAdminRole\",\"type\":\"string\"},{\"name\":\"_networkBootStatus\",\"type\":\"bool\"}],\"name\":\"setMigrationPolicy\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"},{\"name\":\"_orgManager\",\"type\":\"address\"},{\"name\":\"_rolesManager\",\"type\":\"address\"},{\"name\":\"_accountManager\",\"type\":\"address\"},{\"name\":\"_voterManager\",\"type\":\"address\"},{\"name\":\"_nodeManager\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_networkBootStatus\",\"type\":\"bool\"}],\"name\":\"PermissionsInitialized\",\"type\":\"event\"}]"
+const PermImplABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_orgManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_rolesManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_accountManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_voterManager\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_nodeManager\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"bool\",\"name\":\"_networkBootStatus\",\"type\":\"bool\"}],\"name\":\"PermissionsInitialized\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addAdminAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"bytes4[]\",\"name\":\"_selectors\",\"type\":\"bytes4[]\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addContractAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_access\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_voter\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_admin\",\"type\":\"bool\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addNewRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"_certificate\",\"type\":\"bytes\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addOrgCA\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_pOrgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveBlacklistedAccountRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveBlacklistedNodeRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"approveOrgStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"getApprovalPolicy\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNetworkBootStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOp\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPolicyDetails\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_breadth\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isNetworkAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"isOrgAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"removeContractAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"bytes32\",\"name\":\"_fingerprint\",\"type\":\"bytes32\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"removeOrgCA\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"removeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_fromBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_fromTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilTime\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"setAccountValidity\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_required\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"setApprovalPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_oAdminRole\",\"type\":\"string\"},{\"internalType\":\"bool\",\"name\":\"_networkBootStatus\",\"type\":\"bool\"}],\"name\":\"setMigrationPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_fromBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_fromTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilTime\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"setNodeValidity\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"startBlacklistedAccountRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"startBlacklistedNodeRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_target\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_payload\",\"type\":\"bytes\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"updateNetworkBootStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_caller\",\"type\":\"address\"}],\"name\":\"updateOrgStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 var PermImplParsedABI, _ = abi.JSON(strings.NewReader(PermImplABI))
 
 // PermImplBin is the compiled bytecode used for deploying new contracts.
-var PermImplBin = "0x60806040526003600955600a805460ff191690553480156200002057600080fd5b5060405160c08062008587833981018060405260c08110156200004257600080fd5b508051602082015160408301516060840151608085015160a090950151600580546001600160a01b039687166001600160a01b03199182161790915560048054958716958216959095179094556001805493861693851693909317909255600080549185169184169190911790556002805494841694831694909417909355600380549290931691161790556184a980620000de6000396000f3fe608060405234801561001057600080fd5b50600436106101e55760003560e01c8063888430411161010f578063cc9ba6fa116100a2578063ecad01d511610071578063ecad01d5146117db578063f346a3a7146119a5578063f5ad584a14611b11578063f75f0a0614611c21576101e5565b8063cc9ba6fa146112c4578063d1aa0c2014611418578063d621d9571461143e578063e91b0e1914611608576101e5565b8063a042bf40116100de578063a042bf4014610e8b578063a5843f0814611055578063b554656414611078578063b9b7fe6c146110f5576101e5565b80638884304114610b7a5780638baa819114610bf9578063936421d514610d3d5780639bd3810114610dd7576101e5565b806345a59e5b116101875780635ca5adbe116101565780635ca5adbe146107b957806368a61273146108805780636b568d76146109fc5780638683c7fe14610ab0576101e5565b806345a59e5b1461064a5780634b20f45f1461070c5780634cbfa82e1461078b5780634fe57e7a14610793576101e5565b80631c249912116101c35780631c2499121461045e5780633cf5f33b146104dd578063404bf3eb1461055a57806344478e791461062e576101e5565b806304e81f1e146101ea5780631b04c276146102735780631b61022014610350575b600080fd5b6102716004803603608081101561020057600080fd5b810190602081018135600160201b81111561021a57600080fd5b82018360208201111561022c57600080fd5b803590602001918460018302840111600160201b8311171561024d57600080fd5b91935091506001600160a01b03813581169160208101359160409091013516611df4565b005b610271600480360360c081101561028957600080fd5b810190602081018135600160201b8111156102a357600080fd5b8201836020820111156102b557600080fd5b803590602001918460018302840111600160201b831117156102d657600080fd5b919390929091602081019035600160201b8111156102f357600080fd5b82018360208201111561030557600080fd5b803590602001918460018302840111600160201b8311171561032657600080fd5b919350915080359060208101351515906040810135151590606001356001600160a01b0316612047565b6102716004803603606081101561036657600080fd5b810190602081018135600160201b81111561038057600080fd5b82018360208201111561039257600080fd5b803590602001918460018302840111600160201b831117156103b357600080fd5b919390929091602081019035600160201b8111156103d057600080fd5b8201836020820111156103e257600080fd5b803590602001918460018302840111600160201b8311171561040357600080fd5b919390929091602081019035600160201b81111561042057600080fd5b82018360208201111561043257600080fd5b803590602001918460018302840111600160201b8311171561045357600080fd5b509092509050612309565b6102716004803603606081101561047457600080fd5b810190602081018135600160201b81111561048e57600080fd5b8201836020820111156104a057600080fd5b803590602001918460018302840111600160201b831117156104c157600080fd5b91935091506001600160a01b0381358116916020013516612448565b610271600480360360608110156104f357600080fd5b810190602081018135600160201b81111561050d57600080fd5b82018360208201111561051f57600080fd5b803590602001918460018302840111600160201b8311171561054057600080fd5b9193509150803590602001356001600160a01b0316612747565b6102716004803603608081101561057057600080fd5b810190602081018135600160201b81111561058a57600080fd5b82018360208201111561059c57600080fd5b803590602001918460018302840111600160201b831117156105bd57600080fd5b919390926001600160a01b0383351692604081019060200135600160201b8111156105e757600080fd5b8201836020820111156105f957600080fd5b803590602001918460018302840111600160201b8311171561061a57600080fd5b9193509150356001600160a01b0316612a4f565b610636612e0e565b604080519115158252519081900360200190f35b6106366004803603606081101561066057600080fd5b810190602081018135600160201b81111561067a57600080fd5b82018360208201111561068c57600080fd5b803590602001918460018302840111600160201b831117156106ad57600080fd5b919390929091602081019035600160201b8111156106ca57600080fd5b8201836020820111156106dc57600080fd5b803590602001918460018302840111600160201b831117156106fd57600080fd5b91935091503561ffff16612f6c565b6102716004803603606081101561072257600080fd5b810190602081018135600160201b81111561073c57600080fd5b82018360208201111561074e57600080fd5b803590602001918460018302840111600160201b8311171561076f57600080fd5b91935091506001600160a01b0381358116916020013516613070565b6106366132b9565b610271600480360360208110156107a957600080fd5b50356001600160a01b03166132c3565b610271600480360360608110156107cf57600080fd5b810190602081018135600160201b8111156107e957600080fd5b8201836020820111156107fb57600080fd5b803590602001918460018302840111600160201b8311171561081c57600080fd5b919390929091602081019035600160201b81111561083957600080fd5b82018360208201111561084b57600080fd5b803590602001918460018302840111600160201b8311171561086c57600080fd5b9193509150356001600160a01b03166135e4565b610271600480360360e081101561089657600080fd5b810190602081018135600160201b8111156108b057600080fd5b8201836020820111156108c257600080fd5b803590602001918460018302840111600160201b831117156108e357600080fd5b919390929091602081019035600160201b81111561090057600080fd5b82018360208201111561091257600080fd5b803590602001918460018302840111600160201b8311171561093357600080fd5b919390929091602081019035600160201b81111561095057600080fd5b82018360208201111561096257600080fd5b803590602001918460018302840111600160201b8311171561098357600080fd5b919390929091602081019035600160201b8111156109a057600080fd5b8201836020820111156109b257600080fd5b803590602001918460018302840111600160201b831117156109d357600080fd5b9193509150803561ffff90811691602081013590911690604001356001600160a01b0316613aa6565b61063660048036036040811015610a1257600080fd5b6001600160a01b038235169190810190604081016020820135600160201b811115610a3c57600080fd5b820183602082011115610a4e57600080fd5b803590602001918460018302840111600160201b83111715610a6f57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550613ef1945050505050565b61027160048036036080811015610ac657600080fd5b810190602081018135600160201b811115610ae057600080fd5b820183602082011115610af257600080fd5b803590602001918460018302840111600160201b83111715610b1357600080fd5b919390929091602081019035600160201b811115610b3057600080fd5b820183602082011115610b4257600080fd5b803590602001918460018302840111600160201b83111715610b6357600080fd5b919350915061ffff81358116916020013516613fdf565b61027160048036036060811015610b9057600080fd5b810190602081018135600160201b811115610baa57600080fd5b820183602082011115610bbc57600080fd5b803590602001918460018302840111600160201b83111715610bdd57600080fd5b91935091506001600160a01b0381358116916020013516614228565b61027160048036036080811015610c0f57600080fd5b6001600160a01b038235169190810190604081016020820135600160201b811115610c3957600080fd5b820183602082011115610c4b57600080fd5b803590602001918460018302840111600160201b83111715610c6c57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610cbe57600080fd5b820183602082011115610cd057600080fd5b803590602001918460018302840111600160201b83111715610cf157600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550505090356001600160a01b0316915061467d9050565b610636600480360360c0811015610d5357600080fd5b6001600160a01b0382358116926020810135909116916040820135916060810135916080820135919081019060c0810160a0820135600160201b811115610d9957600080fd5b820183602082011115610dab57600080fd5b803590602001918460018302840111600160201b83111715610dcc57600080fd5b509092509050614b8b565b61063660048036036040811015610ded57600080fd5b6001600160a01b038235169190810190604081016020820135600160201b811115610e1757600080fd5b820183602082011115610e2957600080fd5b803590602001918460018302840111600160201b83111715610e4a57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550615059945050505050565b610271600480360360c0811015610ea157600080fd5b810190602081018135600160201b811115610ebb57600080fd5b820183602082011115610ecd57600080fd5b803590602001918460018302840111600160201b83111715610eee57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610f4057600080fd5b820183602082011115610f5257600080fd5b803590602001918460018302840111600160201b83111715610f7357600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610fc557600080fd5b820183602082011115610fd757600080fd5b803590602001918460018302840111600160201b83111715610ff857600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b03169050615411565b6102716004803603604081101561106b57600080fd5b508035906020013561577d565b6102716004803603606081101561108e57600080fd5b810190602081018135600160201b8111156110a857600080fd5b8201836020820111156110ba57600080fd5b803590602001918460018302840111600160201b831117156110db57600080fd5b9193509150803590602001356001600160a01b0316615c4c565b610271600480360360e081101561110b57600080fd5b810190602081018135600160201b81111561112557600080fd5b82018360208201111561113757600080fd5b803590602001918460018302840111600160201b8311171561115857600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156111aa57600080fd5b8201836020820111156111bc57600080fd5b803590602001918460018302840111600160201b831117156111dd57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561122f57600080fd5b82018360208201111561124157600080fd5b803590602001918460018302840111600160201b8311171561126257600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff90811693506020830135169160408101359150606001356001600160a01b0316615fa5565b6112cc61619f565b604080518215156060820152608080825286519082015285519091829160208084019284019160a08501918a019080838360005b83811015611318578181015183820152602001611300565b50505050905090810190601f1680156113455780820380516001836020036101000a031916815260200191505b50848103835287518152875160209182019189019080838360005b83811015611378578181015183820152602001611360565b50505050905090810190601f1680156113a55780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b838110156113d85781810151838201526020016113c0565b50505050905090810190601f1680156114055780820380516001836020036101000a031916815260200191505b5097505050505050505060405180910390f35b6106366004803603602081101561142e57600080fd5b50356001600160a01b0316616372565b610271600480360360c081101561145457600080fd5b810190602081018135600160201b81111561146e57600080fd5b82018360208201111561148057600080fd5b803590602001918460018302840111600160201b831117156114a157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156114f357600080fd5b82018360208201111561150557600080fd5b803590602001918460018302840111600160201b8311171561152657600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561157857600080fd5b82018360208201111561158a57600080fd5b803590602001918460018302840111600160201b831117156115ab57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b03169050616576565b610271600480360360e081101561161e57600080fd5b810190602081018135600160201b81111561163857600080fd5b82018360208201111561164a57600080fd5b803590602001918460018302840111600160201b8311171561166b57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156116bd57600080fd5b8201836020820111156116cf57600080fd5b803590602001918460018302840111600160201b831117156116f057600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561174257600080fd5b82018360208201111561175457600080fd5b803590602001918460018302840111600160201b8311171561177557600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff833581169450602084013516926001600160a01b036040820135811693506060909101351690506169fb565b610271600480360360c08110156117f157600080fd5b810190602081018135600160201b81111561180b57600080fd5b82018360208201111561181d57600080fd5b803590602001918460018302840111600160201b8311171561183e57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561189057600080fd5b8201836020820111156118a257600080fd5b803590602001918460018302840111600160201b831117156118c357600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561191557600080fd5b82018360208201111561192757600080fd5b803590602001918460018302840111600160201b8311171561194857600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b0316905061713d565b611a13600480360360208110156119bb57600080fd5b810190602081018135600160201b8111156119d557600080fd5b8201836020820111156119e757600080fd5b803590602001918460018302840111600160201b83111715611a0857600080fd5b5090925090506173e7565b604051808060200180602001856001600160a01b03166001600160a01b03168152602001848152602001838103835287818151815260200191508051906020019080838360005b83811015611a72578181015183820152602001611a5a565b50505050905090810190601f168015611a9f5780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b83811015611ad2578181015183820152602001611aba565b50505050905090810190601f168015611aff5780820380516001836020036101000a031916815260200191505b50965050505050505060405180910390f35b61027160048036036080811015611b2757600080fd5b810190602081018135600160201b811115611b4157600080fd5b820183602082011115611b5357600080fd5b803590602001918460018302840111600160201b83111715611b7457600080fd5b919390929091602081019035600160201b811115611b9157600080fd5b820183602082011115611ba357600080fd5b803590602001918460018302840111600160201b83111715611bc457600080fd5b919390929091602081019035600160201b811115611be157600080fd5b820183602082011115611bf357600080fd5b803590602001918460018302840111600160201b83111715611c1457600080fd5b919350915035151561754f565b610271600480360360e0811015611c3757600080fd5b810190602081018135600160201b811115611c5157600080fd5b820183602082011115611c6357600080fd5b803590602001918460018302840111600160201b83111715611c8457600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115611cd657600080fd5b820183602082011115611ce857600080fd5b803590602001918460018302840111600160201b83111715611d0957600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115611d5b57600080fd5b820183602082011115611d6d57600080fd5b803590602001918460018302840111600160201b83111715611d8e57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff833581169450602084013516926001600160a01b0360408201358116935060609091013516905061763e565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015611e4257600080fd5b505afa158015611e56573d6000803e3d6000fd5b505050506040513d6020811015611e6c57600080fd5b50516001600160a01b03163314611eb757604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8085858080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611efb9250849150839050615059565b1515600114611f3e57604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b8360011480611f4d5750836002145b80611f585750836003145b1515611f9857604051600160e51b62461bcd0281526004018080602001828103825260258152602001806183e96025913960400191505060405180910390fd5b600054604051600160e11b63425bd4250281526001600160a01b03878116602483015260448201879052606060048301908152606483018a90529216916384b7a84a918a918a918a918a918190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b15801561202657600080fd5b505af115801561203a573d6000803e3d6000fd5b5050505050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561209557600080fd5b505afa1580156120a9573d6000803e3d6000fd5b505050506040513d60208110156120bf57600080fd5b50516001600160a01b0316331461210a57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b85858080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201919091525061214c9250839150617d129050565b15156001146121935760408051600160e51b62461bcd02815260206004820152601a60248201526000805160206183a9833981519152604482015290519081900360640190fd5b8187878080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506121d79250849150839050615059565b151560011461221a57604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b600154604051600160e01b637b713579028152604481018990528715156064820152861515608482015260a06004820190815260a482018d90526001600160a01b0390921691637b713579918e918e918e918e918e918e918e91908190602481019060c4018a8a80828437600083820152601f01601f191690910184810383528881526020019050888880828437600081840152601f19601f8201169050808301925050509950505050505050505050600060405180830381600087803b1580156122e457600080fd5b505af11580156122f8573d6000803e3d6000fd5b505050505050505050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561235757600080fd5b505afa15801561236b573d6000803e3d6000fd5b505050506040513d602081101561238157600080fd5b50516001600160a01b031633146123cc57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff16156124185760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b6124246006888861831a565b506124316007868661831a565b5061243e6008848461831a565b5050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561249657600080fd5b505afa1580156124aa573d6000803e3d6000fd5b505050506040513d60208110156124c057600080fd5b50516001600160a01b0316331461250b57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8061251581616372565b151560011461255857604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b600054604051600160e11b63425bd4250281526001600160a01b03858116602483015260046044830181905260608382019081526064840189905291909316926384b7a84a928992899289929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b1580156125ea57600080fd5b505af11580156125fe573d6000803e3d6000fd5b505060028054604051600160e01b63e98ac22d0281526001600160a01b03888116606483015260066084830181905260a060048401908152815460001960018216156101000201169590950460a4840181905291909316955063e98ac22d945091928a928a928a928692909182916024820191604481019160c490910190869080156126cb5780601f106126a0576101008083540402835291602001916126cb565b820191906000526020600020905b8154815290600101906020018083116126ae57829003601f168201915b50508481038352878152602001888880828437600081840152601f19601f82011690508083019250505084810382526000815260200160200198505050505050505050600060405180830381600087803b15801561272857600080fd5b505af115801561273c573d6000803e3d6000fd5b505050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561279557600080fd5b505afa1580156127a9573d6000803e3d6000fd5b505050506040513d60208110156127bf57600080fd5b50516001600160a01b0316331461280a57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8061281481616372565b151560011461285757604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b6004805460408051600160e01b630cc2749302815260248101879052928301908152604483018790526000926001600160a01b0390921691630cc27493918991899189918190606401858580828437600081840152601f19601f820116905080830192505050945050505050602060405180830381600087803b1580156128dd57600080fd5b505af11580156128f1573d6000803e3d6000fd5b505050506040513d602081101561290757600080fd5b505160028054604051600160e01b63e98ac22d0281526000606482018190526084820185905260a0600483019081526006805460001960018216156101000201169590950460a484018190529596506001600160a01b039093169463e98ac22d94938c938c939289929182916024820191604481019160c4909101908a9080156129d25780601f106129a7576101008083540402835291602001916129d2565b820191906000526020600020905b8154815290600101906020018083116129b557829003601f168201915b50508481038352878152602001888880828437600081840152601f19601f82011690508083019250505084810382526000815260200160200198505050505050505050600060405180830381600087803b158015612a2f57600080fd5b505af1158015612a43573d6000803e3d6000fd5b50505050505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015612a9d57600080fd5b505afa158015612ab1573d6000803e3d6000fd5b505050506040513d6020811015612ac757600080fd5b50516001600160a01b03163314612b1257604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b85858080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250612b549250839150617e019050565b1515600114612ba55760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b81612baf81616372565b1515600114612bf257604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b600054604051600160e01b63e3483a9d0281526001600160a01b0388811660048301908152600160648401819052608060248501908152608485018d9052929094169363e3483a9d938b938e938e938d938d9391929190604481019060a401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505098505050505050505050600060405180830381600087803b158015612cb557600080fd5b505af1158015612cc9573d6000803e3d6000fd5b505060028054604051600160e01b63e98ac22d0281526001600160a01b038b8116606483015260046084830181905260a08382019081526006805460001960018216156101000201169690960460a4850181905292909416965063e98ac22d95508e938e938e9382916024810191604482019160c401908a908015612d8f5780601f10612d6457610100808354040283529160200191612d8f565b820191906000526020600020905b815481529060010190602001808311612d7257829003601f168201915b50508481038352878152602001888880828437600081840152601f19601f82011690508083019250505084810382526000815260200160200198505050505050505050600060405180830381600087803b158015612dec57600080fd5b505af1158015612e00573d6000803e3d6000fd5b505050505050505050505050565b60055460408051600160e21b63395c945702815290516000926001600160a01b03169163e572515c916004808301926020929190829003018186803b158015612e5657600080fd5b505afa158015612e6a573d6000803e3d6000fd5b505050506040513d6020811015612e8057600080fd5b50516001600160a01b03163314612ecb57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff1615612f175760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b600a805460ff1916600117908190556040805160ff9290921615158252517f04f651be6fb8fc575d94591e56e9f6e66e33ef23e949765918b3bdae50c617cf9181900360200190a1600a5460ff1691505b5090565b600a5460009060ff161515612f8357506001613067565b600354604051600160e01b6345a59e5b02815261ffff84166044820152606060048201908152606482018890526001600160a01b03909216916345a59e5b91899189918991899189919081906024810190608401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505097505050505050505060206040518083038186803b15801561303857600080fd5b505afa15801561304c573d6000803e3d6000fd5b505050506040513d602081101561306257600080fd5b505190505b95945050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b1580156130be57600080fd5b505afa1580156130d2573d6000803e3d6000fd5b505050506040513d60208110156130e857600080fd5b50516001600160a01b0316331461313357604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8061313d81616372565b151560011461318057604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b60068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261321a939092909183018282801561320d5780601f106131e25761010080835404028352916020019161320d565b820191906000526020600020905b8154815290600101906020018083116131f057829003601f168201915b5050505050836006617ec6565b156132b257600054604051600160e11b63425bd4250281526001600160a01b0385811660248301526005604483018190526060600484019081526064840189905291909316926384b7a84a928992899289929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b15801561272857600080fd5b5050505050565b600a5460ff165b90565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561331157600080fd5b505afa158015613325573d6000803e3d6000fd5b505050506040513d602081101561333b57600080fd5b50516001600160a01b0316331461338657604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff16156133d25760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b60068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261346c939092909183018282801561345f5780601f106134345761010080835404028352916020019161345f565b820191906000526020600020905b81548152906001019060200180831161344257829003601f168201915b5050505050836001617fc0565b600054604051600160e01b63e3483a9d0281526001600160a01b038481166004830190815260026064840181905260806024850190815260068054600019600182161561010002011683900460848701819052949096169563e3483a9d95899591946007949390929091604481019160a490910190879080156135305780601f1061350557610100808354040283529160200191613530565b820191906000526020600020905b81548152906001019060200180831161351357829003601f168201915b50508381038252855460026000196101006001841615020190911604808252602090910190869080156135a45780601f10613579576101008083540402835291602001916135a4565b820191906000526020600020905b81548152906001019060200180831161358757829003601f168201915b50509650505050505050600060405180830381600087803b1580156135c857600080fd5b505af11580156135dc573d6000803e3d6000fd5b505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561363257600080fd5b505afa158015613646573d6000803e3d6000fd5b505050506040513d602081101561365c57600080fd5b50516001600160a01b031633146136a757604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b82828080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506136e99250839150617d129050565b15156001146137305760408051600160e51b62461bcd02815260206004820152601a60248201526000805160206183a9833981519152604482015290519081900360640190fd5b8184848080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152506137749250849150839050615059565b15156001146137b757604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b604080516020808201908152600780546002600019610100600184161502019091160493830184905292909182916060909101908490801561383a5780601f1061380f5761010080835404028352916020019161383a565b820191906000526020600020905b81548152906001019060200180831161381d57829003601f168201915b50509250505060405160208183030381529060405280519060200120888860405160200180806020018281038252848482818152602001925080828437600081840152601f19601f820116905080830192505050935050505060405160208183030381529060405280519060200120141580156139a7575060408051602080820190815260088054600260001961010060018416150201909116049383018490529290918291606090910190849080156139355780601f1061390a57610100808354040283529160200191613935565b820191906000526020600020905b81548152906001019060200180831161391857829003601f168201915b50509250505060405160208183030381529060405280519060200120888860405160200180806020018281038252848482818152602001925080828437600081840152601f19601f82011690508083019250505093505050506040516020818303038152906040528051906020012014155b15156139fd5760408051600160e51b62461bcd02815260206004820152601d60248201527f61646d696e20726f6c65732063616e6e6f742062652072656d6f766564000000604482015290519081900360640190fd5b60015460408051600160e11b63531a180902815260048101918252604481018a90526001600160a01b039092169163a6343012918b918b918b918b919081906024810190606401878780828437600083820152601f01601f191690910184810383528581526020019050858580828437600081840152601f19601f8201169050808301925050509650505050505050600060405180830381600087803b158015612dec57600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015613af457600080fd5b505afa158015613b08573d6000803e3d6000fd5b505050506040513d6020811015613b1e57600080fd5b50516001600160a01b03163314613b6957604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8a8a8080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250613bab9250839150617e019050565b1515600114613bfc5760408051600160e51b62461bcd0281526020600482015260126024820152600160721b711bdc99c8191bd95cc81b9bdd08195e1a5cdd02604482015290519081900360640190fd5b818c8c8080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250613c409250849150839050615059565b1515600114613c8357604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b600460009054906101000a90046001600160a01b03166001600160a01b0316631f9534808f8f8f8f6040518563ffffffff1660e01b81526004018080602001806020018381038352878782818152602001925080828437600083820152601f01601f191690910184810383528581526020019050858580828437600081840152601f19601f8201169050808301925050509650505050505050600060405180830381600087803b158015613d3657600080fd5b505af1158015613d4a573d6000803e3d6000fd5b5050505060608e8e8e8e60405160200180858580828437600160f91b6017029201918252506001018383808284376040805191909301818103601f19018252909252509550508d1593506122f89250505057600354604051600160e01b634c57331102815261ffff808a1660448301528816606482015260a06004820190815260a482018d90526001600160a01b0390921691634c573311918e918e918e918e918e918e918a919081906024810190608481019060c4018b8b80828437600083820152601f01601f1916909101858103845289815260200190508989808284376000838201819052601f909101601f191690920186810384528751815287516020918201939189019250908190849084905b83811015613e74578181015183820152602001613e5c565b50505050905090810190601f168015613ea15780820380516001836020036101000a031916815260200191505b509a5050505050505050505050600060405180830381600087803b158015613ec857600080fd5b505af1158015613edc573d6000803e3d6000fd5b50505050505050505050505050505050505050565b6000805460408051600160e11b6335ab46bb0281526001600160a01b0386811660048301908152602483019384528651604484015286519190941693636b568d76938893889360649091019060208501908083838c5b83811015613f5f578181015183820152602001613f47565b50505050905090810190601f168015613f8c5780820380516001836020036101000a031916815260200191505b50935050505060206040518083038186803b158015613faa57600080fd5b505afa158015613fbe573d6000803e3d6000fd5b505050506040513d6020811015613fd457600080fd5b505190505b92915050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561402d57600080fd5b505afa158015614041573d6000803e3d6000fd5b505050506040513d602081101561405757600080fd5b50516001600160a01b031633146140a257604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff16156140ee5760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b600354604051600160e01b634530abe102815261ffff80861660448301528416606482015260a06004820190815260a482018990526001600160a01b0390921691634530abe1918a918a918a918a918a918a916006919081906024810190608481019060c4018b8b80828437600083820152601f01601f191690910185810384528981526020019050898980828437600083820152601f01601f1916909101858103835286546002600019610100600184161502019091160480825260209091019150869080156142005780601f106141d557610100808354040283529160200191614200565b820191906000526020600020905b8154815290600101906020018083116141e357829003601f168201915b50509a5050505050505050505050600060405180830381600087803b15801561202657600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561427657600080fd5b505afa15801561428a573d6000803e3d6000fd5b505050506040513d60208110156142a057600080fd5b50516001600160a01b031633146142eb57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b806142f581616372565b151560011461433857604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b60068054604080516020601f600260001961010060018816150201909516949094049384018190048102820181019092528281526143d293909290918301828280156143c55780601f1061439a576101008083540402835291602001916143c5565b820191906000526020600020905b8154815290600101906020018083116143a857829003601f168201915b5050505050836004617ec6565b156132b25760008054604051600160e01b631d09dc930281526020600482019081526024820188905283926001600160a01b031691631d09dc93918a918a91908190604401848480828437600081840152601f19601f82011690508083019250505093505050506040805180830381600087803b15801561445257600080fd5b505af1158015614466573d6000803e3d6000fd5b505050506040513d604081101561447c57600080fd5b5080516020909101519092509050811561452a5760068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261452a939092909183018282801561451d5780601f106144f25761010080835404028352916020019161451d565b820191906000526020600020905b81548152906001019060200180831161450057829003601f168201915b5050505050826000617fc0565b6000805460408051600160e01b63c214e5e50281526001600160a01b03898116602483015260048201928352604482018b90529092169163c214e5e5918b918b918b918190606401858580828437600081840152601f19601f820116905080830192505050945050505050602060405180830381600087803b1580156145af57600080fd5b505af11580156145c3573d6000803e3d6000fd5b505050506040513d60208110156145d957600080fd5b50519050801561243e5760068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261243e93909290918301828280156146705780601f1061464557610100808354040283529160200191614670565b820191906000526020600020905b81548152906001019060200180831161465357829003601f168201915b5050505050876001617fc0565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b1580156146cb57600080fd5b505afa1580156146df573d6000803e3d6000fd5b505050506040513d60208110156146f557600080fd5b50516001600160a01b0316331461474057604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b808361474c8282615059565b151560011461478f57604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b8461479981617d12565b15156001146147e05760408051600160e51b62461bcd02815260206004820152601a60248201526000805160206183a9833981519152604482015290519081900360640190fd5b6147ea8787613ef1565b15156001146148435760408051600160e51b62461bcd02815260206004820152601d60248201527f6f7065726174696f6e2063616e6e6f7420626520706572666f726d6564000000604482015290519081900360640190fd5b61484d8587618156565b15156001146148a65760408051600160e51b62461bcd02815260206004820152601460248201527f726f6c6520646f6573206e6f7420657869737473000000000000000000000000604482015290519081900360640190fd5b6001546000906001600160a01b031663be322e5487896148c581618171565b6040518463ffffffff1660e01b815260040180806020018060200180602001848103845287818151815260200191508051906020019080838360005b83811015614919578181015183820152602001614901565b50505050905090810190601f1680156149465780820380516001836020036101000a031916815260200191505b50848103835286518152865160209182019188019080838360005b83811015614979578181015183820152602001614961565b50505050905090810190601f1680156149a65780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b838110156149d95781810151838201526020016149c1565b50505050905090810190601f168015614a065780820380516001836020036101000a031916815260200191505b50965050505050505060206040518083038186803b158015614a2757600080fd5b505afa158015614a3b573d6000803e3d6000fd5b505050506040513d6020811015614a5157600080fd5b505160008054604051600160e21b63050e95810281526001600160a01b038c81166004830190815285151560648401526080602484019081528d5160848501528d51969750919093169463143a5604948e948e948e948a9492939092604483019260a401916020890191908190849084905b83811015614adb578181015183820152602001614ac3565b50505050905090810190601f168015614b085780820380516001836020036101000a031916815260200191505b50838103825285518152855160209182019187019080838360005b83811015614b3b578181015183820152602001614b23565b50505050905090810190601f168015614b685780820380516001836020036101000a031916815260200191505b509650505050505050600060405180830381600087803b158015612dec57600080fd5b600a5460009060ff161515614ba25750600161504e565b60005460408051600160e11b637ea7d02d0281526001600160a01b038b811660048301529151919092169163fd4fa05a916024808301926020929190829003018186803b158015614bf257600080fd5b505afa158015614c06573d6000803e3d6000fd5b505050506040513d6020811015614c1c57600080fd5b50516002141561504a576000805460408051600160e01b636acee5fd0281526001600160a01b038c81166004830152915160609485949390931692636acee5fd9260248082019391829003018186803b158015614c7857600080fd5b505afa158015614c8c573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f191682016040908152811015614cb557600080fd5b810190808051600160201b811115614ccc57600080fd5b82016020810184811115614cdf57600080fd5b8151600160201b811182820187101715614cf857600080fd5b50509291906020018051600160201b811115614d1357600080fd5b82016020810184811115614d2657600080fd5b8151600160201b811182820187101715614d3f57600080fd5b5050929190505050915091506060614d5683618171565b60048054604051600160e01b633fd62ae702815260209281018381528751602483015287519495506001600160a01b0390921693633fd62ae793889392839260449091019185019080838360005b83811015614dbc578181015183820152602001614da4565b50505050905090810190601f168015614de95780820380516001836020036101000a031916815260200191505b509250505060206040518083038186803b158015614e0657600080fd5b505afa158015614e1a573d6000803e3d6000fd5b505050506040513d6020811015614e3057600080fd5b50511561504657614e408b616372565b80614e505750614e508b84615059565b15614e61576001935050505061504e565b60016001600160a01b038b161515614e7b57506002614e85565b8515614e85575060035b600154604051600160e11b6368fbbc33028152606481018390526080600482019081528551608483015285516001600160a01b039093169263d1f778669287928992889288929182916024810191604482019160a4019060208a019080838360005b83811015614eff578181015183820152602001614ee7565b50505050905090810190601f168015614f2c5780820380516001836020036101000a031916815260200191505b50848103835287518152875160209182019189019080838360005b83811015614f5f578181015183820152602001614f47565b50505050905090810190601f168015614f8c5780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b83811015614fbf578181015183820152602001614fa7565b50505050905090810190601f168015614fec5780820380516001836020036101000a031916815260200191505b5097505050505050505060206040518083038186803b15801561500e57600080fd5b505afa158015615022573d6000803e3d6000fd5b505050506040513d602081101561503857600080fd5b5051945061504e9350505050565b5050505b5060005b979650505050505050565b600080546001600160a01b031663e8b42bf4848461507681618171565b6040518463ffffffff1660e01b815260040180846001600160a01b03166001600160a01b031681526020018060200180602001838103835285818151815260200191508051906020019080838360005b838110156150de5781810151838201526020016150c6565b50505050905090810190601f16801561510b5780820380516001836020036101000a031916815260200191505b50838103825284518152845160209182019186019080838360005b8381101561513e578181015183820152602001615126565b50505050905090810190601f16801561516b5780820380516001836020036101000a031916815260200191505b509550505050505060206040518083038186803b15801561518b57600080fd5b505afa15801561519f573d6000803e3d6000fd5b505050506040513d60208110156151b557600080fd5b5051156151c457506001613fd9565b6001546000805460408051600160e01b6381d66b230281526001600160a01b03888116600483015291519482169463be322e549493909216926381d66b2392602480840193829003018186803b15801561521d57600080fd5b505afa158015615231573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052602081101561525a57600080fd5b810190808051600160201b81111561527157600080fd5b8201602081018481111561528457600080fd5b8151600160201b81118282018710171561529d57600080fd5b5050929190505050846152af86618171565b6040518463ffffffff1660e01b815260040180806020018060200180602001848103845287818151815260200191508051906020019080838360005b838110156153035781810151838201526020016152eb565b50505050905090810190601f1680156153305780820380516001836020036101000a031916815260200191505b50848103835286518152865160209182019188019080838360005b8381101561536357818101518382015260200161534b565b50505050905090810190601f1680156153905780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b838110156153c35781810151838201526020016153ab565b50505050905090810190601f1680156153f05780820380516001836020036101000a031916815260200191505b50965050505050505060206040518083038186803b158015613faa57600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561545f57600080fd5b505afa158015615473573d6000803e3d6000fd5b505050506040513d602081101561548957600080fd5b50516001600160a01b031633146154d457604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b806154de81616372565b151560011461552157604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b60068054604080516020601f600260001961010060018816150201909516949094049384018190048102820181019092528281526155bb93909290918301828280156155ae5780601f10615583576101008083540402835291602001916155ae565b820191906000526020600020905b81548152906001019060200180831161559157829003601f168201915b5050505050836005617ec6565b1561577457600360009054906101000a90046001600160a01b03166001600160a01b03166337d50b27878787878c60056040518763ffffffff1660e01b81526004018080602001806020018761ffff1661ffff1681526020018661ffff1661ffff1681526020018060200185815260200184810384528a818151815260200191508051906020019080838360005b83811015615661578181015183820152602001615649565b50505050905090810190601f16801561568e5780820380516001836020036101000a031916815260200191505b5084810383528951815289516020918201918b019080838360005b838110156156c15781810151838201526020016156a9565b50505050905090810190601f1680156156ee5780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b83811015615721578181015183820152602001615709565b50505050905090810190601f16801561574e5780820380516001836020036101000a031916815260200191505b509950505050505050505050600060405180830381600087803b15801561202657600080fd5b50505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b1580156157cb57600080fd5b505afa1580156157df573d6000803e3d6000fd5b505050506040513d60208110156157f557600080fd5b50516001600160a01b0316331461584057604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460009060ff161561588c5760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b60048054604051600160e01b639e58eb9f028152602481018690526044810185905260609281019283526006805460026000196001831615610100020190911604606483018190526001600160a01b0390931693639e58eb9f93919288928892918291608490910190869080156159445780601f1061591957610100808354040283529160200191615944565b820191906000526020600020905b81548152906001019060200180831161592757829003601f168201915b5050945050505050600060405180830381600087803b15801561596657600080fd5b505af115801561597a573d6000803e3d6000fd5b505060018054600954604051600160e01b637b71357902815260448101829052606481018490526084810184905260a0600482019081526007805460026000198289161561010002019091160460a484018190526001600160a01b039095169750637b71357996509460069490928392918291602481019160c49091019089908015615a475780601f10615a1c57610100808354040283529160200191615a47565b820191906000526020600020905b815481529060010190602001808311615a2a57829003601f168201915b5050838103825287546002600019610100600184161502019091160480825260209091019088908015615abb5780601f10615a9057610100808354040283529160200191615abb565b820191906000526020600020905b815481529060010190602001808311615a9e57829003601f168201915b5050975050505050505050600060405180830381600087803b158015615ae057600080fd5b505af1158015615af4573d6000803e3d6000fd5b505060005460408051600160e01b63cef7f6af028152600481019182526007805460026000196001831615610100020190911604604483018190526001600160a01b03909416955063cef7f6af94509260089291829160248201916064019086908015615ba25780601f10615b7757610100808354040283529160200191615ba2565b820191906000526020600020905b815481529060010190602001808311615b8557829003601f168201915b5050838103825284546002600019610100600184161502019091160480825260209091019085908015615c165780601f10615beb57610100808354040283529160200191615c16565b820191906000526020600020905b815481529060010190602001808311615bf957829003601f168201915b5050945050505050600060405180830381600087803b158015615c3857600080fd5b505af1158015615774573d6000803e3d6000fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015615c9a57600080fd5b505afa158015615cae573d6000803e3d6000fd5b505050506040513d6020811015615cc457600080fd5b50516001600160a01b03163314615d0f57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b80615d1981616372565b1515600114615d5c57604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b8260011480615d6b5750826002145b1515615dc15760408051600160e51b62461bcd02815260206004820152601560248201527f4f7065726174696f6e206e6f7420616c6c6f7765640000000000000000000000604482015290519081900360640190fd5b6000808460011415615dd95750600290506003615dea565b8460021415615dea57506003905060055b615e2b87878080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152508592506182ad915050565b1515600114615e845760408051600160e51b62461bcd02815260206004820152601560248201527f6f7065726174696f6e206e6f7420616c6c6f7765640000000000000000000000604482015290519081900360640190fd5b60068054604080516020601f60026000196101006001881615020190951694909404938401819004810282018101909252828152615f1d9390929091830182828015615f115780601f10615ee657610100808354040283529160200191615f11565b820191906000526020600020905b815481529060010190602001808311615ef457829003601f168201915b50505050508584617ec6565b15615774576004805460408051600160e01b6314f775f902815260248101899052928301908152604483018990526001600160a01b03909116916314f775f9918a918a918a918190606401858580828437600081840152601f19601f820116905080830192505050945050505050600060405180830381600087803b15801561202657600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015615ff357600080fd5b505afa158015616007573d6000803e3d6000fd5b505050506040513d602081101561601d57600080fd5b50516001600160a01b0316331461606857604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b6160728188615059565b15156001146160b557604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b81600114806160c45750816002145b806160cf5750816003145b151561610f57604051600160e51b62461bcd0281526004018080602001828103825260258152602001806183e96025913960400191505060405180910390fd5b600354604051600160e01b6337d50b2702815261ffff80871660448301528516606482015260a4810184905260c060048201908152885160c483015288516001600160a01b03909316926337d50b27928a928a928a928a928f928b9282916024820191608481019160e49091019060208c0190808383600083811015615661578181015183820152602001615649565b600a5460068054604080516020601f6002600019600187161561010002019095169490940493840181900481028201810190925282815260609485948594600094919360079360089360ff9091169286918301828280156162415780601f1061621657610100808354040283529160200191616241565b820191906000526020600020905b81548152906001019060200180831161622457829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959950889450925084019050828280156162cf5780601f106162a4576101008083540402835291602001916162cf565b820191906000526020600020905b8154815290600101906020018083116162b257829003601f168201915b5050855460408051602060026001851615610100026000190190941693909304601f81018490048402820184019092528181529598508794509250840190508282801561635d5780601f106163325761010080835404028352916020019161635d565b820191906000526020600020905b81548152906001019060200180831161634057829003601f168201915b50505050509150935093509350935090919293565b604080516020808201908152600780546002600019610100600184161502019091160493830184905260009390928291606090910190849080156163f75780601f106163cc576101008083540402835291602001916163f7565b820191906000526020600020905b8154815290600101906020018083116163da57829003601f168201915b505060408051601f19818403018152828252805160209091012060008054600160e01b6381d66b230285526001600160a01b038a8116600487015293519297509290921694506381d66b239350602480840193829003018186803b15801561645e57600080fd5b505afa158015616472573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052602081101561649b57600080fd5b810190808051600160201b8111156164b257600080fd5b820160208101848111156164c557600080fd5b8151600160201b8111828201871017156164de57600080fd5b50509291905050506040516020018080602001828103825283818151815260200191508051906020019080838360005b8381101561652657818101518382015260200161650e565b50505050905090810190601f1680156165535780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120149050919050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b1580156165c457600080fd5b505afa1580156165d8573d6000803e3d6000fd5b505050506040513d60208110156165ee57600080fd5b50516001600160a01b0316331461663957604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8061664381616372565b151560011461668657604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b600360009054906101000a90046001600160a01b03166001600160a01b03166337d50b27878787878c60046040518763ffffffff1660e01b81526004018080602001806020018761ffff1661ffff1681526020018661ffff1661ffff1681526020018060200185815260200184810384528a818151815260200191508051906020019080838360005b8381101561672757818101518382015260200161670f565b50505050905090810190601f1680156167545780820380516001836020036101000a031916815260200191505b5084810383528951815289516020918201918b019080838360005b8381101561678757818101518382015260200161676f565b50505050905090810190601f1680156167b45780820380516001836020036101000a031916815260200191505b50848103825286518152865160209182019188019080838360005b838110156167e75781810151838201526020016167cf565b50505050905090810190601f1680156168145780820380516001836020036101000a031916815260200191505b509950505050505050505050600060405180830381600087803b15801561683a57600080fd5b505af115801561684e573d6000803e3d6000fd5b505060028054604051600160e01b63e98ac22d02815260006064820181905260056084830181905260a0600484019081526006805460001960018216156101000201169690960460a485018190526001600160a01b03909516975063e98ac22d96508e948e9482916024820191604481019160c4909101908a9080156169155780601f106168ea57610100808354040283529160200191616915565b820191906000526020600020905b8154815290600101906020018083116168f857829003601f168201915b505084810383528851815288516020918201918a019080838360005b83811015616949578181015183820152602001616931565b50505050905090810190601f1680156169765780820380516001836020036101000a031916815260200191505b50848103825287518152875160209182019189019080838360005b838110156169a9578181015183820152602001616991565b50505050905090810190601f1680156169d65780820380516001836020036101000a031916815260200191505b5098505050505050505050600060405180830381600087803b15801561202657600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b158015616a4957600080fd5b505afa158015616a5d573d6000803e3d6000fd5b505050506040513d6020811015616a7357600080fd5b50516001600160a01b03163314616abe57604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b600a5460ff161515600114616b0b5760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b616b1481616372565b1515600114616b5757604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b60028054604051600160e01b63e98ac22d0281526001600160a01b03858116606483015260016084830181905260a06004840190815260068054600019818516156101000201169690960460a48501819052929094169463e98ac22d9490938d938d938a939092909182916024810191604482019160c401908a908015616c1f5780601f10616bf457610100808354040283529160200191616c1f565b820191906000526020600020905b815481529060010190602001808311616c0257829003601f168201915b505084810383528851815288516020918201918a019080838360005b83811015616c53578181015183820152602001616c3b565b50505050905090810190601f168015616c805780820380516001836020036101000a031916815260200191505b50848103825287518152875160209182019189019080838360005b83811015616cb3578181015183820152602001616c9b565b50505050905090810190601f168015616ce05780820380516001836020036101000a031916815260200191505b5098505050505050505050600060405180830381600087803b158015616d0557600080fd5b505af1158015616d19573d6000803e3d6000fd5b505060048054604051600160e01b63f9953de502815260209281018381528c5160248301528c516001600160a01b03909316955063f9953de594508c93909283926044019185019080838360005b83811015616d7f578181015183820152602001616d67565b50505050905090810190601f168015616dac5780820380516001836020036101000a031916815260200191505b5092505050600060405180830381600087803b158015616dcb57600080fd5b505af1158015616ddf573d6000803e3d6000fd5b5050600354604051600160e01b63549583df02815261ffff80891660448301528716606482015260a0600482019081528a5160a48301528a516001600160a01b03909316945063549583df93508a928a928a928a928f9282916024820191608481019160c49091019060208b019080838360005b83811015616e6b578181015183820152602001616e53565b50505050905090810190601f168015616e985780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b83811015616ecb578181015183820152602001616eb3565b50505050905090810190601f168015616ef85780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b83811015616f2b578181015183820152602001616f13565b50505050905090810190601f168015616f585780820380516001836020036101000a031916815260200191505b5098505050505050505050600060405180830381600087803b158015616f7d57600080fd5b505af1158015616f91573d6000803e3d6000fd5b50505050616f9f8288613ef1565b1515600114616ff85760408051600160e51b62461bcd02815260206004820152601d60248201527f4f7065726174696f6e2063616e6e6f7420626520706572666f726d6564000000604482015290519081900360640190fd5b60008054604051600160e01b63e3483a9d0281526001600160a01b03858116600483019081526001606484018190526080602485019081528d5160848601528d51939095169563e3483a9d9589958f9560089593604483019260a4019160208901918190849084905b83811015617079578181015183820152602001617061565b50505050905090810190601f1680156170a65780820380516001836020036101000a031916815260200191505b508381038252855460026000196101006001841615020190911604808252602090910190869080156171195780601f106170ee57610100808354040283529160200191617119565b820191906000526020600020905b8154815290600101906020018083116170fc57829003601f168201915b50509650505050505050600060405180830381600087803b15801561202657600080fd5b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561718b57600080fd5b505afa15801561719f573d6000803e3d6000fd5b505050506040513d60208110156171b557600080fd5b50516001600160a01b0316331461720057604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b8561720a81617d12565b15156001146172515760408051600160e51b62461bcd02815260206004820152601a60248201526000805160206183a9833981519152604482015290519081900360640190fd5b61725b8288615059565b151560011461729e57604051600160e51b62461bcd02815260040180806020018281038252602281526020018061845c6022913960400191505060405180910390fd5b600354604051600160e01b634c57331102815261ffff80871660448301528516606482015260a060048201908152885160a483015288516001600160a01b0390931692634c573311928a928a928a928a928f92909182916024820191608481019160c49091019060208b019080838360005b83811015617328578181015183820152602001617310565b50505050905090810190601f1680156173555780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b83811015617388578181015183820152602001617370565b50505050905090810190601f1680156173b55780820380516001836020036101000a031916815260200191505b5084810382528551815285516020918201918701908083836000838110156169a9578181015183820152602001616991565b600254604051600160e21b62539ab302815260206004820190815260248201849052606092839260009283926001600160a01b03169163014e6acc9189918991908190604401848480828437600081840152601f19601f820116905080830192505050935050505060006040518083038186803b15801561746757600080fd5b505afa15801561747b573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f1916820160405260808110156174a457600080fd5b810190808051600160201b8111156174bb57600080fd5b820160208101848111156174ce57600080fd5b8151600160201b8111828201871017156174e757600080fd5b50509291906020018051600160201b81111561750257600080fd5b8201602081018481111561751557600080fd5b8151600160201b81118282018710171561752e57600080fd5b50506020820151604090920151949b909a5090985092965091945050505050565b6005546001600160a01b031633146175b15760408051600160e51b62461bcd02815260206004820152600e60248201527f696e76616c69642063616c6c6572000000000000000000000000000000000000604482015290519081900360640190fd5b600a5460009060ff16156175fd5760408051600160e51b62461bcd02815260206004820152601d60248201526000805160206183c9833981519152604482015290519081900360640190fd5b6176096006898961831a565b506176166007878761831a565b506176236008858561831a565b5050600a805460ff1916911515919091179055505050505050565b600560009054906101000a90046001600160a01b03166001600160a01b031663e572515c6040518163ffffffff1660e01b815260040160206040518083038186803b15801561768c57600080fd5b505afa1580156176a0573d6000803e3d6000fd5b505050506040513d60208110156176b657600080fd5b50516001600160a01b0316331461770157604051600160e51b62461bcd02815260040180806020018281038252602881526020018061840e6028913960400191505060405180910390fd5b61770a81616372565b151560011461774d57604051600160e51b62461bcd0281526004018080602001828103825260268152602001806184366026913960400191505060405180910390fd5b6177588760016182ad565b15156001146177b15760408051600160e51b62461bcd02815260206004820152601260248201527f4e6f7468696e6720746f20617070726f76650000000000000000000000000000604482015290519081900360640190fd5b60068054604080516020601f6002600019610100600188161502019095169490940493840181900481028201810190925282815261784b939092909183018282801561783e5780601f106178135761010080835404028352916020019161783e565b820191906000526020600020905b81548152906001019060200180831161782157829003601f168201915b5050505050826001617ec6565b156157745760048054604051600160e11b637181418b02815260209281018381528a5160248301528a516001600160a01b039093169363e3028316938c9383926044909101919085019080838360005b838110156178b357818101518382015260200161789b565b50505050905090810190601f1680156178e05780820380516001836020036101000a031916815260200191505b5092505050600060405180830381600087803b1580156178ff57600080fd5b505af1158015617913573d6000803e3d6000fd5b505060018054600954604051600160e01b637b71357902815260448101829052606481018490526084810184905260a0600482019081526008805460026000198289161561010002019091160460a484018190526001600160a01b039095169750637b7135799650948e9490928392918291602481019160c490910190899080156179df5780601f106179b4576101008083540402835291602001916179df565b820191906000526020600020905b8154815290600101906020018083116179c257829003601f168201915b5050838103825287518152875160209182019189019080838360005b83811015617a135781810151838201526020016179fb565b50505050905090810190601f168015617a405780820380516001836020036101000a031916815260200191505b50975050505050505050600060405180830381600087803b158015617a6457600080fd5b505af1158015617a78573d6000803e3d6000fd5b5050600354604051600160e21b633e0b822b02815261ffff80891660448301528716606482015260a0600482019081528a5160a48301528a516001600160a01b03909316945063f82e08ac93508a928a928a928a928f9282916024820191608481019160c49091019060208b019080838360005b83811015617b04578181015183820152602001617aec565b50505050905090810190601f168015617b315780820380516001836020036101000a031916815260200191505b5084810383528851815288516020918201918a019080838360005b83811015617b64578181015183820152602001617b4c565b50505050905090810190601f168015617b915780820380516001836020036101000a031916815260200191505b50848103825285518152855160209182019187019080838360005b83811015617bc4578181015183820152602001617bac565b50505050905090810190601f168015617bf15780820380516001836020036101000a031916815260200191505b5098505050505050505050600060405180830381600087803b158015617c1657600080fd5b505af1158015617c2a573d6000803e3d6000fd5b50506000805460408051600160e01b63c214e5e50281526001600160a01b038881166024830152600482019283528d5160448301528d519316955063c214e5e594508c9388938392606401916020870191908190849084905b83811015617c9b578181015183820152602001617c83565b50505050905090810190601f168015617cc85780820380516001836020036101000a031916815260200191505b509350505050602060405180830381600087803b158015617ce857600080fd5b505af1158015617cfc573d6000803e3d6000fd5b505050506040513d602081101561273c57600080fd5b6004805460408051600160e01b638c8642df0281526002602482018190529381019182528451604482015284516000946001600160a01b0390941693638c8642df93879391929091829160649091019060208601908083838c5b83811015617d84578181015183820152602001617d6c565b50505050905090810190601f168015617db15780820380516001836020036101000a031916815260200191505b50935050505060206040518083038186803b158015617dcf57600080fd5b505afa158015617de3573d6000803e3d6000fd5b505050506040513d6020811015617df957600080fd5b505192915050565b600480546040517fffe40d1d00000000000000000000000000000000000000000000000000000000815260209281018381528451602483015284516000946001600160a01b039094169363ffe40d1d9387939283926044909201918501908083838b5b83811015617e7c578181015183820152602001617e64565b50505050905090810190601f168015617ea95780820380516001836020036101000a031916815260200191505b509250505060206040518083038186803b158015617dcf57600080fd5b600254604051600160e21b632c084e190281526001600160a01b03848116602483015260448201849052606060048301908152865160648401528651600094929092169263b02138649288928892889282916084019060208701908083838d5b83811015617f3e578181015183820152602001617f26565b50505050905090810190601f168015617f6b5780820380516001836020036101000a031916815260200191505b50945050505050602060405180830381600087803b158015617f8c57600080fd5b505af1158015617fa0573d6000803e3d6000fd5b505050506040513d6020811015617fb657600080fd5b5051949350505050565b801561809a5760025460408051600160e01b635607395b0281526001600160a01b03858116602483015260048201928352865160448301528651931692635607395b9287928792829160640190602086019080838360005b83811015618030578181015183820152602001618018565b50505050905090810190601f16801561805d5780820380516001836020036101000a031916815260200191505b509350505050600060405180830381600087803b15801561807d57600080fd5b505af1158015618091573d6000803e3d6000fd5b50505050618151565b60025460408051600160e11b632ce5eb7f0281526001600160a01b038581166024830152600482019283528651604483015286519316926359cbd6fe9287928792829160640190602086019080838360005b838110156181045781810151838201526020016180ec565b50505050905090810190601f1680156181315780820380516001836020036101000a031916815260200191505b509350505050600060405180830381600087803b158015615c3857600080fd5b505050565b6001546000906001600160a01b031663abf5739f84846152af815b60048054604051600160e11b630bbe46c502815260209281018381528451602483015284516060946001600160a01b039094169363177c8d8a93879392839260449092019185019080838360005b838110156181d75781810151838201526020016181bf565b50505050905090810190601f1680156182045780820380516001836020036101000a031916815260200191505b509250505060006040518083038186803b15801561822157600080fd5b505afa158015618235573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052602081101561825e57600080fd5b810190808051600160201b81111561827557600080fd5b8201602081018481111561828857600080fd5b8151600160201b8111828201871017156182a157600080fd5b50909695505050505050565b6004805460408051600160e01b638c8642df028152602481018590529283019081528451604484015284516000936001600160a01b0390931692638c8642df9287928792829160649091019060208601908083838c83811015613f5f578181015183820152602001613f47565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061835b5782800160ff19823516178555618388565b82800160010185558215618388579182015b8281111561838857823582559160200191906001019061836d565b50612f68926132c09250905b80821115612f68576000815560010161839456fe6f7267206e6f7420696e20617070726f76656420737461747573000000000000496e636f7272656374206e6574776f726b20626f6f7420737461747573000000696e76616c696420616374696f6e2e206f7065726174696f6e206e6f7420616c6c6f77656463616e2062652063616c6c656420627920696e7465726661636520636f6e7472616374206f6e6c796163636f756e74206973206e6f742061206e6574776f726b2061646d696e206163636f756e746163636f756e74206973206e6f742061206f72672061646d696e206163636f756e74a165627a7a72305820076e128f2df9e9b08b731798802e430db87855f1ea7dd2b592784c23ef6897aa0029"
+var PermImplBin = "0x6080346200010957601f620056bd38819003918201601f19168301916001600160401b038311848410176200010e5780849260c09460405283398101031262000109576200004d816200013d565b906200005c602082016200013d565b6200006a604083016200013d565b62000078606084016200013d565b926200009560a06200008d608084016200013d565b92016200013d565b92600360095560ff19600a5416600a5560018060a01b03948580948180948160018060a01b03199b168b6005541617600555168960045416176004551687600154161760015516856000541617600055168360025416176002551690600354161760035560405161556a9081620001538239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fd5b51906001600160a01b0382168203620001095756fe608080604052600436101561001357600080fd5b600090813560e01c90816304e81f1e146141b75750806312e1ee83146140c15780631b04c27614613f0f5780631b61022014613add5780631c2499121461395f5780632f29069c1461384a5780633cf5f33b146136a6578063404bf3eb1461349b57806344478e79146133ba57806345a59e5b146133585780634b20f45f146131c05780634cbfa82e1461319d5780634fe57e7a14612fe85780635038bf2b14612e8557806357f835ce14612df55780635ca5adbe14612beb57806368a61273146128fc5780636b568d76146128e25780637a84f0f3146127a65780638683c7fe1461263557806388843041146123415780638baa81911461203a578063936421d514611fe85780639b0fbfd814611eb45780639bd3810114611ea0578063a042bf4014611cba578063a5843f0814611ae2578063b5546564146117f1578063b9b7fe6c14611614578063c02e7bb21461147b578063cc9ba6fa146113ed578063d1aa0c20146113c4578063d621d957146111f0578063e699480014610fd3578063e91b0e1914610cd5578063ecad01d514610b9d578063f346a3a714610a57578063f5ad584a146105fa5763f75f0a06146101ce57600080fd5b3461052c576101dc366146ea565b9190939460018060a01b0393846005541696604051809863395c945760e21b825281600460209b8c935afa80156105ef5787908c906105b3575b610223925016331461479d565b600161023a8161023288614d85565b151514614ab7565b610267898b89600454166040518080958194638c8642df60e01b83526040600484015260448301906146c5565b87602483015203915afa801561053657829160009161057c575b501515036105425788876040516102a28161029b81614a18565b0382614542565b6102d260008360025416926040519b8c9586948593632c084e1960e21b85526060600486015260648501906146c5565b9116602483015287604483015203925af18015610536578b966000916104f2575b506102fc578580f35b8660045416803b156104ee57868b610332928c83604051809681958294637181418b60e11b8452600484015260248301906146c5565b03925af19081156104e35787916104cf575b50508681541660095491813b156104cb578791610395838e60405196879586948593637b71357960e01b855260a0600486015261038360a4860161499a565b858103600319016024870152906146c5565b916044840152806064840152608483015203925af19081156104c05786916104ac575b5050856003541690813b156104a8578580946103eb8c60405198899788968795633e0b822b60e21b875260048701614b12565b03925af1801561049d57610481575b5093839291610423955416908660405180978195829463c214e5e560e01b845260048401614b68565b03925af180156104765761043e575b80808481808080808580f35b81813d831161046f575b6104528183614542565b8101031261046b5761046390614b5b565b503880610432565b5080fd5b503d610448565b6040513d85823e3d90fd5b61048d90939293614519565b610499579084386103fa565b8480fd5b6040513d84823e3d90fd5b8580fd5b6104b590614519565b6104995784386103b8565b6040513d88823e3d90fd5b8780fd5b6104d890614519565b6104a8578538610344565b6040513d89823e3d90fd5b8680fd5b919293949596508982813d831161052f575b61050e8183614542565b8101031261052c5750906105268b9695949392614b5b565b386102f3565b80fd5b503d610504565b6040513d6000823e3d90fd5b60405162461bcd60e51b8152600481018a905260126024820152714e6f7468696e6720746f20617070726f766560701b6044820152606490fd5b91508a82813d83116105ac575b6105938183614542565b8101031261052c57506105a68291614b5b565b38610281565b503d610589565b50508881813d83116105e8575b6105ca8183614542565b810103126105e457866105df61022392614789565b610216565b8a80fd5b503d6105c0565b6040513d8d823e3d90fd5b503461052c57608036600319011261052c576001600160401b039060043582811161046b5761062d903690600401614331565b9092602435818111610a5357610647903690600401614331565b9490926044358381116104a857610662903690600401614331565b93909661066d6143e2565b9560059360018060a01b038554163303610a1d5761069060ff600a5416156147fa565b838111610a0957806106a3600654614846565b96601f978881116109b1575b508a9088831160011461093e578b92610933575b50508160011b916000199060031b1c1916176006555b82821161091f5781906106ed600754614846565b8681116108c7575b508890868311600114610854578992610849575b50508160011b916000199060031b1c1916176007555b831161083557610730600854614846565b908282116107f1575b5050839082116001146107855783948293949261077a575b50508160011b916000199060031b1c1916176008555b60ff8019600a54169115151617600a5580f35b013590503880610751565b601f198216946000805160206154f583398151915291855b8781106107d95750836001959697106107bf575b505050811b01600855610767565b0135600019600384901b60f8161c191690553880806107b1565b9092602060018192868601358155019401910161079d565b6000805160206154f58339815191529083808601821c8301936020871061082c575b01901c01905b8181101561073957858155600101610819565b93508293610813565b634e487b7160e01b85526041600452602485fd5b013590503880610709565b60078a526000805160206154d5833981519152925090601f1984168a5b8181106108af5750908460019594939210610895575b505050811b0160075561071f565b0135600019600384901b60f8161c19169055388080610887565b91936020600181928787013581550195019201610871565b909150600789526000805160206154d583398151915286808501871c82019260208610610916575b90859493929101871c01905b81811061090857506106f5565b8a81558493506001016108fb565b925081926108ef565b634e487b7160e01b88526041600452602488fd5b0135905038806106c3565b60068c52600080516020615515833981519152925090601f1984168c5b818110610999575090846001959493921061097f575b505050811b016006556106d9565b0135600019600384901b60f8161c19169055388080610971565b9193602060018192878701358155019501920161095b565b90915060068b5260008051602061551583398151915288808501891c82019260208610610a00575b90859493929101891c01905b8181106109f257506106af565b8c81558493506001016109e5565b925081926109d9565b634e487b7160e01b89526041600452602489fd5b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b8380fd5b503461052c57602036600319011261052c576001600160401b036004358282821161052c57610a8d610ac4923690600401614331565b60025460405162539ab360e21b815260206004820152946001600160a01b0394938693928616928492839290916024840191614a96565b03915afa908115610b925784938580948194610b17575b505050610b07610af9956040519687966080885260808801906146c5565b9086820360208801526146c5565b9216604084015260608301520390f35b93509350945092503d8083833e610b2e8183614542565b810193608082860312610b8e578151818111610a535785610b50918401614cf5565b92602083015191821161052c5750610af994610b6d918301614cf5565b92610b076060610b7f60408501614789565b93015193949293929538610adb565b8280fd5b6040513d86823e3d90fd5b503461052c5780610bad36614612565b60055460405163395c945760e21b815292969394926001600160a01b0392916020908290600490829087165afa908115610cca57859184918b91610c77575b5091610c2693610c04610c1e9360019516331461479d565b610c1984610c118461510b565b151514614c4f565b614e45565b151514614bd1565b6003541691823b156104ee578694610c56869260405198899788968795634c57331160e01b875260048701614b12565b03925af1801561049d57610c675750f35b610c7090614519565b61052c5780f35b925050506020813d8211610cc2575b81610c9360209383614542565b81010312610cbe57610c1e85610c2693610c0486610cb2600196614789565b93955093505093610bec565b8880fd5b3d9150610c86565b6040513d8b823e3d90fd5b503461052c5780610ce5366146ea565b60055460405163395c945760e21b81526001600160a01b039996989793969495929091602090839060049082908e165afa8015610f67578a928691610f86575b50600191610d3c610d53946102329316331461479d565b610d4e8360ff600a54161515146147fa565b614d85565b876002541696873b15610a535760405163e98ac22d60e01b815260a06004820152899885908290818381610db4610da28f610d9060a48501614a18565b848103600319016024860152906146c5565b8281036003190160448401528b6146c5565b9e8d169e8f60648301526001608483015203925af1908115610f67578591610f72575b50508860045416803b156104995760405163f9953de560e01b8152602060048201529085908290818381610e0e602482018f6146c5565b03925af1908115610f67578591610f53575b5050886003541690813b1561049957848094610e538a604051998a978896879563549583df60e01b875260048701614b12565b03925af1918215610f46578492610f2f575b5050610e7390600192614ff0565b151503610eea57839283541691823b15610ee557610ecd92849283610ebc936040519687958694859363e3483a9d60e01b855260048501526080602485015260848401906146c5565b82810360031901604484015261499a565b6001606483015203925af1801561049d57610c675750f35b505050fd5b60405162461bcd60e51b815260206004820152601d60248201527f4f7065726174696f6e2063616e6e6f7420626520706572666f726d65640000006044820152606490fd5b610f3a919250614519565b61049957818538610e65565b50604051903d90823e3d90fd5b610f5c90614519565b610a53578338610e20565b6040513d87823e3d90fd5b610f7b90614519565b610a53578338610dd7565b9250506020823d602011610fcb575b81610fa260209383614542565b8101031261049957610232600191610d3c8c610fc0610d5396614789565b935094505091610d25565b3d9150610f95565b503461052c5760c036600319011261052c57610fed6143b6565b90610ff661435e565b916001600160401b03604435818111610a5357611017903690600401614331565b906064358381116104a857611030903690600401614331565b92909380608435116104ee573660236084350112156104ee5760843560040135116104a8573660246084356004013560051b6084350101116104a8576110746143a0565b60055460405163395c945760e21b815290602090829060049082906001600160a01b03165afa9081156111e557889161119b575b506102326110c492610d4e600193848060a01b0316331461479d565b85546001600160a01b031696873b156104ee5760405163095591bf60e31b81526001600160a01b0396871660048201529516602486015260a0604486015260209361112e939092909161111c9160a488019190614a96565b85810360031901606487015291614a96565b60031983820301608484015260843560040135815201602460843501835b608435600401358110611174575050818394818581819503925af1801561049d57610c675750f35b9091823563ffffffff60e01b81168091036104a8578152602090810192019060010161114c565b90506020813d6020116111dd575b816111b660209383614542565b810103126104cb576102326110c492610d4e6111d3600194614789565b93505092506110a8565b3d91506111a9565b6040513d8a823e3d90fd5b503461052c5780600461120236614612565b60055460405163395c945760e21b8152959792959394939260209184919082906001600160a01b03165afa9182156111e557889261137b575b50610232600191610d4e61125794848060a01b0316331461479d565b6003546001600160a01b0316803b156104ee5761129d87876112d36112af978397604051998a98899788966337d50b2760e01b885260c0600489015260c48801906146c5565b868103600319016024880152906146c5565b61ffff918216604486015291166064840152828103600319016084840152896146c5565b600460a483015203925af1908115610b92578491611363575b50506002546001600160a01b031691823b15610ee557611345926113338580946040519687958694859363e98ac22d60e01b855260a0600486015261038360a48601614a18565b838103600319016044850152906146c5565b8260648301526005608483015203925af1801561049d57610c675750f35b61136c90614519565b6113775782386112ec565b5050fd5b91506020823d82116113bc575b8161139560209383614542565b810103126104cb57610232600191610d4e6113b261125795614789565b945050915061123b565b3d9150611388565b503461052c57602036600319011261052c5760206113e3610d4e6143b6565b6040519015158152f35b503461052c578060031936011261052c57610af960ff600a54166040519061141f8261141881614a18565b0383614542565b61146f6040516114328161029b8161491c565b6114616040519161144d836114468161499a565b0384614542565b6040519687966080885260808801906146c5565b9084820360408601526146c5565b90151560608301520390f35b503461052c578060031960e036820112611611576001600160401b0390600435828111610ee5576114b0903690600401614331565b919092602435908111610499576114cb903690600401614331565b9190936114d66143cc565b60055460405163395c945760e21b81526001600160a01b039290916020908390600490829087165afa8015610cca5783928a916115ce575b506115369261151f9116331461479d565b61152881614d85565b9081156115b2575b50614bd1565b6003541691823b156104ee578694611581869261157296604051998a9889978896633021183f60e01b885260c0600489015260c4880191614a96565b92858403016024860152614a96565b60443560448301526064356064830152608435608483015260a43560a483015203925af1801561049d57610c675750f35b6115c891506115c236888b61457e565b90614e45565b38611530565b9250506020823d8211611609575b816115e960209383614542565b81010312610cbe5761151f8361160161153694614789565b91509261150e565b3d91506115dc565b50fd5b503461052c578060031960e036820112611611576001600160401b0390600435828111610ee5576116499036906004016145b5565b91602435818111610499576116629036906004016145b5565b906044359081116104995761167b9036906004016145b5565b90611684614508565b9361168d6144f7565b9360a4359261169a6143cc565b60055460405163395c945760e21b81526001600160a01b0392916020908290600490829087165afa9081156105ef57869184918d916117a2575b50916116ec93610c19610c1e9360019516331461479d565b600185148015611798575b801561178e575b61170790614c9b565b6003541693843b15610cbe5761177789976117479589956117579b6040519c8d9b8c9a8b996337d50b2760e01b8b5260c060048c015260c48b01906146c5565b90848a83030160248b01526146c5565b9361ffff80921660448901521660648701528583030160848601526146c5565b9060a483015203925af1801561049d57610c675750f35b50600385146116fe565b50600285146116f7565b925050506020813d82116117e9575b816117be60209383614542565b810103126105e457610c1e866116ec93610c19866117dd600196614789565b939550935050936116d4565b3d91506117b1565b503461052c57611800366144a0565b9392909160018060a01b038060055416604051809163395c945760e21b825281600460209485935afa80156104e35783908890611aaa575b611845925016331461479d565b611853600161023289614d85565b60018514808015611aa0575b15611a63578690819015611a4c57505060029660035b826118ae61188436888a61457e565b866004541690604051809581948293638c8642df60e01b84526040600485015260448401906146c5565b90602483015203915afa801561053657600090611a17575b600191501515036119da5782829161191460006040516118e98161029b81614a18565b8460025416936040519d8e9687958694632c084e1960e21b86526060600487015260648601906146c5565b92166024840152604483015203925af1908115610536576000916119a6575b5085965061193f578480f35b60045416803b156104995784928361197693604051968795869485936314f775f960e01b8552604060048601526044850191614a96565b90602483015203925af1801561049d57611992575b8080808480f35b61199b90614519565b61052c57803861198b565b87813d83116119d3575b6119ba8183614542565b8101031261052c57506119cd8596614b5b565b38611933565b503d6119b0565b60405162461bcd60e51b81526004810183905260156024820152741bdc195c985d1a5bdb881b9bdd08185b1b1bddd959605a1b6044820152606490fd5b8382813d8311611a45575b611a2c8183614542565b8101031261052c5750611a40600191614b5b565b6118c6565b503d611a22565b600287999299036118755750600397506005611875565b60405162461bcd60e51b815260048101839052601560248201527413dc195c985d1a5bdb881b9bdd08185b1b1bddd959605a1b6044820152606490fd5b506002861461185f565b50508181813d8311611adb575b611ac18183614542565b810103126104ee5782611ad661184592614789565b611838565b503d611ab7565b503461052c57806003196040368201126116115760055460405163395c945760e21b81526001600160a01b03916020908290600490829086165afa8015610b925782908590611c7e575b611b39925016331461479d565b611b4860ff600a5416156147fa565b8060045416803b15610ee557836040518092639e58eb9f60e01b825260606004830152818381611b7a60648201614a18565b6004356024830152602435604483015203925af1908115610b92578491611c6a575b5050806001541660095490803b1561049957849182916040518094818094637b71357960e01b825260a06004830152611be9611bda60a4840161491c565b8b848203016024850152614a18565b906044830152600160648301526001608483015203925af1908115610b92578491611c56575b505416803b1561137757610c56839291839260405194858094819363cef7f6af60e01b835260406004840152611c476044840161491c565b9083820301602484015261499a565b611c5f90614519565b611377578238611c0f565b611c7390614519565b611377578238611b9c565b50506020813d8211611cb2575b81611c9860209383614542565b81010312610ee55781611cad611b3992614789565b611b2c565b3d9150611c8b565b503461052c57611cc936614612565b95949060018060a0969594961b038060055416604051809163395c945760e21b825281600460209485935afa8015610cca5783908a90611e68575b611d11925016331461479d565b611d1f60016102328b614d85565b8082604051611d318161029b81614a18565b611d6160008360025416926040519e8f9586948593632c084e1960e21b85526060600486015260648501906146c5565b911660248301526005604483015203925af190811561053657600091611e34575b50879850611d8e578680f35b6003541691823b156104ee578694611e01611dce938793611de1976040519a8b998a9889976337d50b2760e01b895260c060048a015260c48901906146c5565b60031993848983030160248a01526146c5565b9361ffff80921660448801521660648601528483030160848501526146c5565b600560a483015203925af1801561049d57611e20575b80808080808680f35b611e2990614519565b61052c578038611e17565b89813d8311611e61575b611e488183614542565b8101031261052c5750611e5b8798614b5b565b38611d82565b503d611e3e565b50508181813d8311611e99575b611e7f8183614542565b81010312610cbe5782611e94611d1192614789565b611d04565b503d611e75565b503461052c5760206113e36115c2366145d3565b503461052c5760e036600319011261052c5780611ecf6143b6565b6024356001600160401b03811161137757611eee903690600401614331565b611ef99291926143cc565b60055460405163395c945760e21b81526001600160a01b039290916020908390600490829087165afa80156104e35783928891611fa5575b50611f5892611f429116331461479d565b611f4b81614d85565b908115611f955750614bd1565b8085541690813b156104a8578580946115816040519788968795869463de81152360e01b865216600485015260c0602485015260c4840191614a96565b6115c891506115c236868961457e565b9250506020823d8211611fe0575b81611fc060209383614542565b810103126104ee57611f4283611fd8611f5894614789565b915092611f31565b3d9150611fb3565b503461052c5760c036600319011261052c576120026143b6565b9061200b61435e565b9060a435906001600160401b03821161052c5760206113e385856120323660048801614331565b929050615240565b503461052c576003199060803683011261052c576120566143b6565b6001600160401b03602435818111610a53576120769036906004016145b5565b90604435908111610a535761208f9036906004016145b5565b90612098614374565b60055460405163395c945760e21b8152602095926001600160a01b039290919087908290600490829087165afa9081156111e557859184918a916122f6575b50916120ef93610c19610c1e9360019516331461479d565b6120fd6001610c118561510b565b60016121098484614ff0565b1515036122b15780600154169661213f866121238661515c565b6040518093819263abf5739f60e01b8352898b60048501614d37565b03818c5afa80156111e557889061227c575b600191501515036122405761218986889961216b8761515c565b906040518080958194632f8c8b9560e21b83528b8d60048501614d37565b03915afa9687156111e5578897612208575b50508187541692833b156104cb576121e0966121ef89979388946040519a8b998a98899763050e958160e21b89521660048801526080602488015260848701906146c5565b918583030160448601526146c5565b901515606483015203925af1801561049d57610c675750f35b9080929750813d8311612239575b6122208183614542565b810103126104ee5761223190614b5b565b94388061219b565b503d612216565b60405162461bcd60e51b8152600481018790526014602482015273726f6c6520646f6573206e6f742065786973747360601b6044820152606490fd5b508681813d83116122aa575b6122928183614542565b810103126104cb576122a5600191614b5b565b612151565b503d612288565b60405162461bcd60e51b815260048101869052601d60248201527f6f7065726174696f6e2063616e6e6f7420626520706572666f726d65640000006044820152606490fd5b925050508681813d831161233a575b61230f8183614542565b810103126104cb57610c1e856120ef93610c198661232e600196614789565b939550935050936120d7565b503d612305565b503461052c57612350366143f1565b91909260018060a01b0391826005541693604051809563395c945760e21b825281600460209889935afa80156111e557859089906125fd575b612396925016331461479d565b6123a4600161023283614d85565b84846040516123b68161029b81614a18565b6123e6600083600254169260405196879586948593632c084e1960e21b85526060600486015260648501906146c5565b911660248301526004604483015203925af1908115610536576000916125c8575b50612410578580f35b8286541690604080518093631d09dc9360e01b8252876004830152818a8161243c60248201888b614a96565b03925af19283156104e35785928890899561257d575b50612507575b6124899293508785815416926040519687958694859363c214e5e560e01b8552604060048601526044850191614a96565b908a16602483015203925af1918215610b925784926124d1575b50506124b2575b808080808580f35b6124cb906040516124c68161029b81614a18565b61507c565b386124aa565b90809250813d8311612500575b6124e88183614542565b81010312610b8e576124f990614b5b565b38806124a3565b503d6124de565b91506040516125198161029b81614a18565b846002541693843b156125785761254a9460009283604051809881958294632ce5eb7f60e11b845260048401614b68565b03925af191821561053657859361248993612569575b50839250612458565b61257290614519565b38612560565b600080fd5b94505091506040833d6040116125c0575b8161259b60409383614542565b810103126104ee5784916125b9836125b286614b5b565b9501614789565b9338612452565b3d915061258e565b908582813d83116125f6575b6125de8183614542565b8101031261052c57506125f090614b5b565b38612407565b503d6125d4565b50508581813d831161262e575b6126148183614542565b810103126104cb578461262961239692614789565b612389565b503d61260a565b503461052c5780600319608036820112611611576001600160401b0390600435828111610ee55761266a903690600401614331565b91909260243590811161049957612685903690600401614331565b9361268e6144e6565b612696614508565b60055460405163395c945760e21b81529196916001600160a01b03916020908290600490829086165afa801561279b5782908b9061275b575b6126dc925016331461479d565b6126eb60ff600a5416156147fa565b6003541693843b15610cbe57889661272b96889461273b610c56946040519c8d9b8c9a8b99634530abe160e01b8b5260a060048c015260a48b0191614a96565b91858984030160248a0152614a96565b9261ffff8092166044870152166064850152838203016084840152614a18565b50506020813d8211612793575b8161277560209383614542565b8101031261278f578161278a6126dc92614789565b6126cf565b8980fd5b3d9150612768565b6040513d8c823e3d90fd5b503461052c57606036600319011261052c57806004356001600160401b038111611611576127d8903690600401614331565b6127e061438a565b60055460405163395c945760e21b81526001600160a01b039290916020908390600490829087165afa80156104c0578392879161289f575b5061283f926128299116331461479d565b61283281614d85565b90811561288f5750614bd1565b6004541691823b15610ee55761287692849283604051809681958294635e5eebf360e11b8452604060048501526044840191614a96565b602435602483015203925af1801561049d57610c675750f35b6115c891506115c236868861457e565b9250506020823d82116128da575b816128ba60209383614542565b810103126104a857612829836128d261283f94614789565b915092612818565b3d91506128ad565b503461052c5760206113e36128f6366145d3565b90614ff0565b503461052c5760e036600319011261052c576001600160401b03600435818111610b8e5761292e903690600401614331565b919060243582811161049957612948903690600401614331565b9190936044358481116104ee57612963903690600401614331565b946064359081116104cb5761297c903690600401614331565b9390956129876144f7565b9560a4359261ffff84168403612578578a9960046129a36143cc565b60055460405163395c945760e21b81529260209184919082906001600160a01b03165afa8015612be05789928e91612b81575b50600191612a1283612a0a612a05612a1d976129fd610c1e97858060a01b0316331461479d565b36908b61457e565b6150d0565b151514614b90565b6115c2368c8861457e565b6004546001600160a01b0316803b15612b7d578b84612a569284838c60405196879586948593623f2a6960e71b85528c60048601614c28565b03925af1908115612b72578c91612b5a575b50509082602192612aa494896040519a8b956020870137840191601760f91b602084015285830137018b83820152036001810187520185614542565b80612aad578780f35b6003546001600160a01b031693843b15610cbe5788968793604051998a9889978896634c57331160e01b88526004880160a0905260a4880190612aef92614a96565b90600319878303016024880152612b0592614a96565b9161ffff16604485015261ffff166064840152600319838203016084840152612b2d916146c5565b03925af1801561049d57612b46575b8080808080808780f35b612b4f90614519565b61052c578038612b3c565b612b6690939293614519565b6105e457908a38612a68565b6040513d8e823e3d90fd5b8b80fd5b9250506020823d602011612bd8575b81612b9d60209383614542565b81010312612bd457610c1e600191612a1283612a0a612a058e6129fd612bc5612a1d9a614789565b975050975050505050916129d6565b8c80fd5b3d9150612b90565b6040513d8f823e3d90fd5b503461052c57612bfa36614442565b949391909260018060a01b03806005541696604051809863395c945760e21b825281600460209b8c935afa9081156111e5579083918991612dac575b50612c6f92612c4d600193610c1e9316331461479d565b612c6483610c11612c5f368d8c61457e565b61510b565b6115c2368a8961457e565b60405187810188815281612c87604082018689614a96565b0391612c9b601f1993848101835282614542565b519020604051898101908a8252612cc481612cb86040820161491c565b03858101835282614542565b51902014159081612d4d575b5015612d08578596506001541690813b156104a857858094610c566040519788968795869463531a180960e11b865260048601614c28565b60405162461bcd60e51b815260048101889052601d60248201527f61646d696e20726f6c65732063616e6e6f742062652072656d6f7665640000006044820152606490fd5b905060405188810190898252612d7781612d6b60408201888b614a96565b03848101835282614542565b51902090604051612da18a8201928b845282612d956040820161499a565b03908101835282614542565b519020141538612cd0565b8092508a8092503d8311612dee575b612dc58183614542565b810103126104cb57610c1e612c6f92612c4d85612de3600195614789565b935093505092612c36565b503d612dbb565b503461052c576020908160031936011261052c57600254604051632bfc1ae760e11b81526004803590820152908390829060249082906001600160a01b03165afa918215612e795791612e4c575b50604051908152f35b90508181813d8311612e72575b612e638183614542565b81010312612578575138612e43565b503d612e59565b604051903d90823e3d90fd5b503461052c5760031960a03682011261046b57612ea06143b6565b90612ea961435e565b6001600160401b0391906044358381116104a857612ecb903690600401614331565b9290936064359081116104ee57612ee6903690600401614331565b608435966001600160a01b039691959187891689036125785760048a9960208a600554166040519384809263395c945760e21b82525afa80156105ef578a928c91612f9c575b50600191610d4e612f43946102329316331461479d565b8789541694853b1561278f5789978995612f8d610c56948b976040519d8e9c8d9b8c9a6334fa741960e21b8c521660048b0152166024890152608060448901526084880191614a96565b92858403016064860152614a96565b9250506020823d8211612fe0575b81612fb760209383614542565b810103126105e457610232600191610d4e8c612fd5612f4396614789565b935094505091612f2c565b3d9150612faa565b503461052c57602090600319828136011261046b576130056143b6565b60055460405163395c945760e21b815291926001600160a01b0392909186908290600490829087165afa8015610f675783908690613165575b61304b925016331461479d565b61305a60ff600a5416156147fa565b61306e836040516124c68161029b81614880565b8184541691823b156104995760405163e3483a9d60e01b815293166004840152608060248401526130a160848401614880565b90838203016044840152600090600754906130bb82614846565b8082529160019081811690811561314557506001146130fa575b505050839450839183838180946002606483015203925af1801561049d57610c675750f35b92509060076000526000805160206154d5833981519152926000935b82851061313257509596879650919092010190508183856130d5565b80548486018a0152938801938101613116565b60ff1916838a015250959687965091151560051b010190508183856130d5565b50508581813d8311613196575b61317c8183614542565b81010312610499578261319161304b92614789565b61303e565b503d613172565b503461052c578060031936011261052c57602060ff600a54166040519015158152f35b503461052c576131cf366143f1565b93929160018060a01b03928360055416604051809163395c945760e21b825281600460209485935afa80156104e35786908890613320575b613214925016331461479d565b613222600161023289614d85565b80856040516132348161029b81614880565b61326460008360025416926040519c8d9586948593632c084e1960e21b85526060600486015260648501906146c5565b911660248301526006604483015203925af1908115610536576000916132ec575b50859650613291578480f35b8385541690813b156104a857856132c99381956040519788968795869463425bd42560e11b8652606060048701526064860191614a96565b911660248301526005604483015203925af1801561049d57611992578080808480f35b87813d8311613319575b6133008183614542565b8101031261052c57506133138596614b5b565b38613285565b503d6132f6565b50508181813d8311613351575b6133378183614542565b810103126104ee578561334c61321492614789565b613207565b503d61332d565b503461052c57606036600319011261052c576001600160401b03600435818111610b8e5761338a903690600401614331565b91909260243591821161052c5760206113e385856133ab3660048801614331565b916133b46144e6565b936151b9565b503461052c578060031936011261052c5760055460405163395c945760e21b8152602092916001600160a01b039084908390600490829085165afa8015610476578390613463575b61340f925016331461479d565b7f04f651be6fb8fc575d94591e56e9f6e66e33ef23e949765918b3bdae50c617cf826001600a549361344460ff8616156147fa565b60ff1985168217600a55604051941617151580845292a1604051908152f35b50908381813d8311613494575b61347a8183614542565b81010312610b8e579061348f61340f92614789565b613402565b503d613470565b503461052c5780600319608036820112611611576001600160401b0390600435828111610ee5576134d0903690600401614331565b6134d861435e565b936044359081116104a8576134f1903690600401614331565b6134fc929192614374565b60055460405163395c945760e21b81526001600160a01b0395929091602090839060049082908a165afa801561279b5786928b9161365a575b5060019161354c61355e946102329316331461479d565b610d4e83612a0a612a05368b8e61457e565b8388541691823b15610cbe576135a592858a8a6135b48b8b9684986040519a8b998a98899763e3483a9d60e01b8952166004880152608060248801528d6084880191614a96565b92858403016044860152614a96565b6001606483015203925af19081156104e3578791613646575b5050816002541691823b156104ee5760208795869261361c98846040519a8b998a9863e98ac22d60e01b8a5260a060048b015261360c60a48b01614880565b91878b84030160248c0152614a96565b8781039485016044890152521660648501526004608485015201925af1801561049d57610c675750f35b61364f90614519565b6104a85785386135cd565b9250506020823d821161369e575b8161367560209383614542565b8101031261278f5761023260019161354c8861369361355e96614789565b935094505091613535565b3d9150613668565b503461052c57806136b6366144a0565b60055460405163395c945760e21b815294602094909390926001600160a01b039286908890600490829087165afa80156111e557889788916137fb575b506001610232889493610d4e8761370c9516331461479d565b82600454166040518098818094630cc2749360e01b825260406004830152613738604483018a8c614a96565b90602483015203925af19485156104c05786956137c9575b506002541690813b156104a85760405163e98ac22d60e01b815260a060048201529486948694859387939084906137a19061378d60a48901614880565b9060031995868a84030160248b0152614a96565b868103938401604488015252836064860152608485015201925af1801561049d57610c675750f35b955093508285813d81116137f4575b6137e28183614542565b81010312612578578594519338613750565b503d6137d8565b9750909190508587813d8111613843575b6138168183614542565b810103126104cb5761370c60016102328894610d4e876138368e9d614789565b95505050939450506136f3565b503d61380c565b503461052c578061385a36614442565b60055460405163395c945760e21b81529295939493926001600160a01b039290916020908390600490829087165afa80156111e5578392899161391c575b506138bf926138a99116331461479d565b6138b281614d85565b90811561390c5750614bd1565b6004541690813b156104a857856138fa93610c568296604051988997889687956302bea8ff60e31b8752604060048801526044870191614a96565b84810360031901602486015291614a96565b6115c891506115c236878761457e565b9250506020823d8211613957575b8161393760209383614542565b810103126104cb576138a98361394f6138bf94614789565b915092613898565b3d915061392a565b503461052c578061396f366143f1565b60055460405163395c945760e21b81529394936001600160a01b039290916020908390600490829087165afa80156104e35783928891613a91575b50600191610d4e6139c1946102329316331461479d565b80855416803b156104a85785604051809263425bd42560e11b825260606004830152818381876139f5606483018d8d614a96565b9916988960248301526004604483015203925af19081156104c0578691613a7d575b505060025416803b156104995784938460208195613a5495604051988997889663e98ac22d60e01b885260a0600489015261378d60a48901614880565b86810393840160448801525260648501526006608485015201925af1801561049d57610c675750f35b613a8690614519565b610499578438613a17565b9250506020823d8211613ad5575b81613aac60209383614542565b810103126104ee57610232600191610d4e85613aca6139c196614789565b9350945050916139aa565b3d9150613a9f565b503461052c57606036600319011261052c576001600160401b039060043582811161046b57613b10903690600401614331565b602493919335828111610a5357613b2b903690600401614331565b916044358481116104a857613b44903690600401614331565b94909360018060a01b03936005938585541660405196879163395c945760e21b835282600460209a8b935afa80156105ef578b90613ed7575b613b8a925016331461479d565b613b9960ff600a5416156147fa565b838111610a095780613bac600654614846565b9a601f9b8c8111613e80575b508a908c8311600114613e0d578b92613e02575b50508160011b916000199060031b1c1916176006555b82821161091f578190613bf6600754614846565b8a8111613dab575b5088908a8311600114613d38578992613d2d575b50508160011b916000199060031b1c1916176007555b841161083557613c39600854614846565b90868211613cea575b505083948311600114613c7e5750928293829392613c73575b50508160011b916000199060031b1c19161760085580f35b013590503880613c5b565b601f198316946000805160206154f5833981519152929185905b878210613cd2575050836001959610613cb8575b505050811b0160085580f35b0135600019600384901b60f8161c19169055388080613cac565b80600184968294958701358155019501920190613c98565b6000805160206154f58339815191529087808701821c830193858810613d24575b01901c01905b81811015613c4257858155600101613d11565b93508293613d0b565b013590503880613c12565b60078a526000805160206154d5833981519152925090601f1984168a5b88828210613d95575050908460019594939210613d7b575b505050811b01600755613c28565b0135600019600384901b60f8161c19169055388080613d6d565b6001849682939587013581550195019201613d55565b909150600789526000805160206154d58339815191528a808501871c820192888610613df9575b90859493929101871c01905b818110613deb5750613bfe565b8a8155849350600101613dde565b92508192613dd2565b013590503880613bcc565b60068c52600080516020615515833981519152925090601f1984168c5b8a828210613e6a575050908460019594939210613e50575b505050811b01600655613be2565b0135600019600384901b60f8161c19169055388080613e42565b6001849682939587013581550195019201613e2a565b90915060068b526000805160206155158339815191528c808501891c8201928a8610613ece575b90859493929101891c01905b818110613ec05750613bb8565b8c8155849350600101613eb3565b92508192613ea7565b50908781813d8311613f08575b613eee8183614542565b810103126105e45790613f03613b8a92614789565b613b7d565b503d613ee4565b503461052c5760031960c03682011261046b576001600160401b03600435818111610a5357613f42903690600401614331565b92909160243590811161049957613f5d903690600401614331565b9092613f676143e2565b9060843593841515809503612578578796613f806143a0565b60055460405163395c945760e21b81526001600160a01b039290916020908390600490829087165afa80156105ef578389918c948e91614061575b506001610c11612c5f613ff097610c1e96613fde859997613fe59716331461479d565b369161457e565b6115c2368b8e61457e565b6001541693843b15610cbe57889661403b889461402c986040519b8c9a8b998a98637b71357960e01b8a5260a060048b015260a48a0191614a96565b92878403016024880152614a96565b91604435604485015215156064840152608483015203925af1801561049d57610c675750f35b94505050506020823d82116140b9575b8161407e60209383614542565b810103126105e457610c1e600191613fe583610c11612c5f8f8e613fde8b6140a8613ff09c614789565b975097995050965097505050613fbb565b3d9150614071565b503461052c57606036600319011261052c57806140dc61438a565b60055460405163395c945760e21b81526001600160a01b039290916020908390600490829087165afa8015610b92578392859161416b575b50600191610d4e61412b946102329316331461479d565b60025416803b1561161157818091604460405180948193632a610a9760e01b8352600435600484015260243560248401525af1801561049d57610c675750f35b9250506020823d82116141af575b8161418660209383614542565b81010312610ee557610232600191610d4e856141a461412b96614789565b935094505091614114565b3d9150614179565b90503461046b57608036600319011261046b57816004356001600160401b03811161046b576141ea903690600401614331565b6141f261435e565b916044356141fe614374565b60055463395c945760e21b88526001600160a01b03979190602090839060049082908c165afa80156104e357889288916142e5575b5060019161424a61425594610c1e9316331461479d565b6115c236888861457e565b6001811480156142db575b80156142d1575b61427090614c9b565b8585541692833b156104a8576142a79486926040519889978896879563425bd42560e11b8752606060048801526064870191614a96565b92166024840152604483015203925af1801561049d576142c5575080f35b6142ce90614519565b80f35b5060038114614267565b5060028114614260565b9250506020823d8211614329575b8161430060209383614542565b810103126104ee57610c1e60019161424a8a61431e61425596614789565b935094505091614233565b3d91506142f3565b9181601f84011215612578578235916001600160401b038311612578576020838186019501011161257857565b602435906001600160a01b038216820361257857565b606435906001600160a01b038216820361257857565b604435906001600160a01b038216820361257857565b60a435906001600160a01b038216820361257857565b600435906001600160a01b038216820361257857565b60c435906001600160a01b038216820361257857565b60643590811515820361257857565b606060031982011261257857600435906001600160401b0382116125785761441b91600401614331565b90916001600160a01b03906024358281168103612578579160443590811681036125785790565b6060600319820112612578576001600160401b0391600435838111612578578261446e91600401614331565b939093926024359182116125785761448891600401614331565b90916044356001600160a01b03811681036125785790565b606060031982011261257857600435906001600160401b038211612578576144ca91600401614331565b9091602435906044356001600160a01b03811681036125785790565b6044359061ffff8216820361257857565b6084359061ffff8216820361257857565b6064359061ffff8216820361257857565b6001600160401b03811161452c57604052565b634e487b7160e01b600052604160045260246000fd5b90601f801991011681019081106001600160401b0382111761452c57604052565b6001600160401b03811161452c57601f01601f191660200190565b92919261458a82614563565b916145986040519384614542565b829481845281830111612578578281602093846000960137010152565b9080601f83011215612578578160206145d09335910161457e565b90565b906040600319830112612578576004356001600160a01b03811681036125785791602435906001600160401b038211612578576145d0916004016145b5565b60c0600319820112612578576001600160401b0391600435838111612578578261463e916004016145b5565b926024358181116125785783614656916004016145b5565b926044359182116125785761466d916004016145b5565b9061ffff90606435828116810361257857916084359081168103612578579060a4356001600160a01b03811681036125785790565b60005b8381106146b55750506000910152565b81810151838201526020016146a5565b906020916146de815180928185528580860191016146a2565b601f01601f1916010190565b60e0600319820112612578576001600160401b03916004358381116125785782614716916004016145b5565b92602435818111612578578361472e916004016145b5565b9260443591821161257857614745916004016145b5565b9061ffff9060643582811681036125785791608435908116810361257857906001600160a01b039060a4358281168103612578579160c43590811681036125785790565b51906001600160a01b038216820361257857565b156147a457565b60405162461bcd60e51b815260206004820152602860248201527f63616e2062652063616c6c656420627920696e7465726661636520636f6e7472604482015267616374206f6e6c7960c01b6064820152608490fd5b1561480157565b60405162461bcd60e51b815260206004820152601d60248201527f496e636f7272656374206e6574776f726b20626f6f74207374617475730000006044820152606490fd5b90600182811c92168015614876575b602083101461486057565b634e487b7160e01b600052602260045260246000fd5b91607f1691614855565b6006546000929161489082614846565b9081815260209260019081811690816000146148ff57506001146148b5575b50505050565b9293945090600660005260008051602061551583398151915292846000945b8386106148eb5750505050010190388080806148af565b8054858701830152940193859082016148d4565b60ff191685840152505090151560051b01019150388080806148af565b6007546000929161492c82614846565b9081815260209260019081811690816000146148ff57506001146149505750505050565b929394509060076000526000805160206154d583398151915292846000945b8386106149865750505050010190388080806148af565b80548587018301529401938590820161496f565b600854600092916149aa82614846565b9081815260209260019081811690816000146148ff57506001146149ce5750505050565b929394509060086000526000805160206154f583398151915292846000945b838610614a045750505050010190388080806148af565b8054858701830152940193859082016149ed565b60065460009291614a2882614846565b9081815260209260019081811690816000146148ff5750600114614a4c5750505050565b9293945090600660005260008051602061551583398151915292846000945b838610614a825750505050010190388080806148af565b805485870183015294019385908201614a6b565b908060209392818452848401376000828201840152601f01601f1916010190565b15614abe57565b60405162461bcd60e51b815260206004820152602660248201527f6163636f756e74206973206e6f742061206e6574776f726b2061646d696e206160448201526518d8dbdd5b9d60d21b6064820152608490fd5b91614b3c90614b2e6145d097959360a0865260a08601906146c5565b9084820360208601526146c5565b9361ffff809216604084015216606082015260808184039101526146c5565b5190811515820361257857565b90614b806020919493946040845260408401906146c5565b6001600160a01b03909416910152565b15614b9757565b60405162461bcd60e51b81526020600482015260126024820152711bdc99c8191bd95cc81b9bdd08195e1a5cdd60721b6044820152606490fd5b15614bd857565b60405162461bcd60e51b815260206004820152602260248201527f6163636f756e74206973206e6f742061206f72672061646d696e206163636f756044820152611b9d60f21b6064820152608490fd5b9290614c41906145d09593604086526040860191614a96565b926020818503910152614a96565b15614c5657565b60405162461bcd60e51b815260206004820152601a60248201527f6f7267206e6f7420696e20617070726f766564207374617475730000000000006044820152606490fd5b15614ca257565b60405162461bcd60e51b815260206004820152602560248201527f696e76616c696420616374696f6e2e206f7065726174696f6e206e6f7420616c6044820152641b1bddd95960da1b6064820152608490fd5b81601f82011215612578578051614d0b81614563565b92614d196040519485614542565b81845260208284010111612578576145d091602080850191016146a2565b91614d5290614b2e6145d095936060865260608601906146c5565b9160408184039101526146c5565b906020828203126125785781516001600160401b038111612578576145d09201614cf5565b60008054604080516381d66b2360e01b81526001600160a01b0394851660048201529390929184916024918391165afa918215614e3a57600092614e17575b5080519182614dde602082019260208452848301906146c5565b0392614df2601f1994858101835282614542565b51902091614e1082519182612d95602082019560208752820161491c565b5190201490565b614e3391923d8091833e614e2b8183614542565b810190614d60565b9038614dc4565b50513d6000823e3d90fd5b600080546001600160a01b0393919290841690614e618361515c565b9260409580875193633a2d0afd60e21b8552169283600482015260606024820152614e8f60648201846146c5565b956003198288030160448301528180614eab6020998a946146c5565b0381885afa908115614fe6578791614fb1575b50614fa5578590600154169260248851809681936381d66b2360e01b835260048301525afa928315614f9b5791849391869593614f229793614f7f575b50614f058161515c565b8851632f8c8b9560e21b8152978894859384939160048501614d37565b03915afa938415614f7457508193614f3b575b50505090565b9091809350813d8311614f6d575b614f538183614542565b8101031261052c5750614f6590614b5b565b388080614f35565b503d614f49565b51913d9150823e3d90fd5b614f949193503d8088833e614e2b8183614542565b9138614efb565b86513d87823e3d90fd5b50505050505050600190565b90508581813d8311614fdf575b614fc88183614542565b810103126104ee57614fd990614b5b565b38614ebe565b503d614fbe565b88513d89823e3d90fd5b600054604080516335ab46bb60e11b81526001600160a01b03938416600482015260248101919091529260209284921690829081906150339060448301906146c5565b03915afa90811561053657600091615049575090565b906020823d8211615074575b8161506260209383614542565b8101031261052c57506145d090614b5b565b3d9150615055565b6002546001600160a01b031691823b15612578576150b49260009283604051809681958294635607395b60e01b845260048401614b68565b03925af18015610536576150c55750565b6150ce90614519565b565b600480546040516001621bf2e360e01b0319815260209281018390529283916001600160a01b031690829081906150339060248301906146c5565b602061513f9160018060a01b03600454166040518080958194638c8642df60e01b83526040600484015260448301906146c5565b6002602483015203915afa90811561053657600091615049575090565b60006151909160018060a01b03600454166040518080958194630bbe46c560e11b83526020600484015260248301906146c5565b03915afa908115610536576000916151a6575090565b6145d0913d8091833e614e2b8183614542565b939190929360ff600a541615615236576020936152079361ffff61521960018060a01b036003541695604051998a98899788976345a59e5b60e01b8952606060048a01526064890191614a96565b86810360031901602488015291614a96565b9116604483015203915afa90811561053657600091615049575090565b5050505050600190565b91909160ff600a5416156154cc5760018060a01b03916000938385541693604094855193637ea7d02d60e11b85528286168060048701526020958681602481875afa9081156154c257908a9291839161548e575b506002146152a9575b50505050505050505090565b6024895180958193636acee5fd60e01b835260048301525afa8015615484578892899161542c575b506152db8361515c565b968460045416878a518092633fd62ae760e01b82528260048301528180615305602482018b6146c5565b03915afa908115615422578b916153ed575b506153245789915061529d565b83615332829b999a9b614d85565b9182156153dc575b50506153ce5785946121e0946153869360019282166153b7575090506153996002915b60015416948b519a8b97889687966368fbbc3360e11b88526080600489015260848801906146c5565b60031993848883030160248901526146c5565b90606483015203915afa938415614f7457508193614f3b5750505090565b6153c5575b6153999061535d565b600391506153bc565b505050505050505050600190565b6153e69250614e45565b833861533a565b90508781813d831161541b575b6154048183614542565b810103126105e45761541590614b5b565b38615317565b503d6153fa565b8a513d8d823e3d90fd5b9250503d8089843e61543e8184614542565b82018783820312610cbe5782516001600160401b03908181116105e45782615467918601614cf5565b93878101519182116105e45761547e929101614cf5565b386152d1565b87513d8a823e3d90fd5b80929350888092503d83116154bb575b6154a88183614542565b8101031261278f57518991906002615294565b503d61549e565b89513d8c823e3d90fd5b50505060019056fea66cc928b5edb82af9bd49922954155ab7b0942694bea4ce44661d9a8736c688f3f7a9fe364faab93b216da50a3214154f22a0a2b415b23a84c8169e8b636ee3f652222313e28459528d920b65115c16c04f3efc82aaedc97be59f3f377c0d3fa26469706673582212209b55e7cd4d34c1335e8e024e8b35c7c8eacbf58fe0af80cea1c4fe4da1df101364736f6c63430008150033"
 
 // DeployPermImpl deploys a new Ethereum contract, binding an instance of PermImpl to it.
 func DeployPermImpl(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address, _orgManager common.Address, _rolesManager common.Address, _accountManager common.Address, _voterManager common.Address, _nodeManager common.Address) (common.Address, *types.Transaction, *PermImpl, error) {
@@ -217,6 +217,32 @@ func (_PermImpl *PermImplCallerSession) ConnectionAllowed(_enodeId string, _ip s
 	return _PermImpl.Contract.ConnectionAllowed(&_PermImpl.CallOpts, _enodeId, _ip, _port)
 }
 
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_PermImpl *PermImplCaller) GetApprovalPolicy(opts *bind.CallOpts, _pendingOp *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _PermImpl.contract.Call(opts, out, "getApprovalPolicy", _pendingOp)
+	return *ret0, err
+}
+
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_PermImpl *PermImplSession) GetApprovalPolicy(_pendingOp *big.Int) (*big.Int, error) {
+	return _PermImpl.Contract.GetApprovalPolicy(&_PermImpl.CallOpts, _pendingOp)
+}
+
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_PermImpl *PermImplCallerSession) GetApprovalPolicy(_pendingOp *big.Int) (*big.Int, error) {
+	return _PermImpl.Contract.GetApprovalPolicy(&_PermImpl.CallOpts, _pendingOp)
+}
+
 // GetNetworkBootStatus is a free data retrieval call binding the contract method 0x4cbfa82e.
 //
 // Solidity: function getNetworkBootStatus() constant returns(bool)
@@ -457,6 +483,27 @@ func (_PermImpl *PermImplTransactorSession) AddAdminNode(_enodeId string, _ip st
 	return _PermImpl.Contract.AddAdminNode(&_PermImpl.TransactOpts, _enodeId, _ip, _port, _raftport)
 }
 
+// AddContractAccess is a paid mutator transaction binding the contract method 0xe6994800.
+//
+// Solidity: function addContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors, address _caller) returns()
+func (_PermImpl *PermImplTransactor) AddContractAccess(opts *bind.TransactOpts, _contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "addContractAccess", _contract, _account, _orgId, _roleId, _selectors, _caller)
+}
+
+// AddContractAccess is a paid mutator transaction binding the contract method 0xe6994800.
+//
+// Solidity: function addContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors, address _caller) returns()
+func (_PermImpl *PermImplSession) AddContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.AddContractAccess(&_PermImpl.TransactOpts, _contract, _account, _orgId, _roleId, _selectors, _caller)
+}
+
+// AddContractAccess is a paid mutator transaction binding the contract method 0xe6994800.
+//
+// Solidity: function addContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) AddContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.AddContractAccess(&_PermImpl.TransactOpts, _contract, _account, _orgId, _roleId, _selectors, _caller)
+}
+
 // AddNewRole is a paid mutator transaction binding the contract method 0x1b04c276.
 //
 // Solidity: function addNewRole(string _roleId, string _orgId, uint256 _access, bool _voter, bool _admin, address _caller) returns()
@@ -520,6 +567,27 @@ func (_PermImpl *PermImplTransactorSession) AddOrg(_orgId string, _enodeId strin
 	return _PermImpl.Contract.AddOrg(&_PermImpl.TransactOpts, _orgId, _enodeId, _ip, _port, _raftport, _account, _caller)
 }
 
+// AddOrgCA is a paid mutator transaction binding the contract method 0x2f29069c.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate, address _caller) returns()
+func (_PermImpl *PermImplTransactor) AddOrgCA(opts *bind.TransactOpts, _orgId string, _certificate []byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "addOrgCA", _orgId, _certificate, _caller)
+}
+
+// AddOrgCA is a paid mutator transaction binding the contract method 0x2f29069c.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate, address _caller) returns()
+func (_PermImpl *PermImplSession) AddOrgCA(_orgId string, _certificate []byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.AddOrgCA(&_PermImpl.TransactOpts, _orgId, _certificate, _caller)
+}
+
+// AddOrgCA is a paid mutator transaction binding the contract method 0x2f29069c.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) AddOrgCA(_orgId string, _certificate []byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.AddOrgCA(&_PermImpl.TransactOpts, _orgId, _certificate, _caller)
+}
+
 // AddSubOrg is a paid mutator transaction binding the contract method 0x68a61273.
 //
 // Solidity: function addSubOrg(string _pOrgId, string _orgId, string _enodeId, string _ip, uint16 _port, uint16 _raftport, address _caller) returns()
@@ -709,6 +777,48 @@ func (_PermImpl *PermImplTransactorSession) Init(_breadth *big.Int, _depth *big.
 	return _PermImpl.Contract.Init(&_PermImpl.TransactOpts, _breadth, _depth)
 }
 
+// RemoveContractAccess is a paid mutator transaction binding the contract method 0x5038bf2b.
+//
+// Solidity: function removeContractAccess(address _contract, address _account, string _orgId, string _roleId, address _caller) returns()
+func (_PermImpl *PermImplTransactor) RemoveContractAccess(opts *bind.TransactOpts, _contract common.Address, _account common.Address, _orgId string, _roleId string, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "removeContractAccess", _contract, _account, _orgId, _roleId, _caller)
+}
+
+// RemoveContractAccess is a paid mutator transaction binding the contract method 0x5038bf2b.
+//
+// Solidity: function removeContractAccess(address _contract, address _account, string _orgId, string _roleId, address _caller) returns()
+func (_PermImpl *PermImplSession) RemoveContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.RemoveContractAccess(&_PermImpl.TransactOpts, _contract, _account, _orgId, _roleId, _caller)
+}
+
+// RemoveContractAccess is a paid mutator transaction binding the contract method 0x5038bf2b.
+//
+// Solidity: function removeContractAccess(address _contract, address _account, string _orgId, string _roleId, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) RemoveContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.RemoveContractAccess(&_PermImpl.TransactOpts, _contract, _account, _orgId, _roleId, _caller)
+}
+
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0x7a84f0f3.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint, address _caller) returns()
+func (_PermImpl *PermImplTransactor) RemoveOrgCA(opts *bind.TransactOpts, _orgId string, _fingerprint [32]byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "removeOrgCA", _orgId, _fingerprint, _caller)
+}
+
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0x7a84f0f3.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint, address _caller) returns()
+func (_PermImpl *PermImplSession) RemoveOrgCA(_orgId string, _fingerprint [32]byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.RemoveOrgCA(&_PermImpl.TransactOpts, _orgId, _fingerprint, _caller)
+}
+
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0x7a84f0f3.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) RemoveOrgCA(_orgId string, _fingerprint [32]byte, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.RemoveOrgCA(&_PermImpl.TransactOpts, _orgId, _fingerprint, _caller)
+}
+
 // RemoveRole is a paid mutator transaction binding the contract method 0x5ca5adbe.
 //
 // Solidity: function removeRole(string _roleId, string _orgId, address _caller) returns()
@@ -730,6 +840,48 @@ func (_PermImpl *PermImplTransactorSession) RemoveRole(_roleId string, _orgId st
 	return _PermImpl.Contract.RemoveRole(&_PermImpl.TransactOpts, _roleId, _orgId, _caller)
 }
 
+// SetAccountValidity is a paid mutator transaction binding the contract method 0x9b0fbfd8.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime, address _caller) returns()
+func (_PermImpl *PermImplTransactor) SetAccountValidity(opts *bind.TransactOpts, _account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "setAccountValidity", _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime, _caller)
+}
+
+// SetAccountValidity is a paid mutator transaction binding the contract method 0x9b0fbfd8.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime, address _caller) returns()
+func (_PermImpl *PermImplSession) SetAccountValidity(_account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.SetAccountValidity(&_PermImpl.TransactOpts, _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime, _caller)
+}
+
+// SetAccountValidity is a paid mutator transaction binding the contract method 0x9b0fbfd8.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) SetAccountValidity(_account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.SetAccountValidity(&_PermImpl.TransactOpts, _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime, _caller)
+}
+
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x12e1ee83.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required, address _caller) returns()
+func (_PermImpl *PermImplTransactor) SetApprovalPolicy(opts *bind.TransactOpts, _pendingOp *big.Int, _required *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "setApprovalPolicy", _pendingOp, _required, _caller)
+}
+
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x12e1ee83.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required, address _caller) returns()
+func (_PermImpl *PermImplSession) SetApprovalPolicy(_pendingOp *big.Int, _required *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.SetApprovalPolicy(&_PermImpl.TransactOpts, _pendingOp, _required, _caller)
+}
+
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x12e1ee83.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) SetApprovalPolicy(_pendingOp *big.Int, _required *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.SetApprovalPolicy(&_PermImpl.TransactOpts, _pendingOp, _required, _caller)
+}
+
 // SetMigrationPolicy is a paid mutator transaction binding the contract method 0xf5ad584a.
 //
 // Solidity: function setMigrationPolicy(string _nwAdminOrg, string _nwAdminRole, string _oAdminRole, bool _networkBootStatus) returns()
@@ -751,6 +903,27 @@ func (_PermImpl *PermImplTransactorSession) SetMigrationPolicy(_nwAdminOrg strin
 	return _PermImpl.Contract.SetMigrationPolicy(&_PermImpl.TransactOpts, _nwAdminOrg, _nwAdminRole, _oAdminRole, _networkBootStatus)
 }
 
+// SetNodeValidity is a paid mutator transaction binding the contract method 0xc02e7bb2.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime, address _caller) returns()
+func (_PermImpl *PermImplTransactor) SetNodeValidity(opts *bind.TransactOpts, _enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.contract.Transact(opts, "setNodeValidity", _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime, _caller)
+}
+
+// SetNodeValidity is a paid mutator transaction binding the contract method 0xc02e7bb2.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime, address _caller) returns()
+func (_PermImpl *PermImplSession) SetNodeValidity(_enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.SetNodeValidity(&_PermImpl.TransactOpts, _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime, _caller)
+}
+
+// SetNodeValidity is a paid mutator transaction binding the contract method 0xc02e7bb2.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime, address _caller) returns()
+func (_PermImpl *PermImplTransactorSession) SetNodeValidity(_enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int, _caller common.Address) (*types.Transaction, error) {
+	return _PermImpl.Contract.SetNodeValidity(&_PermImpl.TransactOpts, _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime, _caller)
+}
+
 // SetPolicy is a paid mutator transaction binding the contract method 0x1b610220.
 //
 // Solidity: function setPolicy(string _nwAdminOrg, string _nwAdminRole, string _oAdminRole) returns()
@@ -28,12 +28,12 @@ var (
 )
 
 // PermInterfaceABI is the input ABI used to generate the binding from.
-const PermInterfaceABI = "[{\"constant\":true,\"inputs\":[],\"name\":\"getPermissionsImpl\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setPolicy\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_pOrgId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveBlacklistedAccountRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"updateNetworkBootStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"getNetworkBootStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_acct\",\"type\":\"address\"}],\"name\":\"addAdminAccount\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_permImplementation\",\"type\":\"address\"}],\"name\":\"setPermImplementation\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_access\",\"type\":\"uint256\"},{\"name\":\"_voter\",\"type\":\"bool\"},{\"name\":\"_admin\",\"type\":\"bool\"}],\"name\":\"addNewRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"approveBlacklistedNodeRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"approveOrgStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"startBlacklistedNodeRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_sender\",\"type\":\"address\"},{\"name\":\"_target\",\"type\":\"address\"},{\"name\":\"_value\",\"type\":\"uint256\"},{\"name\":\"_gasPrice\",\"type\":\"uint256\"},{\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"name\":\"_payload\",\"type\":\"bytes\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"isOrgAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_breadth\",\"type\":\"uint256\"},{\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_roleId\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeRole\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"startBlacklistedAccountRecovery\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateOrgStatus\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isNetworkAdmin\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addNode\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOp\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_ip\",\"type\":\"string\"},{\"name\":\"_port\",\"type\":\"uint16\"},{\"name\":\"_raftport\",\"type\":\"uint16\"},{\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveOrg\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permImplUpgradeable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"}]"
+const PermInterfaceABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permImplUpgradeable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_acct\",\"type\":\"address\"}],\"name\":\"addAdminAccount\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addAdminNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"bytes4[]\",\"name\":\"_selectors\",\"type\":\"bytes4[]\"}],\"name\":\"addContractAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_access\",\"type\":\"uint256\"},{\"internalType\":\"bool\",\"name\":\"_voter\",\"type\":\"bool\"},{\"internalType\":\"bool\",\"name\":\"_admin\",\"type\":\"bool\"}],\"name\":\"addNewRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addNode\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"addOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"bytes\",\"name\":\"_certificate\",\"type\":\"bytes\"}],\"name\":\"addOrgCA\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_pOrgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"addSubOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveBlacklistedAccountRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"approveBlacklistedNodeRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"approveOrg\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"approveOrgStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"assignAccountRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"assignAdminRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"}],\"name\":\"connectionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"getApprovalPolicy\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getNetworkBootStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOp\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getPermissionsImpl\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_breadth\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_depth\",\"type\":\"uint256\"}],\"name\":\"init\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"isNetworkAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"isOrgAdmin\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_contract\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"}],\"name\":\"removeContractAccess\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"bytes32\",\"name\":\"_fingerprint\",\"type\":\"bytes32\"}],\"name\":\"removeOrgCA\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_roleId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"removeRole\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_fromBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_fromTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilTime\",\"type\":\"uint256\"}],\"name\":\"setAccountValidity\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_required\",\"type\":\"uint256\"}],\"name\":\"setApprovalPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_fromBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilBlock\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_fromTime\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_untilTime\",\"type\":\"uint256\"}],\"name\":\"setNodeValidity\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permImplementation\",\"type\":\"address\"}],\"name\":\"setPermImplementation\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_nwAdminOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_nwAdminRole\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_oAdminRole\",\"type\":\"string\"}],\"name\":\"setPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"}],\"name\":\"startBlacklistedAccountRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"}],\"name\":\"startBlacklistedNodeRecovery\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"internalType\":\"address\",\"name\":\"_target\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_value\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasPrice\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_gasLimit\",\"type\":\"uint256\"},{\"internalType\":\"bytes\",\"name\":\"_payload\",\"type\":\"bytes\"}],\"name\":\"transactionAllowed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateAccountStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"updateNetworkBootStatus\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_ip\",\"type\":\"string\"},{\"internalType\":\"uint16\",\"name\":\"_port\",\"type\":\"uint16\"},{\"internalType\":\"uint16\",\"name\":\"_raftport\",\"type\":\"uint16\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateNodeStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"uint256\",\"name\":\"_action\",\"type\":\"uint256\"}],\"name\":\"updateOrgStatus\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"validateAccount\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]"
 
 var PermInterfaceParsedABI, _ = abi.JSON(strings.NewReader(PermInterfaceABI))
 
 // PermInterfaceBin is the compiled bytecode used for deploying new contracts.
-var PermInterfaceBin = "0x608060405234801561001057600080fd5b506040516020806134518339810180604052602081101561003057600080fd5b5051600280546001600160a01b0319166001600160a01b039092169190911790556133f1806100606000396000f3fe608060405234801561001057600080fd5b50600436106101e55760003560e01c806358dcff711161010f578063a5843f08116100a2578063d1aa0c2011610071578063d1aa0c2014611673578063ef5f719614611699578063f346a3a714611858578063fa279d61146119c4576101e5565b8063a5843f08146114ad578063a6343012146114d0578063a97914bf1461158e578063bb3b6e8014611605576101e5565b80638683c7fe116100de5780638683c7fe1461109c57806391ba3f96146111d6578063936421d5146113955780639bd381011461142f576101e5565b806358dcff7114610d745780635be9672c14610f335780636b568d7614610fa157806384b7a84a1461101f576101e5565b806343de646c116101875780634fe57e7a116101565780634fe57e7a14610a90578063511bbd9f14610ab6578063513a327714610adc57806351f604c314610ca6576101e5565b806343de646c146108df57806344478e79146109aa57806345a59e5b146109c65780634cbfa82e14610a88576101e5565b80632e125a6c116101c35780632e125a6c146103955780632f7f0a12146105d95780633e239b23146106a75780633f9be4971461071e576101e5565b806303ed6933146101ea57806316724c441461020e5780631b61022014610287575b600080fd5b6101f2611b8e565b604080516001600160a01b039092168252519081900360200190f35b6102856004803603604081101561022457600080fd5b810190602081018135600160201b81111561023e57600080fd5b82018360208201111561025057600080fd5b803590602001918460018302840111600160201b8311171561027157600080fd5b9193509150356001600160a01b0316611b9d565b005b6102856004803603606081101561029d57600080fd5b810190602081018135600160201b8111156102b757600080fd5b8201836020820111156102c957600080fd5b803590602001918460018302840111600160201b831117156102ea57600080fd5b919390929091602081019035600160201b81111561030757600080fd5b82018360208201111561031957600080fd5b803590602001918460018302840111600160201b8311171561033a57600080fd5b919390929091602081019035600160201b81111561035757600080fd5b82018360208201111561036957600080fd5b803590602001918460018302840111600160201b8311171561038a57600080fd5b509092509050611c4c565b610285600480360360c08110156103ab57600080fd5b810190602081018135600160201b8111156103c557600080fd5b8201836020820111156103d757600080fd5b803590602001918460018302840111600160201b831117156103f857600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561044a57600080fd5b82018360208201111561045c57600080fd5b803590602001918460018302840111600160201b8311171561047d57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156104cf57600080fd5b8201836020820111156104e157600080fd5b803590602001918460018302840111600160201b8311171561050257600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561055457600080fd5b82018360208201111561056657600080fd5b803590602001918460018302840111600160201b8311171561058757600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff8335811694506020909301359092169150611d439050565b610285600480360360608110156105ef57600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561061957600080fd5b82018360208201111561062b57600080fd5b803590602001918460018302840111600160201b8311171561064c57600080fd5b919390929091602081019035600160201b81111561066957600080fd5b82018360208201111561067b57600080fd5b803590602001918460018302840111600160201b8311171561069c57600080fd5b509092509050611f52565b610285600480360360408110156106bd57600080fd5b810190602081018135600160201b8111156106d757600080fd5b8201836020820111156106e957600080fd5b803590602001918460018302840111600160201b8311171561070a57600080fd5b9193509150356001600160a01b0316612033565b610285600480360360c081101561073457600080fd5b810190602081018135600160201b81111561074e57600080fd5b82018360208201111561076057600080fd5b803590602001918460018302840111600160201b8311171561078157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156107d357600080fd5b8201836020820111156107e557600080fd5b803590602001918460018302840111600160201b8311171561080657600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561085857600080fd5b82018360208201111561086a57600080fd5b803590602001918460018302840111600160201b8311171561088b57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff833581169450602084013516926040013591506120c59050565b610285600480360360608110156108f557600080fd5b810190602081018135600160201b81111561090f57600080fd5b82018360208201111561092157600080fd5b803590602001918460018302840111600160201b8311171561094257600080fd5b919390926001600160a01b0383351692604081019060200135600160201b81111561096c57600080fd5b82018360208201111561097e57600080fd5b803590602001918460018302840111600160201b8311171561099f57600080fd5b509092509050612274565b6109b2612333565b604080519115158252519081900360200190f35b6109b2600480360360608110156109dc57600080fd5b810190602081018135600160201b8111156109f657600080fd5b820183602082011115610a0857600080fd5b803590602001918460018302840111600160201b83111715610a2957600080fd5b919390929091602081019035600160201b811115610a4657600080fd5b820183602082011115610a5857600080fd5b803590602001918460018302840111600160201b83111715610a7957600080fd5b91935091503561ffff166123b5565b6109b26124a1565b61028560048036036020811015610aa657600080fd5b50356001600160a01b0316612504565b61028560048036036020811015610acc57600080fd5b50356001600160a01b031661256d565b610285600480360360c0811015610af257600080fd5b810190602081018135600160201b811115610b0c57600080fd5b820183602082011115610b1e57600080fd5b803590602001918460018302840111600160201b83111715610b3f57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610b9157600080fd5b820183602082011115610ba357600080fd5b803590602001918460018302840111600160201b83111715610bc457600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610c1657600080fd5b820183602082011115610c2857600080fd5b803590602001918460018302840111600160201b83111715610c4957600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b031690506125f1565b610285600480360360a0811015610cbc57600080fd5b810190602081018135600160201b811115610cd657600080fd5b820183602082011115610ce857600080fd5b803590602001918460018302840111600160201b83111715610d0957600080fd5b919390929091602081019035600160201b811115610d2657600080fd5b820183602082011115610d3857600080fd5b803590602001918460018302840111600160201b83111715610d5957600080fd5b91935091508035906020810135151590604001351515612691565b610285600480360360a0811015610d8a57600080fd5b810190602081018135600160201b811115610da457600080fd5b820183602082011115610db657600080fd5b803590602001918460018302840111600160201b83111715610dd757600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610e2957600080fd5b820183602082011115610e3b57600080fd5b803590602001918460018302840111600160201b83111715610e5c57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115610eae57600080fd5b820183602082011115610ec057600080fd5b803590602001918460018302840111600160201b83111715610ee157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff83358116945060209093013590921691506127869050565b61028560048036036040811015610f4957600080fd5b810190602081018135600160201b811115610f6357600080fd5b820183602082011115610f7557600080fd5b803590602001918460018302840111600160201b83111715610f9657600080fd5b91935091503561292f565b6109b260048036036040811015610fb757600080fd5b6001600160a01b038235169190810190604081016020820135600160201b811115610fe157600080fd5b820183602082011115610ff357600080fd5b803590602001918460018302840111600160201b8311171561101457600080fd5b5090925090506129bc565b6102856004803603606081101561103557600080fd5b810190602081018135600160201b81111561104f57600080fd5b82018360208201111561106157600080fd5b803590602001918460018302840111600160201b8311171561108257600080fd5b91935091506001600160a01b038135169060200135612a74565b610285600480360360808110156110b257600080fd5b810190602081018135600160201b8111156110cc57600080fd5b8201836020820111156110de57600080fd5b803590602001918460018302840111600160201b831117156110ff57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561115157600080fd5b82018360208201111561116357600080fd5b803590602001918460018302840111600160201b8311171561118457600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff8335811694506020909301359092169150612b2c9050565b610285600480360360a08110156111ec57600080fd5b810190602081018135600160201b81111561120657600080fd5b82018360208201111561121857600080fd5b803590602001918460018302840111600160201b8311171561123957600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561128b57600080fd5b82018360208201111561129d57600080fd5b803590602001918460018302840111600160201b831117156112be57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561131057600080fd5b82018360208201111561132257600080fd5b803590602001918460018302840111600160201b8311171561134357600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff8335811694506020909301359092169150612c629050565b6109b2600480360360c08110156113ab57600080fd5b6001600160a01b0382358116926020810135909116916040820135916060810135916080820135919081019060c0810160a0820135600160201b8111156113f157600080fd5b82018360208201111561140357600080fd5b803590602001918460018302840111600160201b8311171561142457600080fd5b509092509050612cf7565b6109b26004803603604081101561144557600080fd5b6001600160a01b038235169190810190604081016020820135600160201b81111561146f57600080fd5b82018360208201111561148157600080fd5b803590602001918460018302840111600160201b831117156114a257600080fd5b509092509050612dde565b610285600480360360408110156114c357600080fd5b5080359060200135612e62565b610285600480360360408110156114e657600080fd5b810190602081018135600160201b81111561150057600080fd5b82018360208201111561151257600080fd5b803590602001918460018302840111600160201b8311171561153357600080fd5b919390929091602081019035600160201b81111561155057600080fd5b82018360208201111561156257600080fd5b803590602001918460018302840111600160201b8311171561158357600080fd5b509092509050612ed0565b610285600480360360408110156115a457600080fd5b810190602081018135600160201b8111156115be57600080fd5b8201836020820111156115d057600080fd5b803590602001918460018302840111600160201b831117156115f157600080fd5b9193509150356001600160a01b0316612f84565b6102856004803603604081101561161b57600080fd5b810190602081018135600160201b81111561163557600080fd5b82018360208201111561164757600080fd5b803590602001918460018302840111600160201b8311171561166857600080fd5b919350915035613016565b6109b26004803603602081101561168957600080fd5b50356001600160a01b03166130a3565b610285600480360360a08110156116af57600080fd5b810190602081018135600160201b8111156116c957600080fd5b8201836020820111156116db57600080fd5b803590602001918460018302840111600160201b831117156116fc57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b81111561174e57600080fd5b82018360208201111561176057600080fd5b803590602001918460018302840111600160201b8311171561178157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b8111156117d357600080fd5b8201836020820111156117e557600080fd5b803590602001918460018302840111600160201b8311171561180657600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505061ffff83358116945060209093013590921691506131269050565b6118c66004803603602081101561186e57600080fd5b810190602081018135600160201b81111561188857600080fd5b82018360208201111561189a57600080fd5b803590602001918460018302840111600160201b831117156118bb57600080fd5b5090925090506131bb565b604051808060200180602001856001600160a01b03166001600160a01b03168152602001848152602001838103835287818151815260200191508051906020019080838360005b8381101561192557818101518382015260200161190d565b50505050905090810190601f1680156119525780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b8381101561198557818101518382015260200161196d565b50505050905090810190601f1680156119b25780820380516001836020036101000a031916815260200191505b50965050505050505060405180910390f35b610285600480360360c08110156119da57600080fd5b810190602081018135600160201b8111156119f457600080fd5b820183602082011115611a0657600080fd5b803590602001918460018302840111600160201b83111715611a2757600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115611a7957600080fd5b820183602082011115611a8b57600080fd5b803590602001918460018302840111600160201b83111715611aac57600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295949360208101935035915050600160201b811115611afe57600080fd5b820183602082011115611b1057600080fd5b803590602001918460018302840111600160201b83111715611b3157600080fd5b91908080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152509295505050813561ffff908116935060208301351691604001356001600160a01b03169050613325565b6000546001600160a01b031690565b600054604051600160e01b63888430410281526001600160a01b03838116602483015233604483018190526060600484019081526064840187905291909316926388843041928792879287929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b505af1158015611c43573d6000803e3d6000fd5b50505050505050565b600054604051600160e51b62db0811028152606060048201908152606482018890526001600160a01b0390921691631b610220918991899189918991899189918190602481019060448101906084018a8a80828437600083820152601f01601f191690910185810384528881526020019050888880828437600083820152601f01601f191690910185810383528681526020019050868680828437600081840152601f19601f8201169050808301925050509950505050505050505050600060405180830381600087803b158015611d2357600080fd5b505af1158015611d37573d6000803e3d6000fd5b50505050505050505050565b60008054604051600160e01b6368a6127302815261ffff8086166084830152841660a48201523360c4820181905260e0600483019081528a5160e48401528a516001600160a01b03909416946368a61273948c948c948c948c948c948c9483926024820192604483019260648101926101049091019160208f01918190849084905b83811015611ddd578181015183820152602001611dc5565b50505050905090810190601f168015611e0a5780820380516001836020036101000a031916815260200191505b5085810384528b5181528b516020918201918d019080838360005b83811015611e3d578181015183820152602001611e25565b50505050905090810190601f168015611e6a5780820380516001836020036101000a031916815260200191505b5085810383528a5181528a516020918201918c019080838360005b83811015611e9d578181015183820152602001611e85565b50505050905090810190601f168015611eca5780820380516001836020036101000a031916815260200191505b5085810382528951815289516020918201918b019080838360005b83811015611efd578181015183820152602001611ee5565b50505050905090810190601f168015611f2a5780820380516001836020036101000a031916815260200191505b509b505050505050505050505050600060405180830381600087803b158015611d2357600080fd5b600054604051600160e01b638baa81910281526001600160a01b03878116600483019081523360648401819052608060248501908152608485018990529290941693638baa8191938a938a938a938a938a9391929190604481019060a401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505098505050505050505050600060405180830381600087803b15801561201457600080fd5b505af1158015612028573d6000803e3d6000fd5b505050505050505050565b600054604051600160e01b634b20f45f0281526001600160a01b0383811660248301523360448301819052606060048401908152606484018790529190931692634b20f45f928792879287929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b60008054604051600160e21b632e6dff9b02815261ffff80871660648301528516608482015260a481018490523360c4820181905260e0600483019081528a5160e48401528a516001600160a01b039094169463b9b7fe6c948c948c948c948c948c948c94839260248201926044830192610104019160208e0191908190849084905b83811015612160578181015183820152602001612148565b50505050905090810190601f16801561218d5780820380516001836020036101000a031916815260200191505b5084810383528a5181528a516020918201918c019080838360005b838110156121c05781810151838201526020016121a8565b50505050905090810190601f1680156121ed5780820380516001836020036101000a031916815260200191505b5084810382528951815289516020918201918b019080838360005b83811015612220578181015183820152602001612208565b50505050905090810190601f16801561224d5780820380516001836020036101000a031916815260200191505b509a5050505050505050505050600060405180830381600087803b158015611d2357600080fd5b600054604051600160e01b63404bf3eb0281526001600160a01b038581166024830152336064830181905260806004840190815260848401899052919093169263404bf3eb9289928992899289928992918190604481019060a401898980828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505098505050505050505050600060405180830381600087803b15801561201457600080fd5b60008060009054906101000a90046001600160a01b03166001600160a01b03166344478e796040518163ffffffff1660e01b8152600401602060405180830381600087803b15801561238457600080fd5b505af1158015612398573d6000803e3d6000fd5b505050506040513d60208110156123ae57600080fd5b5051905090565b60008054604051600160e01b6345a59e5b02815261ffff84166044820152606060048201908152606482018890526001600160a01b03909216916345a59e5b91899189918991899189919081906024810190608401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f82011690508083019250505097505050505050505060206040518083038186803b15801561246b57600080fd5b505afa15801561247f573d6000803e3d6000fd5b505050506040513d602081101561249557600080fd5b50519695505050505050565b60008060009054906101000a90046001600160a01b03166001600160a01b0316634cbfa82e6040518163ffffffff1660e01b815260040160206040518083038186803b1580156124f057600080fd5b505afa158015612398573d6000803e3d6000fd5b6000805460408051600160e11b6327f2bf3d0281526001600160a01b03858116600483015291519190921692634fe57e7a926024808201939182900301818387803b15801561255257600080fd5b505af1158015612566573d6000803e3d6000fd5b5050505050565b6002546001600160a01b031633146125cf5760408051600160e51b62461bcd02815260206004820152600e60248201527f696e76616c69642063616c6c6572000000000000000000000000000000000000604482015290519081900360640190fd5b600080546001600160a01b0319166001600160a01b0392909216919091179055565b60008054604051600160e01b63e91b0e1902815261ffff8087166064830152851660848201526001600160a01b0384811660a48301523360c4830181905260e0600484019081528b5160e48501528b51929094169463e91b0e19948c948c948c948c948c948c9492939092839260248201926044830192610104019160208e01919081908490849083811015612160578181015183820152602001612148565b600054604051600160e11b630d82613b02815260448101859052831515606482015282151560848201523360a4820181905260c06004830190815260c483018a90526001600160a01b0390931692631b04c276928b928b928b928b928b928b928b9291908190602481019060e4018b8b80828437600083820152601f01601f191690910184810383528981526020019050898980828437600081840152601f19601f8201169050808301925050509a5050505050505050505050600060405180830381600087803b15801561276557600080fd5b505af1158015612779573d6000803e3d6000fd5b5050505050505050505050565b60008054604051600160e61b6302810afd02815261ffff8086166064830152841660848201523360a4820181905260c060048301908152895160c484015289516001600160a01b039094169463a042bf40948b948b948b948b948b949293919283926024810192604482019260e49092019160208d01918190849084905b8381101561281c578181015183820152602001612804565b50505050905090810190601f1680156128495780820380516001836020036101000a031916815260200191505b5084810383528951815289516020918201918b019080838360005b8381101561287c578181015183820152602001612864565b50505050905090810190601f1680156128a95780820380516001836020036101000a031916815260200191505b5084810382528851815288516020918201918a019080838360005b838110156128dc5781810151838201526020016128c4565b50505050905090810190601f1680156129095780820380516001836020036101000a031916815260200191505b509950505050505050505050600060405180830381600087803b15801561201457600080fd5b600054604051600160e21b632d551959028152602481018390523360448201819052606060048301908152606483018690526001600160a01b039093169263b5546564928792879287928190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b6000805460408051600160e11b6335ab46bb0281526001600160a01b03878116600483019081526024830193845260448301879052931692636b568d76928892889288929091606401848480828437600083820152604051601f909101601f1916909201965060209550909350505081840390508186803b158015612a4057600080fd5b505afa158015612a54573d6000803e3d6000fd5b505050506040513d6020811015612a6a57600080fd5b5051949350505050565b600054604051600160e11b6302740f8f0281526001600160a01b0384811660248301526044820184905233606483018190526080600484019081526084840188905291909316926304e81f1e92889288928892889290819060a401878780828437600081840152601f19601f8201169050808301925050509650505050505050600060405180830381600087803b158015612b0e57600080fd5b505af1158015612b22573d6000803e3d6000fd5b5050505050505050565b60008054604051600160e11b634341e3ff02815261ffff8086166044830152841660648201526080600482019081528751608483015287516001600160a01b0390931693638683c7fe93899389938993899391928392602482019260a49092019160208a0191908190849084905b83811015612bb2578181015183820152602001612b9a565b50505050905090810190601f168015612bdf5780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b83811015612c12578181015183820152602001612bfa565b50505050905090810190601f168015612c3f5780820380516001836020036101000a031916815260200191505b509650505050505050600060405180830381600087803b158015612b0e57600080fd5b60008054604051600160e01b63d621d95702815261ffff8086166064830152841660848201523360a4820181905260c060048301908152895160c484015289516001600160a01b039094169463d621d957948b948b948b948b948b949293919283926024810192604482019260e49092019160208d01918190849084908381101561281c578181015183820152602001612804565b60008054604051600160e01b63936421d50281526001600160a01b038a8116600483019081528a82166024840152604483018a9052606483018990526084830188905260c060a4840190815260c48401879052919093169263936421d5928c928c928c928c928c928c928c929060e401848480828437600081840152601f19601f8201169050808301925050509850505050505050505060206040518083038186803b158015612da657600080fd5b505afa158015612dba573d6000803e3d6000fd5b505050506040513d6020811015612dd057600080fd5b505198975050505050505050565b6000805460408051600160e01b639bd381010281526001600160a01b03878116600483019081526024830193845260448301879052931692639bd38101928892889288929091606401848480828437600083820152604051601f909101601f1916909201965060209550909350505081840390508186803b158015612a4057600080fd5b6000805460408051600160e31b6314b087e1028152600481018690526024810185905290516001600160a01b039092169263a5843f089260448084019382900301818387803b158015612eb457600080fd5b505af1158015612ec8573d6000803e3d6000fd5b505050505050565b600054604051600160e11b632e52d6df0281523360448201819052606060048301908152606483018790526001600160a01b0390931692635ca5adbe928892889288928892919081906024810190608401888880828437600083820152601f01601f191690910184810383528681526020019050868680828437600081840152601f19601f820116905080830192505050975050505050505050600060405180830381600087803b158015612b0e57600080fd5b600054604051600160e11b630e124c890281526001600160a01b0383811660248301523360448301819052606060048401908152606484018790529190931692631c249912928792879287929091908190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b600054604051600160e01b633cf5f33b028152602481018390523360448201819052606060048301908152606483018690526001600160a01b0390931692633cf5f33b928792879287928190608401868680828437600081840152601f19601f82011690508083019250505095505050505050600060405180830381600087803b158015611c2f57600080fd5b6000805460408051600160e51b63068d50610281526001600160a01b0385811660048301529151919092169163d1aa0c20916024808301926020929190829003018186803b1580156130f457600080fd5b505afa158015613108573d6000803e3d6000fd5b505050506040513d602081101561311e57600080fd5b505192915050565b60008054604051600160e01b63ecad01d502815261ffff8086166064830152841660848201523360a4820181905260c060048301908152895160c484015289516001600160a01b039094169463ecad01d5948b948b948b948b948b949293919283926024810192604482019260e49092019160208d01918190849084908381101561281c578181015183820152602001612804565b60008054604051600160e01b63f346a3a7028152602060048201908152602482018590526060938493909283926001600160a01b039092169163f346a3a791899189918190604401848480828437600081840152601f19601f820116905080830192505050935050505060006040518083038186803b15801561323d57600080fd5b505afa158015613251573d6000803e3d6000fd5b505050506040513d6000823e601f3d908101601f19168201604052608081101561327a57600080fd5b810190808051600160201b81111561329157600080fd5b820160208101848111156132a457600080fd5b8151600160201b8111828201871017156132bd57600080fd5b50509291906020018051600160201b8111156132d857600080fd5b820160208101848111156132eb57600080fd5b8151600160201b81118282018710171561330457600080fd5b50506020820151604090920151949b909a5090985092965091945050505050565b60008054604051600160e11b637baf850302815261ffff8087166064830152851660848201526001600160a01b0384811660a48301523360c4830181905260e0600484019081528b5160e48501528b51929094169463f75f0a06948c948c948c948c948c948c9492939092839260248201926044830192610104019160208e0191908190849084908381101561216057818101518382015260200161214856fea165627a7a72305820b6e6a59155f89147a70225e82521ad7432c887b50d3e810e125f0b82322d043f0029"
+var PermInterfaceBin = "0x60803461007457601f611e3538819003918201601f19168301916001600160401b038311848410176100795780849260209460405283398101031261007457516001600160a01b0381169081900361007457600280546001600160a01b031916919091179055604051611d8c90816100a98239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe6080604081815260048036101561001557600080fd5b600092833560e01c90816303ed6933146117d35750806315f547f8146117575783816316724c44146117115781631b610220146116455781632a610a97146115e8575080632e125a6c146114b1578381632f7f0a12146114015781633021183f1461135f5781633e239b23146113195781633f9be4971461120a57816343de646c1461114257816344478e79146110d55750806345a59e5b1461101f5780634cbfa82e14610fd3578381634fe57e7a14610f7657508063511bbd9f14610efa57838163513a327714610ead5750806351f604c314610dbc57806357f835ce14610d285783816358dcff7114610cdc5781635be9672c14610c96575080636b568d7614610c555783816384b7a84a14610bb15781638683c7fe14610ade57816391ba3f9614610a9257508063936421d5146109f45780639bd3810114610997578063a3bacbca1461082b57838163a5843f08146107d4578163a634301214610755578163a97914bf1461070f578163ae9348401461062c578163bb3b6e80146105e2578163bcbdd7e61461054b57508063d1aa0c20146104bc57838163de811523146103f8578163ef5f7196146103a857508063f346a3a7146102635763fa279d61146101e057600080fd5b82913461025f576101f0366119d1565b90929391969460018060a01b0389541693843b1561025b578996879361022c928a519b8c998a988997637baf850360e11b895233968901611bcf565b03925af1908115610252575061023f5750f35b610248906118f2565b61024f5780f35b80fd5b513d84823e3d90fd5b8980fd5b5050fd5b50913461024f57602036600319011261024f578235926001600160401b039283851161039a57610298839495369084016117f7565b60018060a09793971b0393602085845416916102cd8651998a958694859463f346a3a760e01b86528501526024840191611b96565b03915afa91821561039e578394849685809561031e575b50505061030f610301968351978897608089526080890190611b71565b908782036020890152611b71565b93169084015260608301520390f35b94509450955093503d8083873e610335818761191b565b850160808682031261039a5785518481116103965781610356918801611cef565b936020870151908111610396579061036f918701611cef565b938186015192818416840361024f575061030f6060610301970151949593949396386102e4565b8380fd5b8280fd5b81513d85823e3d90fd5b9290503461025f576103b936611a61565b939192909560018060a01b0388541692833b156103f45761022c899692879389519a8b988997889663ecad01d560e01b885233958801611c95565b8880fd5b9290503461025f5760c036600319011261025f5761041461186e565b6024356001600160401b0381116104b85761043290369085016117f7565b85549094916001600160a01b039182169190823b156104b457879461047b86928851998a9788968795631361f7fb60e31b8752169085015260e0602485015260e4840191611b96565b60443560448301526064356064830152608435608483015260a43560a48301523360c483015203925af1908115610252575061023f5750f35b8780fd5b8480fd5b50903461039a57602036600319011261039a5760206104d961186e565b8454835163068d506160e51b81526001600160a01b039283169581019590955284916024918391165afa91821561039e576020939261051c575b50519015158152f35b61053d919250833d8111610544575b610535818361191b565b810190611bb7565b9038610513565b503d61052b565b9290503461025f578060031936011261025f5781356001600160401b0381116105dd5761057b90369084016117f7565b84549193916001600160a01b0316803b156105d95760608680946105b8875198899687958694637a84f0f360e01b86528501526064840191611b96565b602435602483015233604483015203925af1908115610252575061023f5750f35b8580fd5b505050fd5b9290503461025f576105f336611add565b9060018060a01b0386541690813b156106285786809461022c875198899687958694633cf5f33b60e01b865233938601611c35565b8680fd5b9290503461025f576003196080368201126105dd5761064961186e565b92610652611884565b936001600160401b03926044358481116104b45761067390369085016117f7565b90946064359081116103f45761068c90369086016117f7565b89546001600160a01b03908116959094909291863b1561070b578b988995878c519d8e9b8c9a8b99635038bf2b60e01b8b5216908901521660248701526044860160a0905260a48601906106df92611b96565b918483030160648501526106f292611b96565b33608483015203925af1908115610252575061023f5750f35b8b80fd5b9290503461025f576107203661189a565b9060018060a01b0386541690813b156106285786809461022c875198899687958694630e124c8960e11b865233938601611c64565b9290503461025f5761076636611824565b86549395936001600160a01b031691823b156104b45787946107bb86926060946107a98a519b8c998a988997632e52d6df60e11b89528801526064870191611b96565b84810360031901602486015291611b96565b33604483015203925af1908115610252575061023f5750f35b9290503461025f576107e5366118dc565b8454909391906001600160a01b0316803b156105d957859283604492865197889586946314b087e160e31b865285015260248401525af1908115610252575061023f5750f35b50919034610993576003199060a03683011261039a5761084961186e565b91610852611884565b906001600160401b03906044358281116106285761087390369089016117f7565b906064358481116103f45761088b9036908b016117f7565b9190926084359686881161098f573660238901121561098f57878c013596871161098f573660248860051b8a01011161098f578a546001600160a01b039081169c909a908d3b1561098b578a51621cd32960eb1b8152918c1690820152991660248a015260c060448a015293949361091d93929161090d9160c48b0191611b96565b91858984030160648a0152611b96565b9185830301608486015280825260246020809301930191865b828110610962575050505082849581868181953360a483015203925af1908115610252575061023f5750f35b90919293843563ffffffff60e01b81168091036103f45781528201938201929190600101610936565b8c80fd5b8a80fd5b5080fd5b50903461039a5760206109a936611b0f565b86548551639bd3810160e01b81529687946001600160a01b0390921693859384936109d8939192918501611d34565b03915afa91821561039e576020939261051c5750519015158152f35b50903461039a5760c036600319011261039a57610a0f61186e565b610a17611884565b9260a435916001600160401b0383116105d957610a39602093369084016117f7565b916109d860018060a01b0392838a5416948489519a8b988997889763936421d560e01b8952169087015216602485015260443560448501526064356064850152608435608485015260c060a485015260c4840191611b96565b9290503461025f57610aa336611a61565b939192909560018060a01b0388541692833b156103f45761022c899692879389519a8b988997889663d621d95760e01b885233958801611c95565b9290503461025f57600319916080368401126105dd576001600160401b0381358181116105d957610b129036908401611957565b906024359081116105d957610b2a9036908401611957565b91610b336119c0565b92610b3c6119af565b9560018060a01b0388541692833b156103f457610b7d978996610b8c88946080968b519c8d9a8b998a98634341e3ff60e11b8a528901526084880190611b71565b91868303016024870152611b71565b9161ffff809216604485015216606483015203925af1908115610252575061023f5750f35b9290503461025f57606036600319011261025f5781356001600160401b0381116105dd57610be290369084016117f7565b610bed939193611884565b85546001600160a01b039081169190823b156104b45787946080928692610c2d89519a8b98899788966302740f8f60e11b88528701526084860191611b96565b91166024830152604435604483015233606483015203925af1908115610252575061023f5750f35b50903461039a576020610c6736611b0f565b865485516335ab46bb60e11b81529687946001600160a01b0390921693859384936109d8939192918501611d34565b9290503461025f57610ca736611add565b9060018060a01b0386541690813b156106285786809461022c875198899687958694632d55195960e21b865233938601611c35565b9290503461025f57610ced36611a61565b939192909560018060a01b0388541692833b156103f45761022c899692879389519a8b98899788966302810afd60e61b885233958801611c95565b50919034610993576020928360031936011261039a5782548251632bfc1ae760e11b81528235928101929092528490829060249082906001600160a01b03165afa928315610db1578093610d7f575b505051908152f35b909192508382813d8311610daa575b610d98818361191b565b8101031261024f575051903880610d77565b503d610d8e565b8251903d90823e3d90fd5b50903461039a576003199060a036830112610396576001600160401b039183358381116105d957610df090369086016117f7565b909360243590811161062857610e0990369087016117f7565b95909260643596871515809803610ea85760843592831515809403610ea85789548a996001600160a01b0390911694853b1561098f57610e7c60c0958c99958a96610e6d8d519e8f9c8d9b8c9a630d82613b60e11b8c528b015260c48a0191611b96565b92878403016024880152611b96565b916044356044850152606484015260848301523360a483015203925af1908115610252575061023f5750f35b600080fd5b9290503461025f57610ebe366119d1565b90929391969460018060a01b0389541693843b1561025b578996879361022c928a519b8c998a98899763e91b0e1960e01b895233968901611bcf565b503461039a57602036600319011261039a57610f1461186e565b6002546001600160a01b03939192919084163303610f4257505082546001600160a01b031916911617815580f35b906020606492519162461bcd60e51b8352820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152fd5b9290503461025f57602036600319011261025f57610f9261186e565b83546001600160a01b03908116803b156105d957859283602492865197889586946327f2bf3d60e11b865216908401525af1908115610252575061023f5750f35b50903461039a578260031936011261039a578254815163265fd41760e11b81529260209184919082906001600160a01b03165afa91821561039e576020939261051c5750519015158152f35b50903461039a5760031991606036840112610396576001600160401b039281358481116105d95761105390369084016117f7565b919094602435908111610628576020936110b261ffff611078606094369085016117f7565b9290956110836119c0565b9360018060a01b038d541697610e6d8c519d8e9b8c9a8b9a6345a59e5b60e01b8c528b015260648a0191611b96565b9116604483015203915afa91821561039e576020939261051c5750519015158152f35b8084843461025f578260031936011261025f57825482516344478e7960e01b8152936020928592909183916001600160a01b03165af191821561039e57602093926111235750519015158152f35b61113b919250833d811161054457610535818361191b565b9083610513565b9290503461025f576003196060368201126105dd576001600160401b0383358181116105d95761117590369086016117f7565b919094611180611884565b916044359081116104b45761119890369083016117f7565b94909160018060a01b03808a541694853b1561098f578a9760809589956111f1946111dc8d519e8f9c8d9b8c9a63404bf3eb60e01b8c528b015260848a0191611b96565b94166024870152858403016044860152611b96565b33606483015203925af1908115610252575061023f5750f35b9290503461025f576003199160c0368401126105dd576001600160401b039080358281116105d95761123f9036908301611957565b90602435838111610628576112579036908301611957565b926044359081116106285761126f9036908301611957565b946112786119af565b9561128161199e565b88546001600160a01b03169390843b1561025b576112c7986112e660e0958c99956112d78b978d519e8f9c8d9b8c9a632e6dff9b60e21b8c528b015260e48a0190611b71565b90848983030160248a0152611b71565b91868303016044870152611b71565b9161ffff809216606485015216608483015260a43560a48301523360c483015203925af1908115610252575061023f5750f35b9290503461025f5761132a3661189a565b9060018060a01b0386541690813b156106285786809461022c875198899687958694634b20f45f60e01b865233938601611c64565b9290503461025f5760031960c0368201126105dd576001600160401b039280358481116105d95761139390369083016117f7565b919094602435908111610628576113ad90369083016117f7565b875491946001600160a01b0390921692833b156103f45761047b60e0938a979388946113f28b519c8d9a8b998a986360173dd960e11b8a5289015260e4880191611b96565b92858403016024860152611b96565b9290503461025f57600319916060368401126105dd5761141f61186e565b90602435936001600160401b03948581116106285761144190369084016117f7565b956044359081116104b45761145990369085016117f7565b9460018060a01b0392838a541694853b1561098f578a9788946114a26111f1948c519d8e9b8c9a8b99638baa819160e01b8b521690890152608060248901526084880191611b96565b92858403016044860152611b96565b50903461039a576003199060c036830112610396576001600160401b039183358381116105d9576114e59036908601611957565b90602435848111610628576114fd9036908701611957565b906044358581116104b4576115159036908801611957565b946064359081116104b45761152d9036908801611957565b9261153661199e565b9160a4359761ffff93848a16809a03610ea8578a548b9a6001600160a01b0390911695863b1561070b578b9889958b519c8d9a8b998a986368a6127360e01b8a52890160e0905260e4890161158a91611b71565b838982030160248a015261159d91611b71565b828882030160448901526115b091611b71565b908682030160648701526115c391611b71565b9216608484015260a48301523360c483015203925af1908115610252575061023f5750f35b9290503461025f576115f9366118dc565b8454909391906001600160a01b0316803b156105d957859283606492865197889586946312e1ee8360e01b865285015260248401523360448401525af1908115610252575061023f5750f35b9290503461025f57600319916060368401126105dd576001600160401b039281358481116105d95761167a90369084016117f7565b6024929192358681116104b45761169490369086016117f7565b966044359081116103f4576116ac90369087016117f7565b959060018060a01b038a541694853b1561098f578a9788948a519b8c998a98899762db081160e51b895288016060905260648801906116ea92611b96565b90848783030160248801526116fe92611b96565b9184830301604485015261022c92611b96565b9290503461025f576117223661189a565b9060018060a01b0386541690813b156106285786809461022c875198899687958694638884304160e01b865233938601611c64565b50903461039a578261176836611824565b8454939692936001600160a01b031692909190833b156105d9576117ad86926060946107a98a519b8c998a988997630bca41a760e21b89528801526064870191611b96565b33604483015203925af190811561025257506117c7575080f35b6117d0906118f2565b80f35b84903461099357816003193601126109935790546001600160a01b03168152602090f35b9181601f84011215610ea8578235916001600160401b038311610ea85760208381860195010111610ea857565b6040600319820112610ea8576001600160401b0391600435838111610ea85782611850916004016117f7565b93909392602435918211610ea85761186a916004016117f7565b9091565b600435906001600160a01b0382168203610ea857565b602435906001600160a01b0382168203610ea857565b6040600319820112610ea857600435906001600160401b038211610ea8576118c4916004016117f7565b90916024356001600160a01b0381168103610ea85790565b6040906003190112610ea8576004359060243590565b6001600160401b03811161190557604052565b634e487b7160e01b600052604160045260246000fd5b90601f801991011681019081106001600160401b0382111761190557604052565b6001600160401b03811161190557601f01601f191660200190565b81601f82011215610ea85780359061196e8261193c565b9261197c604051948561191b565b82845260208383010111610ea857816000926020809301838601378301015290565b6084359061ffff82168203610ea857565b6064359061ffff82168203610ea857565b6044359061ffff82168203610ea857565b60c0600319820112610ea8576001600160401b0391600435838111610ea857826119fd91600401611957565b92602435818111610ea85783611a1591600401611957565b92604435918211610ea857611a2c91600401611957565b9061ffff906064358281168103610ea857916084359081168103610ea8579060a4356001600160a01b0381168103610ea85790565b60a0600319820112610ea8576001600160401b0391600435838111610ea85782611a8d91600401611957565b92602435818111610ea85783611aa591600401611957565b92604435918211610ea857611abc91600401611957565b9061ffff906064358281168103610ea857916084359081168103610ea85790565b6040600319820112610ea857600435906001600160401b038211610ea857611b07916004016117f7565b909160243590565b906040600319830112610ea8576004356001600160a01b0381168103610ea85791602435906001600160401b038211610ea85761186a916004016117f7565b60005b838110611b615750506000910152565b8181015183820152602001611b51565b90602091611b8a81518092818552858086019101611b4e565b601f01601f1916010190565b908060209392818452848401376000828201840152601f01601f1916010190565b90816020910312610ea857518015158103610ea85790565b95939192611bff60c096999894611bf1611c0d9460e08b5260e08b0190611b71565b9089820360208b0152611b71565b908782036040890152611b71565b61ffff9182166060870152961660808501526001600160a01b0391821660a085015216910152565b92949390611c4e90604093606086526060860191611b96565b60208401959095526001600160a01b0316910152565b9290611c7e90604093969596606086526060860191611b96565b6001600160a01b0391821660208501529416910152565b94969592611cc360a09593611cb5611cd19460c08a5260c08a0190611b71565b9088820360208a0152611b71565b908682036040880152611b71565b9561ffff8092166060860152166080840152600180831b0316910152565b81601f82011215610ea8578051611d058161193c565b92611d13604051948561191b565b81845260208284010111610ea857611d319160208085019101611b4e565b90565b6001600160a01b039091168152604060208201819052611d3193910191611b9656fea2646970667358221220ec8f16fbbd892be8773bdfbdd878b83bc8edc265f9e6987f6e3439e70c0745f964736f6c63430008150033"
 
 // DeployPermInterface deploys a new Ethereum contract, binding an instance of PermInterface to it.
 func DeployPermInterface(auth *bind.TransactOpts, backend bind.ContractBackend, _permImplUpgradeable common.Address) (common.Address, *types.Transaction, *PermInterface, error) {
@@ -217,6 +217,32 @@ func (_PermInterface *PermInterfaceCallerSession) ConnectionAllowed(_enodeId str
 	return _PermInterface.Contract.ConnectionAllowed(&_PermInterface.CallOpts, _enodeId, _ip, _port)
 }
 
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_PermInterface *PermInterfaceCaller) GetApprovalPolicy(opts *bind.CallOpts, _pendingOp *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _PermInterface.contract.Call(opts, out, "getApprovalPolicy", _pendingOp)
+	return *ret0, err
+}
+
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_PermInterface *PermInterfaceSession) GetApprovalPolicy(_pendingOp *big.Int) (*big.Int, error) {
+	return _PermInterface.Contract.GetApprovalPolicy(&_PermInterface.CallOpts, _pendingOp)
+}
+
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_PermInterface *PermInterfaceCallerSession) GetApprovalPolicy(_pendingOp *big.Int) (*big.Int, error) {
+	return _PermInterface.Contract.GetApprovalPolicy(&_PermInterface.CallOpts, _pendingOp)
+}
+
 // GetNetworkBootStatus is a free data retrieval call binding the contract method 0x4cbfa82e.
 //
 // Solidity: function getNetworkBootStatus() constant returns(bool)
@@ -449,6 +475,27 @@ func (_PermInterface *PermInterfaceTransactorSession) AddAdminNode(_enodeId stri
 	return _PermInterface.Contract.AddAdminNode(&_PermInterface.TransactOpts, _enodeId, _ip, _port, _raftport)
 }
 
+// AddContractAccess is a paid mutator transaction binding the contract method 0xa3bacbca.
+//
+// Solidity: function addContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors) returns()
+func (_PermInterface *PermInterfaceTransactor) AddContractAccess(opts *bind.TransactOpts, _contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "addContractAccess", _contract, _account, _orgId, _roleId, _selectors)
+}
+
+// AddContractAccess is a paid mutator transaction binding the contract method 0xa3bacbca.
+//
+// Solidity: function addContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors) returns()
+func (_PermInterface *PermInterfaceSession) AddContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte) (*types.Transaction, error) {
+	return _PermInterface.Contract.AddContractAccess(&_PermInterface.TransactOpts, _contract, _account, _orgId, _roleId, _selectors)
+}
+
+// AddContractAccess is a paid mutator transaction binding the contract method 0xa3bacbca.
+//
+// Solidity: function addContractAccess(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors) returns()
+func (_PermInterface *PermInterfaceTransactorSession) AddContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string, _selectors [][4]byte) (*types.Transaction, error) {
+	return _PermInterface.Contract.AddContractAccess(&_PermInterface.TransactOpts, _contract, _account, _orgId, _roleId, _selectors)
+}
+
 // AddNewRole is a paid mutator transaction binding the contract method 0x51f604c3.
 //
 // Solidity: function addNewRole(string _roleId, string _orgId, uint256 _access, bool _voter, bool _admin) returns()
@@ -512,6 +559,27 @@ func (_PermInterface *PermInterfaceTransactorSession) AddOrg(_orgId string, _eno
 	return _PermInterface.Contract.AddOrg(&_PermInterface.TransactOpts, _orgId, _enodeId, _ip, _port, _raftport, _account)
 }
 
+// AddOrgCA is a paid mutator transaction binding the contract method 0x15f547f8.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate) returns()
+func (_PermInterface *PermInterfaceTransactor) AddOrgCA(opts *bind.TransactOpts, _orgId string, _certificate []byte) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "addOrgCA", _orgId, _certificate)
+}
+
+// AddOrgCA is a paid mutator transaction binding the contract method 0x15f547f8.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate) returns()
+func (_PermInterface *PermInterfaceSession) AddOrgCA(_orgId string, _certificate []byte) (*types.Transaction, error) {
+	return _PermInterface.Contract.AddOrgCA(&_PermInterface.TransactOpts, _orgId, _certificate)
+}
+
+// AddOrgCA is a paid mutator transaction binding the contract method 0x15f547f8.
+//
+// Solidity: function addOrgCA(string _orgId, bytes _certificate) returns()
+func (_PermInterface *PermInterfaceTransactorSession) AddOrgCA(_orgId string, _certificate []byte) (*types.Transaction, error) {
+	return _PermInterface.Contract.AddOrgCA(&_PermInterface.TransactOpts, _orgId, _certificate)
+}
+
 // AddSubOrg is a paid mutator transaction binding the contract method 0x2e125a6c.
 //
 // Solidity: function addSubOrg(string _pOrgId, string _orgId, string _enodeId, string _ip, uint16 _port, uint16 _raftport) returns()
@@ -701,6 +769,48 @@ func (_PermInterface *PermInterfaceTransactorSession) Init(_breadth *big.Int, _d
 	return _PermInterface.Contract.Init(&_PermInterface.TransactOpts, _breadth, _depth)
 }
 
+// RemoveContractAccess is a paid mutator transaction binding the contract method 0xae934840.
+//
+// Solidity: function removeContractAccess(address _contract, address _account, string _orgId, string _roleId) returns()
+func (_PermInterface *PermInterfaceTransactor) RemoveContractAccess(opts *bind.TransactOpts, _contract common.Address, _account common.Address, _orgId string, _roleId string) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "removeContractAccess", _contract, _account, _orgId, _roleId)
+}
+
+// RemoveContractAccess is a paid mutator transaction binding the contract method 0xae934840.
+//
+// Solidity: function removeContractAccess(address _contract, address _account, string _orgId, string _roleId) returns()
+func (_PermInterface *PermInterfaceSession) RemoveContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string) (*types.Transaction, error) {
+	return _PermInterface.Contract.RemoveContractAccess(&_PermInterface.TransactOpts, _contract, _account, _orgId, _roleId)
+}
+
+// RemoveContractAccess is a paid mutator transaction binding the contract method 0xae934840.
+//
+// Solidity: function removeContractAccess(address _contract, address _account, string _orgId, string _roleId) returns()
+func (_PermInterface *PermInterfaceTransactorSession) RemoveContractAccess(_contract common.Address, _account common.Address, _orgId string, _roleId string) (*types.Transaction, error) {
+	return _PermInterface.Contract.RemoveContractAccess(&_PermInterface.TransactOpts, _contract, _account, _orgId, _roleId)
+}
+
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0xbcbdd7e6.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint) returns()
+func (_PermInterface *PermInterfaceTransactor) RemoveOrgCA(opts *bind.TransactOpts, _orgId string, _fingerprint [32]byte) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "removeOrgCA", _orgId, _fingerprint)
+}
+
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0xbcbdd7e6.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint) returns()
+func (_PermInterface *PermInterfaceSession) RemoveOrgCA(_orgId string, _fingerprint [32]byte) (*types.Transaction, error) {
+	return _PermInterface.Contract.RemoveOrgCA(&_PermInterface.TransactOpts, _orgId, _fingerprint)
+}
+
+// RemoveOrgCA is a paid mutator transaction binding the contract method 0xbcbdd7e6.
+//
+// Solidity: function removeOrgCA(string _orgId, bytes32 _fingerprint) returns()
+func (_PermInterface *PermInterfaceTransactorSession) RemoveOrgCA(_orgId string, _fingerprint [32]byte) (*types.Transaction, error) {
+	return _PermInterface.Contract.RemoveOrgCA(&_PermInterface.TransactOpts, _orgId, _fingerprint)
+}
+
 // RemoveRole is a paid mutator transaction binding the contract method 0xa6343012.
 //
 // Solidity: function removeRole(string _roleId, string _orgId) returns()
@@ -722,6 +832,69 @@ func (_PermInterface *PermInterfaceTransactorSession) RemoveRole(_roleId string,
 	return _PermInterface.Contract.RemoveRole(&_PermInterface.TransactOpts, _roleId, _orgId)
 }
 
+// SetAccountValidity is a paid mutator transaction binding the contract method 0xde811523.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_PermInterface *PermInterfaceTransactor) SetAccountValidity(opts *bind.TransactOpts, _account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "setAccountValidity", _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetAccountValidity is a paid mutator transaction binding the contract method 0xde811523.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_PermInterface *PermInterfaceSession) SetAccountValidity(_account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.SetAccountValidity(&_PermInterface.TransactOpts, _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetAccountValidity is a paid mutator transaction binding the contract method 0xde811523.
+//
+// Solidity: function setAccountValidity(address _account, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_PermInterface *PermInterfaceTransactorSession) SetAccountValidity(_account common.Address, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.SetAccountValidity(&_PermInterface.TransactOpts, _account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x2a610a97.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required) returns()
+func (_PermInterface *PermInterfaceTransactor) SetApprovalPolicy(opts *bind.TransactOpts, _pendingOp *big.Int, _required *big.Int) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "setApprovalPolicy", _pendingOp, _required)
+}
+
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x2a610a97.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required) returns()
+func (_PermInterface *PermInterfaceSession) SetApprovalPolicy(_pendingOp *big.Int, _required *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.SetApprovalPolicy(&_PermInterface.TransactOpts, _pendingOp, _required)
+}
+
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x2a610a97.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required) returns()
+func (_PermInterface *PermInterfaceTransactorSession) SetApprovalPolicy(_pendingOp *big.Int, _required *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.SetApprovalPolicy(&_PermInterface.TransactOpts, _pendingOp, _required)
+}
+
+// SetNodeValidity is a paid mutator transaction binding the contract method 0x3021183f.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_PermInterface *PermInterfaceTransactor) SetNodeValidity(opts *bind.TransactOpts, _enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.contract.Transact(opts, "setNodeValidity", _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetNodeValidity is a paid mutator transaction binding the contract method 0x3021183f.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_PermInterface *PermInterfaceSession) SetNodeValidity(_enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.SetNodeValidity(&_PermInterface.TransactOpts, _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
+// SetNodeValidity is a paid mutator transaction binding the contract method 0x3021183f.
+//
+// Solidity: function setNodeValidity(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) returns()
+func (_PermInterface *PermInterfaceTransactorSession) SetNodeValidity(_enodeId string, _orgId string, _fromBlock *big.Int, _untilBlock *big.Int, _fromTime *big.Int, _untilTime *big.Int) (*types.Transaction, error) {
+	return _PermInterface.Contract.SetNodeValidity(&_PermInterface.TransactOpts, _enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime)
+}
+
 // SetPermImplementation is a paid mutator transaction binding the contract method 0x511bbd9f.
 //
 // Solidity: function setPermImplementation(address _permImplementation) returns()
@@ -28,12 +28,12 @@ var (
 )
 
 // VoterManagerABI is the input ABI used to generate the binding from.
-const VoterManagerABI = "[{\"constant\":true,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOpDetails\",\"outputs\":[{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"addVoter\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"deleteVoter\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_authOrg\",\"type\":\"string\"},{\"name\":\"_vAccount\",\"type\":\"address\"},{\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"processVote\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_authOrg\",\"type\":\"string\"},{\"name\":\"_orgId\",\"type\":\"string\"},{\"name\":\"_enodeId\",\"type\":\"string\"},{\"name\":\"_account\",\"type\":\"address\"},{\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"addVotingItem\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"VoterAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"VoterDeleted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"VotingItemAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"VoteProcessed\",\"type\":\"event\"}]"
+const VoterManagerABI = "[{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_permUpgradable\",\"type\":\"address\"}],\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_required\",\"type\":\"uint256\"}],\"name\":\"ApprovalPolicyChanged\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"VoteProcessed\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"VoterAdded\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"VoterDeleted\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"VotingItemAdded\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"addVoter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"_enodeId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_account\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"addVotingItem\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"}],\"name\":\"deleteVoter\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"getApprovalPolicy\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_orgId\",\"type\":\"string\"}],\"name\":\"getPendingOpDetails\",\"outputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"_authOrg\",\"type\":\"string\"},{\"internalType\":\"address\",\"name\":\"_vAccount\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"}],\"name\":\"processVote\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint256\",\"name\":\"_pendingOp\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"_required\",\"type\":\"uint256\"}],\"name\":\"setApprovalPolicy\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]"
 
 var VoterManagerParsedABI, _ = abi.JSON(strings.NewReader(VoterManagerABI))
 
 // VoterManagerBin is the compiled bytecode used for deploying new contracts.
-var VoterManagerBin = "0x6080604052600060035534801561001557600080fd5b50604051602080611fe48339810180604052602081101561003557600080fd5b5051600080546001600160a01b039092166001600160a01b0319909216919091179055611f7d806100676000396000f3fe608060405234801561001057600080fd5b50600436106100575760003560e01c8063014e6acc1461005c5780635607395b146101c857806359cbd6fe14610241578063b0213864146102b8578063e98ac22d14610349575b600080fd5b6100ca6004803603602081101561007257600080fd5b810190602081018135600160201b81111561008c57600080fd5b82018360208201111561009e57600080fd5b803590602001918460018302840111600160201b831117156100bf57600080fd5b509092509050610466565b604051808060200180602001856001600160a01b03166001600160a01b03168152602001848152602001838103835287818151815260200191508051906020019080838360005b83811015610129578181015183820152602001610111565b50505050905090810190601f1680156101565780820380516001836020036101000a031916815260200191505b50838103825286518152865160209182019188019080838360005b83811015610189578181015183820152602001610171565b50505050905090810190601f1680156101b65780820380516001836020036101000a031916815260200191505b50965050505050505060405180910390f35b61023f600480360360408110156101de57600080fd5b810190602081018135600160201b8111156101f857600080fd5b82018360208201111561020a57600080fd5b803590602001918460018302840111600160201b8311171561022b57600080fd5b9193509150356001600160a01b0316610740565b005b61023f6004803603604081101561025757600080fd5b810190602081018135600160201b81111561027157600080fd5b82018360208201111561028357600080fd5b803590602001918460018302840111600160201b831117156102a457600080fd5b9193509150356001600160a01b0316610f06565b610335600480360360608110156102ce57600080fd5b810190602081018135600160201b8111156102e857600080fd5b8201836020820111156102fa57600080fd5b803590602001918460018302840111600160201b8311171561031b57600080fd5b91935091506001600160a01b0381351690602001356111e8565b604080519115158252519081900360200190f35b61023f600480360360a081101561035f57600080fd5b810190602081018135600160201b81111561037957600080fd5b82018360208201111561038b57600080fd5b803590602001918460018302840111600160201b831117156103ac57600080fd5b919390929091602081019035600160201b8111156103c957600080fd5b8201836020820111156103db57600080fd5b803590602001918460018302840111600160201b831117156103fc57600080fd5b919390929091602081019035600160201b81111561041957600080fd5b82018360208201111561042b57600080fd5b803590602001918460018302840111600160201b8311171561044c57600080fd5b91935091506001600160a01b0381351690602001356116f8565b6060806000806000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b1580156104b957600080fd5b505afa1580156104cd573d6000803e3d6000fd5b505050506040513d60208110156104e357600080fd5b50516001600160a01b031633146105385760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b600061057987878080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b905060018181548110151561058a57fe5b90600052602060002090600b02016004016000016001828154811015156105ad57fe5b90600052602060002090600b02016004016001016001838154811015156105d057fe5b600091825260209091206006600b909202010154600180546001600160a01b0390921691859081106105fe57fe5b60009182526020918290206007600b909202010154845460408051601f6002600019610100600187161502019094169390930492830185900485028101850190915281815291928691908301828280156106995780601f1061066e57610100808354040283529160200191610699565b820191906000526020600020905b81548152906001019060200180831161067c57829003601f168201915b5050865460408051602060026001851615610100026000190190941693909304601f8101849004840282018401909252818152959950889450925084019050828280156107275780601f106106fc57610100808354040283529160200191610727565b820191906000526020600020905b81548152906001019060200180831161070a57829003601f168201915b5050505050925094509450945094505092959194509250565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561078d57600080fd5b505afa1580156107a1573d6000803e3d6000fd5b505050506040513d60208110156107b757600080fd5b50516001600160a01b0316331461080c5760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b60026000848460405160200180806020018281038252848482818152602001925080828437600081840152601f19601f82011690508083019250505093505050506040516020818303038152906040528051906020012081526020019081526020016000205460001415610b78576003805460010190819055604080516020808201908152918101859052600291600091879187918190606001848480828437600081840152601f19601f8201169050808301925050509350505050604051602081830303815290604052805190602001208152602001908152602001600020819055506000600180548091906001016109069190611cdd565b9050838360018381548110151561091957fe5b6000918252602090912061093393600b9092020191611d0e565b506001808281548110151561094457fe5b90600052602060002090600b0201600101819055506001808281548110151561096957fe5b90600052602060002090600b020160020181905550600060018281548110151561098f57fe5b90600052602060002090600b020160030181905550604051806020016040528060008152506001828154811015156109c357fe5b90600052602060002090600b020160040160000190805190602001906109ea929190611d8c565b506040805160208101909152600081526001805483908110610a0857fe5b90600052602060002090600b02016004016001019080519060200190610a2f929190611d8c565b506000600182815481101515610a4157fe5b600091825260208220600b919091020160060180546001600160a01b0319166001600160a01b0393909316929092179091556001805483908110610a8157fe5b600091825260209091206007600b9092020101556001805482908110610aa357fe5b90600052602060002090600b020160010154600182815481101515610ac457fe5b600091825260208083206001600160a01b03871684526009600b9093020191909101905260409020556001805482908110610afb57fe5b60009182526020808320604080518082019091526001600160a01b0387811682526001828501818152600b969096029093016008018054938401815586529290942093519301805492516001600160a01b03199093169390911692909217600160a01b60ff021916600160a01b9115159190910217905550610e90565b6000610bb984848080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b9050600181815481101515610bca57fe5b600091825260208083206001600160a01b03861684526009600b9093020191909101905260409020541515610d2c576001805482908110610c0757fe5b600091825260209091206001600b909202018101805482019055805482908110610c2d57fe5b90600052602060002090600b020160010154600182815481101515610c4e57fe5b600091825260208083206001600160a01b03871684526009600b9093020191909101905260409020556001805482908110610c8557fe5b60009182526020808320604080518082019091526001600160a01b0387811682526001828501818152600b96909602909301600801805480850182559087529390952090519201805493516001600160a01b03199094169290941691909117600160a01b60ff021916600160a01b9215159290920291909117909155805482908110610d0d57fe5b600091825260209091206002600b909202010180546001019055610e8e565b6000610d6f85858080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250879250611ba5915050565b9050600182815481101515610d8057fe5b90600052602060002090600b020160080181815481101515610d9e57fe5b600091825260209091200154600160a01b900460ff16151560011415610e0e5760408051600160e51b62461bcd02815260206004820152600f60248201527f616c7265616479206120766f7465720000000000000000000000000000000000604482015290519081900360640190fd5b60018083815481101515610e1e57fe5b90600052602060002090600b020160080182815481101515610e3c57fe5b60009182526020909120018054911515600160a01b02600160a01b60ff02199092169190911790556001805483908110610e7257fe5b600091825260209091206002600b909202010180546001019055505b505b604080516001600160a01b03831660208201528181529081018390527f424f3ad05c61ea35cad66f22b70b1fad7250d8229921238078c401db36d34574908490849084908060608101858580828437600083820152604051601f909101601f1916909201829003965090945050505050a1505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b158015610f5357600080fd5b505afa158015610f67573d6000803e3d6000fd5b505050506040513d6020811015610f7d57600080fd5b50516001600160a01b03163314610fd25760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b82828080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250849250611016915083905082611bf7565b15156001146110645760408051600160e51b62461bcd02815260206004820152600f6024820152600160891b6e36bab9ba1031329030903b37ba32b902604482015290519081900360640190fd5b60006110a586868080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b905060006110ea87878080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250899250611ba5915050565b90506001828154811015156110fb57fe5b6000918252602082206002600b90920201018054600019019055600180548490811061112357fe5b90600052602060002090600b02016008018281548110151561114157fe5b9060005260206000200160000160146101000a81548160ff0219169083151502179055507f654cd85d9b2abaf3affef0a047625d088e6e4d0448935c9b5016b5f5aa0ca3b68787876040518080602001836001600160a01b03166001600160a01b031681526020018281038252858582818152602001925080828437600083820152604051601f909101601f1916909201829003965090945050505050a150505050505050565b60008060009054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561123757600080fd5b505afa15801561124b573d6000803e3d6000fd5b505050506040513d602081101561126157600080fd5b50516001600160a01b031633146112b65760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b84848080601f0160208091040260200160405190810160405280939291908181526020018383808284376000920191909152508692506112fa915083905082611bf7565b15156001146113485760408051600160e51b62461bcd02815260206004820152600f6024820152600160891b6e36bab9ba1031329030903b37ba32b902604482015290519081900360640190fd5b61138987878080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250889250611ca7915050565b15156001146113e25760408051600160e51b62461bcd02815260206004820152601260248201527f6e6f7468696e6720746f20617070726f76650000000000000000000000000000604482015290519081900360640190fd5b600061142388888080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b905060018181548110151561143457fe5b60009182526020808320848452600a600b9093020191909101815260408083206001600160a01b038a16845290915290205460ff161515600114156114c35760408051600160e51b62461bcd02815260206004820152601260248201527f63616e6e6f7420646f75626c6520766f74650000000000000000000000000000604482015290519081900360640190fd5b60018054829081106114d157fe5b600091825260209091206003600b90920201018054600190810190915580548190839081106114fc57fe5b60009182526020808320858452600b92909202909101600a01815260408083206001600160a01b038b168452825291829020805460ff19169315159390931790925580518281529182018990527f87999b54e45aa02834a1265e356d7bcdceb72b8cbb4396ebaeba32a103b43508918a918a919081908101848480828437600083820152604051601f909101601f19169092018290039550909350505050a160026001828154811015156115ac57fe5b90600052602060002090600b0201600201548115156115c757fe5b046001828154811015156115d757fe5b90600052602060002090600b02016003015411156116e857604080516020810190915260008152600180548390811061160c57fe5b90600052602060002090600b02016004016000019080519060200190611633929190611d8c565b50604080516020810190915260008152600180548390811061165157fe5b90600052602060002090600b02016004016001019080519060200190611678929190611d8c565b50600060018281548110151561168a57fe5b600091825260208220600b919091020160060180546001600160a01b0319166001600160a01b03939093169290921790915560018054839081106116ca57fe5b600091825260209091206007600b90920201015550600192506116ee565b60009350505b5050949350505050565b6000809054906101000a90046001600160a01b03166001600160a01b0316630e32cf906040518163ffffffff1660e01b815260040160206040518083038186803b15801561174557600080fd5b505afa158015611759573d6000803e3d6000fd5b505050506040513d602081101561176f57600080fd5b50516001600160a01b031633146117c45760408051600160e51b62461bcd02815260206004820152600e6024820152600160911b6d34b73b30b634b21031b0b63632b902604482015290519081900360640190fd5b61180388888080601f01602080910402602001604051908101604052809392919081815260200183838082843760009201829052509250611ca7915050565b151561184357604051600160e51b62461bcd028152600401808060200182810382526034815260200180611f1e6034913960400191505060405180910390fd5b600061188489898080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250611afd92505050565b9050868660018381548110151561189757fe5b90600052602060002090600b020160040160000191906118b8929190611d0e565b5084846001838154811015156118ca57fe5b90600052602060002090600b020160040160010191906118eb929190611d0e565b50826001828154811015156118fc57fe5b90600052602060002090600b020160040160020160006101000a8154816001600160a01b0302191690836001600160a01b031602179055508160018281548110151561194457fe5b6000918252602082206007600b9092020101919091555b600180548390811061196957fe5b90600052602060002090600b020160080180549050811015611a6b57600180548390811061199357fe5b90600052602060002090600b0201600801818154811015156119b157fe5b600091825260209091200154600160a01b900460ff1615611a635760006001838154811015156119dd57fe5b90600052602060002090600b0201600a0160008481526020019081526020016000206000600185815481101515611a1057fe5b90600052602060002090600b020160080184815481101515611a2e57fe5b6000918252602080832091909101546001600160a01b031683528201929092526040019020805460ff19169115159190911790555b60010161195b565b506000600182815481101515611a7d57fe5b90600052602060002090600b0201600301819055507f5bfaebb5931145594f63236d2a59314c4dc6035b65d0ca4cee9c7298e2f06ca3898960405180806020018281038252848482818152602001925080828437600083820152604051601f909101601f19169092018290039550909350505050a1505050505050505050565b6000600160026000846040516020018080602001828103825283818151815260200191508051906020019080838360005b83811015611b46578181015183820152602001611b2e565b50505050905090810190601f168015611b735780820380516001836020036101000a031916815260200191505b509250505060405160208183030381529060405280519060200120815260200190815260200160002054039050919050565b600080611bb184611afd565b905060018082815481101515611bc357fe5b600091825260208083206001600160a01b03881684526009600b909302019190910190526040902054039150505b92915050565b600080611c0384611afd565b9050600181815481101515611c1457fe5b600091825260208083206001600160a01b03871684526009600b9093020191909101905260409020541515611c4d576000915050611bf1565b6000611c598585611ba5565b9050600182815481101515611c6a57fe5b90600052602060002090600b020160080181815481101515611c8857fe5b600091825260209091200154600160a01b900460ff1695945050505050565b6000816001611cb585611afd565b81548110611cbf57fe5b90600052602060002090600b02016004016003015414905092915050565b815481835581811115611d0957600b0281600b028360005260206000209182019101611d099190611dfa565b505050565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10611d4f5782800160ff19823516178555611d7c565b82800160010185558215611d7c579182015b82811115611d7c578235825591602001919060010190611d61565b50611d88929150611e7f565b5090565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f10611dcd57805160ff1916838001178555611d7c565b82800160010185558215611d7c579182015b82811115611d7c578251825591602001919060010190611ddf565b611e7c91905b80821115611d88576000611e148282611e99565b60006001830181905560028301819055600383018190556004830190611e3a8282611e99565b611e48600183016000611e99565b506002810180546001600160a01b031916905560006003909101819055611e73906008840190611ee0565b50600b01611e00565b90565b611e7c91905b80821115611d885760008155600101611e85565b50805460018160011615610100020316600290046000825580601f10611ebf5750611edd565b601f016020900490600052602060002090810190611edd9190611e7f565b50565b5080546000825590600052602060002090810190611edd9190611e7c91905b80821115611d885780546001600160a81b0319168155600101611eff56fe6974656d732070656e64696e6720666f7220617070726f76616c2e206e6577206974656d2063616e6e6f74206265206164646564a165627a7a723058207dc9a68c6931494f043f7420dfa8288733d9a3a676ed30b4ac8e9cc704bd928b0029"
+var VoterManagerBin = "0x60803461007557601f61160c38819003918201601f19168301916001600160401b0383118484101761007a5780849260209460405283398101031261007557516001600160a01b0381169081900361007557600060035560018060a01b0319600054161760005560405161156290816100aa8239f35b600080fd5b7f4e487b7100000000000000000000000000000000000000000000000000000000600052604160045260246000fdfe608080604052600436101561001357600080fd5b60003560e01c908163014e6acc14610c88575080632a610a9714610b7e5780635607395b1461070257806357f835ce146106d657806359cbd6fe146105b6578063b0213864146104e15763e98ac22d1461006c57600080fd5b346104dc5760a0806003193601126104dc576004356001600160401b0381116104dc5761009d903690600401610da8565b91906024356001600160401b0381116104dc576100be903690600401610da8565b91906044356001600160401b0381116104dc576100df903690600401610da8565b6064356001600160a01b03811693908490036104dc5760005460405162e32cf960e41b815290602090829060049082906001600160a01b03165afa80156104d05761013d916000916104a1575b506001600160a01b03163314610eb2565b600761015a610155610150368c8a610f10565b6114c9565b610f56565b50015461043f5761016f610150368a88610f10565b95600461017b88610f56565b5001916001600160401b0382116103b35781906101a28261019c865461103c565b866110b7565b600090601f83116001146103d4576000926103c9575b50508160011b916000199060031b1c19161790555b60056101d886610f56565b5001916001600160401b0382116103b35781906101f98261019c865461103c565b600090601f83116001146103485760009261033d575b50508160011b916000199060031b1c19161790555b600661022f84610f56565b500180546001600160a01b031916909117905561024b82610f56565b50600760843591015560005b61026083610f56565b506008809101548210156102ee57908160ff61028a8361029a9561028389610f56565b5001610fa7565b5054871c1661029f575b50610fbf565b610257565b600a6102aa86610f56565b5086600052016020526102c68260406000209261028388610f56565b50546001600160a01b0316600090815260209190915260409020805460ff1916905538610294565b7f5bfaebb5931145594f63236d2a59314c4dc6035b65d0ca4cee9c7298e2f06ca383876000600361031e89610f56565b500155610338604051928392602084526020840191610eef565b0390a1005b01359050388061020f565b909150601f198316918460005260206000209260005b81811061039b5750908460019594939210610381575b505050811b019055610224565b0135600019600384901b60f8161c19169055388080610374565b9193602060018192878701358155019501920161035e565b634e487b7160e01b600052604160045260246000fd5b0135905038806101b8565b909150601f198316918460005260206000209260005b818110610427575090846001959493921061040d575b505050811b0190556101cd565b0135600019600384901b60f8161c19169055388080610400565b919360206001819287870135815501950192016103ea565b60405162461bcd60e51b815260206004820152603460248201527f6974656d732070656e64696e6720666f7220617070726f76616c2e206e6577206044820152731a5d195b4818d85b9b9bdd08189948185919195960621b6064820152608490fd5b6104c3915060203d6020116104c9575b6104bb8183610e72565b810190610e93565b3861012c565b503d6104b1565b6040513d6000823e3d90fd5b600080fd5b346104dc5760603660031901126104dc576004356001600160401b0381116104dc57610511903690600401610da8565b6001600160a01b039160243583811681036104dc57600492602085600054166040519586809262e32cf960e41b82525afa9384156104d05760209561058f9561056592600091610599575b50163314610eb2565b610586600161057e84610579368689610f10565b611439565b151514611125565b60443592611163565b6040519015158152f35b6105b09150883d81116104c9576104bb8183610e72565b8861055c565b346104dc576105c436610e15565b91600460018060a01b03602081600054166040519384809262e32cf960e41b82525afa80156104d057610601926000916106b85750163314610eb2565b610615600161057e85610579368787610f10565b610623610150368484610f10565b9261063881610633368686610f10565b611498565b90600261064486610f56565b50019485549283156106a257600861028361068a937f654cd85d9b2abaf3affef0a047625d088e6e4d0448935c9b5016b5f5aa0ca3b69961033897600019019055610f56565b50805460ff60a01b19169055604051938493846110fc565b634e487b7160e01b600052601160045260246000fd5b6106d0915060203d81116104c9576104bb8183610e72565b8661055c565b346104dc5760203660031901126104dc5760043560005260046020526020604060002054604051908152f35b346104dc5761071036610e15565b60005460405162e32cf960e41b815260209492918590829060049082906001600160a01b03165afa80156104d05761075a91600091610b6157506001600160a01b03163314610eb2565b60405184810185815281610772604082018787610eef565b0391610786601f1993848101835282610e72565b51902060005260028552604060002054156000146109fe576107a9600354610fbf565b80600355604051868101908782526107d5816107c9604082018a8a610eef565b03858101835282610e72565b5190206000526002865260406000205560015490600160401b8210156103b3576001820160015561080582610f56565b505061081082610f56565b50906001600160401b0386116103b3576108348661082e845461103c565b846110b7565b600090601f8711600114610982575091600860008051602061150d8339815191529761096b93886103389796600091610977575b508960011b906000198b60031b1c19161790555b60018061088885610f56565b5001556001600261089885610f56565b500155600060036108a885610f56565b500155600060046108b885610f56565b50016108cd6108c7825461103c565b8261108d565b55600060056108db85610f56565b50016108ea6108c7825461103c565b5560066108f684610f56565b500180546001600160a01b03191690556000600761091385610f56565b50015560016109518161092586610f56565b5001549461093281610f56565b50956009848060a01b038a169788600052018552604060002055610f56565b50916040519461096086610e57565b855284015201610fce565b604051938493846110fc565b90508801358b610868565b86169082600052876000209160005b8181106109e757509761096b936103389695936008938a60008051602061150d8339815191529c106109cd575b5050600189811b01905561087c565b89013560001960038c901b60f8161c191690558a806109be565b91928960018192868b013581550194019201610991565b5092610a0e610150368585610f10565b90610a1882610f56565b5090600960018060a01b038716928360005201815260406000205415600014610ab957610aa68361015560008051602061150d833981519152989460086002956001610a666103389a610f56565b5001610a728154610fbf565b90556001610a7f86610f56565b5001546009610a8d87610f56565b5085600052018252604060002055600161095186610f56565b5001610ab28154610fbf565b905561096b565b9050610aca85610633368787610f10565b90600160ff610ade84600861028388610f56565b505460a01c16151514610b2b5750936002610aa683610b1460008051602061150d83398151915298600861028361033898610f56565b50805460ff60a01b1916600160a01b179055610f56565b6064906040519062461bcd60e51b82526004820152600f60248201526e30b63932b0b23c9030903b37ba32b960891b6044820152fd5b610b789150863d88116104c9576104bb8183610e72565b8661012c565b346104dc5760403660031901126104dc5760005460405162e32cf960e41b815260209160048035926024359290916001600160a01b039186918491829085165afa80156104d057610bd992600091610c715750163314610eb2565b600182101580610c66575b15610c2857906040917fc289fe1e0acf489faad97a20753b39e3da5eb09f15df1400a26c2b84919361aa9382600052600481528184600020558351928352820152a1005b60405162461bcd60e51b8152600481018490526016602482015275696e76616c6964206f7065726174696f6e207479706560501b6044820152606490fd5b506006821115610be4565b6106d09150863d88116104c9576104bb8183610e72565b346104dc5760203660031901126104dc576004356001600160401b0381116104dc57610cb8903690600401610da8565b60005462e32cf960e41b845290926001600160a01b0392916020908290600490829087165afa9182156104d057610d6d94610d0585610d0c9561015095600091610d8a5750163314610eb2565b3691610f10565b610d1581610f56565b50610d7b610d596005610d5260046007610d48610d3189610f56565b50996006610d3e8b610f56565b5001541698610f56565b500154950161134b565b950161134b565b604051958695608087526080870190610dd5565b908582036020870152610dd5565b91604084015260608301520390f35b610da2915060203d81116104c9576104bb8183610e72565b8961055c565b9181601f840112156104dc578235916001600160401b0383116104dc57602083818601950101116104dc57565b919082519283825260005b848110610e01575050826000602080949584010152601f8019910116010190565b602081830181015184830182015201610de0565b60406003198201126104dc57600435906001600160401b0382116104dc57610e3f91600401610da8565b90916024356001600160a01b03811681036104dc5790565b604081019081106001600160401b038211176103b357604052565b90601f801991011681019081106001600160401b038211176103b357604052565b908160209103126104dc57516001600160a01b03811681036104dc5790565b15610eb957565b60405162461bcd60e51b815260206004820152600e60248201526d34b73b30b634b21031b0b63632b960911b6044820152606490fd5b908060209392818452848401376000828201840152601f01601f1916010190565b9291926001600160401b0382116103b35760405191610f39601f8201601f191660200184610e72565b8294818452818301116104dc578281602093846000960137010152565b600154811015610f9157600b906001600052027fb10e2d527612073b26eecdfd717e6a320cf44b4afac2b0732d9fcbe2b7fa0cf60190600090565b634e487b7160e01b600052603260045260246000fd5b8054821015610f915760005260206000200190600090565b60001981146106a25760010190565b8054600160401b8110156103b357610feb91600182018155610fa7565b91909161102657805182546020909201516001600160a81b03199092166001600160a01b03919091161790151560a01b60ff60a01b16179055565b634e487b7160e01b600052600060045260246000fd5b90600182811c9216801561106c575b602083101461105657565b634e487b7160e01b600052602260045260246000fd5b91607f169161104b565b818110611081575050565b60008155600101611076565b90601f811161109a575050565b6110b591600052601f6020600020910160051c810190611076565b565b9190601f81116110c657505050565b6110b5926000526020600020906020601f840160051c830193106110f2575b601f0160051c0190611076565b90915081906110e5565b9160209161111591959495604085526040850191610eef565b6001600160a01b03909416910152565b1561112c57565b60405162461bcd60e51b815260206004820152600f60248201526e36bab9ba1031329030903b37ba32b960891b6044820152606490fd5b919290600182600761117c61015561015036878a610f10565b500154140361131157611193610150368386610f10565b9261119d84610f56565b5090600095858752600a6020930183526040908188209060018060a01b0316908189528452600160ff838a2054161515146112d8579161124b917f87999b54e45aa02834a1265e356d7bcdceb72b8cbb4396ebaeba32a103b4350895949360036112068a610f56565b50016112128154610fbf565b9055600a61121f8a610f56565b508a8c52018552818a20908a528452808920805460ff1916600117905551838152938493840191610eef565b0390a1611266600361125c84610f56565b50015491836113ed565b1115611270575090565b6112d08160006004611283600795610f56565b50016112926108c7825461103c565b55600060056112a083610f56565b50016112af6108c7825461103c565b5560066112bb82610f56565b500180546001600160a01b0319169055610f56565b500155600190565b815162461bcd60e51b815260048101859052601260248201527163616e6e6f7420646f75626c6520766f746560701b6044820152606490fd5b60405162461bcd60e51b81526020600482015260126024820152716e6f7468696e6720746f20617070726f766560701b6044820152606490fd5b9060405191826000825461135e8161103c565b9081845260209460019182811690816000146113cb575060011461138c575b5050506110b592500383610e72565b600090815285812095935091905b8183106113b35750506110b5935082010138808061137d565b8554888401850152948501948794509183019161139a565b925050506110b594925060ff191682840152151560051b82010138808061137d565b6113f8600291610f56565b500154908160011c90600182018092116106a257600052600460205260406000205491808311611433575080821061142e575090565b905090565b91505090565b90611443826114c9565b600961144e82610f56565b506001600160a01b038416600090815291016020526040902054156114905760086102836114816114879460ff96611498565b92610f56565b505460a01c1690565b505050600090565b906114a76101556009936114c9565b506001600160a01b039091166000908152910160205260409020546000190190565b6040516114f4816114e66020820194602086526040830190610dd5565b03601f198101835282610e72565b5190206000526002602052600019604060002054019056fe424f3ad05c61ea35cad66f22b70b1fad7250d8229921238078c401db36d34574a26469706673582212200eb3c4bdbdaf467094ae1d074fa60f334f491f5e21d1ac35e184eb2fada9b20964736f6c63430008150033"
 
 // DeployVoterManager deploys a new Ethereum contract, binding an instance of VoterManager to it.
 func DeployVoterManager(auth *bind.TransactOpts, backend bind.ContractBackend, _permUpgradable common.Address) (common.Address, *types.Transaction, *VoterManager, error) {
@@ -191,6 +191,32 @@ func (_VoterManager *VoterManagerTransactorRaw) Transact(opts *bind.TransactOpts
 	return _VoterManager.Contract.contract.Transact(opts, method, params...)
 }
 
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_VoterManager *VoterManagerCaller) GetApprovalPolicy(opts *bind.CallOpts, _pendingOp *big.Int) (*big.Int, error) {
+	var (
+		ret0 = new(*big.Int)
+	)
+	out := ret0
+	err := _VoterManager.contract.Call(opts, out, "getApprovalPolicy", _pendingOp)
+	return *ret0, err
+}
+
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_VoterManager *VoterManagerSession) GetApprovalPolicy(_pendingOp *big.Int) (*big.Int, error) {
+	return _VoterManager.Contract.GetApprovalPolicy(&_VoterManager.CallOpts, _pendingOp)
+}
+
+// GetApprovalPolicy is a free data retrieval call binding the contract method 0x57f835ce.
+//
+// Solidity: function getApprovalPolicy(uint256 _pendingOp) constant returns(uint256)
+func (_VoterManager *VoterManagerCallerSession) GetApprovalPolicy(_pendingOp *big.Int) (*big.Int, error) {
+	return _VoterManager.Contract.GetApprovalPolicy(&_VoterManager.CallOpts, _pendingOp)
+}
+
 // GetPendingOpDetails is a free data retrieval call binding the contract method 0x014e6acc.
 //
 // Solidity: function getPendingOpDetails(string _orgId) constant returns(string, string, address, uint256)
@@ -309,6 +335,163 @@ func (_VoterManager *VoterManagerTransactorSession) ProcessVote(_authOrg string,
 	return _VoterManager.Contract.ProcessVote(&_VoterManager.TransactOpts, _authOrg, _vAccount, _pendingOp)
 }
 
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x2a610a97.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required) returns()
+func (_VoterManager *VoterManagerTransactor) SetApprovalPolicy(opts *bind.TransactOpts, _pendingOp *big.Int, _required *big.Int) (*types.Transaction, error) {
+	return _VoterManager.contract.Transact(opts, "setApprovalPolicy", _pendingOp, _required)
+}
+
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x2a610a97.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required) returns()
+func (_VoterManager *VoterManagerSession) SetApprovalPolicy(_pendingOp *big.Int, _required *big.Int) (*types.Transaction, error) {
+	return _VoterManager.Contract.SetApprovalPolicy(&_VoterManager.TransactOpts, _pendingOp, _required)
+}
+
+// SetApprovalPolicy is a paid mutator transaction binding the contract method 0x2a610a97.
+//
+// Solidity: function setApprovalPolicy(uint256 _pendingOp, uint256 _required) returns()
+func (_VoterManager *VoterManagerTransactorSession) SetApprovalPolicy(_pendingOp *big.Int, _required *big.Int) (*types.Transaction, error) {
+	return _VoterManager.Contract.SetApprovalPolicy(&_VoterManager.TransactOpts, _pendingOp, _required)
+}
+
+// VoterManagerApprovalPolicyChangedIterator is returned from FilterApprovalPolicyChanged and is used to iterate over the raw logs and unpacked data for ApprovalPolicyChanged events raised by the VoterManager contract.
+type VoterManagerApprovalPolicyChangedIterator struct {
+	Event *VoterManagerApprovalPolicyChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *VoterManagerApprovalPolicyChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(VoterManagerApprovalPolicyChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(VoterManagerApprovalPolicyChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *VoterManagerApprovalPolicyChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *VoterManagerApprovalPolicyChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// VoterManagerApprovalPolicyChanged represents a ApprovalPolicyChanged event raised by the VoterManager contract.
+type VoterManagerApprovalPolicyChanged struct {
+	PendingOp *big.Int
+	Required  *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterApprovalPolicyChanged is a free log retrieval operation binding the contract event 0xc289fe1e0acf489faad97a20753b39e3da5eb09f15df1400a26c2b84919361aa.
+//
+// Solidity: event ApprovalPolicyChanged(uint256 _pendingOp, uint256 _required)
+func (_VoterManager *VoterManagerFilterer) FilterApprovalPolicyChanged(opts *bind.FilterOpts) (*VoterManagerApprovalPolicyChangedIterator, error) {
+
+	logs, sub, err := _VoterManager.contract.FilterLogs(opts, "ApprovalPolicyChanged")
+	if err != nil {
+		return nil, err
+	}
+	return &VoterManagerApprovalPolicyChangedIterator{contract: _VoterManager.contract, event: "ApprovalPolicyChanged", logs: logs, sub: sub}, nil
+}
+
+var ApprovalPolicyChangedTopicHash = "0xc289fe1e0acf489faad97a20753b39e3da5eb09f15df1400a26c2b84919361aa"
+
+// WatchApprovalPolicyChanged is a free log subscription operation binding the contract event 0xc289fe1e0acf489faad97a20753b39e3da5eb09f15df1400a26c2b84919361aa.
+//
+// Solidity: event ApprovalPolicyChanged(uint256 _pendingOp, uint256 _required)
+func (_VoterManager *VoterManagerFilterer) WatchApprovalPolicyChanged(opts *bind.WatchOpts, sink chan<- *VoterManagerApprovalPolicyChanged) (event.Subscription, error) {
+
+	logs, sub, err := _VoterManager.contract.WatchLogs(opts, "ApprovalPolicyChanged")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(VoterManagerApprovalPolicyChanged)
+				if err := _VoterManager.contract.UnpackLog(event, "ApprovalPolicyChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseApprovalPolicyChanged is a log parse operation binding the contract event 0xc289fe1e0acf489faad97a20753b39e3da5eb09f15df1400a26c2b84919361aa.
+//
+// Solidity: event ApprovalPolicyChanged(uint256 _pendingOp, uint256 _required)
+func (_VoterManager *VoterManagerFilterer) ParseApprovalPolicyChanged(log types.Log) (*VoterManagerApprovalPolicyChanged, error) {
+	event := new(VoterManagerApprovalPolicyChanged)
+	if err := _VoterManager.contract.UnpackLog(event, "ApprovalPolicyChanged", log); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
 // VoterManagerVoteProcessedIterator is returned from FilterVoteProcessed and is used to iterate over the raw logs and unpacked data for VoteProcessed events raised by the VoterManager contract.
 type VoterManagerVoteProcessedIterator struct {
 	Event *VoterManagerVoteProcessed // Event containing the contract specifics and raw log
@@ -86,6 +86,37 @@ func (a *Account) AssignAdminRole(_args ptype.TxArgs) (*types.Transaction, error
 	return a.Backend.PermInterfSession.AssignAdminRole(_args.OrgId, _args.AcctId, _args.RoleId)
 }
 
+func (a *Account) AddContractAccess(_args ptype.TxArgs) (*types.Transaction, error) {
+	acct, orgId, roleId := contractAccessGrantee(_args.Access)
+	selectors := make([][4]byte, len(_args.Access.Selectors))
+	for i, s := range _args.Access.Selectors {
+		copy(selectors[i][:], s)
+	}
+	return a.Backend.PermInterfSession.AddContractAccess(_args.Access.Contract, acct, orgId, roleId, selectors)
+}
+
+func (a *Account) RemoveContractAccess(_args ptype.TxArgs) (*types.Transaction, error) {
+	acct, orgId, roleId := contractAccessGrantee(_args.Access)
+	return a.Backend.PermInterfSession.RemoveContractAccess(_args.Access.Contract, acct, orgId, roleId)
+}
+
+// SetAccountValidity sets the validity window of the account, an empty
+// window removes it
+func (a *Account) SetAccountValidity(_args ptype.TxArgs) (*types.Transaction, error) {
+	v := _args.Validity
+	return a.Backend.PermInterfSession.SetAccountValidity(*v.Account, _args.OrgId, new(big.Int).SetUint64(v.FromBlock),
+		new(big.Int).SetUint64(v.UntilBlock), new(big.Int).SetUint64(v.FromTime), new(big.Int).SetUint64(v.UntilTime))
+}
+
+// returns the account or org role the contract access is given to, the
+// contracts take a zero account for accesses given to a role
+func contractAccessGrantee(access core.ContractAccessInfo) (common.Address, string, string) {
+	if access.AcctId != nil {
+		return *access.AcctId, "", ""
+	}
+	return common.Address{}, access.OrgId, access.RoleId
+}
+
 func (i *Init) GetAccountDetailsFromIndex(_aIndex *big.Int) (common.Address, string, string, *big.Int, bool, error) {
 	return i.permAcctSession.GetAccountDetailsFromIndex(_aIndex)
 }
@@ -222,6 +253,18 @@ func (a *Audit) CheckPendingOp(_orgId string) bool {
 	return err == nil && op.Int64() != 0
 }
 
+func (a *Audit) GetPendingOp(_authOrg string) (string, string, common.Address, int64, error) {
+	pOrg, pEnodeId, pAcct, op, err := a.Backend.PermInterfSession.GetPendingOp(_authOrg)
+	if err != nil {
+		return "", "", common.Address{}, 0, err
+	}
+	return pOrg, pEnodeId, pAcct, op.Int64(), nil
+}
+
+func (a *Audit) GetApprovalPolicy(_pendingOp int64) (*big.Int, error) {
+	return a.Backend.PermInterfSession.GetApprovalPolicy(big.NewInt(_pendingOp))
+}
+
 func (c *Control) ConnectionAllowed(_enodeId, _ip string, _port, _raftPort uint16) (bool, error) {
 	url := core.GetNodeUrl(_enodeId, _ip, _port, _raftPort, c.Backend.ContractBackend.IsRaft)
 	enodeId, ip, port, _, err := getNodeDetails(url, c.Backend.ContractBackend.IsRaft, c.Backend.ContractBackend.UseDns)
@@ -256,6 +299,28 @@ func (o *Org) ApproveOrgStatus(_args ptype.TxArgs) (*types.Transaction, error) {
 	return o.Backend.PermInterfSession.ApproveOrgStatus(_args.OrgId, big.NewInt(int64(_args.Action)))
 }
 
+func (o *Org) SetApprovalPolicy(_args ptype.TxArgs) (*types.Transaction, error) {
+	return o.Backend.PermInterfSession.SetApprovalPolicy(big.NewInt(int64(_args.Action)), _args.Required)
+}
+
+// AddOrgCA stores the DER certificate of the certificate authority in the
+// org records of the contracts
+func (o *Org) AddOrgCA(_args ptype.TxArgs) (*types.Transaction, error) {
+	der, err := _args.OrgCA.DER()
+	if err != nil {
+		return nil, err
+	}
+	return o.Backend.PermInterfSession.AddOrgCA(_args.OrgId, der)
+}
+
+func (o *Org) RemoveOrgCA(_args ptype.TxArgs) (*types.Transaction, error) {
+	fingerprint, err := core.ParseCertFingerprint(_args.OrgCA.Fingerprint)
+	if err != nil {
+		return nil, err
+	}
+	return o.Backend.PermInterfSession.RemoveOrgCA(_args.OrgId, fingerprint)
+}
+
 func (o *Org) UpdateOrgStatus(_args ptype.TxArgs) (*types.Transaction, error) {
 	return o.Backend.PermInterfSession.UpdateOrgStatus(_args.OrgId, big.NewInt(int64(_args.Action)))
 }
@@ -309,6 +374,14 @@ func (n *Node) AddNode(_args ptype.TxArgs) (*types.Transaction, error) {
 	return n.Backend.PermInterfSession.AddNode(_args.OrgId, enodeId, ip, port, raftPort)
 }
 
+// SetNodeValidity sets the validity window of the node, an empty window
+// removes it
+func (n *Node) SetNodeValidity(_args ptype.TxArgs) (*types.Transaction, error) {
+	v := _args.Validity
+	return n.Backend.PermInterfSession.SetNodeValidity(v.EnodeId, _args.OrgId, new(big.Int).SetUint64(v.FromBlock),
+		new(big.Int).SetUint64(v.UntilBlock), new(big.Int).SetUint64(v.FromTime), new(big.Int).SetUint64(v.UntilTime))
+}
+
 func (n *Node) UpdateNodeStatus(_args ptype.TxArgs) (*types.Transaction, error) {
 	enodeId, ip, port, raftPort, err := getNodeDetails(_args.Url, n.Backend.ContractBackend.IsRaft, n.Backend.ContractBackend.UseDns)
 	if err != nil {
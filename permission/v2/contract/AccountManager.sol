@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 
@@ -43,11 +43,40 @@ contract AccountManager {
 
     mapping(bytes32 => address) private orgAdminIndex;
 
+    struct ContractAccessDetails {
+        address contractAddr;
+        address account;
+        string orgId;
+        string roleId;
+        bytes4[] selectors;
+        bool active;
+    }
+
+    ContractAccessDetails[] private contractAccessList;
+    mapping(bytes32 => uint) private contractAccessIndex;
+
+    struct ValidityDetails {
+        uint fromBlock;
+        uint untilBlock;
+        uint fromTime;
+        uint untilTime;
+    }
+
+    mapping(address => ValidityDetails) private accountValidity;
+
     // account permission events
     event AccountAccessModified(address _account, string _orgId, string _roleId, bool _orgAdmin, uint _status);
     event AccountAccessRevoked(address _account, string _orgId, string _roleId, bool _orgAdmin);
     event AccountStatusChanged(address _account, string _orgId, uint _status);
 
+    // contract access events
+    event ContractAccessModified(address _contract, address _account, string _orgId, string _roleId, bytes4[] _selectors);
+    event ContractAccessRevoked(address _contract, address _account, string _orgId, string _roleId);
+
+    // account validity events
+    event AccountValidityChanged(address _account, string _orgId, uint _fromBlock, uint _untilBlock,
+        uint _fromTime, uint _untilTime);
+
     /** @notice confirms that the caller is the address of implementation
         contract
       */
@@ -67,7 +96,7 @@ contract AccountManager {
     }
 
     /// @notice constructor. sets the permissions upgradable address
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
@@ -172,8 +201,8 @@ contract AccountManager {
         the time of adding a new account as org admin account. at org
         level there can be one org admin account only
       * @param _orgId - org id
-      * @return bool to indicate if voter update is required or not
-      * @return _adminRole - indicates of the role is an admin role
+      * @return voterUpdate bool to indicate if voter update is required or not
+      * @return account the existing org admin account
       */
     function removeExistingAdmin(string calldata _orgId) external
     onlyImplementation
@@ -195,7 +224,7 @@ contract AccountManager {
     /** @notice function to add an account as network admin or org admin.
       * @param _orgId - org id
       * @param _account - account id
-      * @return bool to indicate if voter update is required or not
+      * @return voterUpdate bool to indicate if voter update is required or not
       */
     function addNewAdmin(string calldata _orgId, address _account) external
     onlyImplementation
@@ -266,12 +295,111 @@ contract AccountManager {
         emit AccountStatusChanged(_account, _orgId, newStatus);
     }
 
+    /** @notice gives an account, or the accounts linked to a role of an org
+        and its sub orgs, access to call a contract. an existing access for
+        the same account or role has its selectors replaced
+      * @param _contract - contract address
+      * @param _account - account id, zero if the access is given to a role
+      * @param _orgId - org id of the role
+      * @param _roleId - role id
+      * @param _selectors - function selectors that can be called, empty for
+        all functions
+      */
+    function setContractAccess(address _contract, address _account, string calldata _orgId,
+        string calldata _roleId, bytes4[] calldata _selectors) external
+    onlyImplementation {
+        require((_account == address(0)) != (bytes(_orgId).length == 0 || bytes(_roleId).length == 0),
+            "contract access must be given to either an account or an org role");
+        bytes32 key = _contractAccessKey(_contract, _account, _orgId, _roleId);
+        if (contractAccessIndex[key] != 0) {
+            uint id = contractAccessIndex[key] - 1;
+            contractAccessList[id].selectors = _selectors;
+            contractAccessList[id].active = true;
+        }
+        else {
+            contractAccessList.push(ContractAccessDetails(_contract, _account,
+                _orgId, _roleId, _selectors, true));
+            contractAccessIndex[key] = contractAccessList.length;
+        }
+        emit ContractAccessModified(_contract, _account, _orgId, _roleId, _selectors);
+    }
+
+    /** @notice revokes the access of an account or an org role to a contract
+      * @param _contract - contract address
+      * @param _account - account id, zero if the access is given to a role
+      * @param _orgId - org id of the role
+      * @param _roleId - role id
+      */
+    function revokeContractAccess(address _contract, address _account, string calldata _orgId,
+        string calldata _roleId) external
+    onlyImplementation {
+        bytes32 key = _contractAccessKey(_contract, _account, _orgId, _roleId);
+        require(contractAccessIndex[key] != 0 && contractAccessList[contractAccessIndex[key] - 1].active,
+            "contract access does not exist");
+        contractAccessList[contractAccessIndex[key] - 1].active = false;
+        emit ContractAccessRevoked(_contract, _account, _orgId, _roleId);
+    }
+
+    /** @notice returns the total number of contract accesses
+      * @return total number of contract accesses
+      */
+    function getNumberOfContractAccesses() external view returns (uint) {
+        return contractAccessList.length;
+    }
+
+    /** @notice returns the contract access details for a given index
+      * @param _cIndex contract access index
+      * @return contract address
+      * @return account id
+      * @return org id
+      * @return role id
+      * @return allowed function selectors
+      * @return bool indicating if the access is active
+      */
+    function getContractAccessDetailsFromIndex(uint _cIndex) external view returns
+    (address, address, string memory, string memory, bytes4[] memory, bool) {
+        ContractAccessDetails storage ca = contractAccessList[_cIndex];
+        return (ca.contractAddr, ca.account, ca.orgId, ca.roleId, ca.selectors, ca.active);
+    }
+
+    /** @notice bounds the role assignment of an account by block height
+        and/or block timestamp. zero values leave the window open on that
+        side, all zero values remove the window
+      * @param _account - account id
+      * @param _orgId - org id of the account
+      * @param _fromBlock - first block the account is valid in
+      * @param _untilBlock - last block the account is valid in
+      * @param _fromTime - first block timestamp the account is valid at
+      * @param _untilTime - last block timestamp the account is valid at
+      */
+    function setAccountValidity(address _account, string calldata _orgId, uint _fromBlock,
+        uint _untilBlock, uint _fromTime, uint _untilTime) external
+    onlyImplementation
+    accountExists(_orgId, _account) {
+        require((_untilBlock == 0 || _untilBlock >= _fromBlock) && (_untilTime == 0 || _untilTime >= _fromTime),
+            "validity window ends before it starts");
+        accountValidity[_account] = ValidityDetails(_fromBlock, _untilBlock, _fromTime, _untilTime);
+        emit AccountValidityChanged(_account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime);
+    }
+
+    /** @notice returns the validity window of an account
+      * @param _account account id
+      * @return first block the account is valid in
+      * @return last block the account is valid in
+      * @return first block timestamp the account is valid at
+      * @return last block timestamp the account is valid at
+      */
+    function getAccountValidity(address _account) external view returns (uint, uint, uint, uint) {
+        ValidityDetails storage v = accountValidity[_account];
+        return (v.fromBlock, v.untilBlock, v.fromTime, v.untilTime);
+    }
+
     /** @notice checks if the passed account exists and if exists does it
         belong to the passed organization.
       * @param _account - account id
       * @param _orgId - org id
       * @return bool true if the account does not exists or exists and belongs
-      * @return passed org
+        passed org
       */
     function validateAccount(address _account, string calldata _orgId) external
     view returns (bool){
@@ -348,7 +476,24 @@ contract AccountManager {
       * @return account index
       */
     function _getAccountIndex(address _account) internal view returns (uint256) {
-        return accountIndex[_account] - 1;
+        unchecked {
+            return accountIndex[_account] - 1;
+        }
+    }
+
+    /** @notice returns the key of a contract access
+      * @param _contract contract address
+      * @param _account account id
+      * @param _orgId org id
+      * @param _roleId role id
+      * @return contract access key
+      */
+    function _contractAccessKey(address _contract, address _account, string memory _orgId,
+        string memory _roleId) internal pure returns (bytes32) {
+        if (_account != address(0)) {
+            return keccak256(abi.encode(_contract, _account));
+        }
+        return keccak256(abi.encode(_contract, _orgId, _roleId));
     }
 
     /** @notice sets the account role to the passed role id and sets the status
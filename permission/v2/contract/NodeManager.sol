@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 /** @title Node manager contract
@@ -39,6 +39,15 @@ contract NodeManager {
     // tracking total number of nodes in network
     uint256 private numberOfNodes;
 
+    struct ValidityDetails {
+        uint256 fromBlock;
+        uint256 untilBlock;
+        uint256 fromTime;
+        uint256 untilTime;
+    }
+    // mapping of enodeId to the validity window of the node
+    mapping(bytes32 => ValidityDetails) private nodeValidity;
+
 
     // node permission events for new node propose
     event NodeProposed(string _enodeId, string _ip, uint16 _port, uint16 _raftport, string _orgId);
@@ -61,6 +70,10 @@ contract NodeManager {
     // node
     event NodeRecoveryCompleted(string _enodeId, string _ip, uint16 _port, uint16 _raftport, string _orgId);
 
+    // node permission events for changing the validity window of a node
+    event NodeValidityChanged(string _enodeId, string _orgId, uint256 _fromBlock, uint256 _untilBlock,
+        uint256 _fromTime, uint256 _untilTime);
+
     /** @notice confirms that the caller is the address of implementation
         contract
     */
@@ -89,15 +102,18 @@ contract NodeManager {
 
     /** @notice constructor. sets the permissions upgradable address
       */
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
     /** @notice fetches the node details given an enode id
       * @param _enodeId full enode id
-      * @return org id
-      * @return enode id
-      * @return status of the node
+      * @return _orgId org id
+      * @return _enodeId enode id
+      * @return _ip ip of the node
+      * @return _port port of the node
+      * @return _raftport raftport of the node
+      * @return _nodeStatus status of the node
       */
     function getNodeDetails(string calldata enodeId) external view
     returns (string memory _orgId, string memory _enodeId, string memory _ip, uint16 _port, uint16 _raftport, uint256 _nodeStatus) {
@@ -112,12 +128,12 @@ contract NodeManager {
 
     /** @notice fetches the node details given the index of the enode
       * @param _nodeIndex node index
-      * @return org id
-      * @return enode id
-      * @return ip of the node
-      * @return port of the node
-      * @return raftport of the node
-      * @return status of the node
+      * @return _orgId org id
+      * @return _enodeId enode id
+      * @return _ip ip of the node
+      * @return _port port of the node
+      * @return _raftport raftport of the node
+      * @return _nodeStatus status of the node
       */
     function getNodeDetailsFromIndex(uint256 _nodeIndex) external view
     returns (string memory _orgId, string memory _enodeId, string memory _ip, uint16 _port, uint16 _raftport, uint256 _nodeStatus) {
@@ -258,6 +274,40 @@ contract NodeManager {
         }
     }
 
+    /** @notice bounds the approval of a node by block height and/or block
+        timestamp. zero values leave the window open on that side, all zero
+        values remove the window
+      * @param _enodeId enode id
+      * @param _orgId org or sub org id to which the enode belongs
+      * @param _fromBlock first block the node is valid in
+      * @param _untilBlock last block the node is valid in
+      * @param _fromTime first block timestamp the node is valid at
+      * @param _untilTime last block timestamp the node is valid at
+      */
+    function setNodeValidity(string memory _enodeId, string memory _orgId, uint256 _fromBlock,
+        uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) public
+    onlyImplementation
+    enodeExists(_enodeId) {
+        require(_checkOrg(_enodeId, _orgId), "enode id does not belong to the passed org");
+        require((_untilBlock == 0 || _untilBlock >= _fromBlock) && (_untilTime == 0 || _untilTime >= _fromTime),
+            "validity window ends before it starts");
+        nodeValidity[keccak256(abi.encode(_enodeId))] = ValidityDetails(_fromBlock, _untilBlock, _fromTime, _untilTime);
+        emit NodeValidityChanged(_enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime);
+    }
+
+    /** @notice returns the validity window of a node
+      * @param _enodeId enode id
+      * @return first block the node is valid in
+      * @return last block the node is valid in
+      * @return first block timestamp the node is valid at
+      * @return last block timestamp the node is valid at
+      */
+    function getNodeValidity(string calldata _enodeId) external view
+    returns (uint256, uint256, uint256, uint256) {
+        ValidityDetails storage v = nodeValidity[keccak256(abi.encode(_enodeId))];
+        return (v.fromBlock, v.untilBlock, v.fromTime, v.untilTime);
+    }
+
     // private functions
     /** @notice returns the node index for given enode id
       * @param _enodeId enode id
@@ -265,7 +315,9 @@ contract NodeManager {
       */
     function _getNodeIndex(string memory _enodeId) internal view
     returns (uint256) {
-        return enodeIdToIndex[keccak256(abi.encode(_enodeId))] - 1;
+        unchecked {
+            return enodeIdToIndex[keccak256(abi.encode(_enodeId))] - 1;
+        }
     }
 
     /** @notice checks if enode id is linked to the org id passed
@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 /** @title Organization manager contract
@@ -42,6 +42,13 @@ contract OrgManager {
     mapping(bytes32 => uint) private OrgIndex;
     uint private orgNum = 0;
 
+    // mapping of org id to the sha256 fingerprints of the DER certificates of
+    // the certificate authorities issuing the node certificates of the org
+    mapping(bytes32 => bytes32[]) private orgCAList;
+    // mapping of org id and fingerprint to the DER certificate of the
+    // certificate authority
+    mapping(bytes32 => mapping(bytes32 => bytes)) private orgCAs;
+
     // events related to Master Org add
     event OrgApproved(string _orgId, string _porgId, string _ultParent,
         uint _level, uint _status);
@@ -52,6 +59,10 @@ contract OrgManager {
     event OrgSuspensionRevoked(string _orgId, string _porgId, string _ultParent,
         uint _level);
 
+    // events related to the certificate authorities of an org
+    event OrgCAAdded(string _orgId, bytes _certificate, bytes32 _fingerprint);
+    event OrgCARemoved(string _orgId, bytes32 _fingerprint);
+
     /** @notice confirms that the caller is the address of implementation
         contract
     */
@@ -62,7 +73,6 @@ contract OrgManager {
 
     /** @notice checks if the org id does not exists
       * @param _orgId - org id
-      * @return true if org does not exist
       */
     modifier orgDoesNotExist(string memory _orgId) {
         require(checkOrgExists(_orgId) == false, "org exists");
@@ -71,7 +81,6 @@ contract OrgManager {
 
     /** @notice checks if the org id does exists
       * @param _orgId - org id
-      * @return true if org exists
       */
     modifier orgExists(string memory _orgId) {
         require(checkOrgExists(_orgId) == true, "org does not exist");
@@ -80,7 +89,7 @@ contract OrgManager {
 
     /** @notice constructor. sets the permissions upgradable address
       */
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
@@ -182,6 +191,62 @@ contract OrgManager {
             orgList[id].ultParent, orgList[id].level, 2);
     }
 
+    /** @notice adds a certificate authority issuing the node certificates of
+        the org and its sub orgs
+      * @param _orgId org id
+      * @param _certificate DER encoded certificate of the authority
+      */
+    function addOrgCA(string calldata _orgId, bytes calldata _certificate) external
+    onlyImplementation
+    orgExists(_orgId) {
+        require(_certificate.length != 0, "certificate is empty");
+        bytes32 orgKey = keccak256(abi.encodePacked(_orgId));
+        bytes32 fingerprint = sha256(_certificate);
+        require(orgCAs[orgKey][fingerprint].length == 0, "certificate authority exists");
+        orgCAs[orgKey][fingerprint] = _certificate;
+        orgCAList[orgKey].push(fingerprint);
+        emit OrgCAAdded(_orgId, _certificate, fingerprint);
+    }
+
+    /** @notice removes a certificate authority from the org
+      * @param _orgId org id
+      * @param _fingerprint sha256 digest of the DER certificate
+      */
+    function removeOrgCA(string calldata _orgId, bytes32 _fingerprint) external
+    onlyImplementation
+    orgExists(_orgId) {
+        bytes32 orgKey = keccak256(abi.encodePacked(_orgId));
+        require(orgCAs[orgKey][_fingerprint].length != 0, "certificate authority does not exist");
+        delete orgCAs[orgKey][_fingerprint];
+        bytes32[] storage list = orgCAList[orgKey];
+        for (uint256 i = 0; i < list.length; i++) {
+            if (list[i] == _fingerprint) {
+                list[i] = list[list.length - 1];
+                list.pop();
+                break;
+            }
+        }
+        emit OrgCARemoved(_orgId, _fingerprint);
+    }
+
+    /** @notice returns the fingerprints of the certificate authorities of an
+        org
+      * @param _orgId org id
+      * @return array of sha256 digests of the DER certificates
+      */
+    function getOrgCAList(string calldata _orgId) external view returns (bytes32[] memory) {
+        return orgCAList[keccak256(abi.encodePacked(_orgId))];
+    }
+
+    /** @notice returns a certificate authority of an org
+      * @param _orgId org id
+      * @param _fingerprint sha256 digest of the DER certificate
+      * @return DER encoded certificate, empty if it does not exist
+      */
+    function getOrgCA(string calldata _orgId, bytes32 _fingerprint) external view returns (bytes memory) {
+        return orgCAs[keccak256(abi.encodePacked(_orgId))][_fingerprint];
+    }
+
     /** @notice returns org info for a given org index
       * @param _orgIndex org index
       * @return org id
@@ -346,7 +411,8 @@ contract OrgManager {
         }
         orgNum++;
         OrgIndex[oid] = orgNum;
-        uint256 id = orgList.length++;
+        uint256 id = orgList.length;
+        orgList.push();
         if (_level == 1) {
             orgList[id].level = _level;
             orgList[id].pindex = 0;
@@ -363,8 +429,7 @@ contract OrgManager {
             orgList[id].level = orgList[parentIndex].level + 1;
             orgList[id].pindex = parentIndex;
             orgList[id].ultParent = orgList[parentIndex].ultParent;
-            uint256 subOrgId = orgList[parentIndex].subOrgIndexList.length++;
-            orgList[parentIndex].subOrgIndexList[subOrgId] = id;
+            orgList[parentIndex].subOrgIndexList.push(id);
             orgList[id].fullOrgId = string(abi.encodePacked(_pOrgId, ".", _orgId));
         }
         orgList[id].orgId = _orgId;
@@ -384,7 +449,9 @@ contract OrgManager {
       * @return org index
       */
     function _getOrgIndex(string memory _orgId) private view returns (uint){
-        return OrgIndex[keccak256(abi.encodePacked(_orgId))] - 1;
+        unchecked {
+            return OrgIndex[keccak256(abi.encodePacked(_orgId))] - 1;
+        }
     }
 
 }
@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./RoleManager.sol";
 import "./AccountManager.sol";
@@ -108,7 +108,7 @@ contract PermissionsImplementation {
       * @param _nodeManager - address of node manager contract
       */
     constructor (address _permUpgradable, address _orgManager, address _rolesManager,
-        address _accountManager, address _voterManager, address _nodeManager) public {
+        address _accountManager, address _voterManager, address _nodeManager) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
         orgManager = OrgManager(_orgManager);
         roleManager = RoleManager(_rolesManager);
@@ -279,6 +279,29 @@ contract PermissionsImplementation {
         }
     }
 
+    /** @notice function to add a certificate authority issuing the node
+        certificates of an org and its sub orgs. can be executed by network
+        admin accounts and the org admin of the org
+      * @param _orgId unique id of the organization
+      * @param _certificate DER encoded certificate of the authority
+      */
+    function addOrgCA(string calldata _orgId, bytes calldata _certificate, address _caller) external
+    onlyInterface {
+        require(isNetworkAdmin(_caller) || isOrgAdmin(_caller, _orgId), "account is not a org admin account");
+        orgManager.addOrgCA(_orgId, _certificate);
+    }
+
+    /** @notice function to remove a certificate authority from an org. can
+        be executed by network admin accounts and the org admin of the org
+      * @param _orgId unique id of the organization
+      * @param _fingerprint sha256 digest of the DER certificate
+      */
+    function removeOrgCA(string calldata _orgId, bytes32 _fingerprint, address _caller) external
+    onlyInterface {
+        require(isNetworkAdmin(_caller) || isOrgAdmin(_caller, _orgId), "account is not a org admin account");
+        orgManager.removeOrgCA(_orgId, _fingerprint);
+    }
+
     /** @notice function to update the org status. it updates the org status
         and adds a voting item for network admins to approve
       * @param _orgId unique id of the organization
@@ -409,6 +432,54 @@ contract PermissionsImplementation {
         accountManager.updateAccountStatus(_orgId, _account, _action);
     }
 
+    /** @notice function to give an account, or the accounts linked to a
+        role of an org and its sub orgs, access to call a contract. can be
+        executed by network admin accounts only
+      * @param _contract contract address
+      * @param _account account id, zero if the access is given to a role
+      * @param _orgId org id of the role
+      * @param _roleId role id
+      * @param _selectors function selectors that can be called, empty for
+        all functions
+      */
+    function addContractAccess(address _contract, address _account, string calldata _orgId,
+        string calldata _roleId, bytes4[] calldata _selectors, address _caller) external
+    onlyInterface
+    networkAdmin(_caller) {
+        accountManager.setContractAccess(_contract, _account, _orgId, _roleId, _selectors);
+    }
+
+    /** @notice function to revoke the access of an account or an org role to
+        a contract. can be executed by network admin accounts only
+      * @param _contract contract address
+      * @param _account account id, zero if the access is given to a role
+      * @param _orgId org id of the role
+      * @param _roleId role id
+      */
+    function removeContractAccess(address _contract, address _account, string calldata _orgId,
+        string calldata _roleId, address _caller) external
+    onlyInterface
+    networkAdmin(_caller) {
+        accountManager.revokeContractAccess(_contract, _account, _orgId, _roleId);
+    }
+
+    /** @notice function to bound the role assignment of an account by block
+        height and/or block timestamp. can be executed by network admin
+        accounts and the org admin of the account's org
+      * @param _account account id
+      * @param _orgId org id of the account
+      * @param _fromBlock first block the account is valid in
+      * @param _untilBlock last block the account is valid in
+      * @param _fromTime first block timestamp the account is valid at
+      * @param _untilTime last block timestamp the account is valid at
+      */
+    function setAccountValidity(address _account, string calldata _orgId, uint256 _fromBlock,
+        uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime, address _caller) external
+    onlyInterface {
+        require(isNetworkAdmin(_caller) || isOrgAdmin(_caller, _orgId), "account is not a org admin account");
+        accountManager.setAccountValidity(_account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime);
+    }
+
     // Node related functions
 
     /** @notice function to add a new node to the organization. can be invoked
@@ -452,6 +523,23 @@ contract PermissionsImplementation {
         nodeManager.updateNodeStatus(_enodeId, _ip, _port, _raftport, _orgId, _action);
     }
 
+    /** @notice function to bound the approval of a node by block height
+        and/or block timestamp. can be executed by network admin accounts and
+        the org admin of the node's org
+      * @param _enodeId enode id
+      * @param _orgId org id to which the node belongs
+      * @param _fromBlock first block the node is valid in
+      * @param _untilBlock last block the node is valid in
+      * @param _fromTime first block timestamp the node is valid at
+      * @param _untilTime last block timestamp the node is valid at
+      */
+    function setNodeValidity(string calldata _enodeId, string calldata _orgId, uint256 _fromBlock,
+        uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime, address _caller) external
+    onlyInterface {
+        require(isNetworkAdmin(_caller) || isOrgAdmin(_caller, _orgId), "account is not a org admin account");
+        nodeManager.setNodeValidity(_enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime);
+    }
+
     /** @notice function to initiate blacklisted nodes recovery. this can be
         invoked by an network admin account only
       * @param _orgId unique id of the organization to which the account belongs
@@ -544,6 +632,25 @@ contract PermissionsImplementation {
         return networkBoot;
     }
 
+    /** @notice function to set the number of network admin approvals
+        required for an operation type. can be executed by network admin
+        accounts only
+      * @param _pendingOp operation type
+      * @param _required number of approvals, zero for majority
+      * @param _caller account executing the function
+      */
+    function setApprovalPolicy(uint256 _pendingOp, uint256 _required, address _caller)
+    external onlyInterface networkAdmin(_caller) {
+        voterManager.setApprovalPolicy(_pendingOp, _required);
+    }
+
+    /** @notice returns the number of approvals set for an operation type
+      * @param _pendingOp operation type
+      */
+    function getApprovalPolicy(uint256 _pendingOp) external view returns (uint256) {
+        return voterManager.getApprovalPolicy(_pendingOp);
+    }
+
     /** @notice function to fetch detail of any pending approval activities
         for network admin organization
       * @param _orgId unique id of the organization to which the account belongs
@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsImplementation.sol";
 import "./PermissionsUpgradable.sol";
@@ -16,7 +16,7 @@ contract PermissionsInterface {
     /** @notice constructor
       * @param _permImplUpgradeable permissions upgradable contract address
       */
-    constructor(address _permImplUpgradeable) public {
+    constructor(address _permImplUpgradeable) {
         permImplUpgradeable = _permImplUpgradeable;
     }
 
@@ -119,6 +119,23 @@ contract PermissionsInterface {
         permImplementation.addSubOrg(_pOrgId, _orgId, _enodeId, _ip, _port, _raftport, msg.sender);
     }
 
+    /** @notice interface to add a certificate authority issuing the node
+        certificates of an org and its sub orgs
+      * @param _orgId unique id of the organization
+      * @param _certificate DER encoded certificate of the authority
+      */
+    function addOrgCA(string calldata _orgId, bytes calldata _certificate) external {
+        permImplementation.addOrgCA(_orgId, _certificate, msg.sender);
+    }
+
+    /** @notice interface to remove a certificate authority from an org
+      * @param _orgId unique id of the organization
+      * @param _fingerprint sha256 digest of the DER certificate
+      */
+    function removeOrgCA(string calldata _orgId, bytes32 _fingerprint) external {
+        permImplementation.removeOrgCA(_orgId, _fingerprint, msg.sender);
+    }
+
     /** @notice interface to update the org status
       * @param _orgId unique id of the organization
       * @param _action 1 for suspending an org and 2 for revoke of suspension
@@ -192,6 +209,60 @@ contract PermissionsInterface {
         permImplementation.updateAccountStatus(_orgId, _account, _action, msg.sender);
     }
 
+    /** @notice interface to give an account, or the accounts linked to a role
+        of an org and its sub orgs, access to call a contract
+      * @param _contract contract address
+      * @param _account account id, zero if the access is given to a role
+      * @param _orgId org id of the role
+      * @param _roleId role id
+      * @param _selectors function selectors that can be called, empty for
+        all functions
+      */
+    function addContractAccess(address _contract, address _account, string calldata _orgId,
+        string calldata _roleId, bytes4[] calldata _selectors) external {
+        permImplementation.addContractAccess(_contract, _account, _orgId, _roleId, _selectors, msg.sender);
+    }
+
+    /** @notice interface to revoke the access of an account or an org role to
+        a contract
+      * @param _contract contract address
+      * @param _account account id, zero if the access is given to a role
+      * @param _orgId org id of the role
+      * @param _roleId role id
+      */
+    function removeContractAccess(address _contract, address _account, string calldata _orgId,
+        string calldata _roleId) external {
+        permImplementation.removeContractAccess(_contract, _account, _orgId, _roleId, msg.sender);
+    }
+
+    /** @notice interface to bound the role assignment of an account by block
+        height and/or block timestamp
+      * @param _account account id
+      * @param _orgId org id of the account
+      * @param _fromBlock first block the account is valid in
+      * @param _untilBlock last block the account is valid in
+      * @param _fromTime first block timestamp the account is valid at
+      * @param _untilTime last block timestamp the account is valid at
+      */
+    function setAccountValidity(address _account, string calldata _orgId, uint256 _fromBlock,
+        uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) external {
+        permImplementation.setAccountValidity(_account, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime, msg.sender);
+    }
+
+    /** @notice interface to bound the approval of a node by block height
+        and/or block timestamp
+      * @param _enodeId enode id
+      * @param _orgId org id to which the node belongs
+      * @param _fromBlock first block the node is valid in
+      * @param _untilBlock last block the node is valid in
+      * @param _fromTime first block timestamp the node is valid at
+      * @param _untilTime last block timestamp the node is valid at
+      */
+    function setNodeValidity(string calldata _enodeId, string calldata _orgId, uint256 _fromBlock,
+        uint256 _untilBlock, uint256 _fromTime, uint256 _untilTime) external {
+        permImplementation.setNodeValidity(_enodeId, _orgId, _fromBlock, _untilBlock, _fromTime, _untilTime, msg.sender);
+    }
+
     /** @notice interface to add a new node to the organization
       * @param _orgId unique id of the organization to which the account belongs
       * @param _enodeId enode id being dded to the org
@@ -259,6 +330,22 @@ contract PermissionsInterface {
         permImplementation.approveBlacklistedAccountRecovery(_orgId, _account, msg.sender);
     }
 
+    /** @notice interface to set the number of network admin approvals
+        required for an operation type
+      * @param _pendingOp operation type
+      * @param _required number of approvals, zero for majority
+      */
+    function setApprovalPolicy(uint256 _pendingOp, uint256 _required) external {
+        permImplementation.setApprovalPolicy(_pendingOp, _required, msg.sender);
+    }
+
+    /** @notice returns the number of approvals set for an operation type
+      * @param _pendingOp operation type
+      */
+    function getApprovalPolicy(uint256 _pendingOp) external view returns (uint256) {
+        return permImplementation.getApprovalPolicy(_pendingOp);
+    }
+
     /** @notice interface to fetch detail of any pending approval activities
         for network admin organization
       * @param _orgId unique id of the organization to which the account belongs
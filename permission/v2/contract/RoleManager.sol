@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 /** @title Role manager contract
@@ -38,7 +38,7 @@ contract RoleManager {
 
     /** @notice constructor. sets the permissions upgradable address
       */
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
@@ -129,11 +129,12 @@ contract RoleManager {
     /** @notice returns the role details for a passed role id and org
       * @param _roleId - unique identifier for the role being added
       * @param _orgId - org id to which the role belongs
-      * @return role id
-      * @return org id
-      * @return access type
-      * @return bool to indicate if the role is a voter role
-      * @return bool to indicate if the role is active
+      * @return roleId role id
+      * @return orgId org id
+      * @return accessType access type
+      * @return voter bool to indicate if the role is a voter role
+      * @return admin bool to indicate if the role is an admin role
+      * @return active bool to indicate if the role is active
       */
     function getRoleDetails(string calldata _roleId, string calldata _orgId)
     external view returns (string memory roleId, string memory orgId,
@@ -149,11 +150,12 @@ contract RoleManager {
 
     /** @notice returns the role details for a passed role index
       * @param _rIndex - unique identifier for the role being added
-      * @return role id
-      * @return org id
-      * @return access type
-      * @return bool to indicate if the role is a voter role
-      * @return bool to indicate if the role is active
+      * @return roleId role id
+      * @return orgId org id
+      * @return accessType access type
+      * @return voter bool to indicate if the role is a voter role
+      * @return admin bool to indicate if the role is an admin role
+      * @return active bool to indicate if the role is active
       */
     function getRoleDetailsFromIndex(uint256 _rIndex) external view returns
     (string memory roleId, string memory orgId, uint256 accessType,
@@ -231,6 +233,8 @@ contract RoleManager {
       */
     function _getRoleIndex(string memory _roleId, string memory _orgId)
     internal view returns (uint256) {
-        return roleIndex[keccak256(abi.encode(_roleId, _orgId))] - 1;
+        unchecked {
+            return roleIndex[keccak256(abi.encode(_roleId, _orgId))] - 1;
+        }
     }
 }
@@ -1,4 +1,4 @@
-pragma solidity ^0.5.3;
+pragma solidity ^0.8.0;
 
 import "./PermissionsUpgradable.sol";
 
@@ -48,6 +48,11 @@ contract VoterManager {
     mapping(bytes32 => uint256) private VoterOrgIndex;
     uint256 private orgNum = 0;
 
+    // approval policy value which requires all voters to approve
+    uint256 private constant UNANIMOUS = type(uint256).max;
+    // number of approvals required by operation type. zero means majority
+    mapping(uint256 => uint256) private approvalPolicy;
+
     // events related to managing voting accounts for the org
     event VoterAdded(string _orgId, address _vAccount);
     event VoterDeleted(string _orgId, address _vAccount);
@@ -55,6 +60,8 @@ contract VoterManager {
     event VotingItemAdded(string _orgId);
     event VoteProcessed(string _orgId);
 
+    event ApprovalPolicyChanged(uint256 _pendingOp, uint256 _required);
+
     /** @notice confirms that the caller is the address of implementation
         contract
     */
@@ -75,7 +82,7 @@ contract VoterManager {
 
     /** @notice constructor. sets the permissions upgradable address
       */
-    constructor (address _permUpgradable) public {
+    constructor (address _permUpgradable) {
         permUpgradable = PermissionsUpgradable(_permUpgradable);
     }
 
@@ -91,7 +98,8 @@ contract VoterManager {
         if (VoterOrgIndex[keccak256(abi.encode(_orgId))] == 0) {
             orgNum++;
             VoterOrgIndex[keccak256(abi.encode(_orgId))] = orgNum;
-            uint256 id = orgVoterList.length++;
+            uint256 id = orgVoterList.length;
+            orgVoterList.push();
             orgVoterList[id].orgId = _orgId;
             orgVoterList[id].voterCount = 1;
             orgVoterList[id].validVoterCount = 1;
@@ -186,8 +194,8 @@ contract VoterManager {
         orgVoterList[id].voteCount++;
         orgVoterList[id].votingStatus[id][_vAccount] = true;
         emit VoteProcessed(_authOrg);
-        if (orgVoterList[id].voteCount > orgVoterList[id].validVoterCount / 2) {
-            // majority achieved, clean up pending op
+        if (orgVoterList[id].voteCount >= _requiredVotes(id, _pendingOp)) {
+            // approval policy met, clean up pending op
             orgVoterList[id].pendingOp.orgId = "";
             orgVoterList[id].pendingOp.enodeId = "";
             orgVoterList[id].pendingOp.account = address(0);
@@ -197,6 +205,28 @@ contract VoterManager {
         return false;
     }
 
+    /** @notice function to set the number of approvals required for an
+        operation type
+      * @param _pendingOp operation type
+      * @param _required number of approvals. zero restores the majority vote
+        and UNANIMOUS requires all voters. a number below the majority
+        still requires the majority
+      */
+    function setApprovalPolicy(uint256 _pendingOp, uint256 _required) external
+    onlyImplementation {
+        require(_pendingOp >= 1 && _pendingOp <= 6, "invalid operation type");
+        approvalPolicy[_pendingOp] = _required;
+        emit ApprovalPolicyChanged(_pendingOp, _required);
+    }
+
+    /** @notice returns the number of approvals set for an operation type
+      * @param _pendingOp operation type
+      * @return number of approvals, zero for majority
+      */
+    function getApprovalPolicy(uint256 _pendingOp) external view returns (uint256) {
+        return approvalPolicy[_pendingOp];
+    }
+
     /** @notice returns the details of any pending operation to be approved
       * @param _orgId org id. this will be the org id of network admin org
       */
@@ -207,6 +237,26 @@ contract VoterManager {
         orgVoterList[orgIndex].pendingOp.account, orgVoterList[orgIndex].pendingOp.opType);
     }
 
+    /** @notice returns the number of votes which complete the pending
+        operation of the org. it is at least the majority of the valid voters
+        and at most all of them
+      * @param _id voter org index
+      * @param _pendingOp operation type
+      */
+    function _requiredVotes(uint256 _id, uint256 _pendingOp)
+    internal view returns (uint256) {
+        uint256 voters = orgVoterList[_id].validVoterCount;
+        uint256 majority = voters / 2 + 1;
+        uint256 required = approvalPolicy[_pendingOp];
+        if (required > voters) {
+            return voters;
+        }
+        if (required < majority) {
+            return majority;
+        }
+        return required;
+    }
+
     /** @notice checks if the voter account exists and is linked to the org
       * @param _orgId org id
       * @param _vAccount voter account id
@@ -237,14 +287,18 @@ contract VoterManager {
     function _getVoterIndex(string memory _orgId, address _vAccount)
     internal view returns (uint256) {
         uint256 orgIndex = _getVoterOrgIndex(_orgId);
-        return orgVoterList[orgIndex].voterIndex[_vAccount] - 1;
+        unchecked {
+            return orgVoterList[orgIndex].voterIndex[_vAccount] - 1;
+        }
     }
 
     /** @notice returns the org index for the org from voter list
       */
     function _getVoterOrgIndex(string memory _orgId)
     internal view returns (uint256) {
-        return VoterOrgIndex[keccak256(abi.encode(_orgId))] - 1;
+        unchecked {
+            return VoterOrgIndex[keccak256(abi.encode(_orgId))] - 1;
+        }
     }
 
 }
@@ -3,17 +3,17 @@
 // this is to generate go binding for smart contracts used in permissioning
 //
 // Require:
-// 1. solc 0.5.4
+// 1. solc 0.8.21
 // 2. abigen (make all from root)
 
-//go:generate solc --abi --bin -o . --overwrite ../AccountManager.sol
-//go:generate solc --abi --bin -o . --overwrite ../NodeManager.sol
-//go:generate solc --abi --bin -o . --overwrite ../OrgManager.sol
-//go:generate solc --abi --bin -o . --overwrite ../PermissionsImplementation.sol
-//go:generate solc --abi --bin -o . --overwrite ../PermissionsInterface.sol
-//go:generate solc --abi --bin -o . --overwrite ../PermissionsUpgradable.sol
-//go:generate solc --abi --bin -o . --overwrite ../RoleManager.sol
-//go:generate solc --abi --bin -o . --overwrite ../VoterManager.sol
+//go:generate solc --optimize --via-ir --evm-version petersburg --abi --bin -o . --overwrite ../AccountManager.sol
+//go:generate solc --optimize --via-ir --evm-version petersburg --abi --bin -o . --overwrite ../NodeManager.sol
+//go:generate solc --optimize --via-ir --evm-version petersburg --abi --bin -o . --overwrite ../OrgManager.sol
+//go:generate solc --optimize --via-ir --evm-version petersburg --abi --bin -o . --overwrite ../PermissionsImplementation.sol
+//go:generate solc --optimize --via-ir --evm-version petersburg --abi --bin -o . --overwrite ../PermissionsInterface.sol
+//go:generate solc --optimize --via-ir --evm-version petersburg --abi --bin -o . --overwrite ../PermissionsUpgradable.sol
+//go:generate solc --optimize --via-ir --evm-version petersburg --abi --bin -o . --overwrite ../RoleManager.sol
+//go:generate solc --optimize --via-ir --evm-version petersburg --abi --bin -o . --overwrite ../VoterManager.sol
 
 //go:generate abigen -pkg bind -abi  ./AccountManager.abi            -bin  ./AccountManager.bin            -type AcctManager   -out ../../bind/accounts.go
 //go:generate abigen -pkg bind -abi  ./NodeManager.abi               -bin  ./NodeManager.bin               -type NodeManager   -out ../../bind/nodes.go
@@ -0,0 +1,301 @@
+package v2
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	pcore "github.com/ethereum/go-ethereum/permission/core"
+	ptype "github.com/ethereum/go-ethereum/permission/core/types"
+	binding "github.com/ethereum/go-ethereum/permission/v2/bind"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testNwAdminOrg   = "NWADMIN"
+	testNwAdminRole  = "NWADMIN"
+	testOrgAdminRole = "OADMIN"
+	testEnodeId      = "ac6b1096ca56b9f6d004b779ae3728bf83f8e22453404cc3cef16a3d9b96608bc67c4b30db88e0a5a6c6390213f7acbe1153ff6d23ce57380104288ae19373ef"
+	testAdminNode    = "enode://" + testEnodeId + "@127.0.0.1:21000?discport=0"
+)
+
+// testNetwork is the v2 permission contracts deployed and booted on a
+// simulated backend, with the guardian as the network admin account and
+// a single admin node
+type testNetwork struct {
+	backend  *backends.SimulatedBackend
+	guardian common.Address
+	init     *Init
+	model    *PermissionModelV2
+}
+
+// newTestNetwork deploys the v2 permission contracts and runs the network
+// boot sequence of the permission service against them
+func newTestNetwork(t *testing.T) *testNetwork {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	guardian := crypto.PubkeyToAddress(key.PublicKey)
+	backend := backends.NewSimulatedBackend(core.GenesisAlloc{
+		guardian: {Balance: new(big.Int).Exp(big.NewInt(10), big.NewInt(30), nil)},
+	}, 100000000)
+	t.Cleanup(func() { backend.Close() })
+
+	config := deployContracts(t, backend, key)
+	init := &Init{Backend: ptype.ContractBackend{EthClnt: backend, Key: key, PermConfig: config}}
+	require.NoError(t, init.BindContracts())
+
+	n := &testNetwork{
+		backend:  backend,
+		guardian: guardian,
+		init:     init,
+		model: &PermissionModelV2{
+			ContractBackend:   init.Backend,
+			PermInterf:        init.PermInterf,
+			PermInterfSession: init.PermInterfSession,
+		},
+	}
+	n.mine(t)(init.SetPolicy(testNwAdminOrg, testNwAdminRole, testOrgAdminRole))
+	n.mine(t)(init.Init(big.NewInt(4), big.NewInt(4)))
+	n.mine(t)(init.AddAdminNode(testAdminNode))
+	n.mine(t)(init.AddAdminAccount(guardian))
+	n.mine(t)(init.UpdateNetworkBootStatus())
+
+	booted, err := init.GetNetworkBootStatus()
+	require.NoError(t, err)
+	require.True(t, booted)
+	return n
+}
+
+func deployContracts(t *testing.T, backend *backends.SimulatedBackend, key *ecdsa.PrivateKey) *ptype.PermissionConfig {
+	auth := bind.NewKeyedTransactor(key)
+	deployed := func(addr common.Address, _ *types.Transaction, _ interface{}, err error) common.Address {
+		require.NoError(t, err)
+		backend.Commit()
+		return addr
+	}
+	config := &ptype.PermissionConfig{PermissionsModel: ptype.PERMISSION_V2}
+	var upgr *binding.PermUpgr
+	var err error
+	config.UpgrdAddress, _, upgr, err = binding.DeployPermUpgr(auth, backend, crypto.PubkeyToAddress(key.PublicKey))
+	require.NoError(t, err)
+	backend.Commit()
+	config.InterfAddress = deployed(binding.DeployPermInterface(auth, backend, config.UpgrdAddress))
+	config.NodeAddress = deployed(binding.DeployNodeManager(auth, backend, config.UpgrdAddress))
+	config.RoleAddress = deployed(binding.DeployRoleManager(auth, backend, config.UpgrdAddress))
+	config.AccountAddress = deployed(binding.DeployAcctManager(auth, backend, config.UpgrdAddress))
+	config.OrgAddress = deployed(binding.DeployOrgManager(auth, backend, config.UpgrdAddress))
+	config.VoterAddress = deployed(binding.DeployVoterManager(auth, backend, config.UpgrdAddress))
+	config.ImplAddress = deployed(binding.DeployPermImpl(auth, backend, config.UpgrdAddress, config.OrgAddress,
+		config.RoleAddress, config.AccountAddress, config.VoterAddress, config.NodeAddress))
+	_, err = upgr.Init(auth, config.InterfAddress, config.ImplAddress)
+	require.NoError(t, err)
+	backend.Commit()
+	return config
+}
+
+// mine returns a function that mines the transaction returned by a
+// contract wrapper and fails the test if it reverted
+func (n *testNetwork) mine(t *testing.T) func(*types.Transaction, error) {
+	return n.mineWithStatus(t, types.ReceiptStatusSuccessful)
+}
+
+// revert returns a function that mines the transaction returned by a
+// contract wrapper and fails the test if it did not revert
+func (n *testNetwork) revert(t *testing.T) func(*types.Transaction, error) {
+	return n.mineWithStatus(t, types.ReceiptStatusFailed)
+}
+
+func (n *testNetwork) mineWithStatus(t *testing.T, status uint64) func(*types.Transaction, error) {
+	return func(tx *types.Transaction, err error) {
+		t.Helper()
+		require.NoError(t, err)
+		n.backend.Commit()
+		receipt, err := n.backend.TransactionReceipt(context.Background(), tx.Hash())
+		require.NoError(t, err)
+		require.Equal(t, status, receipt.Status, "unexpected transaction status")
+	}
+}
+
+func TestAccount_ContractAccess(t *testing.T) {
+	n := newTestNetwork(t)
+	account := &Account{Backend: n.model}
+	contract := common.HexToAddress("0x1932c48b2bf8102ba33b4a6b545c32236e342f34")
+	grantee := common.HexToAddress("0x0638e1574728b6d862dd5d3a3e0942c3be47d996")
+
+	n.mine(t)(account.AddContractAccess(ptype.TxArgs{Access: pcore.ContractAccessInfo{
+		Contract:  contract,
+		AcctId:    &grantee,
+		Selectors: []hexutil.Bytes{{0xa9, 0x05, 0x9c, 0xbb}},
+	}}))
+	n.mine(t)(account.AddContractAccess(ptype.TxArgs{Access: pcore.ContractAccessInfo{
+		Contract: contract,
+		OrgId:    testNwAdminOrg,
+		RoleId:   testNwAdminRole,
+	}}))
+
+	opts := &bind.CallOpts{Pending: true}
+	count, err := n.init.PermAcct.GetNumberOfContractAccesses(opts)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), count.Int64())
+
+	c, acct, orgId, roleId, selectors, active, err := n.init.PermAcct.GetContractAccessDetailsFromIndex(opts, big.NewInt(0))
+	require.NoError(t, err)
+	assert.Equal(t, contract, c)
+	assert.Equal(t, grantee, acct)
+	assert.Empty(t, orgId)
+	assert.Empty(t, roleId)
+	assert.Equal(t, [][4]byte{{0xa9, 0x05, 0x9c, 0xbb}}, selectors)
+	assert.True(t, active)
+
+	c, acct, orgId, roleId, selectors, active, err = n.init.PermAcct.GetContractAccessDetailsFromIndex(opts, big.NewInt(1))
+	require.NoError(t, err)
+	assert.Equal(t, contract, c)
+	assert.Equal(t, common.Address{}, acct)
+	assert.Equal(t, testNwAdminOrg, orgId)
+	assert.Equal(t, testNwAdminRole, roleId)
+	assert.Empty(t, selectors)
+	assert.True(t, active)
+
+	n.mine(t)(account.RemoveContractAccess(ptype.TxArgs{Access: pcore.ContractAccessInfo{
+		Contract: contract,
+		AcctId:   &grantee,
+	}}))
+
+	_, _, _, _, _, active, err = n.init.PermAcct.GetContractAccessDetailsFromIndex(opts, big.NewInt(0))
+	require.NoError(t, err)
+	assert.False(t, active)
+	_, _, _, _, _, active, err = n.init.PermAcct.GetContractAccessDetailsFromIndex(opts, big.NewInt(1))
+	require.NoError(t, err)
+	assert.True(t, active)
+}
+
+func TestAccountAndNode_Validity(t *testing.T) {
+	n := newTestNetwork(t)
+	account := &Account{Backend: n.model}
+	node := &Node{Backend: n.model}
+	opts := &bind.CallOpts{Pending: true}
+
+	n.mine(t)(account.SetAccountValidity(ptype.TxArgs{OrgId: testNwAdminOrg, Validity: pcore.ValidityInfo{
+		Account:    &n.guardian,
+		FromBlock:  5,
+		UntilBlock: 100,
+		UntilTime:  1700000000,
+	}}))
+	from, until, fromTime, untilTime, err := n.init.PermAcct.GetAccountValidity(opts, n.guardian)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{5, 100, 0, 1700000000}, []uint64{from.Uint64(), until.Uint64(), fromTime.Uint64(), untilTime.Uint64()})
+
+	n.mine(t)(node.SetNodeValidity(ptype.TxArgs{OrgId: testNwAdminOrg, Validity: pcore.ValidityInfo{
+		EnodeId:  testEnodeId,
+		FromTime: 1600000000,
+	}}))
+	from, until, fromTime, untilTime, err = n.init.PermNode.GetNodeValidity(opts, testEnodeId)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 0, 1600000000, 0}, []uint64{from.Uint64(), until.Uint64(), fromTime.Uint64(), untilTime.Uint64()})
+
+	// an empty window removes the validity
+	n.mine(t)(account.SetAccountValidity(ptype.TxArgs{OrgId: testNwAdminOrg, Validity: pcore.ValidityInfo{Account: &n.guardian}}))
+	from, until, fromTime, untilTime, err = n.init.PermAcct.GetAccountValidity(opts, n.guardian)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{0, 0, 0, 0}, []uint64{from.Uint64(), until.Uint64(), fromTime.Uint64(), untilTime.Uint64()})
+
+	// windows ending before they start and nodes of another org are rejected
+	n.revert(t)(account.SetAccountValidity(ptype.TxArgs{OrgId: testNwAdminOrg, Validity: pcore.ValidityInfo{
+		Account:    &n.guardian,
+		FromBlock:  10,
+		UntilBlock: 5,
+	}}))
+	n.revert(t)(node.SetNodeValidity(ptype.TxArgs{OrgId: "OTHERORG", Validity: pcore.ValidityInfo{
+		EnodeId:   testEnodeId,
+		UntilTime: 1600000000,
+	}}))
+}
+
+// newTestCert issues a certificate for the org, self signed if no parent is
+// given
+func newTestCert(t *testing.T, org, enodeId string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{Organization: []string{org}, CommonName: org},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	if enodeId != "" {
+		tmpl.URIs = []*url.URL{{Scheme: "enode", Host: enodeId}}
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func TestOrg_OrgCA(t *testing.T) {
+	n := newTestNetwork(t)
+	org := &Org{Backend: n.model}
+	opts := &bind.CallOpts{Pending: true}
+	ca, caKey := newTestCert(t, testNwAdminOrg, "", nil, nil)
+	nodeCert, _ := newTestCert(t, testNwAdminOrg, testEnodeId, ca, caKey)
+	caInfo := pcore.OrgCAInfo{
+		OrgId:       testNwAdminOrg,
+		Certificate: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw})),
+	}
+
+	n.mine(t)(org.AddOrgCA(ptype.TxArgs{OrgId: testNwAdminOrg, OrgCA: caInfo}))
+	// the same authority cannot be added twice
+	n.revert(t)(org.AddOrgCA(ptype.TxArgs{OrgId: testNwAdminOrg, OrgCA: caInfo}))
+
+	list, err := n.init.PermOrg.GetOrgCAList(opts, testNwAdminOrg)
+	require.NoError(t, err)
+	require.Equal(t, [][32]byte{sha256.Sum256(ca.Raw)}, list)
+	der, err := n.init.PermOrg.GetOrgCA(opts, testNwAdminOrg, list[0])
+	require.NoError(t, err)
+	assert.Equal(t, ca.Raw, der)
+
+	// the stored authority is the root of the node certificates of the org
+	pcore.OrgInfoMap = pcore.NewOrgCache(params.DEFAULT_ORGCACHE_SIZE)
+	pcore.OrgInfoMap.UpsertOrg(testNwAdminOrg, "", testNwAdminOrg, big.NewInt(1), pcore.OrgApproved)
+	certs := pcore.NewNodeCertCache()
+	require.NoError(t, certs.AddOrgCA(pcore.OrgCAFromDER(testNwAdminOrg, der)))
+	orgId, err := certs.VerifyNodeCertificate(testEnodeId, [][]byte{nodeCert.Raw})
+	require.NoError(t, err)
+	assert.Equal(t, testNwAdminOrg, orgId)
+
+	n.mine(t)(org.RemoveOrgCA(ptype.TxArgs{OrgId: testNwAdminOrg, OrgCA: pcore.OrgCAInfo{
+		OrgId:       testNwAdminOrg,
+		Fingerprint: hex.EncodeToString(list[0][:]),
+	}}))
+	list, err = n.init.PermOrg.GetOrgCAList(opts, testNwAdminOrg)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	der, err = n.init.PermOrg.GetOrgCA(opts, testNwAdminOrg, sha256.Sum256(ca.Raw))
+	require.NoError(t, err)
+	assert.Empty(t, der)
+}
@@ -0,0 +1,114 @@
+package account
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_accountapproval"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// testApprovalServer holds the transactions to sign for approval
+type testApprovalServer struct {
+	req      *proto_accountapproval.SignTransactionRequest
+	approval *proto_accountapproval.ApprovalResponse
+}
+
+func (s *testApprovalServer) SignTransaction(_ context.Context, req *proto_accountapproval.SignTransactionRequest) (*proto_accountapproval.SignTransactionResponse, error) {
+	s.req = req
+	return &proto_accountapproval.SignTransactionResponse{TicketId: "ticket"}, nil
+}
+
+func (s *testApprovalServer) Approval(_ context.Context, _ *proto_accountapproval.ApprovalRequest) (*proto_accountapproval.ApprovalResponse, error) {
+	return s.approval, nil
+}
+
+// testApprovalConnector serves the test approval service as a plugin would
+type testApprovalConnector struct {
+	ApprovalPluginConnector
+	impl proto_accountapproval.AccountApprovalServiceServer
+}
+
+func (c *testApprovalConnector) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	proto_accountapproval.RegisterAccountApprovalServiceServer(s, c.impl)
+	return nil
+}
+
+func newTestApprovalGateway(t *testing.T, impl proto_accountapproval.AccountApprovalServiceServer) (ApprovalService, func()) {
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		ApprovalConnectorName: &testApprovalConnector{impl: impl},
+	})
+	raw, err := client.Dispense(ApprovalConnectorName)
+	require.NoError(t, err)
+	return raw.(ApprovalService), func() {
+		_ = client.Close()
+		server.Stop()
+	}
+}
+
+func TestApprovalService_SignTx(t *testing.T) {
+	impl := &testApprovalServer{}
+	testObject, done := newTestApprovalGateway(t, impl)
+	defer done()
+	tx := types.NewTransaction(1, common.HexToAddress("0x1"), big.NewInt(1000), 21000, big.NewInt(1), []byte("data"))
+
+	sig, ticketID, err := testObject.SignTx(context.Background(), acct1, tx, big.NewInt(10), []byte("to sign"), "pwd")
+
+	require.NoError(t, err)
+	assert.Empty(t, sig)
+	assert.Equal(t, "ticket", ticketID)
+	assert.Equal(t, acct1.Address.Bytes(), impl.req.Address)
+	assert.Equal(t, []byte{10}, impl.req.ChainId)
+	assert.Equal(t, []byte("to sign"), impl.req.ToSign)
+	assert.Equal(t, "pwd", impl.req.Passphrase)
+	decoded := new(types.Transaction)
+	require.NoError(t, rlp.DecodeBytes(impl.req.RlpTx, decoded))
+	assert.Equal(t, tx.Hash(), decoded.Hash())
+}
+
+func TestApprovalService_Approval(t *testing.T) {
+	impl := &testApprovalServer{approval: &proto_accountapproval.ApprovalResponse{
+		Status: proto_accountapproval.ApprovalStatus_APPROVED,
+		Sig:    []byte("sig"),
+	}}
+	testObject, done := newTestApprovalGateway(t, impl)
+	defer done()
+
+	got, err := testObject.Approval(context.Background(), "ticket")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Approval{Status: ApprovalApproved, Sig: []byte("sig")}, got)
+}
+
+func TestApprovalService_Approval_whenApprovedWithoutSignature(t *testing.T) {
+	impl := &testApprovalServer{approval: &proto_accountapproval.ApprovalResponse{
+		Status: proto_accountapproval.ApprovalStatus_APPROVED,
+	}}
+	testObject, done := newTestApprovalGateway(t, impl)
+	defer done()
+
+	_, err := testObject.Approval(context.Background(), "ticket")
+
+	assert.EqualError(t, err, "approved without signature from plugin")
+}
+
+func TestApprovalService_Approval_whenRejected(t *testing.T) {
+	impl := &testApprovalServer{approval: &proto_accountapproval.ApprovalResponse{
+		Status: proto_accountapproval.ApprovalStatus_REJECTED,
+		Reason: "over the limit",
+	}}
+	testObject, done := newTestApprovalGateway(t, impl)
+	defer done()
+
+	got, err := testObject.Approval(context.Background(), "ticket")
+
+	require.NoError(t, err)
+	assert.Equal(t, &Approval{Status: ApprovalRejected, Reason: "over the limit"}, got)
+}
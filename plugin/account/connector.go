@@ -4,12 +4,16 @@ import (
 	"context"
 
 	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_accountapproval"
 	"github.com/hashicorp/go-plugin"
 	"github.com/jpmorganchase/quorum-account-plugin-sdk-go/proto"
 	"google.golang.org/grpc"
 )
 
-const ConnectorName = "account"
+const (
+	ConnectorName         = "account"
+	ApprovalConnectorName = "accountapproval"
+)
 
 type PluginConnector struct {
 	plugin.Plugin
@@ -24,3 +28,17 @@ func (*PluginConnector) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, cc *
 		client: proto.NewAccountServiceClient(cc),
 	}, nil
 }
+
+type ApprovalPluginConnector struct {
+	plugin.Plugin
+}
+
+func (*ApprovalPluginConnector) GRPCServer(_ *plugin.GRPCBroker, _ *grpc.Server) error {
+	return iplugin.ErrNotSupported
+}
+
+func (*ApprovalPluginConnector) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, cc *grpc.ClientConn) (interface{}, error) {
+	return &approvalService{
+		client: proto_accountapproval.NewAccountApprovalServiceClient(cc),
+	}, nil
+}
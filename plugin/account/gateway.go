@@ -5,14 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_accountapproval"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/jpmorganchase/quorum-account-plugin-sdk-go/proto"
 )
 
@@ -160,6 +164,65 @@ func (g *service) ImportRawKey(ctx context.Context, rawKey string, newAccountCon
 	return acct, nil
 }
 
+type approvalService struct {
+	client proto_accountapproval.AccountApprovalServiceClient
+}
+
+func (g *approvalService) SignTx(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, toSign []byte, passphrase string) ([]byte, string, error) {
+	rlpTx, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return nil, "", err
+	}
+	req := &proto_accountapproval.SignTransactionRequest{
+		Address:    account.Address.Bytes(),
+		RlpTx:      rlpTx,
+		ToSign:     toSign,
+		Passphrase: passphrase,
+	}
+	if chainID != nil {
+		req.ChainId = chainID.Bytes()
+	}
+	resp, err := g.client.SignTransaction(ctx, req)
+	if err != nil {
+		return nil, "", err
+	}
+	if resp == nil {
+		return nil, "", errors.New("empty response from plugin")
+	}
+	if resp.TicketId == "" && len(resp.Sig) == 0 {
+		return nil, "", errors.New("neither signature nor approval ticket from plugin")
+	}
+	return resp.Sig, resp.TicketId, nil
+}
+
+func (g *approvalService) Approval(ctx context.Context, ticketID string) (*Approval, error) {
+	resp, err := g.client.Approval(ctx, &proto_accountapproval.ApprovalRequest{TicketId: ticketID})
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("empty response from plugin")
+	}
+	approval := &Approval{
+		Sig:    resp.Sig,
+		Reason: resp.Reason,
+	}
+	switch resp.Status {
+	case proto_accountapproval.ApprovalStatus_PENDING:
+		approval.Status = ApprovalPending
+	case proto_accountapproval.ApprovalStatus_APPROVED:
+		if len(resp.Sig) == 0 {
+			return nil, errors.New("approved without signature from plugin")
+		}
+		approval.Status = ApprovalApproved
+	case proto_accountapproval.ApprovalStatus_REJECTED:
+		approval.Status = ApprovalRejected
+	default:
+		return nil, fmt.Errorf("unknown approval status %d from plugin", resp.Status)
+	}
+	return approval, nil
+}
+
 func asAccounts(pAccts []*proto.Account) []accounts.Account {
 	accts := make([]accounts.Account, 0, len(pAccts))
 
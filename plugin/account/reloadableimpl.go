@@ -2,9 +2,11 @@ package account
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -103,3 +105,25 @@ func (am *ReloadableService) ImportRawKey(ctx context.Context, rawKey string, ne
 	}
 	return s.ImportRawKey(ctx, rawKey, newAccountConfig)
 }
+
+type ApprovalDispenseFunc func() (ApprovalService, error)
+
+type ReloadableApprovalService struct {
+	DispenseFunc ApprovalDispenseFunc
+}
+
+func (am *ReloadableApprovalService) SignTx(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, toSign []byte, passphrase string) ([]byte, string, error) {
+	s, err := am.DispenseFunc()
+	if err != nil {
+		return nil, "", err
+	}
+	return s.SignTx(ctx, account, tx, chainID, toSign, passphrase)
+}
+
+func (am *ReloadableApprovalService) Approval(ctx context.Context, ticketID string) (*Approval, error) {
+	s, err := am.DispenseFunc()
+	if err != nil {
+		return nil, err
+	}
+	return s.Approval(ctx, ticketID)
+}
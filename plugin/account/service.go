@@ -2,9 +2,11 @@ package account
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 type Service interface {
@@ -24,3 +26,44 @@ type CreatorService interface {
 	NewAccount(ctx context.Context, newAccountConfig interface{}) (accounts.Account, error)
 	ImportRawKey(ctx context.Context, rawKey string, newAccountConfig interface{}) (accounts.Account, error)
 }
+
+type ApprovalStatus int
+
+const (
+	// the transaction awaits approval
+	ApprovalPending ApprovalStatus = iota
+	// the transaction is approved and signed
+	ApprovalApproved
+	// the transaction is rejected and won't be signed
+	ApprovalRejected
+)
+
+func (s ApprovalStatus) String() string {
+	switch s {
+	case ApprovalPending:
+		return "pending"
+	case ApprovalApproved:
+		return "approved"
+	case ApprovalRejected:
+		return "rejected"
+	}
+	return "unknown"
+}
+
+// Approval is the status of a transaction which awaited approval
+type Approval struct {
+	Status ApprovalStatus
+	// signature of the transaction once approved
+	Sig []byte
+	// reason of the rejection
+	Reason string
+}
+
+// ApprovalService signs transactions subject to the signing policy of the account plugin,
+// which may hold a transaction until it is approved out of band
+type ApprovalService interface {
+	// SignTx returns the signature of toSign, the hash of tx, or the ticket of the approval if tx awaits approval
+	SignTx(ctx context.Context, account accounts.Account, tx *types.Transaction, chainID *big.Int, toSign []byte, passphrase string) (sig []byte, ticketID string, err error)
+	// Approval returns the status of the approval of a ticket
+	Approval(ctx context.Context, ticketID string) (*Approval, error)
+}
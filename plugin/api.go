@@ -13,3 +13,8 @@ func NewPluginManagerAPI(pm *PluginManager) *PluginManagerAPI {
 func (pmapi *PluginManagerAPI) ReloadPlugin(name PluginInterfaceName) (bool, error) {
 	return pmapi.pm.Reload(name)
 }
+
+// Plugins returns the plugins in use and their health
+func (pmapi *PluginManagerAPI) Plugins() interface{} {
+	return pmapi.pm.PluginsInfo()
+}
@@ -23,6 +23,8 @@ import (
 type managedPlugin interface {
 	Start() error
 	Stop() error
+	// Ping checks that the plugin is serving gRPC
+	Ping() error
 
 	Info() (PluginInterfaceName, interface{})
 }
@@ -185,7 +187,24 @@ func (bp *basePlugin) init() error {
 	return c.Init(context.Background(), bp.pm.nodeName, rawConfig)
 }
 
+func (bp *basePlugin) Ping() error {
+	if bp.client == nil {
+		return fmt.Errorf("plugin %s has not been started", bp.pluginInterface)
+	}
+	if bp.client.Exited() {
+		return fmt.Errorf("plugin %s exited", bp.pluginInterface)
+	}
+	rpcClient, err := bp.client.Client()
+	if err != nil {
+		return err
+	}
+	return rpcClient.Ping()
+}
+
 func (bp *basePlugin) dispense(name string) (interface{}, error) {
+	if bp.client == nil {
+		return nil, fmt.Errorf("plugin %s has not been started", bp.pluginInterface)
+	}
 	rpcClient, err := bp.client.Client()
 	if err != nil {
 		return nil, err
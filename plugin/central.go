@@ -111,6 +111,14 @@ func (cc *CentralClient) get(target string) (io.ReadCloser, error) {
 	if err := isValidTargetURL(cc.config.BaseURL, target); err != nil {
 		return nil, err
 	}
+	// a file-based registry laid out like Plugin Central
+	if strings.HasPrefix(target, "file://") {
+		filePath, err := resolveFilePath(target)
+		if err != nil {
+			return nil, err
+		}
+		return os.Open(filePath)
+	}
 	res, err := cc.httpClient.Get(target)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,225 @@
+package conformance
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/account"
+)
+
+// AccountFixtures describes how the account plugin under test creates accounts
+type AccountFixtures struct {
+	// configuration of the accounts created by the suite, as given to geth account plugin new
+	NewAccountConfig interface{} `json:"newAccountConfig"`
+	// passphrase of the accounts created with NewAccountConfig
+	Passphrase string `json:"passphrase"`
+	// the plugin imports raw keys
+	ImportRawKey bool `json:"importRawKey"`
+	// duration of the timed unlock verified to expire, 2s if empty
+	UnlockDuration string `json:"unlockDuration,omitempty"`
+}
+
+func accountSuite(t *testing.T, h *Harness) {
+	fixtures := h.Config.Account
+	if fixtures == nil {
+		t.Fatal("no account fixtures")
+	}
+	unlockDuration := 2 * time.Second
+	if fixtures.UnlockDuration != "" {
+		var err error
+		if unlockDuration, err = time.ParseDuration(fixtures.UnlockDuration); err != nil {
+			t.Fatalf("invalid unlockDuration %s: %v", fixtures.UnlockDuration, err)
+		}
+	}
+	f := new(plugin.ReloadableAccountServiceFactory)
+	h.Template(t, f)
+	s, err := f.Create()
+	if err != nil {
+		t.Fatalf("unable to get the account service: %v", err)
+	}
+
+	ctx, cancel := h.Context()
+	acct, err := s.NewAccount(ctx, fixtures.NewAccountConfig)
+	cancel()
+	if err != nil {
+		t.Fatalf("NewAccount failed: %v", err)
+	}
+	if acct.Address == (common.Address{}) {
+		t.Fatal("NewAccount returned the zero address")
+	}
+	hash := crypto.Keccak256([]byte("conformance"))
+
+	t.Run("Status", func(t *testing.T) {
+		ctx, cancel := h.Context()
+		defer cancel()
+		if _, err := s.Status(ctx); err != nil {
+			t.Fatalf("Status failed: %v", err)
+		}
+	})
+	t.Run("Accounts", func(t *testing.T) {
+		assertContains(t, h, s, acct, true)
+		assertContains(t, h, s, accounts.Account{Address: common.HexToAddress("0x1")}, false)
+	})
+	t.Run("Locked", func(t *testing.T) {
+		ctx, cancel := h.Context()
+		defer cancel()
+		if err := s.Lock(ctx, acct); err != nil {
+			t.Fatalf("Lock failed: %v", err)
+		}
+		if _, err := s.Sign(ctx, acct, hash); err == nil {
+			t.Fatal("locked account signed")
+		}
+	})
+	t.Run("UnknownAccount", func(t *testing.T) {
+		ctx, cancel := h.Context()
+		defer cancel()
+		if _, err := s.Sign(ctx, accounts.Account{Address: common.HexToAddress("0x1")}, hash); err == nil {
+			t.Fatal("unknown account signed")
+		}
+	})
+	t.Run("UnlockAndSign", func(t *testing.T) {
+		ctx, cancel := h.Context()
+		defer cancel()
+		if _, err := s.UnlockAndSign(ctx, acct, hash, fixtures.Passphrase+"wrong"); err == nil {
+			t.Error("account unlocked with a wrong passphrase")
+		}
+		sig, err := s.UnlockAndSign(ctx, acct, hash, fixtures.Passphrase)
+		if err != nil {
+			t.Fatalf("UnlockAndSign failed: %v", err)
+		}
+		assertSignature(t, hash, sig, acct.Address)
+		if _, err := s.Sign(ctx, acct, hash); err == nil {
+			t.Error("account still unlocked after UnlockAndSign")
+		}
+	})
+	t.Run("TimedUnlock", func(t *testing.T) {
+		ctx, cancel := h.Context()
+		defer cancel()
+		if err := s.TimedUnlock(ctx, acct, fixtures.Passphrase+"wrong", unlockDuration); err == nil {
+			t.Error("account unlocked with a wrong passphrase")
+		}
+		if err := s.TimedUnlock(ctx, acct, fixtures.Passphrase, unlockDuration); err != nil {
+			t.Fatalf("TimedUnlock failed: %v", err)
+		}
+		sig, err := s.Sign(ctx, acct, hash)
+		if err != nil {
+			t.Fatalf("Sign of unlocked account failed: %v", err)
+		}
+		assertSignature(t, hash, sig, acct.Address)
+
+		time.Sleep(unlockDuration + time.Second)
+		ctx, cancel = h.Context()
+		defer cancel()
+		if _, err := s.Sign(ctx, acct, hash); err == nil {
+			t.Errorf("account still unlocked after %v", unlockDuration)
+		}
+	})
+	t.Run("ImportRawKey", func(t *testing.T) {
+		if !fixtures.ImportRawKey {
+			t.Skip("raw keys are not imported")
+		}
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ctx, cancel := h.Context()
+		defer cancel()
+		imported, err := s.ImportRawKey(ctx, common.Bytes2Hex(crypto.FromECDSA(key)), fixtures.NewAccountConfig)
+		if err != nil {
+			t.Fatalf("ImportRawKey failed: %v", err)
+		}
+		if want := crypto.PubkeyToAddress(key.PublicKey); imported.Address != want {
+			t.Errorf("imported account %s instead of %s", imported.Address.Hex(), want.Hex())
+		}
+	})
+	t.Run("Approval", func(t *testing.T) {
+		approvals, err := f.CreateApprovalService()
+		if err != nil {
+			t.Fatalf("unable to get the approval service: %v", err)
+		}
+		if approvals == nil {
+			t.Skip("AccountApprovalService service is not implemented")
+		}
+		assertApproval(t, h, approvals, acct, fixtures.Passphrase)
+	})
+	t.Run("Reload", func(t *testing.T) {
+		h.Reload(t)
+		assertContains(t, h, s, acct, true)
+		ctx, cancel := h.Context()
+		defer cancel()
+		sig, err := s.UnlockAndSign(ctx, acct, hash, fixtures.Passphrase)
+		if err != nil {
+			t.Fatalf("UnlockAndSign failed after reload: %v", err)
+		}
+		assertSignature(t, hash, sig, acct.Address)
+	})
+}
+
+func assertContains(t *testing.T, h *Harness, s account.Service, acct accounts.Account, contained bool) {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	if s.Contains(ctx, acct) != contained {
+		t.Errorf("Contains(%s) is not %v", acct.Address.Hex(), contained)
+	}
+	listed := false
+	for _, a := range s.Accounts(ctx) {
+		listed = listed || a.Address == acct.Address
+	}
+	if listed != contained {
+		t.Errorf("account %s is listed: %v", acct.Address.Hex(), listed)
+	}
+}
+
+func assertApproval(t *testing.T, h *Harness, approvals account.ApprovalService, acct accounts.Account, passphrase string) {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	approval, err := approvals.Approval(ctx, "unknown ticket")
+	if err != nil {
+		t.Fatalf("Approval of unknown ticket failed: %v", err)
+	}
+	if approval.Status != account.ApprovalRejected {
+		t.Errorf("unknown ticket is %s instead of rejected", approval.Status)
+	}
+
+	chainID := big.NewInt(10)
+	tx := types.NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(0), nil)
+	signer := types.NewEIP155Signer(chainID)
+	toSign := signer.Hash(tx)
+	sig, ticketID, err := approvals.SignTx(ctx, acct, tx, chainID, toSign.Bytes(), passphrase)
+	if err != nil {
+		t.Fatalf("SignTx failed: %v", err)
+	}
+	if ticketID != "" {
+		if approval, err = approvals.Approval(ctx, ticketID); err != nil {
+			t.Fatalf("Approval of ticket %s failed: %v", ticketID, err)
+		}
+		if approval.Status != account.ApprovalApproved {
+			return
+		}
+		sig = approval.Sig
+	}
+	assertSignature(t, toSign.Bytes(), sig, acct.Address)
+}
+
+func assertSignature(t *testing.T, hash, sig []byte, address common.Address) {
+	t.Helper()
+	if len(sig) != crypto.SignatureLength {
+		t.Fatalf("signature of %d bytes instead of %d", len(sig), crypto.SignatureLength)
+	}
+	pub, err := crypto.Ecrecover(hash, sig)
+	if err != nil {
+		t.Fatalf("invalid signature: %v", err)
+	}
+	if signer := common.BytesToAddress(crypto.Keccak256(pub[1:])[12:]); !bytes.Equal(signer.Bytes(), address.Bytes()) {
+		t.Fatalf("signed by %s instead of %s", signer.Hex(), address.Hex())
+	}
+}
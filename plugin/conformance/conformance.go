@@ -0,0 +1,240 @@
+// Package conformance verifies that a plugin fulfils the contract of its plugin interface.
+//
+// The plugin distribution is started the way geth starts it, by the plugin manager from the
+// plugin base directory, and is then exercised through the same templates geth uses: lifecycle,
+// error codes, timeouts, expiry and reload. Plugin authors run the suite in their CI from a Go
+// test:
+//
+//	func TestConformance(t *testing.T) {
+//		conformance.Run(t, &conformance.Config{
+//			Interface:  plugin.SecurityPluginInterfaceName,
+//			BaseDir:    "build/dist",
+//			Definition: plugin.PluginDefinition{Name: "quorum-security-plugin-enterprise", Version: "0.1.0"},
+//			Security:   &conformance.SecurityFixtures{TLS: true, Authentication: true, ValidToken: token},
+//		})
+//	}
+//
+// or, for plugins not written in Go, with a JSON configuration:
+//
+//	PLUGIN_CONFORMANCE_CONFIG=conformance.json go test ./plugin/conformance -run TestConformance
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/plugin"
+)
+
+const (
+	DefaultCallTimeout  = 5 * time.Second
+	DefaultStartTimeout = 30 * time.Second
+)
+
+// Config describes the plugin under test
+type Config struct {
+	// plugin interface implemented by the plugin
+	Interface plugin.PluginInterfaceName `json:"interface"`
+	// directory containing the plugin distribution <name>-<version>.zip, see Package
+	BaseDir string `json:"baseDir"`
+	// name, version and configuration of the plugin as in the plugin settings of geth
+	Definition plugin.PluginDefinition `json:"plugin"`
+	// maximum time of a call to the plugin, DefaultCallTimeout if empty
+	CallTimeout string `json:"callTimeout,omitempty"`
+	// maximum time for the plugin to start, DefaultStartTimeout if empty
+	StartTimeout string `json:"startTimeout,omitempty"`
+
+	// fixtures of the suites of the plugin interfaces
+	Security         *SecurityFixtures         `json:"security,omitempty"`
+	Account          *AccountFixtures          `json:"account,omitempty"`
+	RPC              *RPCFixtures              `json:"rpc,omitempty"`
+	PrivateTxManager *PrivateTxManagerFixtures `json:"privateTxManager,omitempty"`
+}
+
+func (c *Config) durations() (call, start time.Duration, err error) {
+	call, start = DefaultCallTimeout, DefaultStartTimeout
+	if c.CallTimeout != "" {
+		if call, err = time.ParseDuration(c.CallTimeout); err != nil {
+			return 0, 0, fmt.Errorf("invalid callTimeout %s: %v", c.CallTimeout, err)
+		}
+	}
+	if c.StartTimeout != "" {
+		if start, err = time.ParseDuration(c.StartTimeout); err != nil {
+			return 0, 0, fmt.Errorf("invalid startTimeout %s: %v", c.StartTimeout, err)
+		}
+	}
+	return
+}
+
+// Suite exercises the contract of a plugin interface with the plugin started
+type Suite func(t *testing.T, h *Harness)
+
+var (
+	suitesMu sync.RWMutex
+	suites   = map[plugin.PluginInterfaceName]Suite{
+		plugin.HelloWorldPluginInterfaceName:       helloWorldSuite,
+		plugin.SecurityPluginInterfaceName:         securitySuite,
+		plugin.AccountPluginInterfaceName:          accountSuite,
+		plugin.PrivateTxManagerPluginInterfaceName: privateTxManagerSuite,
+		plugin.TxValidatorPluginInterfaceName:      txValidatorSuite,
+		plugin.EventStreamPluginInterfaceName:      eventStreamSuite,
+		plugin.SignerPluginInterfaceName:           signerSuite,
+		plugin.RPCPluginInterfaceName:              rpcSuite,
+	}
+)
+
+// Register sets the suite of a plugin interface, e.g. of a plugin interface added after this
+// package. Run fails for plugin interfaces without a suite.
+func Register(name plugin.PluginInterfaceName, suite Suite) {
+	suitesMu.Lock()
+	defer suitesMu.Unlock()
+	suites[name] = suite
+}
+
+func suite(name plugin.PluginInterfaceName) (Suite, bool) {
+	suitesMu.RLock()
+	defer suitesMu.RUnlock()
+	s, ok := suites[name]
+	return s, ok
+}
+
+// Harness gives the suites access to the started plugin
+type Harness struct {
+	Config *Config
+	// plugin manager running the plugin under test only
+	PluginManager *plugin.PluginManager
+
+	callTimeout  time.Duration
+	startTimeout time.Duration
+}
+
+// Context returns the context of a call to the plugin, which must complete within the call timeout
+func (h *Harness) Context() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), h.callTimeout)
+}
+
+// Template populates a template of the plugin manager, e.g. *plugin.SecurityPluginTemplate
+func (h *Harness) Template(t *testing.T, template interface{}) {
+	t.Helper()
+	v, ok := template.(pluginTemplate)
+	if !ok {
+		t.Fatalf("%T is not a template of the plugin manager", template)
+	}
+	if err := h.PluginManager.GetPluginTemplate(h.Config.Interface, v); err != nil {
+		t.Fatalf("unable to get the template of the plugin: %v", err)
+	}
+}
+
+// Reload restarts the plugin as geth does when the plugin is reloaded
+func (h *Harness) Reload(t *testing.T) {
+	t.Helper()
+	if err := withTimeout(h.startTimeout, func() error {
+		_, err := h.PluginManager.Reload(h.Config.Interface)
+		return err
+	}); err != nil {
+		t.Fatalf("unable to reload the plugin: %v", err)
+	}
+}
+
+// pluginTemplate is satisfied by the templates of the plugin manager
+type pluginTemplate interface {
+	Start() error
+	Stop() error
+	Ping() error
+	Info() (plugin.PluginInterfaceName, interface{})
+}
+
+// Run starts the plugin and runs the suite of its plugin interface
+func Run(t *testing.T, cfg *Config) {
+	callTimeout, startTimeout, err := cfg.durations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, ok := suite(cfg.Interface)
+	if !ok {
+		t.Fatalf("no conformance suite for plugin interface %s", cfg.Interface)
+	}
+	pm, err := plugin.NewPluginManager("conformance", &plugin.Settings{
+		BaseDir: plugin.EnvironmentAwaredValue(cfg.BaseDir),
+		Providers: map[plugin.PluginInterfaceName]plugin.PluginDefinition{
+			cfg.Interface: cfg.Definition,
+		},
+	}, true, false, "")
+	if err != nil {
+		t.Fatalf("unable to load the plugin: %v", err)
+	}
+	h := &Harness{
+		Config:        cfg,
+		PluginManager: pm,
+		callTimeout:   callTimeout,
+		startTimeout:  startTimeout,
+	}
+	if err := withTimeout(startTimeout, func() error { return pm.Start(nil) }); err != nil {
+		t.Fatalf("unable to start the plugin: %v", err)
+	}
+	defer func() {
+		if err := withTimeout(startTimeout, pm.Stop); err != nil {
+			t.Errorf("unable to stop the plugin: %v", err)
+		}
+	}()
+	t.Run("Lifecycle", func(t *testing.T) {
+		lifecycleSuite(t, h)
+	})
+	t.Run(string(cfg.Interface), func(t *testing.T) {
+		s(t, h)
+	})
+}
+
+// lifecycleSuite verifies the plugin is healthy after it is started and reloaded
+func lifecycleSuite(t *testing.T, h *Harness) {
+	assertHealthy := func(t *testing.T) {
+		t.Helper()
+		info, _ := h.PluginManager.PluginsInfo().(map[plugin.PluginInterfaceName]interface{})
+		details, _ := info[h.Config.Interface].(map[string]interface{})
+		if health, ok := details["health"].(plugin.PluginHealth); ok && health.Status != plugin.PluginRunning {
+			t.Fatalf("plugin is %s: %s", health.Status, health.LastError)
+		}
+	}
+	t.Run("Started", assertHealthy)
+	t.Run("Reloaded", func(t *testing.T) {
+		h.Reload(t)
+		assertHealthy(t)
+	})
+}
+
+// withTimeout fails if f doesn't return within the timeout, f is left running in that case
+func withTimeout(timeout time.Duration, f func() error) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- f()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return fmt.Errorf("timed out after %v", timeout)
+	}
+}
+
+// configFromEnv reads the configuration from the file of the PLUGIN_CONFORMANCE_CONFIG environment variable
+func configFromEnv() (*Config, bool, error) {
+	file, ok := os.LookupEnv("PLUGIN_CONFORMANCE_CONFIG")
+	if !ok {
+		return nil, false, nil
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, true, err
+	}
+	cfg := new(Config)
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, true, fmt.Errorf("invalid configuration %s: %v", file, err)
+	}
+	return cfg, true, nil
+}
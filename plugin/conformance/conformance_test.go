@@ -0,0 +1,131 @@
+package conformance
+
+import (
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/plugin"
+)
+
+// servePluginArg starts the test binary as the test plugin, see Package
+const servePluginArg = "serve-conformance-plugin"
+
+func TestMain(m *testing.M) {
+	for _, arg := range os.Args[1:] {
+		if arg == servePluginArg {
+			serveTestPlugin()
+			return
+		}
+	}
+	os.Exit(m.Run())
+}
+
+func pemEncode(blockType string, der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+// testConfig packages the test binary as a plugin distribution implementing the given plugin interface
+func testConfig(t *testing.T, name plugin.PluginInterfaceName) (*Config, func()) {
+	dir, err := ioutil.TempDir("", "conformance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDir := filepath.Join(dir, "keys")
+	if err := os.Mkdir(keyDir, 0700); err != nil {
+		t.Fatal(err)
+	}
+	definition := plugin.PluginDefinition{
+		Name:    "quorum-conformance-test-plugin",
+		Version: "1.0.0",
+		Config: map[string]string{
+			"keyDir":    keyDir,
+			"signerKey": "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291",
+		},
+	}
+	if err := Package(dir, definition, os.Args[0], servePluginArg); err != nil {
+		t.Fatal(err)
+	}
+	return &Config{
+		Interface:  name,
+		BaseDir:    dir,
+		Definition: definition,
+	}, func() { os.RemoveAll(dir) }
+}
+
+func TestRun_whenSecurityPlugin(t *testing.T) {
+	cfg, cleanup := testConfig(t, plugin.SecurityPluginInterfaceName)
+	defer cleanup()
+	cfg.Security = &SecurityFixtures{
+		TLS:            true,
+		Authentication: true,
+		ValidToken:     testValidToken,
+		ExpiredToken:   testExpiredToken,
+	}
+
+	Run(t, cfg)
+}
+
+func TestRun_whenAccountPlugin(t *testing.T) {
+	cfg, cleanup := testConfig(t, plugin.AccountPluginInterfaceName)
+	defer cleanup()
+	cfg.Account = &AccountFixtures{
+		NewAccountConfig: map[string]string{},
+		Passphrase:       testPassphrase,
+		ImportRawKey:     true,
+		UnlockDuration:   "1s",
+	}
+
+	Run(t, cfg)
+}
+
+func TestRun_whenSignerPlugin(t *testing.T) {
+	cfg, cleanup := testConfig(t, plugin.SignerPluginInterfaceName)
+	defer cleanup()
+
+	Run(t, cfg)
+}
+
+func TestRun_whenHelloWorldPlugin(t *testing.T) {
+	cfg, cleanup := testConfig(t, plugin.HelloWorldPluginInterfaceName)
+	defer cleanup()
+
+	Run(t, cfg)
+}
+
+func TestRun_whenPrivateTxManagerPlugin(t *testing.T) {
+	cfg, cleanup := testConfig(t, plugin.PrivateTxManagerPluginInterfaceName)
+	defer cleanup()
+	cfg.PrivateTxManager = &PrivateTxManagerFixtures{To: []string{"recipient"}}
+
+	Run(t, cfg)
+}
+
+func TestRun_whenTxValidatorPlugin(t *testing.T) {
+	cfg, cleanup := testConfig(t, plugin.TxValidatorPluginInterfaceName)
+	defer cleanup()
+
+	Run(t, cfg)
+}
+
+func TestRun_whenEventStreamPlugin(t *testing.T) {
+	cfg, cleanup := testConfig(t, plugin.EventStreamPluginInterfaceName)
+	defer cleanup()
+
+	Run(t, cfg)
+}
+
+// TestConformance verifies the plugin described by the PLUGIN_CONFORMANCE_CONFIG environment variable
+func TestConformance(t *testing.T) {
+	cfg, ok, err := configFromEnv()
+	if !ok {
+		t.Skip("PLUGIN_CONFORMANCE_CONFIG is not set")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Run(t, cfg)
+}
@@ -0,0 +1,44 @@
+package conformance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/eventstream"
+)
+
+func eventStreamSuite(t *testing.T, h *Harness) {
+	ep := new(plugin.EventStreamPluginTemplate)
+	h.Template(t, ep)
+	s, err := ep.Get()
+	if err != nil {
+		t.Fatalf("unable to get the event stream: %v", err)
+	}
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1), Time: 1})
+	canonical := &eventstream.BlockEvent{Type: eventstream.Canonical, Block: block, Receipts: types.Receipts{}}
+	t.Run("Deliver", func(t *testing.T) {
+		assertDeliver(t, h, s, canonical)
+	})
+	t.Run("Redeliver", func(t *testing.T) {
+		// events are delivered at least once, e.g. again after a restart of geth
+		assertDeliver(t, h, s, canonical)
+	})
+	t.Run("Removed", func(t *testing.T) {
+		assertDeliver(t, h, s, &eventstream.BlockEvent{Type: eventstream.Removed, Block: block, Receipts: types.Receipts{}})
+	})
+	t.Run("Reload", func(t *testing.T) {
+		h.Reload(t)
+		assertDeliver(t, h, s, canonical)
+	})
+}
+
+func assertDeliver(t *testing.T, h *Harness, s eventstream.EventStream, ev *eventstream.BlockEvent) {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	if err := s.Deliver(ctx, ev); err != nil {
+		t.Fatalf("Deliver of %s block %d failed: %v", ev.Type, ev.Block.NumberU64(), err)
+	}
+}
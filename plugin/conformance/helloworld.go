@@ -0,0 +1,37 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/helloworld"
+)
+
+func helloWorldSuite(t *testing.T, h *Harness) {
+	hp := new(plugin.HelloWorldPluginTemplate)
+	h.Template(t, hp)
+	s, err := hp.Get()
+	if err != nil {
+		t.Fatalf("unable to get the hello world service: %v", err)
+	}
+	t.Run("Greeting", func(t *testing.T) {
+		assertGreeting(t, h, s)
+	})
+	t.Run("Reload", func(t *testing.T) {
+		h.Reload(t)
+		assertGreeting(t, h, s)
+	})
+}
+
+func assertGreeting(t *testing.T, h *Harness, s helloworld.PluginHelloWorld) {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	greeting, err := s.Greeting(ctx, "conformance")
+	if err != nil {
+		t.Fatalf("Greeting failed: %v", err)
+	}
+	if greeting == "" {
+		t.Fatal("Greeting returned an empty greeting")
+	}
+}
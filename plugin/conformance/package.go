@@ -0,0 +1,69 @@
+package conformance
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/ethereum/go-ethereum/plugin"
+)
+
+// Package writes the distribution of the plugin to baseDir, as geth expects it in the plugin base
+// directory, with the executable as entry point
+func Package(baseDir string, definition plugin.PluginDefinition, executable string, parameters ...string) error {
+	meta, err := json.Marshal(&plugin.MetaData{
+		Version:    string(definition.Version),
+		Os:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		EntryPoint: filepath.Base(executable),
+		Parameters: parameters,
+	})
+	if err != nil {
+		return err
+	}
+	f, err := os.Create(filepath.Join(baseDir, definition.DistFileName()))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w := zip.NewWriter(f)
+	metaWriter, err := w.Create("plugin-meta.json")
+	if err != nil {
+		return err
+	}
+	if _, err := metaWriter.Write(meta); err != nil {
+		return err
+	}
+	if err := addFile(w, executable); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+func addFile(w *zip.Writer, file string) error {
+	in, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return err
+	}
+	out, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(out, in)
+	return err
+}
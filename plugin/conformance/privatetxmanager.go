@@ -0,0 +1,91 @@
+package conformance
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/private"
+)
+
+// PrivateTxManagerFixtures are the keys the private transaction manager plugin under test sends payloads with
+type PrivateTxManagerFixtures struct {
+	// public key of the sender, empty for the default key of the private transaction manager
+	From string `json:"from"`
+	// public keys of the recipients
+	To []string `json:"to"`
+}
+
+func privateTxManagerSuite(t *testing.T, h *Harness) {
+	pp := new(plugin.PrivateTxManagerPluginTemplate)
+	h.Template(t, pp)
+	ptm, err := pp.Get()
+	if err != nil {
+		t.Fatalf("unable to get the private transaction manager: %v", err)
+	}
+	assertName(t, h, ptm)
+	t.Run("UnknownPayload", func(t *testing.T) {
+		hash := common.BytesToEncryptedPayloadHash(crypto.Keccak512([]byte("conformance unknown payload")))
+		var payload []byte
+		if err := withTimeout(h.callTimeout, func() (err error) {
+			payload, _, err = ptm.Receive(hash)
+			return
+		}); err != nil {
+			t.Fatalf("Receive of an unknown payload failed: %v", err)
+		}
+		if len(payload) != 0 {
+			t.Fatalf("payload %x for an unknown hash", payload)
+		}
+	})
+	t.Run("SendReceive", func(t *testing.T) {
+		fixtures := h.Config.PrivateTxManager
+		if fixtures == nil {
+			t.Skip("no private transaction manager fixtures")
+		}
+		payload := []byte("conformance payload")
+		var hash common.EncryptedPayloadHash
+		if err := withTimeout(h.callTimeout, func() (err error) {
+			hash, err = ptm.Send(payload, fixtures.From, fixtures.To, nil)
+			return
+		}); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+		var received []byte
+		var sender bool
+		if err := withTimeout(h.callTimeout, func() (err error) {
+			if received, _, err = ptm.Receive(hash); err != nil {
+				return
+			}
+			sender, err = ptm.IsSender(hash)
+			return
+		}); err != nil {
+			t.Fatalf("Receive failed: %v", err)
+		}
+		if !bytes.Equal(received, payload) {
+			t.Fatalf("received %x instead of %x", received, payload)
+		}
+		if !sender {
+			t.Fatal("not the sender of the payload it sent")
+		}
+	})
+	t.Run("Reload", func(t *testing.T) {
+		h.Reload(t)
+		assertName(t, h, ptm)
+	})
+}
+
+func assertName(t *testing.T, h *Harness, ptm private.PrivateTransactionManager) {
+	t.Helper()
+	var name string
+	if err := withTimeout(h.callTimeout, func() (err error) {
+		name, err = ptm.Name()
+		return
+	}); err != nil {
+		t.Fatalf("Name failed: %v", err)
+	}
+	if name == "" {
+		t.Fatal("Name returned an empty name")
+	}
+}
@@ -0,0 +1,117 @@
+package conformance
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/rpcservice"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// RPCFixtures are the calls verified against the JSON-RPC service plugin under test
+type RPCFixtures struct {
+	Calls []RPCCall `json:"calls"`
+}
+
+// RPCCall is a call and its expected result or error
+type RPCCall struct {
+	// name of the method, without namespace
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	// expected JSON result, not verified if empty
+	Result json.RawMessage `json:"result,omitempty"`
+	// expected JSON-RPC error code, if the call fails
+	ErrorCode int `json:"errorCode,omitempty"`
+}
+
+func rpcSuite(t *testing.T, h *Harness) {
+	rp := new(plugin.RPCPluginTemplate)
+	h.Template(t, rp)
+	s, err := rp.Get()
+	if err != nil {
+		t.Fatalf("unable to get the JSON-RPC service: %v", err)
+	}
+	description := assertDescription(t, h, s)
+	t.Run("UndeclaredMethod", func(t *testing.T) {
+		ctx, cancel := h.Context()
+		defer cancel()
+		_, err := s.Call(ctx, "conformanceUndeclaredMethod", json.RawMessage("[]"))
+		if err == nil {
+			t.Fatal("undeclared method called")
+		}
+		if _, ok := err.(rpc.Error); !ok {
+			t.Fatalf("no JSON-RPC error for undeclared method: %v", err)
+		}
+	})
+	t.Run("Calls", func(t *testing.T) {
+		var calls []RPCCall
+		if h.Config.RPC != nil {
+			calls = h.Config.RPC.Calls
+		}
+		if len(calls) == 0 {
+			t.Skip("no calls")
+		}
+		for _, call := range calls {
+			assertCall(t, h, s, call)
+		}
+	})
+	t.Run("Reload", func(t *testing.T) {
+		h.Reload(t)
+		// geth registers the methods once, when the plugin is first loaded
+		reloaded := assertDescription(t, h, s)
+		if reloaded.Namespace != description.Namespace || len(reloaded.Methods) != len(description.Methods) {
+			t.Fatalf("service %s%v instead of %s%v after reload", reloaded.Namespace, reloaded.Methods, description.Namespace, description.Methods)
+		}
+	})
+}
+
+func assertDescription(t *testing.T, h *Harness, s rpcservice.RPCService) *rpcservice.Description {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	description, err := s.Describe(ctx)
+	if err != nil {
+		t.Fatalf("Describe failed: %v", err)
+	}
+	if err := description.Validate(); err != nil {
+		t.Fatalf("invalid description: %v", err)
+	}
+	return description
+}
+
+func assertCall(t *testing.T, h *Harness, s rpcservice.RPCService, call RPCCall) {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	params := call.Params
+	if len(params) == 0 {
+		params = json.RawMessage("[]")
+	}
+	result, err := s.Call(ctx, call.Method, params)
+	if call.ErrorCode != 0 {
+		rpcErr, ok := err.(rpc.Error)
+		if !ok {
+			t.Errorf("%s: no JSON-RPC error: %v", call.Method, err)
+		} else if rpcErr.ErrorCode() != call.ErrorCode {
+			t.Errorf("%s: error code %d instead of %d", call.Method, rpcErr.ErrorCode(), call.ErrorCode)
+		}
+		return
+	}
+	if err != nil {
+		t.Errorf("%s failed: %v", call.Method, err)
+		return
+	}
+	if len(call.Result) > 0 && !jsonEqual(result, call.Result) {
+		t.Errorf("%s: result %s instead of %s", call.Method, result, call.Result)
+	}
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	var ca, cb bytes.Buffer
+	if json.Compact(&ca, a) != nil || json.Compact(&cb, b) != nil {
+		return bytes.Equal(a, b)
+	}
+	return bytes.Equal(ca.Bytes(), cb.Bytes())
+}
@@ -0,0 +1,171 @@
+package conformance
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/security"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SecurityFixtures describes what the security plugin under test provides
+type SecurityFixtures struct {
+	// the plugin must provide a TLS configuration
+	TLS bool `json:"tls"`
+	// the plugin must authenticate the requests
+	Authentication bool `json:"authentication"`
+	// token the plugin authenticates, e.g. issued for the CI by the authorization server the
+	// plugin is configured with, not verified if empty
+	ValidToken string `json:"validToken,omitempty"`
+	// expired token the plugin rejects, or accepts with an expiration in the past, not verified if empty
+	ExpiredToken string `json:"expiredToken,omitempty"`
+}
+
+func securitySuite(t *testing.T, h *Harness) {
+	fixtures := h.Config.Security
+	if fixtures == nil {
+		fixtures = &SecurityFixtures{}
+	}
+	t.Run("TLSConfigurationSource", func(t *testing.T) {
+		sp := new(plugin.SecurityPluginTemplate)
+		h.Template(t, sp)
+		source, err := sp.TLSConfigurationSource()
+		if err != nil {
+			t.Fatalf("unable to get the TLS configuration source: %v", err)
+		}
+		if source == nil {
+			if fixtures.TLS {
+				t.Fatal("TLSConfigurationSource service is not implemented")
+			}
+			t.Skip("TLSConfigurationSource service is not implemented")
+		}
+		if reloader, ok := source.(*security.TLSConfigurationReloader); ok {
+			defer reloader.Stop()
+		}
+		assertTLSConfiguration(t, h, source)
+		t.Run("Reload", func(t *testing.T) {
+			h.Reload(t)
+			assertTLSConfiguration(t, h, source)
+		})
+	})
+	t.Run("AuthenticationManager", func(t *testing.T) {
+		sp := new(plugin.SecurityPluginTemplate)
+		h.Template(t, sp)
+		am, err := sp.AuthenticationManager()
+		if err != nil {
+			t.Fatalf("unable to get the authentication manager: %v", err)
+		}
+		ctx, cancel := h.Context()
+		defer cancel()
+		enabled, err := am.IsEnabled(ctx)
+		if err != nil {
+			t.Fatalf("IsEnabled failed: %v", err)
+		}
+		if !enabled {
+			if fixtures.Authentication {
+				t.Fatal("AuthenticationManager service is not implemented")
+			}
+			t.Skip("AuthenticationManager service is not implemented")
+		}
+		assertAuthentication(t, h, am, fixtures)
+		t.Run("Reload", func(t *testing.T) {
+			h.Reload(t)
+			assertAuthentication(t, h, am, fixtures)
+		})
+	})
+}
+
+func assertTLSConfiguration(t *testing.T, h *Harness, source security.TLSConfigurationSource) {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	if reloader, ok := source.(*security.TLSConfigurationReloader); ok {
+		// as on SIGHUP, so that the configuration is loaded from the plugin as it is now
+		if err := reloader.Reload(ctx); err != nil {
+			t.Fatalf("Reload failed: %v", err)
+		}
+	}
+	cfg, err := source.Get(ctx)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if cfg == nil {
+		t.Fatal("no TLS configuration")
+	}
+	if cfg.GetConfigForClient != nil {
+		if cfg, err = cfg.GetConfigForClient(&tls.ClientHelloInfo{}); err != nil {
+			t.Fatalf("no TLS configuration for clients: %v", err)
+		}
+	}
+	if cfg.MinVersion < tls.VersionTLS12 {
+		t.Errorf("minimum TLS version %#x lower than TLS 1.2", cfg.MinVersion)
+	}
+	if len(cfg.Certificates) == 0 || len(cfg.Certificates[0].Certificate) == 0 {
+		t.Fatal("no certificate")
+	}
+	cert, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatalf("invalid certificate: %v", err)
+	}
+	if now := time.Now(); now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		t.Errorf("certificate valid from %v to %v only", cert.NotBefore, cert.NotAfter)
+	}
+}
+
+func assertAuthentication(t *testing.T, h *Harness, am security.AuthenticationManager, fixtures *SecurityFixtures) {
+	t.Helper()
+	t.Run("InvalidToken", func(t *testing.T) {
+		for _, token := range []string{"", "invalid token"} {
+			ctx, cancel := h.Context()
+			_, err := am.Authenticate(ctx, token)
+			cancel()
+			if err == nil {
+				t.Fatalf("token %q authenticated", token)
+			}
+			if s, ok := status.FromError(err); ok && (s.Code() == codes.DeadlineExceeded || s.Code() == codes.Unavailable) {
+				t.Fatalf("token %q not authenticated in time: %v", token, err)
+			}
+		}
+	})
+	t.Run("ValidToken", func(t *testing.T) {
+		if fixtures.ValidToken == "" {
+			t.Skip("no valid token")
+		}
+		ctx, cancel := h.Context()
+		defer cancel()
+		token, err := am.Authenticate(ctx, fixtures.ValidToken)
+		if err != nil {
+			t.Fatalf("valid token not authenticated: %v", err)
+		}
+		expiredAt, err := ptypes.Timestamp(token.ExpiredAt)
+		if err != nil {
+			t.Fatalf("invalid expiration: %v", err)
+		}
+		if !expiredAt.After(time.Now()) {
+			t.Errorf("valid token expired at %v", expiredAt)
+		}
+		if len(token.Authorities) == 0 {
+			t.Error("no authority granted to valid token")
+		}
+	})
+	t.Run("ExpiredToken", func(t *testing.T) {
+		if fixtures.ExpiredToken == "" {
+			t.Skip("no expired token")
+		}
+		ctx, cancel := h.Context()
+		defer cancel()
+		token, err := am.Authenticate(ctx, fixtures.ExpiredToken)
+		if err != nil {
+			return
+		}
+		// geth rejects the calls of an authenticated token once expired
+		if expiredAt, err := ptypes.Timestamp(token.ExpiredAt); err == nil && expiredAt.After(time.Now()) {
+			t.Errorf("expired token authenticated until %v", expiredAt)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package conformance
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/signer"
+)
+
+func signerSuite(t *testing.T, h *Harness) {
+	sp := new(plugin.SignerPluginTemplate)
+	h.Template(t, sp)
+	s, err := sp.Get()
+	if err != nil {
+		t.Fatalf("unable to get the signer: %v", err)
+	}
+	address := assertSignerAccount(t, h, s)
+	t.Run("SignHash", func(t *testing.T) {
+		assertSignHash(t, h, s, address)
+	})
+	t.Run("InvalidHash", func(t *testing.T) {
+		ctx, cancel := h.Context()
+		defer cancel()
+		if _, err := s.SignHash(ctx, []byte("not a hash")); err == nil {
+			t.Fatal("signed a hash of 10 bytes")
+		}
+	})
+	t.Run("Reload", func(t *testing.T) {
+		h.Reload(t)
+		// the validator key of the node can't change on restart
+		if reloaded := assertSignerAccount(t, h, s); reloaded != address {
+			t.Fatalf("validator key %s instead of %s after reload", reloaded.Hex(), address.Hex())
+		}
+		assertSignHash(t, h, s, address)
+	})
+}
+
+func assertSignerAccount(t *testing.T, h *Harness, s signer.Signer) common.Address {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	address, err := s.Account(ctx)
+	if err != nil {
+		t.Fatalf("Account failed: %v", err)
+	}
+	if address == (common.Address{}) {
+		t.Fatal("Account returned the zero address")
+	}
+	return address
+}
+
+func assertSignHash(t *testing.T, h *Harness, s signer.Signer, address common.Address) {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	hash := crypto.Keccak256([]byte("conformance"))
+	sig, err := s.SignHash(ctx, hash)
+	if err != nil {
+		t.Fatalf("SignHash failed: %v", err)
+	}
+	assertSignature(t, hash, sig, address)
+}
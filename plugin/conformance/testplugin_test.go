@@ -0,0 +1,464 @@
+package conformance
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+	"github.com/ethereum/go-ethereum/plugin/account"
+	"github.com/ethereum/go-ethereum/plugin/eventstream"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_accountapproval"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_common"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_eventstream"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_privatetxmanager"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_signer"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_txvalidator"
+	"github.com/ethereum/go-ethereum/plugin/helloworld"
+	"github.com/ethereum/go-ethereum/plugin/initializer"
+	"github.com/ethereum/go-ethereum/plugin/privatetxmanager"
+	"github.com/ethereum/go-ethereum/plugin/security"
+	"github.com/ethereum/go-ethereum/plugin/signer"
+	"github.com/ethereum/go-ethereum/plugin/txvalidator"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/hashicorp/go-plugin"
+	proto_account "github.com/jpmorganchase/quorum-account-plugin-sdk-go/proto"
+	proto_helloworld "github.com/jpmorganchase/quorum-hello-world-plugin-sdk-go/proto"
+	proto_security "github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
+	"google.golang.org/grpc"
+)
+
+const (
+	testValidToken   = "valid token"
+	testExpiredToken = "expired token"
+	testPassphrase   = "conformance"
+)
+
+// testPluginConfig is the configuration of the test plugin
+type testPluginConfig struct {
+	// directory of the keys of the accounts
+	KeyDir string `json:"keyDir"`
+	// validator key of the signer
+	SignerKey string `json:"signerKey"`
+}
+
+// testPlugin serves every plugin interface with a suite, as a well behaved plugin
+type testPlugin struct {
+	mu         sync.Mutex
+	cfg        testPluginConfig
+	certPem    []byte
+	keyPem     []byte
+	unlocked   map[common.Address]time.Time
+	approvals  map[string][]byte
+	nextTicket int
+	payloads   map[common.EncryptedPayloadHash][]byte
+}
+
+// serveTestPlugin serves the test plugin when the test binary is started as a plugin
+func serveTestPlugin() {
+	p := &testPlugin{
+		unlocked:  make(map[common.Address]time.Time),
+		approvals: make(map[string][]byte),
+		payloads:  make(map[common.EncryptedPayloadHash][]byte),
+	}
+	if err := p.generateCertificate(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	register := func(f func(s *grpc.Server)) plugin.Plugin {
+		return &testConnector{register: f}
+	}
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: iplugin.DefaultHandshakeConfig,
+		Plugins: map[string]plugin.Plugin{
+			initializer.ConnectorName: register(func(s *grpc.Server) {
+				proto_common.RegisterPluginInitializerServer(s, p)
+			}),
+			security.TLSConfigurationConnectorName: register(func(s *grpc.Server) {
+				proto_security.RegisterTLSConfigurationSourceServer(s, p)
+			}),
+			security.AuthenticationConnectorName: register(func(s *grpc.Server) {
+				proto_security.RegisterAuthenticationManagerServer(s, p)
+			}),
+			account.ConnectorName: register(func(s *grpc.Server) {
+				proto_account.RegisterAccountServiceServer(s, &testAccountServer{p})
+			}),
+			account.ApprovalConnectorName: register(func(s *grpc.Server) {
+				proto_accountapproval.RegisterAccountApprovalServiceServer(s, &testApprovalServer{p})
+			}),
+			signer.ConnectorName: register(func(s *grpc.Server) {
+				proto_signer.RegisterSignerServer(s, &testSignerServer{p})
+			}),
+			helloworld.ConnectorName: register(func(s *grpc.Server) {
+				proto_helloworld.RegisterPluginGreetingServer(s, &testHelloWorldServer{})
+			}),
+			privatetxmanager.ConnectorName: register(func(s *grpc.Server) {
+				proto_privatetxmanager.RegisterPrivateTransactionManagerServer(s, &testPrivateTxManagerServer{p})
+			}),
+			txvalidator.ConnectorName: register(func(s *grpc.Server) {
+				proto_txvalidator.RegisterTransactionValidatorServer(s, &testTxValidatorServer{})
+			}),
+			eventstream.ConnectorName: register(func(s *grpc.Server) {
+				proto_eventstream.RegisterEventStreamServer(s, &testEventStreamServer{})
+			}),
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
+
+type testConnector struct {
+	plugin.Plugin
+	register func(s *grpc.Server)
+}
+
+func (c *testConnector) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	c.register(s)
+	return nil
+}
+
+func (c *testConnector) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, _ *grpc.ClientConn) (interface{}, error) {
+	return nil, iplugin.ErrNotSupported
+}
+
+func (p *testPlugin) Init(_ context.Context, req *proto_common.PluginInitialization_Request) (*proto_common.PluginInitialization_Response, error) {
+	if len(req.RawConfiguration) > 0 {
+		if err := json.Unmarshal(req.RawConfiguration, &p.cfg); err != nil {
+			return nil, err
+		}
+	}
+	return &proto_common.PluginInitialization_Response{}, nil
+}
+
+func (p *testPlugin) generateCertificate() error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	p.certPem = pemEncode("CERTIFICATE", der)
+	p.keyPem = pemEncode("EC PRIVATE KEY", keyDer)
+	return nil
+}
+
+func (p *testPlugin) Get(_ context.Context, _ *proto_security.TLSConfiguration_Request) (*proto_security.TLSConfiguration_Response, error) {
+	return &proto_security.TLSConfiguration_Response{Data: &proto_security.TLSConfiguration_Data{
+		CertPem: p.certPem,
+		KeyPem:  p.keyPem,
+	}}, nil
+}
+
+func (p *testPlugin) Authenticate(_ context.Context, token *proto_security.AuthenticationToken) (*proto_security.PreAuthenticatedAuthenticationToken, error) {
+	var expiredAt time.Time
+	switch string(token.RawToken) {
+	case testValidToken:
+		expiredAt = time.Now().Add(time.Hour)
+	case testExpiredToken:
+		expiredAt = time.Now().Add(-time.Hour)
+	default:
+		return nil, errors.New("invalid token")
+	}
+	ts, err := ptypes.TimestampProto(expiredAt)
+	if err != nil {
+		return nil, err
+	}
+	return &proto_security.PreAuthenticatedAuthenticationToken{
+		RawToken:    token.RawToken,
+		ExpiredAt:   ts,
+		Authorities: []*proto_security.GrantedAuthority{{Service: "*", Method: "*"}},
+	}, nil
+}
+
+func (p *testPlugin) key(address []byte) (*ecdsa.PrivateKey, error) {
+	data, err := ioutil.ReadFile(filepath.Join(p.cfg.KeyDir, common.Bytes2Hex(address)))
+	if err != nil {
+		return nil, fmt.Errorf("unknown account %x", address)
+	}
+	return crypto.HexToECDSA(string(data))
+}
+
+func (p *testPlugin) unlockedKey(address []byte) (*ecdsa.PrivateKey, error) {
+	p.mu.Lock()
+	until, ok := p.unlocked[common.BytesToAddress(address)]
+	p.mu.Unlock()
+	if !ok || time.Now().After(until) {
+		return nil, fmt.Errorf("account %x is locked", address)
+	}
+	return p.key(address)
+}
+
+func (p *testPlugin) unlock(address []byte, passphrase string) (*ecdsa.PrivateKey, error) {
+	if passphrase != testPassphrase {
+		return nil, errors.New("invalid passphrase")
+	}
+	return p.key(address)
+}
+
+func (p *testPlugin) store(key *ecdsa.PrivateKey) (*proto_account.Account, error) {
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	file := filepath.Join(p.cfg.KeyDir, common.Bytes2Hex(address.Bytes()))
+	if err := ioutil.WriteFile(file, []byte(common.Bytes2Hex(crypto.FromECDSA(key))), 0600); err != nil {
+		return nil, err
+	}
+	return &proto_account.Account{Address: address.Bytes(), Url: "file://" + file}, nil
+}
+
+type testAccountServer struct {
+	*testPlugin
+}
+
+func (s *testAccountServer) Status(_ context.Context, _ *proto_account.StatusRequest) (*proto_account.StatusResponse, error) {
+	return &proto_account.StatusResponse{Status: "ok"}, nil
+}
+
+func (s *testAccountServer) Open(_ context.Context, _ *proto_account.OpenRequest) (*proto_account.OpenResponse, error) {
+	return &proto_account.OpenResponse{}, nil
+}
+
+func (s *testAccountServer) Close(_ context.Context, _ *proto_account.CloseRequest) (*proto_account.CloseResponse, error) {
+	return &proto_account.CloseResponse{}, nil
+}
+
+func (s *testAccountServer) Accounts(_ context.Context, _ *proto_account.AccountsRequest) (*proto_account.AccountsResponse, error) {
+	files, err := ioutil.ReadDir(s.cfg.KeyDir)
+	if err != nil {
+		return nil, err
+	}
+	resp := &proto_account.AccountsResponse{}
+	for _, f := range files {
+		file := filepath.Join(s.cfg.KeyDir, f.Name())
+		resp.Accounts = append(resp.Accounts, &proto_account.Account{Address: common.FromHex(f.Name()), Url: "file://" + file})
+	}
+	return resp, nil
+}
+
+func (s *testAccountServer) Contains(_ context.Context, req *proto_account.ContainsRequest) (*proto_account.ContainsResponse, error) {
+	_, err := s.key(req.Address)
+	return &proto_account.ContainsResponse{IsContained: err == nil}, nil
+}
+
+func (s *testAccountServer) Sign(_ context.Context, req *proto_account.SignRequest) (*proto_account.SignResponse, error) {
+	key, err := s.unlockedKey(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(req.ToSign, key)
+	return &proto_account.SignResponse{Sig: sig}, err
+}
+
+func (s *testAccountServer) UnlockAndSign(_ context.Context, req *proto_account.UnlockAndSignRequest) (*proto_account.SignResponse, error) {
+	key, err := s.unlock(req.Address, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(req.ToSign, key)
+	return &proto_account.SignResponse{Sig: sig}, err
+}
+
+func (s *testAccountServer) TimedUnlock(_ context.Context, req *proto_account.TimedUnlockRequest) (*proto_account.TimedUnlockResponse, error) {
+	if _, err := s.unlock(req.Address, req.Password); err != nil {
+		return nil, err
+	}
+	until := time.Now().Add(time.Duration(req.Duration))
+	if req.Duration == 0 {
+		until = time.Now().Add(100 * 365 * 24 * time.Hour)
+	}
+	s.mu.Lock()
+	s.unlocked[common.BytesToAddress(req.Address)] = until
+	s.mu.Unlock()
+	return &proto_account.TimedUnlockResponse{}, nil
+}
+
+func (s *testAccountServer) Lock(_ context.Context, req *proto_account.LockRequest) (*proto_account.LockResponse, error) {
+	s.mu.Lock()
+	delete(s.unlocked, common.BytesToAddress(req.Address))
+	s.mu.Unlock()
+	return &proto_account.LockResponse{}, nil
+}
+
+func (s *testAccountServer) NewAccount(_ context.Context, _ *proto_account.NewAccountRequest) (*proto_account.NewAccountResponse, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	acct, err := s.store(key)
+	return &proto_account.NewAccountResponse{Account: acct}, err
+}
+
+func (s *testAccountServer) ImportRawKey(_ context.Context, req *proto_account.ImportRawKeyRequest) (*proto_account.ImportRawKeyResponse, error) {
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(req.RawKey, "0x"))
+	if err != nil {
+		return nil, err
+	}
+	acct, err := s.store(key)
+	return &proto_account.ImportRawKeyResponse{Account: acct}, err
+}
+
+// testApprovalServer holds every transaction for approval, which is then given on the first poll
+type testApprovalServer struct {
+	*testPlugin
+}
+
+func (s *testApprovalServer) SignTransaction(_ context.Context, req *proto_accountapproval.SignTransactionRequest) (*proto_accountapproval.SignTransactionResponse, error) {
+	key, err := s.unlock(req.Address, req.Passphrase)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(req.ToSign, key)
+	if err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextTicket++
+	ticketID := fmt.Sprintf("ticket-%d", s.nextTicket)
+	s.approvals[ticketID] = sig
+	return &proto_accountapproval.SignTransactionResponse{TicketId: ticketID}, nil
+}
+
+func (s *testApprovalServer) Approval(_ context.Context, req *proto_accountapproval.ApprovalRequest) (*proto_accountapproval.ApprovalResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sig, ok := s.approvals[req.TicketId]
+	if !ok {
+		return &proto_accountapproval.ApprovalResponse{Status: proto_accountapproval.ApprovalStatus_REJECTED, Reason: "unknown ticket"}, nil
+	}
+	return &proto_accountapproval.ApprovalResponse{Status: proto_accountapproval.ApprovalStatus_APPROVED, Sig: sig}, nil
+}
+
+type testSignerServer struct {
+	*testPlugin
+}
+
+func (s *testSignerServer) Account(_ context.Context, _ *proto_signer.AccountRequest) (*proto_signer.AccountResponse, error) {
+	key, err := crypto.HexToECDSA(s.cfg.SignerKey)
+	if err != nil {
+		return nil, err
+	}
+	return &proto_signer.AccountResponse{Address: crypto.PubkeyToAddress(key.PublicKey).Bytes()}, nil
+}
+
+func (s *testSignerServer) SignHash(_ context.Context, req *proto_signer.SignHashRequest) (*proto_signer.SignHashResponse, error) {
+	key, err := crypto.HexToECDSA(s.cfg.SignerKey)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := crypto.Sign(req.Hash, key)
+	if err != nil {
+		return nil, err
+	}
+	return &proto_signer.SignHashResponse{Signature: sig}, nil
+}
+
+type testHelloWorldServer struct{}
+
+func (s *testHelloWorldServer) Greeting(_ context.Context, req *proto_helloworld.PluginHelloWorld_Request) (*proto_helloworld.PluginHelloWorld_Response, error) {
+	return &proto_helloworld.PluginHelloWorld_Response{Msg: "Hello " + req.Msg}, nil
+}
+
+// testPrivateTxManagerServer keeps the payloads in memory, only distributing them to itself
+type testPrivateTxManagerServer struct {
+	*testPlugin
+}
+
+func (s *testPrivateTxManagerServer) store(payload []byte) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	hash := common.BytesToEncryptedPayloadHash(crypto.Keccak512(payload))
+	s.payloads[hash] = payload
+	return hash.Bytes()
+}
+
+func (s *testPrivateTxManagerServer) load(hash []byte) []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.payloads[common.BytesToEncryptedPayloadHash(hash)]
+}
+
+func (s *testPrivateTxManagerServer) Name(_ context.Context, _ *proto_privatetxmanager.NameRequest) (*proto_privatetxmanager.NameResponse, error) {
+	return &proto_privatetxmanager.NameResponse{Name: "conformance"}, nil
+}
+
+func (s *testPrivateTxManagerServer) HasFeature(_ context.Context, _ *proto_privatetxmanager.HasFeatureRequest) (*proto_privatetxmanager.HasFeatureResponse, error) {
+	return &proto_privatetxmanager.HasFeatureResponse{}, nil
+}
+
+func (s *testPrivateTxManagerServer) Send(_ context.Context, req *proto_privatetxmanager.SendRequest) (*proto_privatetxmanager.SendResponse, error) {
+	return &proto_privatetxmanager.SendResponse{Hash: s.store(req.Payload)}, nil
+}
+
+func (s *testPrivateTxManagerServer) StoreRaw(_ context.Context, req *proto_privatetxmanager.StoreRawRequest) (*proto_privatetxmanager.StoreRawResponse, error) {
+	return &proto_privatetxmanager.StoreRawResponse{Hash: s.store(req.Payload)}, nil
+}
+
+func (s *testPrivateTxManagerServer) SendSignedTx(_ context.Context, req *proto_privatetxmanager.SendSignedTxRequest) (*proto_privatetxmanager.SendSignedTxResponse, error) {
+	return &proto_privatetxmanager.SendSignedTxResponse{Data: req.Hash}, nil
+}
+
+func (s *testPrivateTxManagerServer) Receive(_ context.Context, req *proto_privatetxmanager.ReceiveRequest) (*proto_privatetxmanager.ReceiveResponse, error) {
+	return &proto_privatetxmanager.ReceiveResponse{Payload: s.load(req.Hash)}, nil
+}
+
+func (s *testPrivateTxManagerServer) ReceiveRaw(ctx context.Context, req *proto_privatetxmanager.ReceiveRequest) (*proto_privatetxmanager.ReceiveResponse, error) {
+	return s.Receive(ctx, req)
+}
+
+func (s *testPrivateTxManagerServer) IsSender(_ context.Context, req *proto_privatetxmanager.IsSenderRequest) (*proto_privatetxmanager.IsSenderResponse, error) {
+	return &proto_privatetxmanager.IsSenderResponse{Sender: s.load(req.Hash) != nil}, nil
+}
+
+func (s *testPrivateTxManagerServer) GetParticipants(_ context.Context, _ *proto_privatetxmanager.GetParticipantsRequest) (*proto_privatetxmanager.GetParticipantsResponse, error) {
+	return &proto_privatetxmanager.GetParticipantsResponse{}, nil
+}
+
+func (s *testPrivateTxManagerServer) EncryptPayload(_ context.Context, _ *proto_privatetxmanager.EncryptPayloadRequest) (*proto_privatetxmanager.EncryptPayloadResponse, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s *testPrivateTxManagerServer) DecryptPayload(_ context.Context, _ *proto_privatetxmanager.DecryptPayloadRequest) (*proto_privatetxmanager.ReceiveResponse, error) {
+	return nil, errors.New("not supported")
+}
+
+// testTxValidatorServer denies the contract creations
+type testTxValidatorServer struct{}
+
+func (s *testTxValidatorServer) ValidateTransaction(_ context.Context, req *proto_txvalidator.ValidateTransactionRequest) (*proto_txvalidator.ValidateTransactionResponse, error) {
+	if len(req.To) == 0 {
+		return &proto_txvalidator.ValidateTransactionResponse{Reason: "contract creation"}, nil
+	}
+	return &proto_txvalidator.ValidateTransactionResponse{Allowed: true}, nil
+}
+
+type testEventStreamServer struct{}
+
+func (s *testEventStreamServer) Deliver(_ context.Context, _ *proto_eventstream.BlockEvent) (*proto_eventstream.DeliverResponse, error) {
+	return &proto_eventstream.DeliverResponse{}, nil
+}
@@ -0,0 +1,53 @@
+package conformance
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin"
+	"github.com/ethereum/go-ethereum/plugin/txvalidator"
+)
+
+func txValidatorSuite(t *testing.T, h *Harness) {
+	vp := new(plugin.TxValidatorPluginTemplate)
+	h.Template(t, vp)
+	v, err := vp.Get()
+	if err != nil {
+		t.Fatalf("unable to get the transaction validator: %v", err)
+	}
+	tx := types.NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(1), 21000, big.NewInt(0), nil)
+	from := common.HexToAddress("0x2")
+	var allowed bool
+	t.Run("TxPool", func(t *testing.T) {
+		allowed = assertValidation(t, h, v, tx, from, 0)
+	})
+	t.Run("BlockProduction", func(t *testing.T) {
+		// the node producing a block must not include a transaction its pool would deny
+		if assertValidation(t, h, v, tx, from, 1) != allowed {
+			t.Fatal("different decisions for the transaction pool and the block produced")
+		}
+	})
+	t.Run("Reload", func(t *testing.T) {
+		h.Reload(t)
+		if assertValidation(t, h, v, tx, from, 0) != allowed {
+			t.Fatal("different decision after reload")
+		}
+	})
+}
+
+// assertValidation verifies the transaction is allowed, or denied with a reason
+func assertValidation(t *testing.T, h *Harness, v txvalidator.TransactionValidator, tx *types.Transaction, from common.Address, blockNumber uint64) bool {
+	t.Helper()
+	ctx, cancel := h.Context()
+	defer cancel()
+	allowed, reason, err := v.ValidateTransaction(ctx, tx, from, nil, blockNumber)
+	if err != nil {
+		t.Fatalf("ValidateTransaction failed: %v", err)
+	}
+	if !allowed && reason == "" {
+		t.Fatal("transaction denied without a reason")
+	}
+	return allowed
+}
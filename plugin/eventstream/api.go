@@ -0,0 +1,26 @@
+package eventstream
+
+import "github.com/ethereum/go-ethereum/common/hexutil"
+
+type EventStreamAPI struct {
+	s *Streamer
+}
+
+func NewEventStreamAPI(s *Streamer) *EventStreamAPI {
+	return &EventStreamAPI{
+		s: s,
+	}
+}
+
+// Status returns the progress of the event stream
+func (api *EventStreamAPI) Status() Status {
+	return api.s.Status()
+}
+
+// Replay delivers the canonical blocks from the given number onwards again
+func (api *EventStreamAPI) Replay(from hexutil.Uint64) (bool, error) {
+	if err := api.s.Replay(uint64(from)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
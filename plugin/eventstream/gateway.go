@@ -0,0 +1,80 @@
+package eventstream
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_eventstream"
+)
+
+type PluginGateway struct {
+	client proto_eventstream.EventStreamClient
+}
+
+func (g *PluginGateway) Deliver(ctx context.Context, ev *BlockEvent) error {
+	_, err := g.client.Deliver(ctx, toProtoBlockEvent(ev))
+	return err
+}
+
+func toProtoBlockEvent(ev *BlockEvent) *proto_eventstream.BlockEvent {
+	b := ev.Block
+	pev := &proto_eventstream.BlockEvent{
+		Type:       toProtoEventType(ev.Type),
+		Number:     b.NumberU64(),
+		Hash:       b.Hash().Bytes(),
+		ParentHash: b.ParentHash().Bytes(),
+		Timestamp:  b.Time(),
+		Receipts:   make([]*proto_eventstream.Receipt, 0, len(ev.Receipts)),
+	}
+	txs := b.Transactions()
+	for i, r := range ev.Receipts {
+		pr := &proto_eventstream.Receipt{
+			TxHash:            r.TxHash.Bytes(),
+			TxIndex:           uint64(i),
+			Status:            r.Status,
+			CumulativeGasUsed: r.CumulativeGasUsed,
+			GasUsed:           r.GasUsed,
+			Logs:              make([]*proto_eventstream.Log, 0, len(r.Logs)),
+		}
+		if i < len(txs) {
+			tx := txs[i]
+			pr.From = tx.From().Bytes()
+			if tx.To() != nil {
+				pr.To = tx.To().Bytes()
+			}
+			pr.IsPrivate = tx.IsPrivate()
+		}
+		if r.ContractAddress != (common.Address{}) {
+			pr.ContractAddress = r.ContractAddress.Bytes()
+		}
+		for _, l := range r.Logs {
+			pr.Logs = append(pr.Logs, toProtoLog(l))
+		}
+		pev.Receipts = append(pev.Receipts, pr)
+	}
+	return pev
+}
+
+func toProtoLog(l *types.Log) *proto_eventstream.Log {
+	topics := make([][]byte, len(l.Topics))
+	for i, t := range l.Topics {
+		topics[i] = t.Bytes()
+	}
+	return &proto_eventstream.Log{
+		Address: l.Address.Bytes(),
+		Topics:  topics,
+		Data:    l.Data,
+		Index:   uint64(l.Index),
+	}
+}
+
+func toProtoEventType(t EventType) proto_eventstream.EventType {
+	switch t {
+	case Removed:
+		return proto_eventstream.EventType_REMOVED
+	case Side:
+		return proto_eventstream.EventType_SIDE
+	}
+	return proto_eventstream.EventType_CANONICAL
+}
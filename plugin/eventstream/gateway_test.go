@@ -0,0 +1,121 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_eventstream"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// testEventStream records the delivered event
+type testEventStream struct {
+	err error
+	ev  *proto_eventstream.BlockEvent
+}
+
+func (s *testEventStream) Deliver(_ context.Context, ev *proto_eventstream.BlockEvent) (*proto_eventstream.DeliverResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.ev = ev
+	return &proto_eventstream.DeliverResponse{}, nil
+}
+
+// testConnector serves the test event stream as a plugin would
+type testConnector struct {
+	PluginConnector
+	impl proto_eventstream.EventStreamServer
+}
+
+func (c *testConnector) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	proto_eventstream.RegisterEventStreamServer(s, c.impl)
+	return nil
+}
+
+func newTestGateway(t *testing.T, impl proto_eventstream.EventStreamServer) (*PluginGateway, func()) {
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		ConnectorName: &testConnector{impl: impl},
+	})
+	raw, err := client.Dispense(ConnectorName)
+	require.NoError(t, err)
+	return raw.(*PluginGateway), func() {
+		_ = client.Close()
+		server.Stop()
+	}
+}
+
+func TestPluginGateway_Deliver(t *testing.T) {
+	impl := &testEventStream{}
+	testObject, done := newTestGateway(t, impl)
+	defer done()
+	to := common.HexToAddress("0x1")
+	privateTx := types.NewTransaction(0, to, big.NewInt(0), 21000, big.NewInt(0), []byte("arbitrary payload hash"))
+	privateTx.SetPrivate()
+	creation := types.NewContractCreation(1, big.NewInt(0), 100000, big.NewInt(0), []byte("arbitrary code"))
+	topic := common.StringToHash("arbitrary topic")
+	receipts := types.Receipts{
+		{
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: 21000,
+			GasUsed:           21000,
+			TxHash:            privateTx.Hash(),
+			Logs:              []*types.Log{{Address: to, Topics: []common.Hash{topic}, Data: []byte("arbitrary data"), Index: 0}},
+		},
+		{
+			Status:            types.ReceiptStatusFailed,
+			CumulativeGasUsed: 121000,
+			GasUsed:           100000,
+			TxHash:            creation.Hash(),
+			ContractAddress:   common.HexToAddress("0xc"),
+		},
+	}
+	header := &types.Header{Number: big.NewInt(7), ParentHash: common.StringToHash("arbitrary parent"), Time: 1234}
+	block := types.NewBlock(header, []*types.Transaction{privateTx, creation}, nil, receipts)
+
+	err := testObject.Deliver(context.Background(), &BlockEvent{Type: Removed, Block: block, Receipts: receipts})
+
+	require.NoError(t, err)
+	ev := impl.ev
+	assert.Equal(t, proto_eventstream.EventType_REMOVED, ev.Type)
+	assert.Equal(t, uint64(7), ev.Number)
+	assert.Equal(t, block.Hash().Bytes(), ev.Hash)
+	assert.Equal(t, header.ParentHash.Bytes(), ev.ParentHash)
+	assert.Equal(t, uint64(1234), ev.Timestamp)
+	require.Len(t, ev.Receipts, 2)
+	private := ev.Receipts[0]
+	assert.Equal(t, privateTx.Hash().Bytes(), private.TxHash)
+	assert.True(t, private.IsPrivate)
+	assert.Equal(t, to.Bytes(), private.To)
+	assert.Empty(t, private.ContractAddress)
+	assert.Equal(t, types.ReceiptStatusSuccessful, private.Status)
+	require.Len(t, private.Logs, 1)
+	assert.Equal(t, to.Bytes(), private.Logs[0].Address)
+	assert.Equal(t, [][]byte{topic.Bytes()}, private.Logs[0].Topics)
+	assert.Equal(t, []byte("arbitrary data"), private.Logs[0].Data)
+	created := ev.Receipts[1]
+	assert.Equal(t, uint64(1), created.TxIndex)
+	assert.False(t, created.IsPrivate)
+	assert.Empty(t, created.To)
+	assert.Equal(t, common.HexToAddress("0xc").Bytes(), created.ContractAddress)
+	assert.Equal(t, uint64(100000), created.GasUsed)
+	assert.Equal(t, uint64(121000), created.CumulativeGasUsed)
+}
+
+func TestPluginGateway_Deliver_whenPluginFails(t *testing.T) {
+	testObject, done := newTestGateway(t, &testEventStream{err: errors.New("broker unavailable")})
+	defer done()
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1)})
+
+	err := testObject.Deliver(context.Background(), &BlockEvent{Block: block})
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "broker unavailable")
+}
@@ -0,0 +1,60 @@
+package eventstream
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type EventType int
+
+const (
+	// block added to the canonical chain
+	Canonical EventType = iota
+	// block previously delivered as canonical which a reorg removed from the
+	// canonical chain
+	Removed
+	// block imported to a side chain
+	Side
+)
+
+func (t EventType) String() string {
+	switch t {
+	case Canonical:
+		return "canonical"
+	case Removed:
+		return "removed"
+	case Side:
+		return "side"
+	}
+	return "unknown"
+}
+
+// BlockEvent is a block with its receipts. Receipts of private transactions
+// are the private receipts if the node is a party of the transaction.
+type BlockEvent struct {
+	Type     EventType
+	Block    *types.Block
+	Receipts types.Receipts
+}
+
+// EventStream receives the blocks of the chain. Events are delivered at least
+// once, so the same event may be delivered again after a failure or a restart.
+type EventStream interface {
+	// Deliver returns once the event has been durably handled
+	Deliver(ctx context.Context, ev *BlockEvent) error
+}
+
+type EventStreamDeferFunc func() (EventStream, error)
+
+type ReloadableEventStream struct {
+	DeferFunc EventStreamDeferFunc
+}
+
+func (d *ReloadableEventStream) Deliver(ctx context.Context, ev *BlockEvent) error {
+	s, err := d.DeferFunc()
+	if err != nil {
+		return err
+	}
+	return s.Deliver(ctx, ev)
+}
@@ -0,0 +1,398 @@
+package eventstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// CursorFileName is the file in the datadir keeping track of the blocks
+	// delivered to the plugin
+	CursorFileName = "eventstream-cursor.json"
+
+	deliveryTimeout = 30 * time.Second
+	// delay before retrying a failed delivery, doubled after each failure
+	minRetryBackoff = time.Second
+	maxRetryBackoff = time.Minute
+	// side chain blocks waiting to be delivered, the oldest are dropped
+	// beyond this
+	maxPendingSideBlocks = 256
+)
+
+var errBlockNotFound = errors.New("block not found")
+
+// Chain is the part of the blockchain read by the streamer
+type Chain interface {
+	CurrentBlock() *types.Block
+	GetBlock(hash common.Hash, number uint64) *types.Block
+	GetBlockByNumber(number uint64) *types.Block
+	GetCanonicalHash(number uint64) common.Hash
+	GetReceiptsByHash(hash common.Hash) types.Receipts
+	SubscribeChainEvent(ch chan<- core.ChainEvent) event.Subscription
+	SubscribeChainSideEvent(ch chan<- core.ChainSideEvent) event.Subscription
+}
+
+// Cursor is the position of the stream in the canonical chain
+type Cursor struct {
+	// number of the next block to deliver
+	Next uint64 `json:"next"`
+	// hash of the last block delivered, which is the parent of the next one
+	ParentHash common.Hash `json:"parentHash"`
+}
+
+// Status is the progress of the stream
+type Status struct {
+	Cursor
+	Head              uint64 `json:"head"`
+	PendingSideBlocks int    `json:"pendingSideBlocks"`
+	LastError         string `json:"lastError,omitempty"`
+}
+
+// Streamer delivers the blocks of the chain to the event stream plugin.
+//
+// Canonical blocks are delivered in order, at least once: the cursor is
+// persisted after each acknowledged delivery and the stream resumes from it
+// after a restart. When a reorg removes delivered blocks, they are delivered
+// again as removed, newest first, before the blocks of the new canonical
+// chain. Side chain blocks are delivered as they are imported, on a best
+// effort basis.
+type Streamer struct {
+	chain      Chain
+	stream     EventStream
+	cursorPath string
+
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	mux     sync.Mutex
+	cursor  *Cursor
+	side    []*types.Block
+	lastErr error
+
+	wake chan struct{}
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewStreamer creates a streamer resuming from the cursor persisted in
+// cursorPath. Without one, the stream starts at the head of the chain.
+func NewStreamer(chain Chain, stream EventStream, cursorPath string) (*Streamer, error) {
+	cursor, err := loadCursor(cursorPath)
+	if err != nil {
+		return nil, err
+	}
+	return &Streamer{
+		chain:      chain,
+		stream:     stream,
+		cursorPath: cursorPath,
+		minBackoff: minRetryBackoff,
+		maxBackoff: maxRetryBackoff,
+		cursor:     cursor,
+		wake:       make(chan struct{}, 1),
+		quit:       make(chan struct{}),
+	}, nil
+}
+
+func (s *Streamer) Protocols() []p2p.Protocol {
+	return nil
+}
+
+func (s *Streamer) APIs() []rpc.API {
+	return []rpc.API{
+		{
+			Namespace: "eventstream",
+			Version:   "1.0",
+			Service:   NewEventStreamAPI(s),
+			Public:    false,
+		},
+	}
+}
+
+func (s *Streamer) Start(_ *p2p.Server) error {
+	s.mux.Lock()
+	if s.cursor == nil {
+		head := s.chain.CurrentBlock()
+		s.cursor = &Cursor{Next: head.NumberU64(), ParentHash: head.ParentHash()}
+	}
+	s.mux.Unlock()
+	log.Info("Starting event stream", "next", s.Cursor().Next)
+
+	chainCh := make(chan core.ChainEvent, 10)
+	sideCh := make(chan core.ChainSideEvent, 10)
+	chainSub := s.chain.SubscribeChainEvent(chainCh)
+	sideSub := s.chain.SubscribeChainSideEvent(sideCh)
+
+	s.wg.Add(2)
+	go s.listen(chainCh, sideCh, chainSub, sideSub)
+	go s.loop()
+	return nil
+}
+
+func (s *Streamer) Stop() error {
+	close(s.quit)
+	s.wg.Wait()
+	log.Info("Event stream stopped")
+	return nil
+}
+
+// Cursor returns the position of the stream
+func (s *Streamer) Cursor() Cursor {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.cursor == nil {
+		return Cursor{}
+	}
+	return *s.cursor
+}
+
+// Replay rewinds the stream so that the canonical blocks from the given
+// number onwards are delivered again
+func (s *Streamer) Replay(from uint64) error {
+	head := s.chain.CurrentBlock().NumberU64()
+	if from > head {
+		return fmt.Errorf("block %d is beyond the head of the chain %d", from, head)
+	}
+	cursor := Cursor{Next: from}
+	if from > 0 {
+		cursor.ParentHash = s.chain.GetCanonicalHash(from - 1)
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if err := saveCursor(s.cursorPath, &cursor); err != nil {
+		return err
+	}
+	s.cursor = &cursor
+	log.Info("Replaying event stream", "from", from)
+	s.notify()
+	return nil
+}
+
+// Status returns the progress of the stream
+func (s *Streamer) Status() Status {
+	head := s.chain.CurrentBlock().NumberU64()
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	status := Status{Head: head, PendingSideBlocks: len(s.side)}
+	if s.cursor != nil {
+		status.Cursor = *s.cursor
+	}
+	if s.lastErr != nil {
+		status.LastError = s.lastErr.Error()
+	}
+	return status
+}
+
+// listen wakes up the delivery loop on chain events. It never blocks on the
+// delivery, so that a slow plugin doesn't hold up the chain.
+func (s *Streamer) listen(chainCh chan core.ChainEvent, sideCh chan core.ChainSideEvent, chainSub, sideSub event.Subscription) {
+	defer s.wg.Done()
+	defer chainSub.Unsubscribe()
+	defer sideSub.Unsubscribe()
+	for {
+		select {
+		case <-chainCh:
+			s.notify()
+		case ev := <-sideCh:
+			s.queueSide(ev.Block)
+			s.notify()
+		case err := <-chainSub.Err():
+			log.Error("Event stream no longer receives chain events", "err", err)
+			return
+		case err := <-sideSub.Err():
+			log.Error("Event stream no longer receives side chain events", "err", err)
+			return
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+func (s *Streamer) loop() {
+	defer s.wg.Done()
+	backoff := s.minBackoff
+	var retry <-chan time.Time
+	for {
+		if retry == nil {
+			err := s.deliverPending()
+			s.mux.Lock()
+			s.lastErr = err
+			s.mux.Unlock()
+			if err != nil {
+				log.Warn("Failed to deliver event to event stream plugin", "retryIn", backoff, "err", err)
+				retry = time.After(backoff)
+				if backoff *= 2; backoff > s.maxBackoff {
+					backoff = s.maxBackoff
+				}
+			} else {
+				backoff = s.minBackoff
+			}
+		}
+		select {
+		case <-s.wake:
+		case <-retry:
+			retry = nil
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// deliverPending delivers the canonical blocks after the cursor, then the
+// side chain blocks waiting to be delivered
+func (s *Streamer) deliverPending() error {
+	if err := s.catchUp(); err != nil {
+		return err
+	}
+	for !s.stopped() {
+		s.mux.Lock()
+		if len(s.side) == 0 {
+			s.mux.Unlock()
+			return nil
+		}
+		b := s.side[0]
+		s.mux.Unlock()
+		if err := s.deliver(Side, b); err != nil {
+			return err
+		}
+		s.mux.Lock()
+		if len(s.side) > 0 && s.side[0] == b {
+			s.side = s.side[1:]
+		}
+		s.mux.Unlock()
+	}
+	return nil
+}
+
+func (s *Streamer) catchUp() error {
+	for !s.stopped() {
+		cursor := s.Cursor()
+		if cursor.Next > 0 && s.chain.GetCanonicalHash(cursor.Next-1) != cursor.ParentHash {
+			// the last delivered block is no longer canonical
+			removed := s.chain.GetBlock(cursor.ParentHash, cursor.Next-1)
+			if removed == nil {
+				return fmt.Errorf("removed block %d [%s]: %v", cursor.Next-1, cursor.ParentHash.TerminalString(), errBlockNotFound)
+			}
+			if err := s.deliver(Removed, removed); err != nil {
+				return err
+			}
+			if err := s.advance(cursor, Cursor{Next: cursor.Next - 1, ParentHash: removed.ParentHash()}); err != nil {
+				return err
+			}
+			continue
+		}
+		b := s.chain.GetBlockByNumber(cursor.Next)
+		if b == nil {
+			// caught up with the head of the chain
+			return nil
+		}
+		if b.ParentHash() != cursor.ParentHash {
+			// the chain was reorganized in the meantime
+			continue
+		}
+		if err := s.deliver(Canonical, b); err != nil {
+			return err
+		}
+		if err := s.advance(cursor, Cursor{Next: b.NumberU64() + 1, ParentHash: b.Hash()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Streamer) deliver(t EventType, b *types.Block) error {
+	ctx, cancel := context.WithTimeout(context.Background(), deliveryTimeout)
+	defer cancel()
+	ev := &BlockEvent{
+		Type:     t,
+		Block:    b,
+		Receipts: s.chain.GetReceiptsByHash(b.Hash()),
+	}
+	if err := s.stream.Deliver(ctx, ev); err != nil {
+		return fmt.Errorf("%s block %d [%s]: %v", t, b.NumberU64(), b.Hash().TerminalString(), err)
+	}
+	log.Trace("Delivered event to event stream plugin", "type", t, "number", b.NumberU64(), "hash", b.Hash())
+	return nil
+}
+
+// advance moves the cursor unless it was moved meanwhile, e.g. by a replay
+func (s *Streamer) advance(from, to Cursor) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.cursor == nil || *s.cursor != from {
+		return nil
+	}
+	if err := saveCursor(s.cursorPath, &to); err != nil {
+		return err
+	}
+	s.cursor = &to
+	return nil
+}
+
+func (s *Streamer) queueSide(b *types.Block) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if len(s.side) >= maxPendingSideBlocks {
+		log.Warn("Too many side chain blocks pending, dropping the oldest", "number", s.side[0].NumberU64(), "hash", s.side[0].Hash())
+		s.side = s.side[1:]
+	}
+	s.side = append(s.side, b)
+}
+
+func (s *Streamer) notify() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (s *Streamer) stopped() bool {
+	select {
+	case <-s.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+func loadCursor(path string) (*Cursor, error) {
+	blob, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	cursor := new(Cursor)
+	if err := json.Unmarshal(blob, cursor); err != nil {
+		return nil, fmt.Errorf("invalid event stream cursor %s: %v", path, err)
+	}
+	return cursor, nil
+}
+
+// saveCursor writes the cursor to a temporary file first so that a crash
+// never leaves a partially written cursor behind
+func saveCursor(path string, cursor *Cursor) error {
+	blob, err := json.Marshal(cursor)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, blob, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
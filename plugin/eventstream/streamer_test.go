@@ -0,0 +1,221 @@
+package eventstream
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type delivered struct {
+	Type   EventType
+	Number uint64
+	Hash   common.Hash
+}
+
+// recordingStream records the delivered events and fails the deliveries
+// while err is set
+type recordingStream struct {
+	mux    sync.Mutex
+	err    error
+	events []delivered
+}
+
+func (s *recordingStream) Deliver(_ context.Context, ev *BlockEvent) error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, delivered{ev.Type, ev.Block.NumberU64(), ev.Block.Hash()})
+	return nil
+}
+
+func (s *recordingStream) setErr(err error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.err = err
+}
+
+func (s *recordingStream) delivered() []delivered {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return append([]delivered(nil), s.events...)
+}
+
+type testChain struct {
+	*core.BlockChain
+	db      ethdb.Database
+	genesis *types.Block
+}
+
+func newTestChain(t *testing.T) *testChain {
+	db := rawdb.NewMemoryDatabase()
+	genesis := (&core.Genesis{Config: params.TestChainConfig}).MustCommit(db)
+	chain, err := core.NewBlockChain(db, nil, params.TestChainConfig, ethash.NewFaker(), vm.Config{}, nil)
+	require.NoError(t, err)
+	return &testChain{BlockChain: chain, db: db, genesis: genesis}
+}
+
+// generate inserts n blocks on top of parent, mined by coinbase
+func (c *testChain) generate(t *testing.T, parent *types.Block, n int, coinbase common.Address) []*types.Block {
+	blocks, _ := core.GenerateChain(params.TestChainConfig, parent, ethash.NewFaker(), c.db, n, func(_ int, b *core.BlockGen) {
+		b.SetCoinbase(coinbase)
+	})
+	_, err := c.InsertChain(blocks)
+	require.NoError(t, err)
+	return blocks
+}
+
+func newTestStreamer(t *testing.T, chain Chain, stream EventStream) (*Streamer, func()) {
+	dir, err := ioutil.TempDir("", "eventstream")
+	require.NoError(t, err)
+	s, err := NewStreamer(chain, stream, filepath.Join(dir, CursorFileName))
+	require.NoError(t, err)
+	s.minBackoff, s.maxBackoff = time.Millisecond, time.Millisecond
+	return s, func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func canonical(blocks ...*types.Block) []delivered {
+	events := make([]delivered, len(blocks))
+	for i, b := range blocks {
+		events[i] = delivered{Canonical, b.NumberU64(), b.Hash()}
+	}
+	return events
+}
+
+func TestStreamer_catchUp_persistsCursor(t *testing.T) {
+	chain := newTestChain(t)
+	defer chain.Stop()
+	blocks := chain.generate(t, chain.genesis, 3, common.Address{1})
+	stream := &recordingStream{}
+	testObject, done := newTestStreamer(t, chain, stream)
+	defer done()
+	require.NoError(t, testObject.Replay(1))
+
+	require.NoError(t, testObject.catchUp())
+
+	assert.Equal(t, canonical(blocks...), stream.delivered())
+	expected := Cursor{Next: 4, ParentHash: blocks[2].Hash()}
+	assert.Equal(t, expected, testObject.Cursor())
+	persisted, err := loadCursor(testObject.cursorPath)
+	require.NoError(t, err)
+	assert.Equal(t, &expected, persisted)
+}
+
+func TestStreamer_catchUp_resumesAfterFailedDelivery(t *testing.T) {
+	chain := newTestChain(t)
+	defer chain.Stop()
+	blocks := chain.generate(t, chain.genesis, 2, common.Address{1})
+	stream := &recordingStream{}
+	testObject, done := newTestStreamer(t, chain, stream)
+	defer done()
+	require.NoError(t, testObject.Replay(1))
+	require.NoError(t, testObject.catchUp())
+	more := chain.generate(t, blocks[1], 2, common.Address{1})
+
+	stream.setErr(errors.New("broker unavailable"))
+	err := testObject.catchUp()
+
+	assert.EqualError(t, err, "canonical block 3 ["+more[0].Hash().TerminalString()+"]: broker unavailable")
+	assert.Equal(t, uint64(3), testObject.Cursor().Next)
+
+	// a restarted node resumes from the persisted cursor
+	stream.setErr(nil)
+	restarted, err := NewStreamer(chain, stream, testObject.cursorPath)
+	require.NoError(t, err)
+	require.NoError(t, restarted.catchUp())
+
+	assert.Equal(t, canonical(append(blocks, more...)...), stream.delivered())
+}
+
+func TestStreamer_catchUp_deliversRemovedBlocksOnReorg(t *testing.T) {
+	chain := newTestChain(t)
+	defer chain.Stop()
+	old := chain.generate(t, chain.genesis, 2, common.Address{1})
+	stream := &recordingStream{}
+	testObject, done := newTestStreamer(t, chain, stream)
+	defer done()
+	require.NoError(t, testObject.Replay(1))
+	require.NoError(t, testObject.catchUp())
+	fork := chain.generate(t, chain.genesis, 3, common.Address{2})
+	require.Equal(t, fork[2].Hash(), chain.CurrentBlock().Hash())
+
+	require.NoError(t, testObject.catchUp())
+
+	expected := canonical(old...)
+	expected = append(expected,
+		delivered{Removed, 2, old[1].Hash()},
+		delivered{Removed, 1, old[0].Hash()})
+	expected = append(expected, canonical(fork...)...)
+	assert.Equal(t, expected, stream.delivered())
+	assert.Equal(t, Cursor{Next: 4, ParentHash: fork[2].Hash()}, testObject.Cursor())
+}
+
+func TestStreamer_deliverPending_deliversSideBlocks(t *testing.T) {
+	chain := newTestChain(t)
+	defer chain.Stop()
+	blocks := chain.generate(t, chain.genesis, 2, common.Address{1})
+	stream := &recordingStream{}
+	testObject, done := newTestStreamer(t, chain, stream)
+	defer done()
+	testObject.cursor = &Cursor{Next: 3, ParentHash: blocks[1].Hash()}
+	side := chain.generate(t, chain.genesis, 1, common.Address{2})
+	testObject.queueSide(side[0])
+
+	stream.setErr(errors.New("broker unavailable"))
+	assert.Error(t, testObject.deliverPending())
+	assert.Equal(t, 1, testObject.Status().PendingSideBlocks, "failed side block is kept")
+
+	stream.setErr(nil)
+	require.NoError(t, testObject.deliverPending())
+
+	assert.Equal(t, []delivered{{Side, 1, side[0].Hash()}}, stream.delivered())
+	assert.Equal(t, Status{Cursor: Cursor{Next: 3, ParentHash: blocks[1].Hash()}, Head: 2}, testObject.Status())
+}
+
+func TestStreamer_Replay_whenBeyondHead(t *testing.T) {
+	chain := newTestChain(t)
+	defer chain.Stop()
+	testObject, done := newTestStreamer(t, chain, &recordingStream{})
+	defer done()
+
+	assert.EqualError(t, testObject.Replay(1), "block 1 is beyond the head of the chain 0")
+}
+
+func TestStreamer_Start_streamsNewBlocks(t *testing.T) {
+	chain := newTestChain(t)
+	defer chain.Stop()
+	stream := &recordingStream{err: errors.New("broker unavailable")}
+	testObject, done := newTestStreamer(t, chain, stream)
+	defer done()
+	require.NoError(t, testObject.Start(nil))
+
+	blocks := chain.generate(t, chain.genesis, 2, common.Address{1})
+	stream.setErr(nil)
+	deadline := time.Now().Add(5 * time.Second)
+	for testObject.Cursor().Next != 3 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	require.NoError(t, testObject.Stop())
+
+	// the stream starts at the head of the chain when it has no cursor
+	assert.Equal(t, canonical(append([]*types.Block{chain.genesis}, blocks...)...), stream.delivered())
+}
@@ -11,6 +11,12 @@
 
 // generate stubs
 //go:generate protoc -I ../../vendor/github.com/jpmorganchase/quorum-plugin-definitions -I ../../vendor --go_out=plugins=grpc:proto_common init.proto
+//go:generate protoc -I . -I ../../vendor --go_out=plugins=grpc:proto_privatetxmanager privatetxmanager.proto
+//go:generate protoc -I . -I ../../vendor --go_out=plugins=grpc:proto_txvalidator txvalidator.proto
+//go:generate protoc -I . -I ../../vendor --go_out=plugins=grpc:proto_eventstream eventstream.proto
+//go:generate protoc -I . -I ../../vendor --go_out=plugins=grpc:proto_rpc rpc.proto
+//go:generate protoc -I . -I ../../vendor --go_out=plugins=grpc:proto_signer signer.proto
+//go:generate protoc -I . -I ../../vendor --go_out=plugins=grpc:proto_accountapproval accountapproval.proto
 
 // generate mocks for unit testing
 //go:generate mockgen -package proto_common -destination proto_common/mock_init.go -source proto_common/init.pb.go
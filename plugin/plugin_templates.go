@@ -5,8 +5,14 @@ import (
 
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/plugin/account"
+	"github.com/ethereum/go-ethereum/plugin/eventstream"
 	"github.com/ethereum/go-ethereum/plugin/helloworld"
+	"github.com/ethereum/go-ethereum/plugin/privatetxmanager"
+	"github.com/ethereum/go-ethereum/plugin/rpcservice"
 	"github.com/ethereum/go-ethereum/plugin/security"
+	"github.com/ethereum/go-ethereum/plugin/signer"
+	"github.com/ethereum/go-ethereum/plugin/txvalidator"
+	"github.com/ethereum/go-ethereum/private"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -35,12 +41,21 @@ type SecurityPluginTemplate struct {
 // TLSConfigurationSource returns an implementation of security.TLSConfigurationSource which could be nil
 // in case the plugin doesn't implement the corresponding service. In order to verify that, it attempts
 // to make a call and inspect the error.
+//
+// The implementation is a security.TLSConfigurationReloader which reloads the configuration from the plugin
+// on SIGHUP and at the configured interval.
 func (sp *SecurityPluginTemplate) TLSConfigurationSource() (security.TLSConfigurationSource, error) {
-	raw, err := sp.dispense(security.TLSConfigurationConnectorName)
+	deferFunc := func() (security.TLSConfigurationSource, error) {
+		raw, err := sp.dispense(security.TLSConfigurationConnectorName)
+		if err != nil {
+			return nil, err
+		}
+		return raw.(security.TLSConfigurationSource), nil
+	}
+	tlsConfigurationSource, err := deferFunc()
 	if err != nil {
 		return nil, err
 	}
-	tlsConfigurationSource := raw.(security.TLSConfigurationSource)
 	// try to invoke the method to test if the plugin actually implements the service
 	_, err = tlsConfigurationSource.Get(context.Background())
 	rpcStatus, ok := status.FromError(err)
@@ -48,13 +63,14 @@ func (sp *SecurityPluginTemplate) TLSConfigurationSource() (security.TLSConfigur
 		log.Info("Security: Plugin doesn't implement TLSConfigurationSource service", "err", err)
 		return nil, nil
 	}
-	return tlsConfigurationSource, nil
+	return security.NewTLSConfigurationReloader(security.NewDeferredTLSConfigurationSource(deferFunc), sp.securityConfig())
 }
 
 // AuthenticationManager returns an implementation of security.AuthenticationManager which could be
 // a deferred implemenation or a disabled implementation.
 //
-// The deferred implementation delegates to the actual implemenation (which is the plugin client).
+// The deferred implementation delegates to the actual implemenation (which is the plugin client)
+// and caches the authenticated tokens unless the cache is disabled.
 //
 // The disabled implementation allows no authentication verification.
 func (sp *SecurityPluginTemplate) AuthenticationManager() (security.AuthenticationManager, error) {
@@ -76,7 +92,14 @@ func (sp *SecurityPluginTemplate) AuthenticationManager() (security.Authenticati
 			return security.NewDisabledAuthenticationManager(), nil
 		}
 	}
-	return security.NewDeferredAuthenticationManager(deferFunc), nil
+	return security.NewCachingAuthenticationManager(security.NewDeferredAuthenticationManager(deferFunc), sp.securityConfig())
+}
+
+func (sp *SecurityPluginTemplate) securityConfig() *security.Config {
+	if sp.pm == nil || sp.pm.settings == nil {
+		return nil
+	}
+	return sp.pm.settings.Security
 }
 
 type ReloadableAccountServiceFactory struct {
@@ -96,3 +119,115 @@ func (f *ReloadableAccountServiceFactory) Create() (account.Service, error) {
 
 	return am, nil
 }
+
+// CreateApprovalService returns the service signing transactions subject to the signing policy of the
+// account plugin, nil if the plugin doesn't implement it. In order to verify that, it attempts to make a
+// call and inspect the error.
+func (f *ReloadableAccountServiceFactory) CreateApprovalService() (account.ApprovalService, error) {
+	dispenseFunc := func() (account.ApprovalService, error) {
+		raw, err := f.dispense(account.ApprovalConnectorName)
+		if err != nil {
+			return nil, err
+		}
+		return raw.(account.ApprovalService), nil
+	}
+	s, err := dispenseFunc()
+	if err != nil {
+		return nil, err
+	}
+	// try to invoke the method to test if the plugin actually implements the service
+	_, err = s.Approval(context.Background(), "")
+	rpcStatus, ok := status.FromError(err)
+	if ok && rpcStatus.Code() == codes.Unimplemented {
+		log.Info("Account: Plugin doesn't implement AccountApprovalService service", "err", err)
+		return nil, nil
+	}
+	return &account.ReloadableApprovalService{
+		DispenseFunc: dispenseFunc,
+	}, nil
+}
+
+// a template that returns the private transaction manager plugin instance
+type PrivateTxManagerPluginTemplate struct {
+	*basePlugin
+}
+
+func (p *PrivateTxManagerPluginTemplate) Get() (private.PrivateTransactionManager, error) {
+	return &privatetxmanager.ReloadablePrivateTxManager{
+		DeferFunc: func() (private.PrivateTransactionManager, error) {
+			raw, err := p.dispense(privatetxmanager.ConnectorName)
+			if err != nil {
+				return nil, err
+			}
+			return raw.(private.PrivateTransactionManager), nil
+		},
+	}, nil
+}
+
+// a template that returns the transaction validator plugin instance
+type TxValidatorPluginTemplate struct {
+	*basePlugin
+}
+
+func (p *TxValidatorPluginTemplate) Get() (txvalidator.TransactionValidator, error) {
+	return &txvalidator.ReloadableTransactionValidator{
+		DeferFunc: func() (txvalidator.TransactionValidator, error) {
+			raw, err := p.dispense(txvalidator.ConnectorName)
+			if err != nil {
+				return nil, err
+			}
+			return raw.(txvalidator.TransactionValidator), nil
+		},
+	}, nil
+}
+
+// a template that returns the event stream plugin instance
+type EventStreamPluginTemplate struct {
+	*basePlugin
+}
+
+func (p *EventStreamPluginTemplate) Get() (eventstream.EventStream, error) {
+	return &eventstream.ReloadableEventStream{
+		DeferFunc: func() (eventstream.EventStream, error) {
+			raw, err := p.dispense(eventstream.ConnectorName)
+			if err != nil {
+				return nil, err
+			}
+			return raw.(eventstream.EventStream), nil
+		},
+	}, nil
+}
+
+// a template that returns the JSON-RPC service plugin instance
+type RPCPluginTemplate struct {
+	*basePlugin
+}
+
+func (p *RPCPluginTemplate) Get() (rpcservice.RPCService, error) {
+	return &rpcservice.ReloadableRPCService{
+		DeferFunc: func() (rpcservice.RPCService, error) {
+			raw, err := p.dispense(rpcservice.ConnectorName)
+			if err != nil {
+				return nil, err
+			}
+			return raw.(rpcservice.RPCService), nil
+		},
+	}, nil
+}
+
+// a template that returns the signer plugin instance
+type SignerPluginTemplate struct {
+	*basePlugin
+}
+
+func (p *SignerPluginTemplate) Get() (signer.Signer, error) {
+	return &signer.ReloadableSigner{
+		DeferFunc: func() (signer.Signer, error) {
+			raw, err := p.dispense(signer.ConnectorName)
+			if err != nil {
+				return nil, err
+			}
+			return raw.(signer.Signer), nil
+		},
+	}, nil
+}
@@ -0,0 +1,35 @@
+package privatetxmanager
+
+import (
+	"context"
+
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_privatetxmanager"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+)
+
+const ConnectorName = "privatetxmanager"
+
+// PluginConnector connects to a private transaction manager plugin.
+//
+// Impl is only set by plugins, which serve it as the private transaction manager.
+type PluginConnector struct {
+	plugin.Plugin
+	Impl private.PrivateTransactionManager
+}
+
+func (p *PluginConnector) GRPCServer(b *plugin.GRPCBroker, s *grpc.Server) error {
+	if p.Impl == nil {
+		return iplugin.ErrNotSupported
+	}
+	proto_privatetxmanager.RegisterPrivateTransactionManagerServer(s, &PluginServer{impl: p.Impl})
+	return nil
+}
+
+func (p *PluginConnector) GRPCClient(ctx context.Context, b *plugin.GRPCBroker, cc *grpc.ClientConn) (interface{}, error) {
+	return &PluginGateway{
+		client: proto_privatetxmanager.NewPrivateTransactionManagerClient(cc),
+	}, nil
+}
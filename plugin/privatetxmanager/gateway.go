@@ -0,0 +1,216 @@
+package privatetxmanager
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_privatetxmanager"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// callTimeout bounds every call to the plugin, which may distribute payloads
+// to the other private transaction managers before answering
+const callTimeout = 30 * time.Second
+
+// PluginGateway is the private transaction manager provided by a plugin
+type PluginGateway struct {
+	client proto_privatetxmanager.PrivateTransactionManagerClient
+}
+
+func (g *PluginGateway) Name() (string, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.Name(ctx, &proto_privatetxmanager.NameRequest{})
+	if err != nil {
+		return "", err
+	}
+	return resp.Name, nil
+}
+
+func (g *PluginGateway) HasFeature(f engine.PrivateTransactionManagerFeature) bool {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.HasFeature(ctx, &proto_privatetxmanager.HasFeatureRequest{
+		Feature: uint64(f),
+	})
+	if err != nil {
+		log.Error("unable to check the features of the private transaction manager plugin", "feature", f, "err", err)
+		return false
+	}
+	return resp.Result
+}
+
+func (g *PluginGateway) Send(data []byte, from string, to []string, extra *engine.ExtraMetadata) (common.EncryptedPayloadHash, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.Send(ctx, &proto_privatetxmanager.SendRequest{
+		Payload: data,
+		From:    from,
+		To:      to,
+		Extra:   toProtoExtra(extra),
+	})
+	if err != nil {
+		return common.EncryptedPayloadHash{}, toError(err)
+	}
+	return common.BytesToEncryptedPayloadHash(resp.Hash), nil
+}
+
+func (g *PluginGateway) StoreRaw(data []byte, from string) (common.EncryptedPayloadHash, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.StoreRaw(ctx, &proto_privatetxmanager.StoreRawRequest{
+		Payload: data,
+		From:    from,
+	})
+	if err != nil {
+		return common.EncryptedPayloadHash{}, toError(err)
+	}
+	return common.BytesToEncryptedPayloadHash(resp.Hash), nil
+}
+
+func (g *PluginGateway) SendSignedTx(data common.EncryptedPayloadHash, to []string, extra *engine.ExtraMetadata) ([]byte, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.SendSignedTx(ctx, &proto_privatetxmanager.SendSignedTxRequest{
+		Hash:  data.Bytes(),
+		To:    to,
+		Extra: toProtoExtra(extra),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return resp.Data, nil
+}
+
+func (g *PluginGateway) Receive(data common.EncryptedPayloadHash) ([]byte, *engine.ExtraMetadata, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.Receive(ctx, &proto_privatetxmanager.ReceiveRequest{
+		Hash: data.Bytes(),
+	})
+	return fromReceiveResponse(resp, err)
+}
+
+func (g *PluginGateway) ReceiveRaw(data common.EncryptedPayloadHash) ([]byte, *engine.ExtraMetadata, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.ReceiveRaw(ctx, &proto_privatetxmanager.ReceiveRequest{
+		Hash: data.Bytes(),
+	})
+	return fromReceiveResponse(resp, err)
+}
+
+func (g *PluginGateway) IsSender(txHash common.EncryptedPayloadHash) (bool, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.IsSender(ctx, &proto_privatetxmanager.IsSenderRequest{
+		Hash: txHash.Bytes(),
+	})
+	if err != nil {
+		return false, toError(err)
+	}
+	return resp.Sender, nil
+}
+
+func (g *PluginGateway) GetParticipants(txHash common.EncryptedPayloadHash) ([]string, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.GetParticipants(ctx, &proto_privatetxmanager.GetParticipantsRequest{
+		Hash: txHash.Bytes(),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return resp.Participants, nil
+}
+
+func (g *PluginGateway) EncryptPayload(data []byte, from string, to []string, extra *engine.ExtraMetadata) ([]byte, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.EncryptPayload(ctx, &proto_privatetxmanager.EncryptPayloadRequest{
+		Payload: data,
+		From:    from,
+		To:      to,
+		Extra:   toProtoExtra(extra),
+	})
+	if err != nil {
+		return nil, toError(err)
+	}
+	return resp.EncryptedPayload, nil
+}
+
+func (g *PluginGateway) DecryptPayload(payload common.DecryptRequest) ([]byte, *engine.ExtraMetadata, error) {
+	ctx, cancel := callContext()
+	defer cancel()
+	resp, err := g.client.DecryptPayload(ctx, &proto_privatetxmanager.DecryptPayloadRequest{
+		SenderKey:       payload.SenderKey,
+		CipherText:      payload.CipherText,
+		CipherTextNonce: payload.CipherTextNonce,
+		RecipientBoxes:  payload.RecipientBoxes,
+		RecipientNonce:  payload.RecipientNonce,
+		RecipientKeys:   payload.RecipientKeys,
+	})
+	return fromReceiveResponse(resp, err)
+}
+
+// callContext returns the context of a call to the plugin
+func callContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), callTimeout)
+}
+
+// fromReceiveResponse returns a nil payload if the plugin didn't find it
+func fromReceiveResponse(resp *proto_privatetxmanager.ReceiveResponse, err error) ([]byte, *engine.ExtraMetadata, error) {
+	if err != nil {
+		return nil, nil, toError(err)
+	}
+	if len(resp.Payload) == 0 {
+		return nil, nil, nil
+	}
+	return resp.Payload, fromProtoExtra(resp.Extra), nil
+}
+
+// toError maps operations the plugin doesn't implement to
+// engine.ErrPrivateTxManagerNotSupported
+func toError(err error) error {
+	if s, ok := status.FromError(err); ok && s.Code() == codes.Unimplemented {
+		return engine.ErrPrivateTxManagerNotSupported
+	}
+	return err
+}
+
+func toProtoExtra(extra *engine.ExtraMetadata) *proto_privatetxmanager.ExtraMetadata {
+	if extra == nil {
+		return nil
+	}
+	acHashes := make([][]byte, 0, len(extra.ACHashes))
+	for h := range extra.ACHashes {
+		acHashes = append(acHashes, h.Bytes())
+	}
+	// deterministic order for the same metadata
+	sort.Slice(acHashes, func(i, j int) bool { return string(acHashes[i]) < string(acHashes[j]) })
+	return &proto_privatetxmanager.ExtraMetadata{
+		AcHashes:     acHashes,
+		AcMerkleRoot: extra.ACMerkleRoot.Bytes(),
+		PrivacyFlag:  uint64(extra.PrivacyFlag),
+	}
+}
+
+func fromProtoExtra(extra *proto_privatetxmanager.ExtraMetadata) *engine.ExtraMetadata {
+	if extra == nil {
+		return nil
+	}
+	acHashes := make(common.EncryptedPayloadHashes, len(extra.AcHashes))
+	for _, h := range extra.AcHashes {
+		acHashes[common.BytesToEncryptedPayloadHash(h)] = struct{}{}
+	}
+	return &engine.ExtraMetadata{
+		ACHashes:     acHashes,
+		ACMerkleRoot: common.BytesToHash(extra.AcMerkleRoot),
+		PrivacyFlag:  engine.PrivacyFlagType(extra.PrivacyFlag),
+	}
+}
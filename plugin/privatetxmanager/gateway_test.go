@@ -0,0 +1,142 @@
+package privatetxmanager
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestGateway connects a gateway to the reference implementation served
+// over gRPC
+func newTestGateway(t *testing.T) (*PluginGateway, func()) {
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		ConnectorName: &PluginConnector{Impl: NewReferencePrivateTxManager("sender")},
+	})
+	raw, err := client.Dispense(ConnectorName)
+	require.NoError(t, err)
+	return raw.(*PluginGateway), func() {
+		_ = client.Close()
+		server.Stop()
+	}
+}
+
+func TestPluginGateway_Identifiable(t *testing.T) {
+	testObject, done := newTestGateway(t)
+	defer done()
+
+	name, err := testObject.Name()
+	require.NoError(t, err)
+	assert.Equal(t, "ReferencePrivateTxManager", name)
+	assert.True(t, testObject.HasFeature(engine.PrivacyEnhancements))
+	assert.False(t, testObject.HasFeature(engine.PrivateTransactionManagerFeature(2)))
+}
+
+func TestPluginGateway_SendAndReceive(t *testing.T) {
+	testObject, done := newTestGateway(t)
+	defer done()
+	extra := &engine.ExtraMetadata{
+		ACHashes: common.EncryptedPayloadHashes{
+			common.BytesToEncryptedPayloadHash([]byte("arbitrary hash 1")): struct{}{},
+			common.BytesToEncryptedPayloadHash([]byte("arbitrary hash 2")): struct{}{},
+		},
+		ACMerkleRoot: common.StringToHash("arbitrary root"),
+		PrivacyFlag:  engine.PrivacyFlagStateValidation,
+	}
+
+	hash, err := testObject.Send([]byte("arbitrary payload"), "", []string{"recipient"}, extra)
+	require.NoError(t, err)
+	assert.False(t, common.EmptyEncryptedPayloadHash(hash))
+
+	payload, actualExtra, err := testObject.Receive(hash)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("arbitrary payload"), payload)
+	assert.Equal(t, extra, actualExtra)
+
+	isSender, err := testObject.IsSender(hash)
+	require.NoError(t, err)
+	assert.True(t, isSender)
+
+	participants, err := testObject.GetParticipants(hash)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sender", "recipient"}, participants)
+}
+
+func TestPluginGateway_Receive_whenNotFound(t *testing.T) {
+	testObject, done := newTestGateway(t)
+	defer done()
+
+	payload, extra, err := testObject.Receive(common.BytesToEncryptedPayloadHash([]byte("unknown")))
+
+	assert.NoError(t, err)
+	assert.Nil(t, payload)
+	assert.Nil(t, extra)
+}
+
+func TestPluginGateway_StoreRawAndSendSignedTx(t *testing.T) {
+	testObject, done := newTestGateway(t)
+	defer done()
+
+	hash, err := testObject.StoreRaw([]byte("arbitrary signed payload"), "other sender")
+	require.NoError(t, err)
+
+	payload, _, err := testObject.ReceiveRaw(hash)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("arbitrary signed payload"), payload)
+
+	data, err := testObject.SendSignedTx(hash, []string{"recipient"}, &engine.ExtraMetadata{
+		ACHashes:    common.EncryptedPayloadHashes{},
+		PrivacyFlag: engine.PrivacyFlagPartyProtection,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, hash.Bytes(), data)
+
+	payload, extra, err := testObject.Receive(hash)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("arbitrary signed payload"), payload)
+	assert.Equal(t, engine.PrivacyFlagPartyProtection, extra.PrivacyFlag)
+
+	participants, err := testObject.GetParticipants(hash)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"other sender", "recipient"}, participants)
+}
+
+func TestPluginGateway_SendSignedTx_whenNotStored(t *testing.T) {
+	testObject, done := newTestGateway(t)
+	defer done()
+
+	_, err := testObject.SendSignedTx(common.BytesToEncryptedPayloadHash([]byte("unknown")), nil, nil)
+
+	assert.EqualError(t, err, "rpc error: code = Unknown desc = raw payload not found")
+}
+
+func TestPluginGateway_whenNotSupported(t *testing.T) {
+	testObject, done := newTestGateway(t)
+	defer done()
+
+	_, err := testObject.EncryptPayload([]byte("arbitrary payload"), "", nil, nil)
+	assert.Equal(t, engine.ErrPrivateTxManagerNotSupported, err)
+
+	_, _, err = testObject.DecryptPayload(common.DecryptRequest{CipherText: []byte("arbitrary cipher text")})
+	assert.Equal(t, engine.ErrPrivateTxManagerNotSupported, err)
+}
+
+func TestReloadablePrivateTxManager_whenPluginIsNotAvailable(t *testing.T) {
+	testObject := &ReloadablePrivateTxManager{
+		DeferFunc: func() (private.PrivateTransactionManager, error) {
+			return nil, errors.New("arbitrary error")
+		},
+	}
+
+	_, _, err := testObject.Receive(common.BytesToEncryptedPayloadHash([]byte("arbitrary hash")))
+
+	assert.EqualError(t, err, "arbitrary error")
+	assert.False(t, testObject.HasFeature(engine.PrivacyEnhancements))
+	_, err = testObject.Name()
+	assert.EqualError(t, err, "arbitrary error")
+}
@@ -0,0 +1,133 @@
+package privatetxmanager
+
+import (
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/private/engine"
+)
+
+var errRawPayloadNotFound = errors.New("raw payload not found")
+
+// ReferencePrivateTxManager is a reference implementation of a private
+// transaction manager plugin, see plugin/privatetxmanager/reference.
+//
+// It keeps payloads in memory and neither encrypts nor distributes them, so
+// it is only useful for development and testing.
+type ReferencePrivateTxManager struct {
+	mux      sync.RWMutex
+	key      string // public key of the default sender
+	seq      uint64
+	payloads map[common.EncryptedPayloadHash]*referencePayload
+}
+
+type referencePayload struct {
+	data  []byte
+	from  string
+	to    []string
+	extra *engine.ExtraMetadata
+	raw   bool // stored by StoreRaw and not sent yet
+}
+
+func NewReferencePrivateTxManager(key string) *ReferencePrivateTxManager {
+	return &ReferencePrivateTxManager{
+		key:      key,
+		payloads: make(map[common.EncryptedPayloadHash]*referencePayload),
+	}
+}
+
+// SetKey sets the public key of the default sender
+func (r *ReferencePrivateTxManager) SetKey(key string) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	r.key = key
+}
+
+func (r *ReferencePrivateTxManager) Name() (string, error) {
+	return "ReferencePrivateTxManager", nil
+}
+
+func (r *ReferencePrivateTxManager) HasFeature(f engine.PrivateTransactionManagerFeature) bool {
+	return f == engine.PrivacyEnhancements
+}
+
+// store returns a unique hash for every payload, like a private transaction
+// manager would by hashing the encrypted payload
+func (r *ReferencePrivateTxManager) store(p *referencePayload) common.EncryptedPayloadHash {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if p.from == "" {
+		p.from = r.key
+	}
+	r.seq++
+	seq := make([]byte, 8)
+	binary.BigEndian.PutUint64(seq, r.seq)
+	hash := common.BytesToEncryptedPayloadHash(crypto.Keccak512([]byte(p.from), p.data, seq))
+	r.payloads[hash] = p
+	return hash
+}
+
+func (r *ReferencePrivateTxManager) get(hash common.EncryptedPayloadHash) *referencePayload {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return r.payloads[hash]
+}
+
+func (r *ReferencePrivateTxManager) Send(data []byte, from string, to []string, extra *engine.ExtraMetadata) (common.EncryptedPayloadHash, error) {
+	return r.store(&referencePayload{data: data, from: from, to: to, extra: extra}), nil
+}
+
+func (r *ReferencePrivateTxManager) StoreRaw(data []byte, from string) (common.EncryptedPayloadHash, error) {
+	return r.store(&referencePayload{data: data, from: from, raw: true}), nil
+}
+
+func (r *ReferencePrivateTxManager) SendSignedTx(data common.EncryptedPayloadHash, to []string, extra *engine.ExtraMetadata) ([]byte, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	p, ok := r.payloads[data]
+	if !ok || !p.raw {
+		return nil, errRawPayloadNotFound
+	}
+	p.to, p.extra, p.raw = to, extra, false
+	return data.Bytes(), nil
+}
+
+func (r *ReferencePrivateTxManager) Receive(data common.EncryptedPayloadHash) ([]byte, *engine.ExtraMetadata, error) {
+	p := r.get(data)
+	if p == nil || p.raw {
+		return nil, nil, nil
+	}
+	return p.data, p.extra, nil
+}
+
+func (r *ReferencePrivateTxManager) ReceiveRaw(data common.EncryptedPayloadHash) ([]byte, *engine.ExtraMetadata, error) {
+	p := r.get(data)
+	if p == nil || !p.raw {
+		return nil, nil, nil
+	}
+	return p.data, nil, nil
+}
+
+func (r *ReferencePrivateTxManager) IsSender(txHash common.EncryptedPayloadHash) (bool, error) {
+	// payloads are never distributed, so all of them were sent from here
+	return r.get(txHash) != nil, nil
+}
+
+func (r *ReferencePrivateTxManager) GetParticipants(txHash common.EncryptedPayloadHash) ([]string, error) {
+	p := r.get(txHash)
+	if p == nil {
+		return nil, nil
+	}
+	return append([]string{p.from}, p.to...), nil
+}
+
+func (r *ReferencePrivateTxManager) EncryptPayload(data []byte, from string, to []string, extra *engine.ExtraMetadata) ([]byte, error) {
+	return nil, engine.ErrPrivateTxManagerNotSupported
+}
+
+func (r *ReferencePrivateTxManager) DecryptPayload(payload common.DecryptRequest) ([]byte, *engine.ExtraMetadata, error) {
+	return nil, nil, engine.ErrPrivateTxManagerNotSupported
+}
@@ -0,0 +1,60 @@
+// Reference private transaction manager plugin, serving
+// privatetxmanager.ReferencePrivateTxManager.
+//
+// The plugin is configured with the public key of the default sender:
+//
+//	{"publicKey": "BULeR8JyUWhiuuCMU/HLA0Q5pzkYT+cHII3ZKBey3Bo="}
+package main
+
+import (
+	"context"
+	"encoding/json"
+
+	iplugin "github.com/ethereum/go-ethereum/internal/plugin"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_common"
+	"github.com/ethereum/go-ethereum/plugin/initializer"
+	"github.com/ethereum/go-ethereum/plugin/privatetxmanager"
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+)
+
+type config struct {
+	PublicKey string `json:"publicKey"`
+}
+
+type initializerConnector struct {
+	plugin.Plugin
+	ptm *privatetxmanager.ReferencePrivateTxManager
+}
+
+func (c *initializerConnector) GRPCServer(b *plugin.GRPCBroker, s *grpc.Server) error {
+	proto_common.RegisterPluginInitializerServer(s, c)
+	return nil
+}
+
+func (c *initializerConnector) GRPCClient(ctx context.Context, b *plugin.GRPCBroker, cc *grpc.ClientConn) (interface{}, error) {
+	return nil, iplugin.ErrNotSupported
+}
+
+func (c *initializerConnector) Init(_ context.Context, req *proto_common.PluginInitialization_Request) (*proto_common.PluginInitialization_Response, error) {
+	var cfg config
+	if len(req.RawConfiguration) > 0 {
+		if err := json.Unmarshal(req.RawConfiguration, &cfg); err != nil {
+			return nil, err
+		}
+	}
+	c.ptm.SetKey(cfg.PublicKey)
+	return &proto_common.PluginInitialization_Response{}, nil
+}
+
+func main() {
+	ptm := privatetxmanager.NewReferencePrivateTxManager("")
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: iplugin.DefaultHandshakeConfig,
+		Plugins: map[string]plugin.Plugin{
+			initializer.ConnectorName:      &initializerConnector{ptm: ptm},
+			privatetxmanager.ConnectorName: &privatetxmanager.PluginConnector{Impl: ptm},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
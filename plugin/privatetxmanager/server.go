@@ -0,0 +1,118 @@
+package privatetxmanager
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_privatetxmanager"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PluginServer serves a private transaction manager to geth. It is used by
+// plugins written in Go, see PluginConnector.
+type PluginServer struct {
+	impl private.PrivateTransactionManager
+}
+
+func (s *PluginServer) Name(_ context.Context, _ *proto_privatetxmanager.NameRequest) (*proto_privatetxmanager.NameResponse, error) {
+	name, err := s.impl.Name()
+	if err != nil {
+		return nil, err
+	}
+	return &proto_privatetxmanager.NameResponse{Name: name}, nil
+}
+
+func (s *PluginServer) HasFeature(_ context.Context, req *proto_privatetxmanager.HasFeatureRequest) (*proto_privatetxmanager.HasFeatureResponse, error) {
+	return &proto_privatetxmanager.HasFeatureResponse{
+		Result: s.impl.HasFeature(engine.PrivateTransactionManagerFeature(req.Feature)),
+	}, nil
+}
+
+func (s *PluginServer) Send(_ context.Context, req *proto_privatetxmanager.SendRequest) (*proto_privatetxmanager.SendResponse, error) {
+	hash, err := s.impl.Send(req.Payload, req.From, req.To, fromProtoExtra(req.Extra))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto_privatetxmanager.SendResponse{Hash: hash.Bytes()}, nil
+}
+
+func (s *PluginServer) StoreRaw(_ context.Context, req *proto_privatetxmanager.StoreRawRequest) (*proto_privatetxmanager.StoreRawResponse, error) {
+	hash, err := s.impl.StoreRaw(req.Payload, req.From)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto_privatetxmanager.StoreRawResponse{Hash: hash.Bytes()}, nil
+}
+
+func (s *PluginServer) SendSignedTx(_ context.Context, req *proto_privatetxmanager.SendSignedTxRequest) (*proto_privatetxmanager.SendSignedTxResponse, error) {
+	data, err := s.impl.SendSignedTx(common.BytesToEncryptedPayloadHash(req.Hash), req.To, fromProtoExtra(req.Extra))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto_privatetxmanager.SendSignedTxResponse{Data: data}, nil
+}
+
+func (s *PluginServer) Receive(_ context.Context, req *proto_privatetxmanager.ReceiveRequest) (*proto_privatetxmanager.ReceiveResponse, error) {
+	return toReceiveResponse(s.impl.Receive(common.BytesToEncryptedPayloadHash(req.Hash)))
+}
+
+func (s *PluginServer) ReceiveRaw(_ context.Context, req *proto_privatetxmanager.ReceiveRequest) (*proto_privatetxmanager.ReceiveResponse, error) {
+	return toReceiveResponse(s.impl.ReceiveRaw(common.BytesToEncryptedPayloadHash(req.Hash)))
+}
+
+func (s *PluginServer) IsSender(_ context.Context, req *proto_privatetxmanager.IsSenderRequest) (*proto_privatetxmanager.IsSenderResponse, error) {
+	sender, err := s.impl.IsSender(common.BytesToEncryptedPayloadHash(req.Hash))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto_privatetxmanager.IsSenderResponse{Sender: sender}, nil
+}
+
+func (s *PluginServer) GetParticipants(_ context.Context, req *proto_privatetxmanager.GetParticipantsRequest) (*proto_privatetxmanager.GetParticipantsResponse, error) {
+	participants, err := s.impl.GetParticipants(common.BytesToEncryptedPayloadHash(req.Hash))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto_privatetxmanager.GetParticipantsResponse{Participants: participants}, nil
+}
+
+func (s *PluginServer) EncryptPayload(_ context.Context, req *proto_privatetxmanager.EncryptPayloadRequest) (*proto_privatetxmanager.EncryptPayloadResponse, error) {
+	encrypted, err := s.impl.EncryptPayload(req.Payload, req.From, req.To, fromProtoExtra(req.Extra))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto_privatetxmanager.EncryptPayloadResponse{EncryptedPayload: encrypted}, nil
+}
+
+func (s *PluginServer) DecryptPayload(_ context.Context, req *proto_privatetxmanager.DecryptPayloadRequest) (*proto_privatetxmanager.ReceiveResponse, error) {
+	return toReceiveResponse(s.impl.DecryptPayload(common.DecryptRequest{
+		SenderKey:       req.SenderKey,
+		CipherText:      req.CipherText,
+		CipherTextNonce: req.CipherTextNonce,
+		RecipientBoxes:  req.RecipientBoxes,
+		RecipientNonce:  req.RecipientNonce,
+		RecipientKeys:   req.RecipientKeys,
+	}))
+}
+
+func toReceiveResponse(payload []byte, extra *engine.ExtraMetadata, err error) (*proto_privatetxmanager.ReceiveResponse, error) {
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &proto_privatetxmanager.ReceiveResponse{
+		Payload: payload,
+		Extra:   toProtoExtra(extra),
+	}, nil
+}
+
+// toStatus reports engine.ErrPrivateTxManagerNotSupported as an unimplemented
+// operation, which geth maps back to the same error
+func toStatus(err error) error {
+	if err == engine.ErrPrivateTxManagerNotSupported {
+		return status.Error(codes.Unimplemented, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,105 @@
+package privatetxmanager
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
+)
+
+type PrivateTxManagerDeferFunc func() (private.PrivateTransactionManager, error)
+
+// ReloadablePrivateTxManager looks up the plugin for every call so that the
+// plugin can be reloaded, and is usable before the plugin has started
+type ReloadablePrivateTxManager struct {
+	DeferFunc PrivateTxManagerDeferFunc
+}
+
+func (d *ReloadablePrivateTxManager) Name() (string, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return "", err
+	}
+	return p.Name()
+}
+
+func (d *ReloadablePrivateTxManager) HasFeature(f engine.PrivateTransactionManagerFeature) bool {
+	p, err := d.DeferFunc()
+	if err != nil {
+		log.Error("private transaction manager plugin is not available", "err", err)
+		return false
+	}
+	return p.HasFeature(f)
+}
+
+func (d *ReloadablePrivateTxManager) Send(data []byte, from string, to []string, extra *engine.ExtraMetadata) (common.EncryptedPayloadHash, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return common.EncryptedPayloadHash{}, err
+	}
+	return p.Send(data, from, to, extra)
+}
+
+func (d *ReloadablePrivateTxManager) StoreRaw(data []byte, from string) (common.EncryptedPayloadHash, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return common.EncryptedPayloadHash{}, err
+	}
+	return p.StoreRaw(data, from)
+}
+
+func (d *ReloadablePrivateTxManager) SendSignedTx(data common.EncryptedPayloadHash, to []string, extra *engine.ExtraMetadata) ([]byte, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return nil, err
+	}
+	return p.SendSignedTx(data, to, extra)
+}
+
+func (d *ReloadablePrivateTxManager) Receive(data common.EncryptedPayloadHash) ([]byte, *engine.ExtraMetadata, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.Receive(data)
+}
+
+func (d *ReloadablePrivateTxManager) ReceiveRaw(data common.EncryptedPayloadHash) ([]byte, *engine.ExtraMetadata, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.ReceiveRaw(data)
+}
+
+func (d *ReloadablePrivateTxManager) IsSender(txHash common.EncryptedPayloadHash) (bool, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return false, err
+	}
+	return p.IsSender(txHash)
+}
+
+func (d *ReloadablePrivateTxManager) GetParticipants(txHash common.EncryptedPayloadHash) ([]string, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return nil, err
+	}
+	return p.GetParticipants(txHash)
+}
+
+func (d *ReloadablePrivateTxManager) EncryptPayload(data []byte, from string, to []string, extra *engine.ExtraMetadata) ([]byte, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return nil, err
+	}
+	return p.EncryptPayload(data, from, to, extra)
+}
+
+func (d *ReloadablePrivateTxManager) DecryptPayload(payload common.DecryptRequest) ([]byte, *engine.ExtraMetadata, error) {
+	p, err := d.DeferFunc()
+	if err != nil {
+		return nil, nil, err
+	}
+	return p.DecryptPayload(payload)
+}
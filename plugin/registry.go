@@ -0,0 +1,246 @@
+package plugin
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// <name>-<version>.zip, the version starting with <major>.<minor>
+var distFileNamePattern = regexp.MustCompile(`^(.+?)-(\d+\.\d+[^/]*)\.zip$`)
+
+// An installed plugin distribution
+type InstalledPlugin struct {
+	Name     string  `json:"name"`
+	Version  Version `json:"version"`
+	Path     string  `json:"path"`
+	Checksum string  `json:"checksum"`
+	// whether the signature file is installed next to the distribution file
+	Signed bool `json:"signed"`
+}
+
+// LocalRegistry manages the plugin distributions in the plugin base
+// directory, where geth looks for them before downloading from Plugin
+// Central. Each version is installed side by side as <name>-<version>.zip
+// with its signature file, so that switching versions is a change of the
+// plugin settings.
+type LocalRegistry struct {
+	baseDir  string
+	verifier Verifier
+}
+
+func NewLocalRegistry(baseDir string, verifier Verifier) *LocalRegistry {
+	return &LocalRegistry{
+		baseDir:  baseDir,
+		verifier: verifier,
+	}
+}
+
+// ParsePluginReference parses a plugin given as <name>@<version>
+func ParsePluginReference(ref string) (*PluginDefinition, error) {
+	i := strings.LastIndex(ref, "@")
+	if i <= 0 || i == len(ref)-1 {
+		return nil, fmt.Errorf("invalid plugin %q, expected <name>@<version>", ref)
+	}
+	return &PluginDefinition{Name: ref[:i], Version: Version(ref[i+1:])}, nil
+}
+
+// ParseDistFileName parses the name and version of a plugin from the name of
+// its distribution file
+func ParseDistFileName(fileName string) (*PluginDefinition, error) {
+	m := distFileNamePattern.FindStringSubmatch(fileName)
+	if m == nil {
+		return nil, fmt.Errorf("invalid plugin distribution file name %q, expected <name>-<version>.zip", fileName)
+	}
+	return &PluginDefinition{Name: m[1], Version: Version(m[2])}, nil
+}
+
+// FindInRegistry returns the distribution file of the plugin in a local
+// directory laid out like Plugin Central
+func FindInRegistry(registryDir string, definition *PluginDefinition) (string, error) {
+	distFile := filepath.Join(registryDir, filepath.FromSlash(definition.RemotePath()), definition.DistFileName())
+	if !common.FileExist(distFile) {
+		return "", fmt.Errorf("plugin %s for %s/%s is not found in %s", definition.FullName(), runtime.GOOS, runtime.GOARCH, registryDir)
+	}
+	return distFile, nil
+}
+
+// Install verifies the distribution file and copies it with its signature
+// file, expected next to it, to the plugin base directory. The plugin is
+// identified from the file name if definition is nil.
+func (r *LocalRegistry) Install(distFile string, definition *PluginDefinition) (*InstalledPlugin, error) {
+	if definition == nil {
+		var err error
+		if definition, err = ParseDistFileName(filepath.Base(distFile)); err != nil {
+			return nil, err
+		}
+	}
+	target := r.distFile(definition)
+	if common.FileExist(target) {
+		return nil, fmt.Errorf("plugin %s is already installed", definition.FullName())
+	}
+	meta, err := readPluginMeta(distFile)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin distribution %s: %v", distFile, err)
+	}
+	if (meta.Os != "" && meta.Os != runtime.GOOS) || (meta.Arch != "" && meta.Arch != runtime.GOARCH) {
+		return nil, fmt.Errorf("plugin %s is built for %s/%s", definition.FullName(), meta.Os, meta.Arch)
+	}
+	if err := copyFile(distFile, target); err != nil {
+		return nil, err
+	}
+	if sigFile := distFile + signatureFileSuffix; common.FileExist(sigFile) {
+		if err := copyFile(sigFile, r.signatureFile(definition)); err != nil {
+			r.remove(definition)
+			return nil, err
+		}
+	}
+	if err := r.Verify(definition); err != nil {
+		r.remove(definition)
+		return nil, err
+	}
+	log.Info("Installed plugin", "name", definition.Name, "version", definition.Version, "path", target)
+	return r.installed(definition)
+}
+
+// Verify checks the integrity of the installed plugin
+func (r *LocalRegistry) Verify(definition *PluginDefinition) error {
+	distFile := r.distFile(definition)
+	if !common.FileExist(distFile) {
+		return fmt.Errorf("plugin %s is not installed", definition.FullName())
+	}
+	checksum, err := getSha256Checksum(distFile)
+	if err != nil {
+		return err
+	}
+	if err := r.verifier.VerifySignature(definition, checksum); err != nil {
+		return fmt.Errorf("unable to verify plugin signature: %v", err)
+	}
+	return nil
+}
+
+// List returns the installed plugins ordered by name and version
+func (r *LocalRegistry) List() ([]*InstalledPlugin, error) {
+	files, err := ioutil.ReadDir(r.baseDir)
+	if err != nil {
+		return nil, err
+	}
+	plugins := make([]*InstalledPlugin, 0)
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		definition, err := ParseDistFileName(f.Name())
+		if err != nil {
+			continue
+		}
+		p, err := r.installed(definition)
+		if err != nil {
+			return nil, err
+		}
+		plugins = append(plugins, p)
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		if plugins[i].Name != plugins[j].Name {
+			return plugins[i].Name < plugins[j].Name
+		}
+		return plugins[i].Version < plugins[j].Version
+	})
+	return plugins, nil
+}
+
+// Remove deletes the installed plugin and its signature file
+func (r *LocalRegistry) Remove(definition *PluginDefinition) error {
+	if !common.FileExist(r.distFile(definition)) {
+		return fmt.Errorf("plugin %s is not installed", definition.FullName())
+	}
+	if err := os.Remove(r.distFile(definition)); err != nil {
+		return err
+	}
+	if err := os.Remove(r.signatureFile(definition)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	log.Info("Removed plugin", "name", definition.Name, "version", definition.Version)
+	return nil
+}
+
+func (r *LocalRegistry) installed(definition *PluginDefinition) (*InstalledPlugin, error) {
+	distFile := r.distFile(definition)
+	checksum, err := getSha256Checksum(distFile)
+	if err != nil {
+		return nil, err
+	}
+	return &InstalledPlugin{
+		Name:     definition.Name,
+		Version:  definition.Version,
+		Path:     distFile,
+		Checksum: checksum,
+		Signed:   common.FileExist(r.signatureFile(definition)),
+	}, nil
+}
+
+func (r *LocalRegistry) remove(definition *PluginDefinition) {
+	_ = os.Remove(r.distFile(definition))
+	_ = os.Remove(r.signatureFile(definition))
+}
+
+func (r *LocalRegistry) distFile(definition *PluginDefinition) string {
+	return filepath.Join(r.baseDir, definition.DistFileName())
+}
+
+func (r *LocalRegistry) signatureFile(definition *PluginDefinition) string {
+	return filepath.Join(r.baseDir, definition.SignatureFileName())
+}
+
+// read plugin-meta.json from the distribution file without unpacking it
+func readPluginMeta(distFile string) (*MetaData, error) {
+	zr, err := zip.OpenReader(distFile)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	for _, f := range zr.File {
+		if f.Name != "plugin-meta.json" {
+			continue
+		}
+		rc, err := f.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		meta := new(MetaData)
+		if err := json.NewDecoder(rc).Decode(meta); err != nil {
+			return nil, err
+		}
+		return meta, nil
+	}
+	return nil, fmt.Errorf("plugin-meta.json not found")
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	return out.Close()
+}
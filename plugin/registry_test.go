@@ -0,0 +1,183 @@
+package plugin
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVerifier records the verified checksums and fails if err is set
+type fakeVerifier struct {
+	err       error
+	checksums map[string]string
+}
+
+func (v *fakeVerifier) VerifySignature(definition *PluginDefinition, checksum string) error {
+	if v.checksums == nil {
+		v.checksums = make(map[string]string)
+	}
+	v.checksums[definition.FullName()] = checksum
+	return v.err
+}
+
+// creates the distribution file of the plugin with its signature file in dir
+func createArbitraryDistribution(t *testing.T, dir string, definition *PluginDefinition) string {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	zipFile, err := createArbitraryZip(dir)
+	require.NoError(t, err)
+	distFile := filepath.Join(dir, definition.DistFileName())
+	require.NoError(t, os.Rename(zipFile, distFile))
+	require.NoError(t, ioutil.WriteFile(distFile+signatureFileSuffix, []byte("arbitrary signature"), 0644))
+	return distFile
+}
+
+func newTestLocalRegistry(t *testing.T, verifier Verifier) (*LocalRegistry, string, func()) {
+	tmpDir, err := ioutil.TempDir("", "q-")
+	require.NoError(t, err)
+	baseDir := filepath.Join(tmpDir, "plugins")
+	require.NoError(t, os.Mkdir(baseDir, 0755))
+	return NewLocalRegistry(baseDir, verifier), tmpDir, func() {
+		_ = os.RemoveAll(tmpDir)
+	}
+}
+
+func TestParsePluginReference(t *testing.T) {
+	definition, err := ParsePluginReference("quorum-account-plugin@0.1.0-rc1")
+
+	require.NoError(t, err)
+	assert.Equal(t, &PluginDefinition{Name: "quorum-account-plugin", Version: "0.1.0-rc1"}, definition)
+
+	for _, ref := range []string{"quorum-account-plugin", "@0.1.0", "quorum-account-plugin@"} {
+		_, err := ParsePluginReference(ref)
+		assert.Error(t, err, ref)
+	}
+}
+
+func TestParseDistFileName(t *testing.T) {
+	definition, err := ParseDistFileName("quorum-account-plugin-2fa-0.1.0-rc1.zip")
+
+	require.NoError(t, err)
+	assert.Equal(t, &PluginDefinition{Name: "quorum-account-plugin-2fa", Version: "0.1.0-rc1"}, definition)
+
+	for _, fileName := range []string{"quorum-account-plugin.zip", "quorum-account-plugin-0.1.0.tar.gz"} {
+		_, err := ParseDistFileName(fileName)
+		assert.Error(t, err, fileName)
+	}
+}
+
+func TestLocalRegistry_Install_fromRegistry(t *testing.T) {
+	verifier := &fakeVerifier{}
+	testObject, tmpDir, done := newTestLocalRegistry(t, verifier)
+	defer done()
+	registryDir := filepath.Join(tmpDir, "registry")
+	v1 := &PluginDefinition{Name: "arbitrary-plugin", Version: "1.0.0"}
+	v2 := &PluginDefinition{Name: "arbitrary-plugin", Version: "2.0.0"}
+	for _, definition := range []*PluginDefinition{v2, v1} {
+		createArbitraryDistribution(t, filepath.Join(registryDir, filepath.FromSlash(definition.RemotePath())), definition)
+		distFile, err := FindInRegistry(registryDir, definition)
+		require.NoError(t, err)
+
+		installed, err := testObject.Install(distFile, definition)
+
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(testObject.baseDir, definition.DistFileName()), installed.Path)
+		assert.True(t, installed.Signed)
+		assert.Equal(t, verifier.checksums[definition.FullName()], installed.Checksum)
+	}
+
+	// versions are installed side by side where geth looks for them
+	plugins, err := testObject.List()
+	require.NoError(t, err)
+	require.Len(t, plugins, 2)
+	assert.Equal(t, Version("1.0.0"), plugins[0].Version)
+	assert.Equal(t, Version("2.0.0"), plugins[1].Version)
+	_, err = os.Stat(filepath.Join(testObject.baseDir, v1.SignatureFileName()))
+	assert.NoError(t, err)
+
+	require.NoError(t, testObject.Remove(v1))
+
+	plugins, err = testObject.List()
+	require.NoError(t, err)
+	require.Len(t, plugins, 1)
+	assert.Equal(t, Version("2.0.0"), plugins[0].Version)
+	_, err = os.Stat(filepath.Join(testObject.baseDir, v1.SignatureFileName()))
+	assert.True(t, os.IsNotExist(err))
+	assert.EqualError(t, testObject.Remove(v1), "plugin arbitrary-plugin-1.0.0 is not installed")
+}
+
+func TestLocalRegistry_Install_fromDistributionFile(t *testing.T) {
+	testObject, tmpDir, done := newTestLocalRegistry(t, &fakeVerifier{})
+	defer done()
+	definition := &PluginDefinition{Name: "arbitrary-plugin", Version: "1.0.0"}
+	distFile := createArbitraryDistribution(t, filepath.Join(tmpDir, "downloads"), definition)
+
+	installed, err := testObject.Install(distFile, nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "arbitrary-plugin", installed.Name)
+	assert.Equal(t, Version("1.0.0"), installed.Version)
+	assert.NoError(t, testObject.Verify(definition))
+
+	_, err = testObject.Install(distFile, nil)
+
+	assert.EqualError(t, err, "plugin arbitrary-plugin-1.0.0 is already installed")
+}
+
+func TestLocalRegistry_Install_whenSignatureInvalid(t *testing.T) {
+	testObject, tmpDir, done := newTestLocalRegistry(t, &fakeVerifier{err: errors.New("signature mismatch")})
+	defer done()
+	definition := &PluginDefinition{Name: "arbitrary-plugin", Version: "1.0.0"}
+	distFile := createArbitraryDistribution(t, filepath.Join(tmpDir, "downloads"), definition)
+
+	_, err := testObject.Install(distFile, definition)
+
+	assert.EqualError(t, err, "unable to verify plugin signature: signature mismatch")
+	plugins, err := testObject.List()
+	require.NoError(t, err)
+	assert.Empty(t, plugins)
+	_, err = os.Stat(filepath.Join(testObject.baseDir, definition.SignatureFileName()))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestLocalRegistry_Install_whenNotAPlugin(t *testing.T) {
+	testObject, tmpDir, done := newTestLocalRegistry(t, &fakeVerifier{})
+	defer done()
+	distFile := filepath.Join(tmpDir, "arbitrary-plugin-1.0.0.zip")
+	require.NoError(t, ioutil.WriteFile(distFile, []byte("arbitrary content"), 0644))
+
+	_, err := testObject.Install(distFile, nil)
+
+	assert.Error(t, err)
+	plugins, err := testObject.List()
+	require.NoError(t, err)
+	assert.Empty(t, plugins)
+}
+
+func TestFindInRegistry_whenNotFound(t *testing.T) {
+	_, err := FindInRegistry("/arbitrary/registry", &PluginDefinition{Name: "arbitrary-plugin", Version: "1.0.0"})
+
+	assert.Error(t, err)
+}
+
+func TestCentralClient_PublicKey_fromFileRegistry(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "q-")
+	require.NoError(t, err)
+	defer func() {
+		_ = os.RemoveAll(tmpDir)
+	}()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, DefaultPublicKeyFile), arbitraryPubKey, 0644))
+	testObject := NewPluginCentralClient(&PluginCentralConfiguration{
+		BaseURL:      "file://" + tmpDir,
+		PublicKeyURI: DefaultPublicKeyFile,
+	})
+
+	actualValue, err := testObject.PublicKey()
+
+	require.NoError(t, err)
+	assert.Equal(t, arbitraryPubKey, actualValue)
+}
@@ -0,0 +1,62 @@
+package rpcservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_rpc"
+)
+
+// JSON-RPC error code of the call errors which come without a code
+const defaultErrorCode = -32000
+
+// CallError is an error returned by the plugin for a call, which is passed
+// to the JSON-RPC client with its code
+type CallError struct {
+	Code    int
+	Message string
+}
+
+func (e *CallError) Error() string { return e.Message }
+
+func (e *CallError) ErrorCode() int { return e.Code }
+
+type PluginGateway struct {
+	client proto_rpc.RPCServiceClient
+}
+
+func (g *PluginGateway) Describe(ctx context.Context) (*Description, error) {
+	resp, err := g.client.Describe(ctx, &proto_rpc.DescribeRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return &Description{
+		Namespace: resp.Namespace,
+		Methods:   resp.Methods,
+	}, nil
+}
+
+func (g *PluginGateway) Call(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	resp, err := g.client.Call(ctx, &proto_rpc.CallRequest{
+		Method: method,
+		Params: params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Error != nil {
+		code := int(resp.Error.Code)
+		if code == 0 {
+			code = defaultErrorCode
+		}
+		return nil, &CallError{Code: code, Message: resp.Error.Message}
+	}
+	if len(resp.Result) == 0 {
+		return json.RawMessage("null"), nil
+	}
+	if !json.Valid(resp.Result) {
+		return nil, fmt.Errorf("invalid JSON result of method %s", method)
+	}
+	return resp.Result, nil
+}
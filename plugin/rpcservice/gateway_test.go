@@ -0,0 +1,118 @@
+package rpcservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_rpc"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// testRPCService echoes the parameters of the calls
+type testRPCService struct {
+	err      error
+	response *proto_rpc.CallResponse
+}
+
+func (s *testRPCService) Describe(_ context.Context, _ *proto_rpc.DescribeRequest) (*proto_rpc.DescribeResponse, error) {
+	return &proto_rpc.DescribeResponse{Namespace: "kyc", Methods: []string{"check"}}, nil
+}
+
+func (s *testRPCService) Call(_ context.Context, req *proto_rpc.CallRequest) (*proto_rpc.CallResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	if s.response != nil {
+		return s.response, nil
+	}
+	return &proto_rpc.CallResponse{Result: req.Params}, nil
+}
+
+// testConnector serves the test service as a plugin would
+type testConnector struct {
+	PluginConnector
+	impl proto_rpc.RPCServiceServer
+}
+
+func (c *testConnector) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	proto_rpc.RegisterRPCServiceServer(s, c.impl)
+	return nil
+}
+
+func newTestGateway(t *testing.T, impl proto_rpc.RPCServiceServer) (*PluginGateway, func()) {
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		ConnectorName: &testConnector{impl: impl},
+	})
+	raw, err := client.Dispense(ConnectorName)
+	require.NoError(t, err)
+	return raw.(*PluginGateway), func() {
+		_ = client.Close()
+		server.Stop()
+	}
+}
+
+func TestPluginGateway_Describe(t *testing.T) {
+	testObject, done := newTestGateway(t, &testRPCService{})
+	defer done()
+
+	d, err := testObject.Describe(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, &Description{Namespace: "kyc", Methods: []string{"check"}}, d)
+}
+
+func TestPluginGateway_Call(t *testing.T) {
+	testObject, done := newTestGateway(t, &testRPCService{})
+	defer done()
+
+	result, err := testObject.Call(context.Background(), "check", json.RawMessage(`["0x1"]`))
+
+	require.NoError(t, err)
+	assert.Equal(t, json.RawMessage(`["0x1"]`), result)
+}
+
+func TestPluginGateway_Call_whenNoResult(t *testing.T) {
+	testObject, done := newTestGateway(t, &testRPCService{response: &proto_rpc.CallResponse{}})
+	defer done()
+
+	result, err := testObject.Call(context.Background(), "check", json.RawMessage(`[]`))
+
+	require.NoError(t, err)
+	assert.Equal(t, json.RawMessage("null"), result)
+}
+
+func TestPluginGateway_Call_whenCallError(t *testing.T) {
+	testObject, done := newTestGateway(t, &testRPCService{response: &proto_rpc.CallResponse{
+		Result: []byte(`"ignored"`),
+		Error:  &proto_rpc.Error{Code: -32602, Message: "unknown customer"},
+	}})
+	defer done()
+
+	_, err := testObject.Call(context.Background(), "check", json.RawMessage(`[]`))
+
+	assert.Equal(t, &CallError{Code: -32602, Message: "unknown customer"}, err)
+}
+
+func TestPluginGateway_Call_whenInvalidResult(t *testing.T) {
+	testObject, done := newTestGateway(t, &testRPCService{response: &proto_rpc.CallResponse{Result: []byte("{")}})
+	defer done()
+
+	_, err := testObject.Call(context.Background(), "check", json.RawMessage(`[]`))
+
+	assert.EqualError(t, err, "invalid JSON result of method check")
+}
+
+func TestPluginGateway_Call_whenPluginFails(t *testing.T) {
+	testObject, done := newTestGateway(t, &testRPCService{err: errors.New("arbitrary error")})
+	defer done()
+
+	_, err := testObject.Call(context.Background(), "check", json.RawMessage(`[]`))
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "arbitrary error")
+}
@@ -0,0 +1,107 @@
+package rpcservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	namespacePattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*$`)
+	methodPattern    = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]*$`)
+)
+
+// Description is the namespace and the methods a plugin serves
+type Description struct {
+	Namespace string
+	Methods   []string
+}
+
+// RPCNamespace is the namespace the methods are exposed in, which is kept
+// apart from the namespaces of geth
+func (d *Description) RPCNamespace() string {
+	return fmt.Sprintf("plugin@%s", d.Namespace)
+}
+
+func (d *Description) Validate() error {
+	if !namespacePattern.MatchString(d.Namespace) {
+		return fmt.Errorf("invalid namespace %q", d.Namespace)
+	}
+	if len(d.Methods) == 0 {
+		return fmt.Errorf("no methods in namespace %s", d.Namespace)
+	}
+	seen := make(map[string]bool)
+	for _, m := range d.Methods {
+		if !methodPattern.MatchString(m) {
+			return fmt.Errorf("invalid method %q", m)
+		}
+		if seen[m] {
+			return fmt.Errorf("duplicate method %q", m)
+		}
+		seen[m] = true
+	}
+	return nil
+}
+
+// RPCService is a JSON-RPC service served by a plugin
+type RPCService interface {
+	// Describe returns the namespace and the methods of the service
+	Describe(ctx context.Context) (*Description, error)
+	// Call calls the method with the JSON array of its parameters and returns
+	// the JSON encoded result
+	Call(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error)
+}
+
+type RPCServiceDeferFunc func() (RPCService, error)
+
+type ReloadableRPCService struct {
+	DeferFunc RPCServiceDeferFunc
+}
+
+func (d *ReloadableRPCService) Describe(ctx context.Context) (*Description, error) {
+	s, err := d.DeferFunc()
+	if err != nil {
+		return nil, err
+	}
+	return s.Describe(ctx)
+}
+
+func (d *ReloadableRPCService) Call(ctx context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	s, err := d.DeferFunc()
+	if err != nil {
+		return nil, err
+	}
+	return s.Call(ctx, method, params)
+}
+
+// NewAPI describes the service and returns the API proxying the calls of
+// its methods. The methods are described once, a plugin adding methods
+// after a restart requires geth to be restarted.
+func NewAPI(ctx context.Context, s RPCService) (*rpc.API, error) {
+	d, err := s.Describe(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	methods := make(rpc.RawMethods, len(d.Methods))
+	for _, m := range d.Methods {
+		method := m
+		methods[method] = func(ctx context.Context, params json.RawMessage) (interface{}, error) {
+			if len(params) == 0 {
+				params = json.RawMessage("[]")
+			}
+			return s.Call(ctx, method, params)
+		}
+	}
+	return &rpc.API{
+		Namespace: d.RPCNamespace(),
+		Version:   "1.0.0",
+		Service:   methods,
+		Public:    true,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package rpcservice
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubRPCService returns the method and the parameters of the calls
+type stubRPCService struct {
+	description *Description
+}
+
+func (s *stubRPCService) Describe(_ context.Context) (*Description, error) {
+	return s.description, nil
+}
+
+func (s *stubRPCService) Call(_ context.Context, method string, params json.RawMessage) (json.RawMessage, error) {
+	if method == "fail" {
+		return nil, &CallError{Code: -32602, Message: "unknown customer"}
+	}
+	return json.Marshal(map[string]interface{}{"method": method, "params": params})
+}
+
+func TestDescription_Validate(t *testing.T) {
+	assert.NoError(t, (&Description{Namespace: "kyc", Methods: []string{"check", "check_v2"}}).Validate())
+
+	for _, d := range []*Description{
+		{Namespace: "", Methods: []string{"check"}},
+		{Namespace: "kyc_v2", Methods: []string{"check"}},
+		{Namespace: "plugin@kyc", Methods: []string{"check"}},
+		{Namespace: "kyc"},
+		{Namespace: "kyc", Methods: []string{"check", "check"}},
+		{Namespace: "kyc", Methods: []string{"1check"}},
+	} {
+		assert.Error(t, d.Validate(), "%v", d)
+	}
+}
+
+func TestNewAPI(t *testing.T) {
+	api, err := NewAPI(context.Background(), &stubRPCService{&Description{Namespace: "kyc", Methods: []string{"check", "fail"}}})
+	require.NoError(t, err)
+	assert.Equal(t, "plugin@kyc", api.Namespace)
+	server := rpc.NewServer()
+	defer server.Stop()
+	require.NoError(t, server.RegisterName(api.Namespace, api.Service))
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	var result map[string]interface{}
+	require.NoError(t, client.Call(&result, "plugin@kyc_check", "0x1"))
+	assert.Equal(t, map[string]interface{}{"method": "check", "params": []interface{}{"0x1"}}, result)
+
+	require.NoError(t, client.Call(&result, "plugin@kyc_check"))
+	assert.Equal(t, []interface{}{}, result["params"])
+
+	err = client.Call(nil, "plugin@kyc_fail")
+	require.Error(t, err)
+	assert.Equal(t, "unknown customer", err.Error())
+	assert.Equal(t, -32602, err.(rpc.Error).ErrorCode())
+
+	assert.Error(t, client.Call(nil, "plugin@kyc_undeclared"))
+}
+
+func TestNewAPI_whenInvalidDescription(t *testing.T) {
+	_, err := NewAPI(context.Background(), &stubRPCService{&Description{Namespace: "kyc"}})
+
+	assert.EqualError(t, err, "no methods in namespace kyc")
+}
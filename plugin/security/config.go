@@ -0,0 +1,58 @@
+package security
+
+import (
+	"fmt"
+	"time"
+)
+
+const DefaultTokenCacheTTL = time.Minute
+
+// Config is how geth uses the security plugin
+type Config struct {
+	// maximum number of authenticated tokens kept in memory. The cache is
+	// disabled unless it is positive.
+	TokenCacheSize int `json:"tokenCacheSize,omitempty" toml:",omitempty"`
+	// maximum time an authenticated token is reused without asking the plugin
+	// again, e.g. "30s", DefaultTokenCacheTTL if empty. A token is never
+	// reused after it expires, but a revoked token is accepted until it
+	// leaves the cache.
+	TokenCacheTTL string `json:"tokenCacheTTL,omitempty" toml:",omitempty"`
+	// interval at which the TLS configuration is reloaded from the plugin,
+	// e.g. "24h". The TLS configuration is also reloaded on SIGHUP.
+	TLSReloadInterval string `json:"tlsReloadInterval,omitempty" toml:",omitempty"`
+}
+
+func (c *Config) tokenCache() (size int, ttl time.Duration, err error) {
+	if c == nil || c.TokenCacheSize <= 0 {
+		return 0, 0, nil
+	}
+	size, ttl = c.TokenCacheSize, DefaultTokenCacheTTL
+	if c.TokenCacheTTL != "" {
+		if ttl, err = parsePositiveDuration(c.TokenCacheTTL); err != nil {
+			return 0, 0, fmt.Errorf("invalid tokenCacheTTL %s: %v", c.TokenCacheTTL, err)
+		}
+	}
+	return
+}
+
+func (c *Config) tlsReloadInterval() (time.Duration, error) {
+	if c == nil || c.TLSReloadInterval == "" {
+		return 0, nil
+	}
+	interval, err := parsePositiveDuration(c.TLSReloadInterval)
+	if err != nil {
+		return 0, fmt.Errorf("invalid tlsReloadInterval %s: %v", c.TLSReloadInterval, err)
+	}
+	return interval, nil
+}
+
+func parsePositiveDuration(s string) (time.Duration, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("must be positive")
+	}
+	return d, nil
+}
@@ -17,6 +17,28 @@ type AuthenticationManager interface {
 	IsEnabled(ctx context.Context) (bool, error)
 }
 
+type TLSConfigurationSourceDeferFunc func() (TLSConfigurationSource, error)
+
+// DeferredTLSConfigurationSource gets the TLS configuration from the actual
+// source on each call, so that it follows the plugin when it is restarted
+type DeferredTLSConfigurationSource struct {
+	deferFunc TLSConfigurationSourceDeferFunc
+}
+
+func (d *DeferredTLSConfigurationSource) Get(ctx context.Context) (*tls.Config, error) {
+	s, err := d.deferFunc()
+	if err != nil {
+		return nil, err
+	}
+	return s.Get(ctx)
+}
+
+func NewDeferredTLSConfigurationSource(deferFunc TLSConfigurationSourceDeferFunc) *DeferredTLSConfigurationSource {
+	return &DeferredTLSConfigurationSource{
+		deferFunc: deferFunc,
+	}
+}
+
 type AuthentiationManagerDeferFunc func() (AuthenticationManager, error)
 
 type DeferredAuthenticationManager struct {
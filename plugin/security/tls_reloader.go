@@ -0,0 +1,139 @@
+package security
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+// time allowed to the plugin to provide the TLS configuration on reload
+const tlsReloadTimeout = 30 * time.Second
+
+var tlsReloadFailureMeter = metrics.NewRegisteredMeter("plugin/security/tls/reloadfailures", nil)
+
+// TLSConfigurationReloader serves the TLS configuration of the plugin to the
+// RPC servers and reloads it on SIGHUP and at the configured interval, so that
+// certificates are renewed without restarting the servers. Connections
+// already established keep the configuration they were made with.
+type TLSConfigurationReloader struct {
+	source   TLSConfigurationSource
+	interval time.Duration
+
+	mux     sync.RWMutex
+	current *tls.Config
+	quit    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func NewTLSConfigurationReloader(source TLSConfigurationSource, cfg *Config) (*TLSConfigurationReloader, error) {
+	interval, err := cfg.tlsReloadInterval()
+	if err != nil {
+		return nil, err
+	}
+	return &TLSConfigurationReloader{
+		source:   source,
+		interval: interval,
+	}, nil
+}
+
+// Get loads the TLS configuration from the plugin the first time and starts
+// reloading it. The returned configuration serves each new connection with
+// the latest configuration loaded. It is nil if the plugin provides none.
+func (r *TLSConfigurationReloader) Get(ctx context.Context) (*tls.Config, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+	if r.current == nil {
+		cfg, err := r.source.Get(ctx)
+		if err != nil || cfg == nil {
+			return nil, err
+		}
+		r.current = cfg
+		r.quit = make(chan struct{})
+		r.wg.Add(1)
+		go r.loop(r.quit)
+	}
+	return &tls.Config{
+		GetConfigForClient: r.configForClient,
+		GetCertificate:     r.certificate,
+	}, nil
+}
+
+// Reload loads the TLS configuration from the plugin, keeping the current
+// one if it fails
+func (r *TLSConfigurationReloader) Reload(ctx context.Context) error {
+	cfg, err := r.source.Get(ctx)
+	if err == nil && cfg == nil {
+		err = errors.New("no TLS configuration provided by the plugin")
+	}
+	if err != nil {
+		tlsReloadFailureMeter.Mark(1)
+		return err
+	}
+	r.mux.Lock()
+	r.current = cfg
+	r.mux.Unlock()
+	return nil
+}
+
+// Stop stops reloading the TLS configuration
+func (r *TLSConfigurationReloader) Stop() {
+	r.mux.Lock()
+	quit := r.quit
+	r.quit = nil
+	r.mux.Unlock()
+	if quit != nil {
+		close(quit)
+		r.wg.Wait()
+	}
+}
+
+func (r *TLSConfigurationReloader) loop(quit chan struct{}) {
+	defer r.wg.Done()
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	defer signal.Stop(sighup)
+	var tick <-chan time.Time
+	if r.interval > 0 {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	for {
+		select {
+		case <-sighup:
+		case <-tick:
+		case <-quit:
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), tlsReloadTimeout)
+		if err := r.Reload(ctx); err != nil {
+			log.Error("Security: unable to reload TLS configuration, keeping the current one", "err", err)
+		} else {
+			log.Info("Security: reloaded TLS configuration")
+		}
+		cancel()
+	}
+}
+
+func (r *TLSConfigurationReloader) configForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	return r.current, nil
+}
+
+func (r *TLSConfigurationReloader) certificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mux.RLock()
+	defer r.mux.RUnlock()
+	if len(r.current.Certificates) == 0 {
+		return nil, errors.New("no certificate")
+	}
+	return &r.current.Certificates[0], nil
+}
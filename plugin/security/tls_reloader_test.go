@@ -0,0 +1,89 @@
+package security
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"testing"
+
+	testifyassert "github.com/stretchr/testify/assert"
+	testifyrequire "github.com/stretchr/testify/require"
+)
+
+type stubTLSConfigurationSource struct {
+	cfg *tls.Config
+	err error
+}
+
+func (s *stubTLSConfigurationSource) Get(_ context.Context) (*tls.Config, error) {
+	return s.cfg, s.err
+}
+
+func newTestTLSConfig(t *testing.T, serverName string) *tls.Config {
+	cfg, err := transform(abitraryTLSConfigurationData)
+	testifyrequire.NoError(t, err)
+	cfg.ServerName = serverName
+	return cfg
+}
+
+func TestTLSConfigurationReloader_Reload(t *testing.T) {
+	assert := testifyassert.New(t)
+	source := &stubTLSConfigurationSource{cfg: newTestTLSConfig(t, "first")}
+	testObject, err := NewTLSConfigurationReloader(source, nil)
+	testifyrequire.NoError(t, err)
+	defer testObject.Stop()
+
+	cfg, err := testObject.Get(context.Background())
+	testifyrequire.NoError(t, err)
+	current, err := cfg.GetConfigForClient(&tls.ClientHelloInfo{})
+	assert.NoError(err)
+	assert.Equal("first", current.ServerName)
+	cert, err := cfg.GetCertificate(&tls.ClientHelloInfo{})
+	assert.NoError(err)
+	assert.NotNil(cert)
+
+	source.cfg = newTestTLSConfig(t, "second")
+	assert.NoError(testObject.Reload(context.Background()))
+	current, err = cfg.GetConfigForClient(&tls.ClientHelloInfo{})
+	assert.NoError(err)
+	assert.Equal("second", current.ServerName)
+}
+
+func TestTLSConfigurationReloader_Reload_whenFailed(t *testing.T) {
+	assert := testifyassert.New(t)
+	source := &stubTLSConfigurationSource{cfg: newTestTLSConfig(t, "first")}
+	testObject, err := NewTLSConfigurationReloader(source, nil)
+	testifyrequire.NoError(t, err)
+	defer testObject.Stop()
+	cfg, err := testObject.Get(context.Background())
+	testifyrequire.NoError(t, err)
+
+	source.cfg, source.err = nil, errors.New("arbitrary error")
+	assert.EqualError(testObject.Reload(context.Background()), "arbitrary error")
+	source.err = nil
+	assert.EqualError(testObject.Reload(context.Background()), "no TLS configuration provided by the plugin")
+
+	current, err := cfg.GetConfigForClient(&tls.ClientHelloInfo{})
+	assert.NoError(err)
+	assert.Equal("first", current.ServerName)
+}
+
+func TestTLSConfigurationReloader_Get_whenNoConfiguration(t *testing.T) {
+	assert := testifyassert.New(t)
+	testObject, err := NewTLSConfigurationReloader(&stubTLSConfigurationSource{}, nil)
+	testifyrequire.NoError(t, err)
+	defer testObject.Stop()
+
+	cfg, err := testObject.Get(context.Background())
+
+	assert.NoError(err)
+	assert.Nil(cfg)
+}
+
+func TestNewTLSConfigurationReloader_whenInvalidInterval(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	_, err := NewTLSConfigurationReloader(&stubTLSConfigurationSource{}, &Config{TLSReloadInterval: "daily"})
+
+	assert.Error(err)
+}
@@ -0,0 +1,94 @@
+package security
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/golang/protobuf/ptypes"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
+)
+
+var (
+	tokenCacheHitMeter  = metrics.NewRegisteredMeter("plugin/security/tokencache/hits", nil)
+	tokenCacheMissMeter = metrics.NewRegisteredMeter("plugin/security/tokencache/misses", nil)
+)
+
+type cachedToken struct {
+	token *proto.PreAuthenticatedAuthenticationToken
+	until time.Time
+}
+
+// CachingAuthenticationManager keeps the tokens authenticated by the plugin
+// so that the plugin isn't called for every request. Tokens are kept until
+// they expire, for at most the TTL of the cache. Failed authentications are
+// not kept.
+type CachingAuthenticationManager struct {
+	AuthenticationManager
+	ttl   time.Duration
+	cache *lru.Cache // sha256 of the raw token -> *cachedToken
+	now   func() time.Time
+}
+
+// NewCachingAuthenticationManager returns the authentication manager caching
+// the tokens of am as configured, am itself if the cache isn't enabled
+func NewCachingAuthenticationManager(am AuthenticationManager, cfg *Config) (AuthenticationManager, error) {
+	size, ttl, err := cfg.tokenCache()
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return am, nil
+	}
+	cache, err := lru.New(size)
+	if err != nil {
+		return nil, err
+	}
+	return &CachingAuthenticationManager{
+		AuthenticationManager: am,
+		ttl:                   ttl,
+		cache:                 cache,
+		now:                   time.Now,
+	}, nil
+}
+
+func (m *CachingAuthenticationManager) Authenticate(ctx context.Context, token string) (*proto.PreAuthenticatedAuthenticationToken, error) {
+	key := sha256.Sum256([]byte(token))
+	now := m.now()
+	if v, ok := m.cache.Get(key); ok {
+		if cached := v.(*cachedToken); now.Before(cached.until) {
+			tokenCacheHitMeter.Mark(1)
+			return cached.token, nil
+		}
+		m.cache.Remove(key)
+	}
+	tokenCacheMissMeter.Mark(1)
+	authToken, err := m.AuthenticationManager.Authenticate(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	until := now.Add(m.ttl)
+	if authToken.ExpiredAt != nil {
+		expiredAt, err := ptypes.Timestamp(authToken.ExpiredAt)
+		if err != nil {
+			// left to the authorization check to reject
+			log.Debug("Security: not caching token with invalid expiration", "err", err)
+			return authToken, nil
+		}
+		if expiredAt.Before(until) {
+			until = expiredAt
+		}
+	}
+	if now.Before(until) {
+		m.cache.Add(key, &cachedToken{token: authToken, until: until})
+	}
+	return authToken, nil
+}
+
+// Purge removes all the tokens from the cache, e.g. after tokens are revoked
+func (m *CachingAuthenticationManager) Purge() {
+	m.cache.Purge()
+}
@@ -0,0 +1,136 @@
+package security
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
+	testifyassert "github.com/stretchr/testify/assert"
+	testifyrequire "github.com/stretchr/testify/require"
+)
+
+type countingAuthenticationManager struct {
+	calls     int
+	expiredAt time.Time
+	err       error
+}
+
+func (m *countingAuthenticationManager) Authenticate(_ context.Context, token string) (*proto.PreAuthenticatedAuthenticationToken, error) {
+	m.calls++
+	if m.err != nil {
+		return nil, m.err
+	}
+	expiredAt, err := ptypes.TimestampProto(m.expiredAt)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.PreAuthenticatedAuthenticationToken{
+		RawToken:  []byte(token),
+		ExpiredAt: expiredAt,
+	}, nil
+}
+
+func (m *countingAuthenticationManager) IsEnabled(_ context.Context) (bool, error) {
+	return true, nil
+}
+
+func newTestCache(t *testing.T, delegate AuthenticationManager, cfg *Config, now *time.Time) *CachingAuthenticationManager {
+	am, err := NewCachingAuthenticationManager(delegate, cfg)
+	testifyrequire.NoError(t, err)
+	testObject := am.(*CachingAuthenticationManager)
+	testObject.now = func() time.Time { return *now }
+	return testObject
+}
+
+func TestCachingAuthenticationManager_Authenticate_whenCached(t *testing.T) {
+	assert := testifyassert.New(t)
+	now := time.Now()
+	delegate := &countingAuthenticationManager{expiredAt: now.Add(time.Hour)}
+	testObject := newTestCache(t, delegate, &Config{TokenCacheSize: 16}, &now)
+
+	first, err := testObject.Authenticate(context.Background(), "arbitrary token")
+	assert.NoError(err)
+	second, err := testObject.Authenticate(context.Background(), "arbitrary token")
+	assert.NoError(err)
+	assert.Equal(first, second)
+	assert.Equal(1, delegate.calls)
+
+	_, err = testObject.Authenticate(context.Background(), "another token")
+	assert.NoError(err)
+	assert.Equal(2, delegate.calls)
+}
+
+func TestCachingAuthenticationManager_Authenticate_whenTTLElapsed(t *testing.T) {
+	assert := testifyassert.New(t)
+	now := time.Now()
+	delegate := &countingAuthenticationManager{expiredAt: now.Add(time.Hour)}
+	testObject := newTestCache(t, delegate, &Config{TokenCacheSize: 16, TokenCacheTTL: "10s"}, &now)
+
+	_, _ = testObject.Authenticate(context.Background(), "arbitrary token")
+	now = now.Add(9 * time.Second)
+	_, _ = testObject.Authenticate(context.Background(), "arbitrary token")
+	assert.Equal(1, delegate.calls)
+	now = now.Add(time.Second)
+	_, _ = testObject.Authenticate(context.Background(), "arbitrary token")
+	assert.Equal(2, delegate.calls)
+}
+
+func TestCachingAuthenticationManager_Authenticate_whenTokenExpiresBeforeTTL(t *testing.T) {
+	assert := testifyassert.New(t)
+	now := time.Now()
+	delegate := &countingAuthenticationManager{expiredAt: now.Add(5 * time.Second)}
+	testObject := newTestCache(t, delegate, &Config{TokenCacheSize: 16}, &now)
+
+	_, _ = testObject.Authenticate(context.Background(), "arbitrary token")
+	now = now.Add(5 * time.Second)
+	_, _ = testObject.Authenticate(context.Background(), "arbitrary token")
+	assert.Equal(2, delegate.calls)
+}
+
+func TestCachingAuthenticationManager_Authenticate_whenFailed(t *testing.T) {
+	assert := testifyassert.New(t)
+	now := time.Now()
+	delegate := &countingAuthenticationManager{err: errors.New("invalid token")}
+	testObject := newTestCache(t, delegate, &Config{TokenCacheSize: 16}, &now)
+
+	_, err := testObject.Authenticate(context.Background(), "arbitrary token")
+	assert.EqualError(err, "invalid token")
+	_, err = testObject.Authenticate(context.Background(), "arbitrary token")
+	assert.EqualError(err, "invalid token")
+	assert.Equal(2, delegate.calls)
+}
+
+func TestCachingAuthenticationManager_Authenticate_whenBounded(t *testing.T) {
+	assert := testifyassert.New(t)
+	now := time.Now()
+	delegate := &countingAuthenticationManager{expiredAt: now.Add(time.Hour)}
+	testObject := newTestCache(t, delegate, &Config{TokenCacheSize: 1}, &now)
+
+	_, _ = testObject.Authenticate(context.Background(), "arbitrary token")
+	_, _ = testObject.Authenticate(context.Background(), "another token")
+	_, _ = testObject.Authenticate(context.Background(), "arbitrary token")
+	assert.Equal(3, delegate.calls)
+}
+
+func TestNewCachingAuthenticationManager_whenDisabled(t *testing.T) {
+	assert := testifyassert.New(t)
+	delegate := &countingAuthenticationManager{}
+
+	for _, cfg := range []*Config{nil, {}, {TokenCacheSize: -1}} {
+		am, err := NewCachingAuthenticationManager(delegate, cfg)
+
+		assert.NoError(err)
+		assert.Equal(delegate, am)
+	}
+}
+
+func TestNewCachingAuthenticationManager_whenInvalidTTL(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	_, err := NewCachingAuthenticationManager(&countingAuthenticationManager{}, &Config{TokenCacheSize: 16, TokenCacheTTL: "-1s"})
+
+	assert.EqualError(err, "invalid tokenCacheTTL -1s: must be positive")
+}
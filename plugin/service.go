@@ -7,8 +7,15 @@ import (
 	"unsafe"
 
 	"github.com/ethereum/go-ethereum/accounts/pluggable"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/plugin/eventstream"
+	"github.com/ethereum/go-ethereum/plugin/signer"
+	"github.com/ethereum/go-ethereum/plugin/txvalidator"
+	"github.com/ethereum/go-ethereum/private"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -23,6 +30,8 @@ type PluginManager struct {
 	mux                sync.Mutex                            // control concurrent access to plugins cache
 	plugins            map[PluginInterfaceName]managedPlugin // lazy load the actual plugin templates
 	initializedPlugins map[PluginInterfaceName]managedPlugin // prepopulate during initialization of plugin manager, needed for starting/stopping/getting info
+	supervisor         *supervisor                           // health checks and restarts the plugins once started
+	eventFeed          event.Feed
 }
 
 func (s *PluginManager) Protocols() []p2p.Protocol { return nil }
@@ -54,10 +63,30 @@ func (s *PluginManager) Start(_ *p2p.Server) (err error) {
 		for _, p := range startedPlugins {
 			_ = p.Stop()
 		}
+		return
+	}
+	if len(s.initializedPlugins) > 0 {
+		sv := newSupervisor(s.initializedPlugins, &s.eventFeed, healthCheckInterval, minRestartBackoff, maxRestartBackoff)
+		s.mux.Lock()
+		s.supervisor = sv
+		s.mux.Unlock()
+		sv.start()
 	}
 	return
 }
 
+func (s *PluginManager) getSupervisor() *supervisor {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.supervisor
+}
+
+// SubscribePluginEvents registers a subscription for changes of the status of
+// the plugins
+func (s *PluginManager) SubscribePluginEvents(ch chan<- PluginEvent) event.Subscription {
+	return s.eventFeed.Subscribe(ch)
+}
+
 func (s *PluginManager) getPlugin(name PluginInterfaceName) (managedPlugin, bool) {
 	s.mux.Lock()
 	defer s.mux.Unlock()
@@ -122,6 +151,9 @@ func (s *PluginManager) GetPluginTemplate(name PluginInterfaceName, v managedPlu
 
 func (s *PluginManager) Stop() error {
 	log.Info("Stopping all plugins", "count", len(s.initializedPlugins))
+	if sv := s.getSupervisor(); sv != nil {
+		sv.stop()
+	}
 	allErrors := make([]error, 0)
 	for _, p := range s.initializedPlugins {
 		if err := p.Stop(); err != nil {
@@ -142,8 +174,14 @@ func (s *PluginManager) PluginsInfo() interface{} {
 		return info
 	}
 	info["baseDir"] = s.pluginBaseDir
+	sv := s.getSupervisor()
 	for _, p := range s.initializedPlugins {
 		k, v := p.Info()
+		if m, ok := v.(map[string]interface{}); ok && sv != nil {
+			if health, ok := sv.health(k); ok {
+				m["health"] = health
+			}
+		}
 		info[k] = v
 	}
 	return info
@@ -162,10 +200,80 @@ func (s *PluginManager) AddAccountPluginToBackend(b *pluggable.Backend) error {
 	if err := b.SetPluginService(service); err != nil {
 		return err
 	}
+	approvalService, err := v.CreateApprovalService()
+	if err != nil {
+		return err
+	}
+	if approvalService != nil {
+		return b.SetApprovalService(approvalService)
+	}
 	return nil
 }
 
+// PrivateTxManager returns the private transaction manager provided by the plugin
+func (s *PluginManager) PrivateTxManager() (private.PrivateTransactionManager, error) {
+	v := new(PrivateTxManagerPluginTemplate)
+	if err := s.GetPluginTemplate(PrivateTxManagerPluginInterfaceName, v); err != nil {
+		return nil, err
+	}
+	return v.Get()
+}
+
+// TransactionValidationFunc returns the function validating transactions with
+// the transaction validator plugin, see core.TransactionValidationFunc
+func (s *PluginManager) TransactionValidationFunc() (func(*types.Transaction, common.Address, uint64) error, error) {
+	v := new(TxValidatorPluginTemplate)
+	if err := s.GetPluginTemplate(TxValidatorPluginInterfaceName, v); err != nil {
+		return nil, err
+	}
+	validator, err := v.Get()
+	if err != nil {
+		return nil, err
+	}
+	return txvalidator.NewValidationFunc(validator, s.settings.TxValidator)
+}
+
+// BlockTransactionValidationFunc returns the function validating the
+// transactions of imported blocks with the transaction validator plugin, or
+// nil if it is not configured to validate blocks, see
+// core.BlockTransactionValidationFunc
+func (s *PluginManager) BlockTransactionValidationFunc() (func(*types.Transaction, common.Address, uint64) error, error) {
+	if s.settings.TxValidator == nil || !s.settings.TxValidator.ValidateBlocks {
+		return nil, nil
+	}
+	v := new(TxValidatorPluginTemplate)
+	if err := s.GetPluginTemplate(TxValidatorPluginInterfaceName, v); err != nil {
+		return nil, err
+	}
+	validator, err := v.Get()
+	if err != nil {
+		return nil, err
+	}
+	return txvalidator.NewBlockValidationFunc(validator, s.settings.TxValidator)
+}
+
+// EventStream returns the event stream provided by the plugin
+func (s *PluginManager) EventStream() (eventstream.EventStream, error) {
+	v := new(EventStreamPluginTemplate)
+	if err := s.GetPluginTemplate(EventStreamPluginInterfaceName, v); err != nil {
+		return nil, err
+	}
+	return v.Get()
+}
+
+// Signer returns the signer holding the validator key provided by the plugin
+func (s *PluginManager) Signer() (signer.Signer, error) {
+	v := new(SignerPluginTemplate)
+	if err := s.GetPluginTemplate(SignerPluginInterfaceName, v); err != nil {
+		return nil, err
+	}
+	return v.Get()
+}
+
 func (s *PluginManager) Reload(name PluginInterfaceName) (bool, error) {
+	if sv := s.getSupervisor(); sv != nil {
+		return sv.reload(name)
+	}
 	p, ok := s.getPlugin(name)
 	if !ok {
 		return false, fmt.Errorf("no such plugin provider: %s", name)
@@ -178,18 +286,39 @@ func (s *PluginManager) Reload(name PluginInterfaceName) (bool, error) {
 }
 
 // this is to configure delegate APIs call to the plugins
+func pluginNamespace(name PluginInterfaceName) string {
+	return fmt.Sprintf("plugin@%s", name)
+}
+
+func isPluginNamespace(namespace string) bool {
+	for name := range pluginProviders {
+		if namespace == pluginNamespace(name) {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *PluginManager) delegateAPIs() []rpc.API {
 	apis := make([]rpc.API, 0)
 	for _, p := range s.initializedPlugins {
 		interfaceName, _ := p.Info()
 		if pluginProvider, ok := pluginProviders[interfaceName]; ok {
 			if pluginProvider.apiProviderFunc != nil {
-				namespace := fmt.Sprintf("plugin@%s", interfaceName)
+				namespace := pluginNamespace(interfaceName)
 				log.Debug("adding RPC API delegate for plugin", "provider", interfaceName, "namespace", namespace)
-				if delegates, err := pluginProvider.apiProviderFunc(namespace, s); err != nil {
+				delegates, err := pluginProvider.apiProviderFunc(namespace, s)
+				if err != nil {
 					log.Error("unable to delegate RPC API calls to plugin", "provider", interfaceName, "error", err)
-				} else {
-					apis = append(apis, delegates...)
+					continue
+				}
+				for _, api := range delegates {
+					// a plugin declaring its own namespace can't take over the namespace of another plugin interface
+					if api.Namespace != namespace && isPluginNamespace(api.Namespace) {
+						log.Error("unable to delegate RPC API calls to plugin", "provider", interfaceName, "error", fmt.Sprintf("namespace %s is reserved", api.Namespace))
+						continue
+					}
+					apis = append(apis, api)
 				}
 			}
 		}
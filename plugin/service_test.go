@@ -142,3 +142,16 @@ func (i invalidPluginTemplate) Stop() error {
 func (i invalidPluginTemplate) Info() (PluginInterfaceName, interface{}) {
 	panic("implement me")
 }
+
+func (i invalidPluginTemplate) Ping() error {
+	panic("implement me")
+}
+
+func TestIsPluginNamespace(t *testing.T) {
+	assert := testifyassert.New(t)
+
+	assert.True(isPluginNamespace("plugin@account"))
+	assert.True(isPluginNamespace("plugin@rpc"))
+	assert.False(isPluginNamespace("plugin@kyc"))
+	assert.False(isPluginNamespace("account"))
+}
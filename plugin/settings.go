@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -12,19 +13,32 @@ import (
 	"strings"
 
 	"github.com/ethereum/go-ethereum/plugin/account"
+	"github.com/ethereum/go-ethereum/plugin/eventstream"
 	"github.com/ethereum/go-ethereum/plugin/helloworld"
+	"github.com/ethereum/go-ethereum/plugin/privatetxmanager"
+	"github.com/ethereum/go-ethereum/plugin/rpcservice"
 	"github.com/ethereum/go-ethereum/plugin/security"
+	"github.com/ethereum/go-ethereum/plugin/signer"
+	"github.com/ethereum/go-ethereum/plugin/txvalidator"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/hashicorp/go-plugin"
 	"github.com/naoina/toml"
 )
 
 const (
-	HelloWorldPluginInterfaceName = PluginInterfaceName("helloworld") // lower-case always
-	SecurityPluginInterfaceName   = PluginInterfaceName("security")
-	AccountPluginInterfaceName    = PluginInterfaceName("account")
+	HelloWorldPluginInterfaceName       = PluginInterfaceName("helloworld") // lower-case always
+	SecurityPluginInterfaceName         = PluginInterfaceName("security")
+	AccountPluginInterfaceName          = PluginInterfaceName("account")
+	PrivateTxManagerPluginInterfaceName = PluginInterfaceName("privatetxmanager")
+	TxValidatorPluginInterfaceName      = PluginInterfaceName("txvalidator")
+	EventStreamPluginInterfaceName      = PluginInterfaceName("eventstream")
+	RPCPluginInterfaceName              = PluginInterfaceName("rpc")
+	SignerPluginInterfaceName           = PluginInterfaceName("signer")
 )
 
+// appended to the name of the distribution file to get its signature file
+const signatureFileSuffix = ".sha256sum.asc"
+
 var (
 	// define additional plugins being supported here
 	pluginProviders = map[PluginInterfaceName]pluginProvider{
@@ -73,7 +87,49 @@ var (
 				}}, nil
 			},
 			pluginSet: plugin.PluginSet{
-				account.ConnectorName: &account.PluginConnector{},
+				account.ConnectorName:         &account.PluginConnector{},
+				account.ApprovalConnectorName: &account.ApprovalPluginConnector{},
+			},
+		},
+		PrivateTxManagerPluginInterfaceName: {
+			pluginSet: plugin.PluginSet{
+				privatetxmanager.ConnectorName: &privatetxmanager.PluginConnector{},
+			},
+		},
+		TxValidatorPluginInterfaceName: {
+			pluginSet: plugin.PluginSet{
+				txvalidator.ConnectorName: &txvalidator.PluginConnector{},
+			},
+		},
+		EventStreamPluginInterfaceName: {
+			pluginSet: plugin.PluginSet{
+				eventstream.ConnectorName: &eventstream.PluginConnector{},
+			},
+		},
+		RPCPluginInterfaceName: {
+			// the plugin declares its own namespace
+			apiProviderFunc: func(_ string, pm *PluginManager) ([]rpc.API, error) {
+				template := new(RPCPluginTemplate)
+				if err := pm.GetPluginTemplate(RPCPluginInterfaceName, template); err != nil {
+					return nil, err
+				}
+				service, err := template.Get()
+				if err != nil {
+					return nil, err
+				}
+				api, err := rpcservice.NewAPI(context.Background(), service)
+				if err != nil {
+					return nil, err
+				}
+				return []rpc.API{*api}, nil
+			},
+			pluginSet: plugin.PluginSet{
+				rpcservice.ConnectorName: &rpcservice.PluginConnector{},
+			},
+		},
+		SignerPluginInterfaceName: {
+			pluginSet: plugin.PluginSet{
+				signer.ConnectorName: &signer.PluginConnector{},
 			},
 		},
 	}
@@ -165,7 +221,7 @@ func (m *PluginDefinition) DistFileName() string {
 
 // return plugin distribution signature file name
 func (m *PluginDefinition) SignatureFileName() string {
-	return fmt.Sprintf("%s.sha256sum.asc", m.DistFileName())
+	return m.DistFileName() + signatureFileSuffix
 }
 
 // must be always be lowercase when define constants
@@ -205,6 +261,10 @@ type Settings struct {
 	BaseDir       EnvironmentAwaredValue                   `json:"baseDir" toml:""`
 	CentralConfig *PluginCentralConfiguration              `json:"central" toml:"Central"`
 	Providers     map[PluginInterfaceName]PluginDefinition `json:"providers" toml:""`
+	// how geth calls the transaction validator plugin
+	TxValidator *txvalidator.Config `json:"txValidator,omitempty" toml:",omitempty"`
+	// how geth uses the security plugin
+	Security *security.Config `json:"security,omitempty" toml:",omitempty"`
 }
 
 func (s *Settings) GetPluginDefinition(name PluginInterfaceName) (*PluginDefinition, bool) {
@@ -244,7 +304,8 @@ func (s *Settings) CheckSettingsAreSupported(supportedPlugins []PluginInterfaceN
 type PluginCentralConfiguration struct {
 	// To implement certificate pinning while communicating with PluginCentral
 	// if it's empty, we skip cert pinning logic
-	CertFingerprint       string `json:"certFingerprint" toml:""`
+	CertFingerprint string `json:"certFingerprint" toml:""`
+	// e.g. https://host/path, or file:///path for a directory laid out like Plugin Central
 	BaseURL               string `json:"baseURL" toml:""`
 	PublicKeyURI          string `json:"publicKeyURI" toml:""`
 	InsecureSkipTLSVerify bool   `json:"insecureSkipTLSVerify" toml:""`
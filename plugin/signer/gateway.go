@@ -0,0 +1,34 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_signer"
+)
+
+type PluginGateway struct {
+	client proto_signer.SignerClient
+}
+
+func (g *PluginGateway) Account(ctx context.Context) (common.Address, error) {
+	resp, err := g.client.Account(ctx, &proto_signer.AccountRequest{})
+	if err != nil {
+		return common.Address{}, err
+	}
+	if len(resp.Address) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("invalid address of %d bytes", len(resp.Address))
+	}
+	return common.BytesToAddress(resp.Address), nil
+}
+
+func (g *PluginGateway) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	resp, err := g.client.SignHash(ctx, &proto_signer.SignHashRequest{
+		Hash: hash,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Signature, nil
+}
@@ -0,0 +1,99 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_signer"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// testSigner records the signed hash
+type testSigner struct {
+	address []byte
+	err     error
+	hash    []byte
+}
+
+func (s *testSigner) Account(_ context.Context, _ *proto_signer.AccountRequest) (*proto_signer.AccountResponse, error) {
+	return &proto_signer.AccountResponse{Address: s.address}, nil
+}
+
+func (s *testSigner) SignHash(_ context.Context, req *proto_signer.SignHashRequest) (*proto_signer.SignHashResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.hash = req.Hash
+	return &proto_signer.SignHashResponse{Signature: []byte("arbitrary signature")}, nil
+}
+
+// testConnector serves the test signer as a plugin would
+type testConnector struct {
+	PluginConnector
+	impl proto_signer.SignerServer
+}
+
+func (c *testConnector) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	proto_signer.RegisterSignerServer(s, c.impl)
+	return nil
+}
+
+func newTestGateway(t *testing.T, impl proto_signer.SignerServer) (*PluginGateway, func()) {
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		ConnectorName: &testConnector{impl: impl},
+	})
+	raw, err := client.Dispense(ConnectorName)
+	require.NoError(t, err)
+	return raw.(*PluginGateway), func() {
+		_ = client.Close()
+		server.Stop()
+	}
+}
+
+func TestPluginGateway_Account(t *testing.T) {
+	address := common.HexToAddress("0x1")
+	testObject, done := newTestGateway(t, &testSigner{address: address.Bytes()})
+	defer done()
+
+	actual, err := testObject.Account(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, address, actual)
+}
+
+func TestPluginGateway_Account_whenInvalidAddress(t *testing.T) {
+	testObject, done := newTestGateway(t, &testSigner{address: []byte{1}})
+	defer done()
+
+	_, err := testObject.Account(context.Background())
+
+	assert.EqualError(t, err, "invalid address of 1 bytes")
+}
+
+func TestPluginGateway_SignHash(t *testing.T) {
+	impl := &testSigner{}
+	testObject, done := newTestGateway(t, impl)
+	defer done()
+	hash := common.StringToHash("arbitrary hash").Bytes()
+
+	sig, err := testObject.SignHash(context.Background(), hash)
+
+	require.NoError(t, err)
+	assert.Equal(t, []byte("arbitrary signature"), sig)
+	assert.Equal(t, hash, impl.hash)
+}
+
+func TestPluginGateway_SignHash_whenPluginFails(t *testing.T) {
+	testObject, done := newTestGateway(t, &testSigner{err: errors.New("vault sealed")})
+	defer done()
+
+	_, err := testObject.SignHash(context.Background(), common.StringToHash("arbitrary hash").Bytes())
+
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "vault sealed")
+}
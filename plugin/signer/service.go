@@ -0,0 +1,106 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// time allowed to the plugin for a signature, well within the IBFT round timeout
+const signTimeout = 5 * time.Second
+
+var ErrSignerNotReady = errors.New("signer plugin is not ready")
+
+// Signer holds the validator key of the node
+type Signer interface {
+	// Account returns the address of the validator key
+	Account(ctx context.Context) (common.Address, error)
+	// SignHash signs the 32 byte hash, returning the signature in the
+	// [R || S || V] format where V is 0 or 1
+	SignHash(ctx context.Context, hash []byte) ([]byte, error)
+}
+
+type SignerDeferFunc func() (Signer, error)
+
+type ReloadableSigner struct {
+	DeferFunc SignerDeferFunc
+}
+
+func (d *ReloadableSigner) Account(ctx context.Context) (common.Address, error) {
+	s, err := d.DeferFunc()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return s.Account(ctx)
+}
+
+func (d *ReloadableSigner) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	s, err := d.DeferFunc()
+	if err != nil {
+		return nil, err
+	}
+	return s.SignHash(ctx, hash)
+}
+
+// consensusSigner signs the consensus messages and seals with the plugin,
+// checking the signatures are made by the validator key so that a
+// misconfigured plugin doesn't go unnoticed until the peers reject them
+type consensusSigner struct {
+	signer Signer
+
+	mux     sync.Mutex
+	address common.Address
+}
+
+// NewConsensusSigner returns the consensus signer of the plugin
+func NewConsensusSigner(s Signer) consensus.Signer {
+	return &consensusSigner{signer: s}
+}
+
+// Address returns the address of the validator key, which is asked to the
+// plugin until it succeeds
+func (s *consensusSigner) Address() (common.Address, error) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	if s.address != (common.Address{}) {
+		return s.address, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	address, err := s.signer.Account(ctx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	s.address = address
+	return address, nil
+}
+
+func (s *consensusSigner) SignHash(hash []byte) ([]byte, error) {
+	address, err := s.Address()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), signTimeout)
+	defer cancel()
+	sig, err := s.signer.SignHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return nil, fmt.Errorf("invalid signature of %d bytes", len(sig))
+	}
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature: %v", err)
+	}
+	if signer := crypto.PubkeyToAddress(*pub); signer != address {
+		return nil, fmt.Errorf("signature by %s instead of the validator key %s", signer.Hex(), address.Hex())
+	}
+	return sig, nil
+}
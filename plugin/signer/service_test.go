@@ -0,0 +1,99 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// keySigner signs with the key, returning the address of account
+type keySigner struct {
+	key      *ecdsa.PrivateKey
+	account  common.Address
+	err      error
+	accounts int
+}
+
+func (s *keySigner) Account(_ context.Context) (common.Address, error) {
+	s.accounts++
+	return s.account, s.err
+}
+
+func (s *keySigner) SignHash(_ context.Context, hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.key)
+}
+
+func newKeySigner(t *testing.T) *keySigner {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	return &keySigner{key: key, account: crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func TestConsensusSigner_SignHash(t *testing.T) {
+	s := newKeySigner(t)
+	testObject := NewConsensusSigner(s)
+	hash := crypto.Keccak256([]byte("arbitrary data"))
+
+	sig, err := testObject.SignHash(hash)
+
+	require.NoError(t, err)
+	pub, err := crypto.SigToPub(hash, sig)
+	require.NoError(t, err)
+	assert.Equal(t, s.account, crypto.PubkeyToAddress(*pub))
+	address, err := testObject.Address()
+	require.NoError(t, err)
+	assert.Equal(t, s.account, address)
+	assert.Equal(t, 1, s.accounts, "the address is asked once")
+}
+
+func TestConsensusSigner_Address_whenPluginFails(t *testing.T) {
+	s := newKeySigner(t)
+	s.err = errors.New("plugin not started")
+	testObject := NewConsensusSigner(s)
+
+	_, err := testObject.Address()
+	assert.EqualError(t, err, "plugin not started")
+
+	s.err = nil
+	address, err := testObject.Address()
+	require.NoError(t, err)
+	assert.Equal(t, s.account, address)
+}
+
+func TestConsensusSigner_SignHash_whenSignedByAnotherKey(t *testing.T) {
+	s := newKeySigner(t)
+	other := common.HexToAddress("0x1")
+	s.account = other
+	testObject := NewConsensusSigner(s)
+
+	_, err := testObject.SignHash(crypto.Keccak256([]byte("arbitrary data")))
+
+	assert.EqualError(t, err, "signature by "+crypto.PubkeyToAddress(s.key.PublicKey).Hex()+" instead of the validator key "+other.Hex())
+}
+
+func TestConsensusSigner_SignHash_whenInvalidSignature(t *testing.T) {
+	testObject := NewConsensusSigner(&ReloadableSigner{DeferFunc: func() (Signer, error) {
+		return &invalidSigner{}, nil
+	}})
+
+	_, err := testObject.SignHash(crypto.Keccak256([]byte("arbitrary data")))
+
+	assert.EqualError(t, err, "invalid signature of 64 bytes")
+}
+
+// invalidSigner returns signatures without recovery id
+type invalidSigner struct{}
+
+func (s *invalidSigner) Account(_ context.Context) (common.Address, error) {
+	return common.HexToAddress("0x1"), nil
+}
+
+func (s *invalidSigner) SignHash(_ context.Context, _ []byte) ([]byte, error) {
+	return make([]byte, 64), nil
+}
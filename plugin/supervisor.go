@@ -0,0 +1,266 @@
+package plugin
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+const (
+	// how often running plugins are health checked
+	healthCheckInterval = 10 * time.Second
+	// delay before restarting an unhealthy plugin, doubled after each
+	// restart that doesn't last
+	minRestartBackoff = time.Second
+	maxRestartBackoff = 2 * time.Minute
+)
+
+type PluginStatus string
+
+const (
+	PluginRunning    PluginStatus = "running"
+	PluginUnhealthy  PluginStatus = "unhealthy" // failed a health check or to start, waiting to be restarted
+	PluginRestarting PluginStatus = "restarting"
+	PluginStopped    PluginStatus = "stopped"
+)
+
+// PluginEvent is posted when the status of a plugin changes
+type PluginEvent struct {
+	Provider PluginInterfaceName
+	Status   PluginStatus
+	Err      error
+	Restarts uint64
+}
+
+// PluginHealth is the status of a supervised plugin
+type PluginHealth struct {
+	Status      PluginStatus `json:"status"`
+	LastCheck   time.Time    `json:"lastCheck"`
+	LastError   string       `json:"lastError,omitempty"`
+	Restarts    uint64       `json:"restarts"`
+	NextRestart *time.Time   `json:"nextRestart,omitempty"`
+}
+
+type supervisedPlugin struct {
+	name   PluginInterfaceName
+	plugin managedPlugin
+
+	lifecycle sync.Mutex // serializes health checks, restarts and reloads
+
+	mux       sync.RWMutex
+	health    PluginHealth
+	backoff   time.Duration
+	startedAt time.Time
+
+	restartCounter metrics.Counter
+	failureCounter metrics.Counter
+	upGauge        metrics.Gauge
+}
+
+// supervisor health checks the plugins over gRPC and restarts the ones which
+// crashed or stopped serving. Each plugin is supervised on its own, so that
+// a failing plugin doesn't hold up the others.
+type supervisor struct {
+	interval   time.Duration
+	minBackoff time.Duration
+	maxBackoff time.Duration
+
+	plugins map[PluginInterfaceName]*supervisedPlugin
+	feed    *event.Feed
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newSupervisor(plugins map[PluginInterfaceName]managedPlugin, feed *event.Feed, interval, minBackoff, maxBackoff time.Duration) *supervisor {
+	s := &supervisor{
+		interval:   interval,
+		minBackoff: minBackoff,
+		maxBackoff: maxBackoff,
+		plugins:    make(map[PluginInterfaceName]*supervisedPlugin, len(plugins)),
+		feed:       feed,
+		quit:       make(chan struct{}),
+	}
+	now := time.Now()
+	for name, p := range plugins {
+		sp := &supervisedPlugin{
+			name:           name,
+			plugin:         p,
+			health:         PluginHealth{Status: PluginRunning, LastCheck: now},
+			backoff:        minBackoff,
+			startedAt:      now,
+			restartCounter: metrics.GetOrRegisterCounter(fmt.Sprintf("plugin/%s/restarts", name), nil),
+			failureCounter: metrics.GetOrRegisterCounter(fmt.Sprintf("plugin/%s/healthcheck/failures", name), nil),
+			upGauge:        metrics.GetOrRegisterGauge(fmt.Sprintf("plugin/%s/up", name), nil),
+		}
+		sp.upGauge.Update(1)
+		s.plugins[name] = sp
+	}
+	return s
+}
+
+func (s *supervisor) start() {
+	for _, sp := range s.plugins {
+		s.wg.Add(1)
+		go s.run(sp)
+	}
+}
+
+// stop stops supervising the plugins, which are then stopped by the manager
+func (s *supervisor) stop() {
+	close(s.quit)
+	s.wg.Wait()
+	for _, sp := range s.plugins {
+		sp.update(PluginStopped, nil, nil)
+		sp.upGauge.Update(0)
+	}
+}
+
+func (s *supervisor) run(sp *supervisedPlugin) {
+	defer s.wg.Done()
+	timer := time.NewTimer(s.interval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-s.quit:
+			return
+		case <-timer.C:
+			timer.Reset(s.check(sp))
+		}
+	}
+}
+
+// check health checks the plugin, or restarts it if it is unhealthy, and
+// returns when to check it next
+func (s *supervisor) check(sp *supervisedPlugin) time.Duration {
+	sp.lifecycle.Lock()
+	defer sp.lifecycle.Unlock()
+
+	if sp.status() == PluginUnhealthy {
+		return s.restart(sp)
+	}
+	err := sp.plugin.Ping()
+	sp.mux.Lock()
+	sp.health.LastCheck = time.Now()
+	if err == nil && time.Since(sp.startedAt) >= s.maxBackoff {
+		// the plugin is stable again
+		sp.backoff = s.minBackoff
+	}
+	sp.mux.Unlock()
+	if err == nil {
+		return s.interval
+	}
+	log.Warn("Plugin failed health check", "provider", sp.name, "err", err)
+	sp.failureCounter.Inc(1)
+	return s.unhealthy(sp, err)
+}
+
+// unhealthy schedules the restart of the plugin and returns its delay
+func (s *supervisor) unhealthy(sp *supervisedPlugin, err error) time.Duration {
+	sp.upGauge.Update(0)
+	sp.mux.Lock()
+	delay := sp.backoff
+	if sp.backoff *= 2; sp.backoff > s.maxBackoff {
+		sp.backoff = s.maxBackoff
+	}
+	sp.mux.Unlock()
+	next := time.Now().Add(delay)
+	sp.update(PluginUnhealthy, err, &next)
+	s.post(sp, err)
+	log.Info("Scheduled plugin restart", "provider", sp.name, "in", delay)
+	return delay
+}
+
+// restart restarts the plugin and returns when to check it next
+func (s *supervisor) restart(sp *supervisedPlugin) time.Duration {
+	if err := s.restartPlugin(sp); err != nil {
+		log.Error("Plugin failed to restart", "provider", sp.name, "err", err)
+		return s.unhealthy(sp, err)
+	}
+	log.Info("Plugin restarted", "provider", sp.name, "restarts", sp.restarts())
+	return s.interval
+}
+
+func (s *supervisor) restartPlugin(sp *supervisedPlugin) (err error) {
+	sp.update(PluginRestarting, nil, nil)
+	s.post(sp, nil)
+	// a plugin misbehaving while being restarted must not take down geth
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	_ = sp.plugin.Stop()
+	if err := sp.plugin.Start(); err != nil {
+		return err
+	}
+	sp.mux.Lock()
+	sp.health.Restarts++
+	sp.startedAt = time.Now()
+	sp.mux.Unlock()
+	sp.restartCounter.Inc(1)
+	sp.upGauge.Update(1)
+	sp.update(PluginRunning, nil, nil)
+	s.post(sp, nil)
+	return nil
+}
+
+// reload restarts the plugin on request. If it fails to start, the
+// supervisor keeps trying.
+func (s *supervisor) reload(name PluginInterfaceName) (bool, error) {
+	sp, ok := s.plugins[name]
+	if !ok {
+		return false, fmt.Errorf("no such plugin provider: %s", name)
+	}
+	sp.lifecycle.Lock()
+	defer sp.lifecycle.Unlock()
+	if err := s.restartPlugin(sp); err != nil {
+		s.unhealthy(sp, err)
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *supervisor) post(sp *supervisedPlugin, err error) {
+	sp.mux.RLock()
+	ev := PluginEvent{Provider: sp.name, Status: sp.health.Status, Err: err, Restarts: sp.health.Restarts}
+	sp.mux.RUnlock()
+	s.feed.Send(ev)
+}
+
+// health returns the status of the plugin
+func (s *supervisor) health(name PluginInterfaceName) (PluginHealth, bool) {
+	sp, ok := s.plugins[name]
+	if !ok {
+		return PluginHealth{}, false
+	}
+	sp.mux.RLock()
+	defer sp.mux.RUnlock()
+	return sp.health, true
+}
+
+func (sp *supervisedPlugin) update(status PluginStatus, err error, nextRestart *time.Time) {
+	sp.mux.Lock()
+	defer sp.mux.Unlock()
+	sp.health.Status = status
+	sp.health.NextRestart = nextRestart
+	if err != nil {
+		sp.health.LastError = err.Error()
+	}
+}
+
+func (sp *supervisedPlugin) status() PluginStatus {
+	sp.mux.RLock()
+	defer sp.mux.RUnlock()
+	return sp.health.Status
+}
+
+func (sp *supervisedPlugin) restarts() uint64 {
+	sp.mux.RLock()
+	defer sp.mux.RUnlock()
+	return sp.health.Restarts
+}
@@ -0,0 +1,177 @@
+package plugin
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/event"
+	testifyassert "github.com/stretchr/testify/assert"
+	testifyrequire "github.com/stretchr/testify/require"
+)
+
+type fakePlugin struct {
+	mux      sync.Mutex
+	pingErr  error
+	startErr error
+	starts   int
+	stops    int
+}
+
+func (p *fakePlugin) Start() error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.starts++
+	return p.startErr
+}
+
+func (p *fakePlugin) Stop() error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.stops++
+	return nil
+}
+
+func (p *fakePlugin) Ping() error {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.pingErr
+}
+
+func (p *fakePlugin) Info() (PluginInterfaceName, interface{}) {
+	return HelloWorldPluginInterfaceName, map[string]interface{}{}
+}
+
+func (p *fakePlugin) set(pingErr, startErr error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	p.pingErr, p.startErr = pingErr, startErr
+}
+
+func (p *fakePlugin) startCount() int {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+	return p.starts
+}
+
+func newTestSupervisor(p managedPlugin) (*supervisor, *event.Feed) {
+	feed := new(event.Feed)
+	return newSupervisor(map[PluginInterfaceName]managedPlugin{
+		HelloWorldPluginInterfaceName: p,
+	}, feed, time.Hour, time.Second, 4*time.Second), feed
+}
+
+func TestSupervisor_check_whenHealthy(t *testing.T) {
+	assert := testifyassert.New(t)
+	p := &fakePlugin{}
+	testObject, _ := newTestSupervisor(p)
+
+	next := testObject.check(testObject.plugins[HelloWorldPluginInterfaceName])
+
+	assert.Equal(time.Hour, next)
+	assert.Equal(0, p.startCount())
+	health, ok := testObject.health(HelloWorldPluginInterfaceName)
+	assert.True(ok)
+	assert.Equal(PluginRunning, health.Status)
+}
+
+func TestSupervisor_check_restartsCrashedPlugin(t *testing.T) {
+	assert := testifyassert.New(t)
+	p := &fakePlugin{}
+	testObject, feed := newTestSupervisor(p)
+	sp := testObject.plugins[HelloWorldPluginInterfaceName]
+	events := make(chan PluginEvent, 10)
+	sub := feed.Subscribe(events)
+	defer sub.Unsubscribe()
+
+	p.set(errors.New("plugin exited"), nil)
+	next := testObject.check(sp)
+
+	assert.Equal(time.Second, next, "first restart is after the minimum backoff")
+	health, _ := testObject.health(HelloWorldPluginInterfaceName)
+	assert.Equal(PluginUnhealthy, health.Status)
+	assert.Equal("plugin exited", health.LastError)
+	assert.NotNil(health.NextRestart)
+	assert.Equal(PluginEvent{Provider: HelloWorldPluginInterfaceName, Status: PluginUnhealthy, Err: errors.New("plugin exited")}, <-events)
+
+	p.set(nil, nil)
+	next = testObject.check(sp)
+
+	assert.Equal(time.Hour, next)
+	assert.Equal(1, p.startCount())
+	health, _ = testObject.health(HelloWorldPluginInterfaceName)
+	assert.Equal(PluginRunning, health.Status)
+	assert.Equal(uint64(1), health.Restarts)
+	assert.Nil(health.NextRestart)
+	assert.Equal(PluginRestarting, (<-events).Status)
+	assert.Equal(PluginEvent{Provider: HelloWorldPluginInterfaceName, Status: PluginRunning, Restarts: 1}, <-events)
+}
+
+func TestSupervisor_check_backsOffWhenRestartFails(t *testing.T) {
+	assert := testifyassert.New(t)
+	p := &fakePlugin{}
+	testObject, _ := newTestSupervisor(p)
+	sp := testObject.plugins[HelloWorldPluginInterfaceName]
+
+	p.set(errors.New("plugin exited"), errors.New("unable to start"))
+	delays := []time.Duration{testObject.check(sp)}
+	for i := 0; i < 3; i++ {
+		delays = append(delays, testObject.check(sp))
+	}
+
+	assert.Equal([]time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 4 * time.Second}, delays)
+	assert.Equal(3, p.startCount())
+	health, _ := testObject.health(HelloWorldPluginInterfaceName)
+	assert.Equal(PluginUnhealthy, health.Status)
+	assert.Equal("unable to start", health.LastError)
+	assert.Equal(uint64(0), health.Restarts)
+}
+
+func TestSupervisor_reload(t *testing.T) {
+	assert := testifyassert.New(t)
+	p := &fakePlugin{}
+	testObject, _ := newTestSupervisor(p)
+
+	ok, err := testObject.reload(HelloWorldPluginInterfaceName)
+
+	assert.NoError(err)
+	assert.True(ok)
+	health, _ := testObject.health(HelloWorldPluginInterfaceName)
+	assert.Equal(uint64(1), health.Restarts)
+
+	_, err = testObject.reload(SecurityPluginInterfaceName)
+
+	assert.EqualError(err, "no such plugin provider: security")
+}
+
+func TestSupervisor_run_restartsCrashedPlugin(t *testing.T) {
+	p := &fakePlugin{pingErr: errors.New("plugin exited")}
+	testObject := newSupervisor(map[PluginInterfaceName]managedPlugin{
+		HelloWorldPluginInterfaceName: p,
+	}, new(event.Feed), time.Millisecond, time.Millisecond, 10*time.Millisecond)
+
+	testObject.start()
+	deadline := time.Now().Add(5 * time.Second)
+	for p.startCount() == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	testObject.stop()
+
+	testifyrequire.NotZero(t, p.startCount())
+	health, _ := testObject.health(HelloWorldPluginInterfaceName)
+	testifyassert.Equal(t, PluginStopped, health.Status)
+}
+
+func TestPluginManager_PluginsInfo_includesHealth(t *testing.T) {
+	assert := testifyassert.New(t)
+	testObject := typicalPluginManager(t)
+	testObject.initializedPlugins[HelloWorldPluginInterfaceName] = &fakePlugin{}
+
+	testifyrequire.NoError(t, testObject.Start(nil))
+	defer testObject.Stop()
+	info := testObject.PluginsInfo().(map[PluginInterfaceName]interface{})
+
+	health := info[HelloWorldPluginInterfaceName].(map[string]interface{})["health"].(PluginHealth)
+	assert.Equal(PluginRunning, health.Status)
+}
@@ -0,0 +1,60 @@
+package txvalidator
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_txvalidator"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+type PluginGateway struct {
+	client proto_txvalidator.TransactionValidatorClient
+}
+
+func (g *PluginGateway) ValidateTransaction(ctx context.Context, tx *types.Transaction, from common.Address, metadata *engine.ExtraMetadata, blockNumber uint64) (bool, string, error) {
+	raw, err := rlp.EncodeToBytes(tx)
+	if err != nil {
+		return false, "", err
+	}
+	req := &proto_txvalidator.ValidateTransactionRequest{
+		RawTransaction:  raw,
+		Hash:            tx.Hash().Bytes(),
+		From:            from.Bytes(),
+		Value:           tx.Value().Bytes(),
+		Data:            tx.Data(),
+		Nonce:           tx.Nonce(),
+		Gas:             tx.Gas(),
+		IsPrivate:       tx.IsPrivate(),
+		PrivacyMetadata: toProtoMetadata(metadata),
+		BlockNumber:     blockNumber,
+	}
+	if tx.To() != nil {
+		req.To = tx.To().Bytes()
+	}
+	resp, err := g.client.ValidateTransaction(ctx, req)
+	if err != nil {
+		return false, "", err
+	}
+	return resp.Allowed, resp.Reason, nil
+}
+
+func toProtoMetadata(extra *engine.ExtraMetadata) *proto_txvalidator.PrivacyMetadata {
+	if extra == nil {
+		return nil
+	}
+	acHashes := make([][]byte, 0, len(extra.ACHashes))
+	for h := range extra.ACHashes {
+		acHashes = append(acHashes, h.Bytes())
+	}
+	// deterministic order for the same metadata
+	sort.Slice(acHashes, func(i, j int) bool { return string(acHashes[i]) < string(acHashes[j]) })
+	return &proto_txvalidator.PrivacyMetadata{
+		PrivacyFlag:  uint64(extra.PrivacyFlag),
+		AcHashes:     acHashes,
+		AcMerkleRoot: extra.ACMerkleRoot.Bytes(),
+	}
+}
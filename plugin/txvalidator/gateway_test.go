@@ -0,0 +1,110 @@
+package txvalidator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/plugin/gen/proto_txvalidator"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// testValidator records the request and denies transactions to blocked
+type testValidator struct {
+	blocked common.Address
+	req     *proto_txvalidator.ValidateTransactionRequest
+}
+
+func (v *testValidator) ValidateTransaction(_ context.Context, req *proto_txvalidator.ValidateTransactionRequest) (*proto_txvalidator.ValidateTransactionResponse, error) {
+	v.req = req
+	if common.BytesToAddress(req.To) == v.blocked {
+		return &proto_txvalidator.ValidateTransactionResponse{Reason: "recipient is blocked"}, nil
+	}
+	return &proto_txvalidator.ValidateTransactionResponse{Allowed: true}, nil
+}
+
+// testConnector serves the test validator as a plugin would
+type testConnector struct {
+	PluginConnector
+	impl proto_txvalidator.TransactionValidatorServer
+}
+
+func (c *testConnector) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	proto_txvalidator.RegisterTransactionValidatorServer(s, c.impl)
+	return nil
+}
+
+func newTestGateway(t *testing.T, impl proto_txvalidator.TransactionValidatorServer) (*PluginGateway, func()) {
+	client, server := plugin.TestPluginGRPCConn(t, map[string]plugin.Plugin{
+		ConnectorName: &testConnector{impl: impl},
+	})
+	raw, err := client.Dispense(ConnectorName)
+	require.NoError(t, err)
+	return raw.(*PluginGateway), func() {
+		_ = client.Close()
+		server.Stop()
+	}
+}
+
+func TestPluginGateway_ValidateTransaction(t *testing.T) {
+	impl := &testValidator{blocked: common.HexToAddress("0xb10c")}
+	testObject, done := newTestGateway(t, impl)
+	defer done()
+	to := common.HexToAddress("0x1")
+	tx := types.NewTransaction(3, to, big.NewInt(10), 21000, big.NewInt(0), []byte("arbitrary data"))
+	tx.SetPrivate()
+	from := common.HexToAddress("0xf")
+	root := common.StringToHash("arbitrary root")
+
+	allowed, reason, err := testObject.ValidateTransaction(context.Background(), tx, from, &engine.ExtraMetadata{
+		ACHashes:     common.EncryptedPayloadHashes{},
+		ACMerkleRoot: root,
+		PrivacyFlag:  engine.PrivacyFlagPartyProtection,
+	}, 12)
+
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Empty(t, reason)
+	assert.Equal(t, tx.Hash().Bytes(), impl.req.Hash)
+	assert.Equal(t, from.Bytes(), impl.req.From)
+	assert.Equal(t, to.Bytes(), impl.req.To)
+	assert.Equal(t, []byte{10}, impl.req.Value)
+	assert.Equal(t, []byte("arbitrary data"), impl.req.Data)
+	assert.Equal(t, uint64(3), impl.req.Nonce)
+	assert.Equal(t, uint64(21000), impl.req.Gas)
+	assert.True(t, impl.req.IsPrivate)
+	assert.Equal(t, uint64(engine.PrivacyFlagPartyProtection), impl.req.PrivacyMetadata.PrivacyFlag)
+	assert.Equal(t, root.Bytes(), impl.req.PrivacyMetadata.AcMerkleRoot)
+	assert.Equal(t, uint64(12), impl.req.BlockNumber)
+	var decoded types.Transaction
+	require.NoError(t, rlp.DecodeBytes(impl.req.RawTransaction, &decoded))
+	assert.Equal(t, tx.Hash(), decoded.Hash())
+}
+
+func TestPluginGateway_ValidateTransaction_whenDenied(t *testing.T) {
+	impl := &testValidator{blocked: common.HexToAddress("0xb10c")}
+	testObject, done := newTestGateway(t, impl)
+	defer done()
+	tx := types.NewContractCreation(0, big.NewInt(0), 21000, big.NewInt(0), nil)
+
+	allowed, _, err := testObject.ValidateTransaction(context.Background(), tx, common.Address{}, nil, 0)
+
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Empty(t, impl.req.To, "contract creation has no recipient")
+	assert.Nil(t, impl.req.PrivacyMetadata)
+
+	tx = types.NewTransaction(0, impl.blocked, big.NewInt(0), 21000, big.NewInt(0), nil)
+	allowed, reason, err := testObject.ValidateTransaction(context.Background(), tx, common.Address{}, nil, 0)
+
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, "recipient is blocked", reason)
+}
@@ -0,0 +1,116 @@
+package txvalidator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/private"
+	"github.com/ethereum/go-ethereum/private/engine"
+)
+
+// how long to wait for the plugin to validate a transaction by default
+const DefaultTimeout = time.Second
+
+var ErrTransactionDenied = errors.New("transaction denied")
+
+// Config configures how geth calls the transaction validator plugin
+type Config struct {
+	// maximum time to wait for the plugin to validate a transaction, e.g. "500ms"
+	Timeout string `json:"timeout,omitempty" toml:",omitempty"`
+	// allow transactions when the plugin fails or times out, rather than
+	// denying them. This applies to the transactions the node accepts into
+	// its pool and puts into the blocks it produces, never to imported blocks.
+	FailOpen bool `json:"failOpen,omitempty" toml:",omitempty"`
+	// also reject the imported blocks with transactions the plugin denies.
+	// Every node of the network must then run the plugin with the same
+	// rules. The plugin is called without privacy metadata, and a failing
+	// plugin stops the node importing blocks until it is back.
+	ValidateBlocks bool `json:"validateBlocks,omitempty" toml:",omitempty"`
+}
+
+// timeout returns the maximum time to wait for the plugin
+func (cfg *Config) timeout() (time.Duration, error) {
+	if cfg == nil || cfg.Timeout == "" {
+		return DefaultTimeout, nil
+	}
+	timeout, err := time.ParseDuration(cfg.Timeout)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timeout %s: %v", cfg.Timeout, err)
+	}
+	if timeout <= 0 {
+		return 0, fmt.Errorf("invalid timeout %s: must be positive", cfg.Timeout)
+	}
+	return timeout, nil
+}
+
+// NewValidationFunc returns the function validating transactions with the
+// plugin, see core.TransactionValidationFunc
+func NewValidationFunc(v TransactionValidator, cfg *Config) (func(tx *types.Transaction, from common.Address, blockNumber uint64) error, error) {
+	timeout, err := cfg.timeout()
+	if err != nil {
+		return nil, err
+	}
+	failOpen := cfg != nil && cfg.FailOpen
+	return func(tx *types.Transaction, from common.Address, blockNumber uint64) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		allowed, reason, err := v.ValidateTransaction(ctx, tx, from, privacyMetadata(tx), blockNumber)
+		if err != nil {
+			if failOpen {
+				log.Warn("Allowing transaction the transaction validator plugin failed to validate", "hash", tx.Hash(), "err", err)
+				return nil
+			}
+			return fmt.Errorf("%v: transaction validator plugin failed: %v", ErrTransactionDenied, err)
+		}
+		if !allowed {
+			return fmt.Errorf("%v: %s", ErrTransactionDenied, reason)
+		}
+		return nil
+	}, nil
+}
+
+// NewBlockValidationFunc returns the function validating the transactions of
+// imported blocks with the plugin, see core.BlockTransactionValidationFunc, or
+// nil if the configuration does not enable block validation. The plugin gets
+// no privacy metadata, which only the participants of a private transaction
+// have, and its failures deny the transaction whatever FailOpen says.
+func NewBlockValidationFunc(v TransactionValidator, cfg *Config) (func(tx *types.Transaction, from common.Address, blockNumber uint64) error, error) {
+	if cfg == nil || !cfg.ValidateBlocks {
+		return nil, nil
+	}
+	timeout, err := cfg.timeout()
+	if err != nil {
+		return nil, err
+	}
+	return func(tx *types.Transaction, from common.Address, blockNumber uint64) error {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		allowed, reason, err := v.ValidateTransaction(ctx, tx, from, nil, blockNumber)
+		if err != nil {
+			return fmt.Errorf("%v: transaction validator plugin failed: %v", ErrTransactionDenied, err)
+		}
+		if !allowed {
+			return fmt.Errorf("%v: %s", ErrTransactionDenied, reason)
+		}
+		return nil
+	}, nil
+}
+
+// privacyMetadata returns the privacy metadata of a private transaction the
+// node is a participant of
+func privacyMetadata(tx *types.Transaction) *engine.ExtraMetadata {
+	if !tx.IsPrivate() || private.P == nil {
+		return nil
+	}
+	_, extra, err := private.P.Receive(common.BytesToEncryptedPayloadHash(tx.Data()))
+	if err != nil {
+		log.Debug("Unable to get the privacy metadata of transaction", "hash", tx.Hash(), "err", err)
+		return nil
+	}
+	return extra
+}
@@ -0,0 +1,114 @@
+package txvalidator
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/private/engine"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type validatorFunc func(ctx context.Context) (bool, string, error)
+
+func (f validatorFunc) ValidateTransaction(ctx context.Context, _ *types.Transaction, _ common.Address, _ *engine.ExtraMetadata, _ uint64) (bool, string, error) {
+	return f(ctx)
+}
+
+var arbitraryTx = types.NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(0), 21000, big.NewInt(0), nil)
+
+func TestNewValidationFunc_whenDenied(t *testing.T) {
+	validate, err := NewValidationFunc(validatorFunc(func(context.Context) (bool, string, error) {
+		return false, "sender has not passed KYC", nil
+	}), nil)
+	require.NoError(t, err)
+
+	assert.EqualError(t, validate(arbitraryTx, common.Address{}, 0), "transaction denied: sender has not passed KYC")
+}
+
+func TestNewValidationFunc_whenPluginFails(t *testing.T) {
+	failing := validatorFunc(func(context.Context) (bool, string, error) {
+		return false, "", errors.New("connection refused")
+	})
+
+	failClosed, err := NewValidationFunc(failing, &Config{})
+	require.NoError(t, err)
+	failOpen, err := NewValidationFunc(failing, &Config{FailOpen: true})
+	require.NoError(t, err)
+
+	assert.EqualError(t, failClosed(arbitraryTx, common.Address{}, 0), "transaction denied: transaction validator plugin failed: connection refused")
+	assert.NoError(t, failOpen(arbitraryTx, common.Address{}, 0))
+}
+
+func TestNewValidationFunc_whenPluginTimesOut(t *testing.T) {
+	blocking := validatorFunc(func(ctx context.Context) (bool, string, error) {
+		<-ctx.Done()
+		return false, "", ctx.Err()
+	})
+
+	validate, err := NewValidationFunc(blocking, &Config{Timeout: "10ms"})
+	require.NoError(t, err)
+
+	assert.EqualError(t, validate(arbitraryTx, common.Address{}, 0), "transaction denied: transaction validator plugin failed: context deadline exceeded")
+}
+
+func TestNewValidationFunc_whenTimeoutIsInvalid(t *testing.T) {
+	_, err := NewValidationFunc(validatorFunc(nil), &Config{Timeout: "soon"})
+	assert.Error(t, err)
+
+	_, err = NewValidationFunc(validatorFunc(nil), &Config{Timeout: "-1s"})
+	assert.EqualError(t, err, "invalid timeout -1s: must be positive")
+}
+
+type metadataValidator struct {
+	metadata *engine.ExtraMetadata
+	called   bool
+}
+
+func (v *metadataValidator) ValidateTransaction(_ context.Context, _ *types.Transaction, _ common.Address, metadata *engine.ExtraMetadata, _ uint64) (bool, string, error) {
+	v.metadata, v.called = metadata, true
+	return true, "", nil
+}
+
+func TestNewBlockValidationFunc_whenDisabled(t *testing.T) {
+	validate, err := NewBlockValidationFunc(validatorFunc(nil), nil)
+	require.NoError(t, err)
+	assert.Nil(t, validate)
+
+	validate, err = NewBlockValidationFunc(validatorFunc(nil), &Config{})
+	require.NoError(t, err)
+	assert.Nil(t, validate)
+}
+
+func TestNewBlockValidationFunc_whenPluginFails(t *testing.T) {
+	failing := validatorFunc(func(context.Context) (bool, string, error) {
+		return false, "", errors.New("connection refused")
+	})
+
+	validate, err := NewBlockValidationFunc(failing, &Config{ValidateBlocks: true, FailOpen: true})
+	require.NoError(t, err)
+
+	assert.EqualError(t, validate(arbitraryTx, common.Address{}, 1), "transaction denied: transaction validator plugin failed: connection refused")
+}
+
+func TestNewBlockValidationFunc_withoutPrivacyMetadata(t *testing.T) {
+	v := new(metadataValidator)
+	validate, err := NewBlockValidationFunc(v, &Config{ValidateBlocks: true})
+	require.NoError(t, err)
+
+	privateTx := types.NewTransaction(0, common.HexToAddress("0x1"), big.NewInt(0), 21000, big.NewInt(0), nil)
+	privateTx.SetPrivate()
+
+	assert.NoError(t, validate(privateTx, common.Address{}, 1))
+	assert.True(t, v.called)
+	assert.Nil(t, v.metadata)
+}
+
+func TestNewBlockValidationFunc_whenTimeoutIsInvalid(t *testing.T) {
+	_, err := NewBlockValidationFunc(validatorFunc(nil), &Config{ValidateBlocks: true, Timeout: "-1s"})
+	assert.EqualError(t, err, "invalid timeout -1s: must be positive")
+}
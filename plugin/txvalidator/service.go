@@ -0,0 +1,33 @@
+package txvalidator
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/private/engine"
+)
+
+// TransactionValidator allows or denies transactions based on custom rules.
+// Denied transactions come with a reason.
+type TransactionValidator interface {
+	// metadata is the privacy metadata of a private transaction, which is nil
+	// if the node is not a participant or the block is validated. blockNumber
+	// is the block the node produces or validates, or 0 for a transaction
+	// entering the transaction pool.
+	ValidateTransaction(ctx context.Context, tx *types.Transaction, from common.Address, metadata *engine.ExtraMetadata, blockNumber uint64) (bool, string, error)
+}
+
+type TransactionValidatorDeferFunc func() (TransactionValidator, error)
+
+type ReloadableTransactionValidator struct {
+	DeferFunc TransactionValidatorDeferFunc
+}
+
+func (d *ReloadableTransactionValidator) ValidateTransaction(ctx context.Context, tx *types.Transaction, from common.Address, metadata *engine.ExtraMetadata, blockNumber uint64) (bool, string, error) {
+	v, err := d.DeferFunc()
+	if err != nil {
+		return false, "", err
+	}
+	return v.ValidateTransaction(ctx, tx, from, metadata, blockNumber)
+}
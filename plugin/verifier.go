@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"path"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -29,11 +30,7 @@ func NewVerifier(pm *PluginManager, localVerify bool, publicKey string) (Verifie
 	log.Debug("using verifier", "local", localVerify)
 	pluginBaseDir := pm.pluginBaseDir
 	centralClient := pm.centralClient
-	// resolve public key
-	if publicKey == "" {
-		publicKey = fmt.Sprintf("file://%s", path.Join(pluginBaseDir, DefaultPublicKeyFile))
-	}
-	publicKeyPath, err := resolveFilePath(publicKey)
+	publicKeyPath, err := resolvePublicKeyPath(publicKey, pluginBaseDir)
 	if err != nil {
 		return nil, err
 	}
@@ -43,3 +40,28 @@ func NewVerifier(pm *PluginManager, localVerify bool, publicKey string) (Verifie
 		return NewOnlineVerifier(centralClient), nil
 	}
 }
+
+// NewOfflineVerifier verifies the plugins in the plugin base directory
+// without Plugin Central, as when installing them from a local registry
+func NewOfflineVerifier(pluginBaseDir string, skipVerify bool, publicKey string) (Verifier, error) {
+	if skipVerify {
+		return NewNonVerifier(), nil
+	}
+	publicKeyPath, err := resolvePublicKeyPath(publicKey, pluginBaseDir)
+	if err != nil {
+		return nil, err
+	}
+	if !common.FileExist(publicKeyPath) {
+		return nil, fmt.Errorf("PGP public key %s not found", publicKeyPath)
+	}
+	return NewLocalVerifier(publicKeyPath, pluginBaseDir)
+}
+
+// resolve the URI of the PGP public key, which defaults to the one in the
+// plugin base directory
+func resolvePublicKeyPath(publicKey string, pluginBaseDir string) (string, error) {
+	if publicKey == "" {
+		publicKey = fmt.Sprintf("file://%s", path.Join(pluginBaseDir, DefaultPublicKeyFile))
+	}
+	return resolveFilePath(publicKey)
+}
@@ -106,8 +106,8 @@ func (g *constellation) Receive(data common.EncryptedPayloadHash) ([]byte, *engi
 	return privatePayload, &extra, nil
 }
 
-func (g *constellation) Name() string {
-	return "Constellation"
+func (g *constellation) Name() (string, error) {
+	return "Constellation", nil
 }
 
 func (g *constellation) HasFeature(f engine.PrivateTransactionManagerFeature) bool {
@@ -50,8 +50,8 @@ func (ptm *PrivateTransactionManager) ReceiveRaw(data common.EncryptedPayloadHas
 	return nil, nil, engine.ErrPrivateTxManagerNotinUse
 }
 
-func (ptm *PrivateTransactionManager) Name() string {
-	return "NotInUse"
+func (ptm *PrivateTransactionManager) Name() (string, error) {
+	return "NotInUse", nil
 }
 
 func (ptm *PrivateTransactionManager) HasFeature(f engine.PrivateTransactionManagerFeature) bool {
@@ -10,7 +10,8 @@ import (
 
 func TestName(t *testing.T) {
 	ptm := &PrivateTransactionManager{}
-	name := ptm.Name()
+	name, err := ptm.Name()
+	assert.NoError(t, err)
 
 	assert.Equal(t, name, "NotInUse", "got wrong name for NotInUsePrivateTxManager")
 }
@@ -371,8 +371,8 @@ func (t *tesseraPrivateTxManager) GetParticipants(txHash common.EncryptedPayload
 	return split, nil
 }
 
-func (t *tesseraPrivateTxManager) Name() string {
-	return "Tessera"
+func (t *tesseraPrivateTxManager) Name() (string, error) {
+	return "Tessera", nil
 }
 
 func (t *tesseraPrivateTxManager) HasFeature(f engine.PrivateTransactionManagerFeature) bool {
@@ -25,7 +25,7 @@ var (
 )
 
 type Identifiable interface {
-	Name() string
+	Name() (string, error)
 	HasFeature(f engine.PrivateTransactionManagerFeature) bool
 }
 
@@ -116,7 +116,8 @@ func selectPrivateTxManager(client *engine.Client) (PrivateTransactionManager, e
 	}
 	var privateTxManager PrivateTransactionManager
 	defer func() {
-		log.Info("Target Private Tx Manager", "name", privateTxManager.Name(), "distributionVersion", version)
+		name, _ := privateTxManager.Name()
+		log.Info("Target Private Tx Manager", "name", name, "distributionVersion", version)
 	}()
 	if res.StatusCode != 200 {
 		// Constellation doesn't have /version endpoint
@@ -1,11 +1,11 @@
 package raft
 
 import (
-	"crypto/ecdsa"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth"
@@ -34,7 +34,7 @@ type RaftService struct {
 	// we need an event mux to instantiate the blockchain
 	eventMux         *event.TypeMux
 	minter           *minter
-	nodeKey          *ecdsa.PrivateKey
+	signer           consensus.Signer // Quorum: signs the blocks with the node key or the signer plugin
 	calcGasLimitFunc func(block *types.Block) uint64
 }
 
@@ -47,10 +47,12 @@ func New(ctx *node.ServiceContext, chainConfig *params.ChainConfig, raftId, raft
 		accountManager:   e.AccountManager(),
 		downloader:       e.Downloader(),
 		startPeers:       startPeers,
-		nodeKey:          ctx.NodeKey(),
 		calcGasLimitFunc: e.CalcGasLimit,
 	}
 
+	if service.signer = e.ConsensusSigner(); service.signer == nil {
+		service.signer = consensus.NewKeySigner(ctx.NodeKey())
+	}
 	service.minter = newMinter(chainConfig, service, blockTime)
 
 	var err error
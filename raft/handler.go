@@ -703,6 +703,10 @@ func (pm *ProtocolManager) minedBroadcastLoop() {
 	for obj := range pm.minedBlockSub.Chan() {
 		switch ev := obj.Data.(type) {
 		case core.NewMinedBlockEvent:
+			// blocks sealed by Istanbul after the migration aren't ordered by raft
+			if pm.blockchain.Config().IsRaftMigration(ev.Block.Number()) {
+				continue
+			}
 			select {
 			case pm.blockProposalC <- ev.Block:
 			case <-pm.quitSync:
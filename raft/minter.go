@@ -30,7 +30,6 @@ import (
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
@@ -310,6 +309,11 @@ func (minter *minter) mintNewBlock() {
 	defer minter.mu.Unlock()
 
 	work := minter.createWork()
+	if minter.chain.Config().IsRaftMigration(work.header.Number) {
+		log.Info("Stopped minting since Istanbul took over block production", "number", work.header.Number)
+		atomic.StoreInt32(&minter.minting, 0)
+		return
+	}
 	transactions := minter.getTransactions()
 
 	committedTxes, publicReceipts, _, logs := work.commitTransactions(transactions, minter.chain)
@@ -375,6 +379,16 @@ func (env *work) commitTransactions(txes *types.TransactionsByPriceAndNonce, bc
 			break
 		}
 
+		// Quorum - apply the custom rules of the transaction validator plugin
+		// to the block being produced
+		if core.TransactionValidationFunc != nil {
+			if err := core.TransactionValidationFunc(tx, tx.From(), env.header.Number.Uint64()); err != nil {
+				log.Info("TX denied by the validator plugin, will be removed", "hash", tx.Hash(), "err", err)
+				txes.Pop() // skip rest of txes from this account
+				continue
+			}
+		}
+
 		env.publicState.Prepare(tx.Hash(), common.Hash{}, txCount)
 
 		publicReceipt, privateReceipt, err := env.commitTransaction(tx, bc, gp)
@@ -422,8 +436,7 @@ func (env *work) commitTransaction(tx *types.Transaction, bc *core.BlockChain, g
 
 func (minter *minter) buildExtraSeal(headerHash common.Hash) []byte {
 	//Sign the headerHash
-	nodeKey := minter.eth.nodeKey
-	sig, err := crypto.Sign(headerHash.Bytes(), nodeKey)
+	sig, err := minter.eth.signer.SignHash(headerHash.Bytes())
 	if err != nil {
 		log.Warn("Block sealing failed", "err", err)
 	}
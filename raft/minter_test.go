@@ -11,6 +11,7 @@ import (
 	mapset "github.com/deckarep/golang-set"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/node"
@@ -28,7 +29,7 @@ func TestSignHeader(t *testing.T) {
 	nodeKey := config.NodeKey()
 
 	raftProtocolManager := &ProtocolManager{raftId: testRaftId}
-	raftService := &RaftService{nodeKey: nodeKey, raftProtocolManager: raftProtocolManager}
+	raftService := &RaftService{signer: consensus.NewKeySigner(nodeKey), raftProtocolManager: raftProtocolManager}
 	minter := minter{eth: raftService}
 
 	//create some fake header to sign
@@ -203,6 +204,6 @@ func newTestRaftService(t *testing.T, raftId uint16, nodes []uint64, learners []
 		confState:           raftpb.ConfState{Nodes: nodes, Learners: learners},
 		p2pServer:           mockp2p,
 	}
-	raftService := &RaftService{nodeKey: nodeKey, raftProtocolManager: raftProtocolManager}
+	raftService := &RaftService{signer: consensus.NewKeySigner(nodeKey), raftProtocolManager: raftProtocolManager}
 	return raftService
 }
@@ -50,6 +50,26 @@ func TestClientRequest(t *testing.T) {
 	}
 }
 
+func TestClientErrorData(t *testing.T) {
+	server := newTestServer()
+	defer server.Stop()
+	client := DialInProc(server)
+	defer client.Close()
+
+	var resp interface{}
+	err := client.Call(&resp, "test_returnError")
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	de, ok := err.(DataError)
+	if !ok {
+		t.Fatalf("client did not return DataError: %#v", err)
+	}
+	if data := de.ErrorData(); !reflect.DeepEqual(data, "testError data") {
+		t.Fatalf("wrong error data %#v", data)
+	}
+}
+
 func TestClientBatchRequest(t *testing.T) {
 	server := newTestServer()
 	defer server.Stop()
@@ -331,6 +331,9 @@ func (h *handler) handleCall(cp *callProc, msg *jsonrpcMessage) *jsonrpcMessage
 	if callb == nil {
 		return msg.errorResponse(&methodNotFoundError{method: msg.Method})
 	}
+	if callb.isRaw {
+		return h.runMethod(cp.ctx, msg, callb, []reflect.Value{reflect.ValueOf(msg.Params)})
+	}
 	args, err := parsePositionalArguments(msg.Params, callb.argTypes)
 	if err != nil {
 		return msg.errorResponse(&invalidParamsError{err.Error()})
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
 )
@@ -24,6 +26,38 @@ const (
 	ctxPreauthenticatedToken = securityContextKey("PREAUTHENTICATED_TOKEN") // key to save the preauthenticated token once authenticated
 )
 
+// meters of the requests and calls rejected by the security checks, per reason
+var (
+	authPluginFailureMeter = metrics.NewRegisteredMeter("rpc/security/failures/plugin", nil)
+	authMissingTokenMeter  = metrics.NewRegisteredMeter("rpc/security/failures/missingtoken", nil)
+	authInvalidTokenMeter  = metrics.NewRegisteredMeter("rpc/security/failures/invalidtoken", nil)
+	authExpiredTokenMeter  = metrics.NewRegisteredMeter("rpc/security/failures/expiredtoken", nil)
+	authAccessDeniedMeter  = metrics.NewRegisteredMeter("rpc/security/failures/accessdenied", nil)
+	authCallDeniedMeter    = metrics.NewRegisteredMeter("rpc/security/failures/calldenied", nil)
+)
+
+// AuthorizeCallFunc further restricts the calls granted by the security plugin,
+// e.g. based on the on-chain permissions of the account the authenticated identity maps to
+type AuthorizeCallFunc func(token *proto.PreAuthenticatedAuthenticationToken, service, method string) error
+
+var (
+	authorizeCallMux sync.RWMutex
+	authorizeCall    AuthorizeCallFunc
+)
+
+// SetAuthorizeCallFunc sets the function restricting the granted calls, nil removes it
+func SetAuthorizeCallFunc(f AuthorizeCallFunc) {
+	authorizeCallMux.Lock()
+	defer authorizeCallMux.Unlock()
+	authorizeCall = f
+}
+
+func getAuthorizeCallFunc() AuthorizeCallFunc {
+	authorizeCallMux.RLock()
+	defer authorizeCallMux.RUnlock()
+	return authorizeCall
+}
+
 type securityContextConfigurer interface {
 	Configure(secCtx securityContext)
 }
@@ -91,13 +125,20 @@ func secureCall(resolver securityContextResolver, msg *jsonrpcMessage) error {
 	}
 	if authToken, isPreauthenticated := secCtx.Value(ctxPreauthenticatedToken).(*proto.PreAuthenticatedAuthenticationToken); isPreauthenticated {
 		if err := verifyExpiration(authToken); err != nil {
+			authExpiredTokenMeter.Mark(1)
 			return err
 		}
 		elem := strings.SplitN(msg.Method, serviceMethodSeparator, 2)
 		if len(elem) != 2 {
 			log.Warn("unsupported method when performing authorization check", "method", msg.Method)
 		} else if err := verifyAccess(elem[0], elem[1], authToken.Authorities); err != nil {
+			authAccessDeniedMeter.Mark(1)
 			return err
+		} else if authorize := getAuthorizeCallFunc(); authorize != nil {
+			if err := authorize(authToken, elem[0], elem[1]); err != nil {
+				authCallDeniedMeter.Mark(1)
+				return &securityError{err.Error()}
+			}
 		}
 	}
 	return nil
@@ -202,6 +202,35 @@ func TestSecureCall_whenMethodInJSONMessageIsNotSupported(t *testing.T) {
 	assert.NoError(err)
 }
 
+func TestSecureCall_whenAuthorizeCallFuncDenies(t *testing.T) {
+	assert := testifyassert.New(t)
+	expiredAt, _ := ptypes.TimestampProto(time.Now().Add(1 * time.Hour))
+	stubSecurityContextResolver := newStubSecurityContextResolver([]struct{ k, v interface{} }{
+		{ctxPreauthenticatedToken, &proto.PreAuthenticatedAuthenticationToken{
+			ExpiredAt: expiredAt,
+			Authorities: []*proto.GrantedAuthority{
+				{
+					Service: "*",
+					Method:  "*",
+				},
+			},
+		}},
+	})
+	defer SetAuthorizeCallFunc(nil)
+	SetAuthorizeCallFunc(func(_ *proto.PreAuthenticatedAuthenticationToken, service, method string) error {
+		if service == "quorumPermission" {
+			return errors.New(service + "_" + method + " - not an org admin")
+		}
+		return nil
+	})
+
+	assert.NoError(secureCall(stubSecurityContextResolver, &jsonrpcMessage{Method: "eth_blockNumber"}))
+	err := secureCall(stubSecurityContextResolver, &jsonrpcMessage{Method: "quorumPermission_addOrg"})
+
+	assert.EqualError(err, "quorumPermission_addOrg - not an org admin")
+	assert.Equal(-32001, err.(Error).ErrorCode())
+}
+
 type stubSecurityContextResolver struct {
 	ctx securityContext
 }
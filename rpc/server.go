@@ -155,6 +155,7 @@ func (s *Server) authenticateHttpRequest(r *http.Request, cfg securityContextCon
 	if isAuthEnabled, err := s.authenticationManager.IsEnabled(context.Background()); err != nil {
 		// this indicates a failure in the plugin. We don't want any subsequent request unchecked
 		log.Error("failure when checking if authentication manager is enabled", "err", err)
+		authPluginFailureMeter.Mark(1)
 		securityContext = context.WithValue(securityContext, ctxAuthenticationError, &securityError{"internal error"})
 		return
 	} else if !isAuthEnabled {
@@ -162,11 +163,13 @@ func (s *Server) authenticateHttpRequest(r *http.Request, cfg securityContextCon
 	}
 	if token, hasToken := extractToken(r); hasToken {
 		if authToken, err := s.authenticationManager.Authenticate(context.Background(), token); err != nil {
+			authInvalidTokenMeter.Mark(1)
 			securityContext = context.WithValue(securityContext, ctxAuthenticationError, &securityError{err.Error()})
 		} else {
 			securityContext = context.WithValue(securityContext, ctxPreauthenticatedToken, authToken)
 		}
 	} else {
+		authMissingTokenMeter.Mark(1)
 		securityContext = context.WithValue(securityContext, ctxAuthenticationError, &securityError{"missing access token"})
 	}
 }
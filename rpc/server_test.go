@@ -20,6 +20,7 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -33,6 +34,7 @@ import (
 	"github.com/golang/protobuf/ptypes"
 	"github.com/jpmorganchase/quorum-security-plugin-sdk-go/proto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestServerRegisterName(t *testing.T) {
@@ -52,12 +54,39 @@ func TestServerRegisterName(t *testing.T) {
 		t.Fatalf("Expected service calc to be registered")
 	}
 
-	wantCallbacks := 7
+	wantCallbacks := 8
 	if len(svc.callbacks) != wantCallbacks {
 		t.Errorf("Expected %d callbacks for service 'service', got %d", wantCallbacks, len(svc.callbacks))
 	}
 }
 
+func TestServer_RawMethods(t *testing.T) {
+	server := NewServer()
+	defer server.Stop()
+	var received json.RawMessage
+	err := server.RegisterName("plugin@kyc", RawMethods{
+		"check": func(_ context.Context, params json.RawMessage) (interface{}, error) {
+			received = params
+			return json.RawMessage(`{"approved":true}`), nil
+		},
+		"fail": func(_ context.Context, _ json.RawMessage) (interface{}, error) {
+			return nil, errors.New("arbitrary error")
+		},
+	})
+	require.NoError(t, err)
+	client := DialInProc(server)
+	defer client.Close()
+
+	var result map[string]interface{}
+	err = client.Call(&result, "plugin@kyc_check", "0x1", map[string]int{"level": 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"approved": true}, result)
+	assert.JSONEq(t, `["0x1",{"level":2}]`, string(received))
+	assert.EqualError(t, client.Call(nil, "plugin@kyc_fail"), "arbitrary error")
+	assert.Error(t, server.RegisterName("plugin@kyc", RawMethods{}))
+}
+
 func TestServer(t *testing.T) {
 	files, err := ioutil.ReadDir("testdata")
 	if err != nil {
@@ -18,6 +18,7 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -38,6 +39,19 @@ var (
 	stringType       = reflect.TypeOf("")
 )
 
+// Quorum
+//
+// RawMethod is a method taking its parameters as the raw JSON array of the
+// request, for methods which are only known at runtime, e.g. declared by a
+// plugin. The result is encoded as JSON, a json.RawMessage being passed
+// through as is.
+type RawMethod func(ctx context.Context, params json.RawMessage) (interface{}, error)
+
+// RawMethods is a service made of raw methods, by method name. It is
+// registered like any other service and its methods go through the same
+// authorization checks.
+type RawMethods map[string]RawMethod
+
 type serviceRegistry struct {
 	mu       sync.Mutex
 	services map[string]service
@@ -58,6 +72,7 @@ type callback struct {
 	hasCtx      bool           // method's first argument is a context (not included in argTypes)
 	errPos      int            // err return idx, of -1 when method cannot return error
 	isSubscribe bool           // true if this is a subscription callback
+	isRaw       bool           // Quorum: true if the callback takes the raw JSON parameters, see RawMethod
 }
 
 func (r *serviceRegistry) registerName(name string, rcvr interface{}) error {
@@ -65,7 +80,12 @@ func (r *serviceRegistry) registerName(name string, rcvr interface{}) error {
 	if name == "" {
 		return fmt.Errorf("no service name for type %s", rcvrVal.Type().String())
 	}
-	callbacks := suitableCallbacks(rcvrVal)
+	var callbacks map[string]*callback
+	if methods, ok := rcvr.(RawMethods); ok {
+		callbacks = rawCallbacks(methods)
+	} else {
+		callbacks = suitableCallbacks(rcvrVal)
+	}
 	if len(callbacks) == 0 {
 		return fmt.Errorf("service %T doesn't have any suitable methods/subscriptions to expose", rcvr)
 	}
@@ -133,6 +153,22 @@ func suitableCallbacks(receiver reflect.Value) map[string]*callback {
 	return callbacks
 }
 
+// Quorum
+//
+// rawCallbacks turns the raw methods into callbacks.
+func rawCallbacks(methods RawMethods) map[string]*callback {
+	callbacks := make(map[string]*callback)
+	for name, method := range methods {
+		if method == nil {
+			continue
+		}
+		cb := newCallback(reflect.Value{}, reflect.ValueOf(method))
+		cb.isRaw = true
+		callbacks[name] = cb
+	}
+	return callbacks
+}
+
 // newCallback turns fn (a function) into a callback object. It returns nil if the function
 // is unsuitable as an RPC callback.
 func newCallback(receiver, fn reflect.Value) *callback {
@@ -77,6 +77,15 @@ func (s *testService) Sleep(ctx context.Context, duration time.Duration) {
 	time.Sleep(duration)
 }
 
+type testError struct{}
+
+func (testError) Error() string          { return "testError" }
+func (testError) ErrorData() interface{} { return "testError data" }
+
+func (s *testService) ReturnError() error {
+	return testError{}
+}
+
 func (s *testService) Rets() (string, error) {
 	return "", nil
 }
@@ -41,6 +41,14 @@ type Error interface {
 	ErrorCode() int // returns the code
 }
 
+// Quorum
+//
+// DataError is an error with structured data for the RPC clients
+type DataError interface {
+	Error() string          // returns the message
+	ErrorData() interface{} // returns the data
+}
+
 // ServerCodec implements reading, parsing and writing RPC messages for the server side of
 // a RPC session. Implementations must be go-routine safe since the codec can be called in
 // multiple go-routines concurrently.
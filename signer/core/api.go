@@ -165,7 +165,7 @@ func StartClefAccountManager(ksLocation string, nousb, lightKDF bool, plugins *p
 	// <Quorum>
 	if plugins != nil {
 		if _, ok := plugins.Providers[plugin.AccountPluginInterfaceName]; ok {
-			pluginBackend := pluggable.NewBackend()
+			pluginBackend := pluggable.NewBackend("")
 			backends = append(backends, pluginBackend)
 		}
 	}